@@ -0,0 +1,121 @@
+// Binary gotdexport exports chat history in Telegram Desktop format.
+//
+// Client is configured from environment, see telegram.OptionsFromEnvironment.
+// Phone and password are read from TG_PHONE and TG_PASSWORD, code is
+// prompted from terminal.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/gotd/td/constant"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/telegram/export"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+func codePrompt(ctx context.Context, sentCode *tg.AuthSentCode) (string, error) {
+	fmt.Print("Enter code: ")
+	code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(code), nil
+}
+
+// resolve resolves peer from username, link, phone, TDLib ID or "me".
+func resolve(ctx context.Context, m *peers.Manager, from string) (tg.InputPeerClass, error) {
+	if from == "me" {
+		return &tg.InputPeerSelf{}, nil
+	}
+	if id, err := strconv.ParseInt(from, 10, 64); err == nil {
+		p, err := m.ResolveTDLibID(ctx, constant.TDLibPeerID(id))
+		if err != nil {
+			return nil, err
+		}
+		return p.InputPeer(), nil
+	}
+
+	p, err := m.Resolve(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	return p.InputPeer(), nil
+}
+
+func run(ctx context.Context) error {
+	var (
+		from        = flag.String("peer", "", "chat to export: username, link, phone, TDLib ID or \"me\"")
+		out         = flag.String("out", "export", "output directory")
+		skipMedia   = flag.Bool("skip-media", false, "do not download media files")
+		maxFileSize = flag.Int64("max-file-size", 0, "maximum size of downloaded file in bytes, zero means no limit")
+		batchSize   = flag.Int("batch", 100, "count of messages requested at once")
+		debug       = flag.Bool("debug", false, "enable debug logging")
+	)
+	flag.Parse()
+	if *from == "" {
+		flag.Usage()
+		return errors.New("peer is required")
+	}
+
+	lvl := zapcore.InfoLevel
+	if *debug {
+		lvl = zapcore.DebugLevel
+	}
+	logger, _ := zap.NewDevelopment(zap.IncreaseLevel(lvl))
+	defer func() { _ = logger.Sync() }()
+
+	client, err := telegram.ClientFromEnvironment(telegram.Options{
+		Logger: logger,
+	})
+	if err != nil {
+		return errors.Wrap(err, "create client")
+	}
+
+	flow := auth.NewFlow(
+		auth.Env("TG_", auth.CodeAuthenticatorFunc(codePrompt)),
+		auth.SendCodeOptions{},
+	)
+	return client.Run(ctx, func(ctx context.Context) error {
+		if err := client.Auth().IfNecessary(ctx, flow); err != nil {
+			return errors.Wrap(err, "auth")
+		}
+
+		api := client.API()
+		p, err := resolve(ctx, peers.Options{Logger: logger}.Build(api), *from)
+		if err != nil {
+			return errors.Wrapf(err, "resolve %q", *from)
+		}
+
+		e := export.New(api, export.Options{
+			BatchSize:   *batchSize,
+			SkipMedia:   *skipMedia,
+			MaxFileSize: *maxFileSize,
+			Logger:      logger.Named("export"),
+		})
+		return e.Export(ctx, p, *out)
+	})
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := run(ctx); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(2)
+	}
+}
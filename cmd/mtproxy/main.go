@@ -0,0 +1,201 @@
+// Binary mtproxy runs MTProxy server.
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/server"
+	"github.com/gotd/td/tdsync"
+)
+
+// secrets is a repeatable flag of MTProxy secrets.
+type secrets []mtproxy.Secret
+
+func (s *secrets) String() string {
+	r := make([]string, 0, len(*s))
+	for _, secret := range *s {
+		r = append(r, hex.EncodeToString(secret.Secret))
+	}
+	return strings.Join(r, ",")
+}
+
+func (s *secrets) Set(value string) error {
+	data, err := hex.DecodeString(value)
+	if err != nil {
+		data, err = base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+		if err != nil {
+			return errors.Errorf("secret %q is neither hex nor base64", value)
+		}
+	}
+
+	secret, err := mtproxy.ParseSecret(data)
+	if err != nil {
+		return err
+	}
+	*s = append(*s, secret)
+	return nil
+}
+
+func fetch(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode/100 != 2 {
+		return nil, errors.Errorf("status code %d", res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+func fetchMiddle(ctx context.Context, secretURL, configURL string) (server.Middle, error) {
+	secret, err := fetch(ctx, secretURL)
+	if err != nil {
+		return server.Middle{}, errors.Wrap(err, "fetch proxy secret")
+	}
+
+	data, err := fetch(ctx, configURL)
+	if err != nil {
+		return server.Middle{}, errors.Wrap(err, "fetch proxy config")
+	}
+	cfg, err := server.ParseProxyConfig(data)
+	if err != nil {
+		return server.Middle{}, errors.Wrap(err, "parse proxy config")
+	}
+
+	return server.Middle{
+		Secret: secret,
+		Config: cfg,
+	}, nil
+}
+
+func serveStats(ctx context.Context, addr string, s *server.Server) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(s.Stats())
+	})
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func run(ctx context.Context) error {
+	var (
+		opts      server.Options
+		addr      = flag.String("addr", "0.0.0.0:443", "address to listen")
+		tag       = flag.String("tag", "", "ad tag from @MTProxybot (hex)")
+		secretURL = flag.String("proxy-secret-url", "https://core.telegram.org/getProxySecret", "middle proxy secret URL, used with -tag")
+		configURL = flag.String("proxy-config-url", "https://core.telegram.org/getProxyConfig", "middle proxy config URL, used with -tag")
+		publicIP  = flag.String("public-ip", "", "public IPv4 address of server, if server is behind NAT")
+		stats     = flag.String("stats", "", "address to serve JSON statistics on /stats (blank to disable)")
+		debug     = flag.Bool("debug", false, "enable debug logging")
+	)
+	flag.Var((*secrets)(&opts.Secrets), "secret", "MTProxy secret in hex or base64, can be repeated")
+	flag.DurationVar(&opts.TimeSkew, "time-skew", 5*time.Minute, "maximum allowed client time skew")
+	flag.BoolVar(&opts.PreferIPv6, "prefer-ipv6", false, "prefer IPv6 DC addresses")
+	flag.Parse()
+
+	cfg := zap.NewProductionConfig()
+	if *debug {
+		cfg.Level.SetLevel(zap.DebugLevel)
+	}
+	logger, err := cfg.Build()
+	if err != nil {
+		return errors.Wrap(err, "create logger")
+	}
+	defer func() { _ = logger.Sync() }()
+	opts.Logger = logger
+
+	if *tag != "" {
+		opts.AdTag, err = hex.DecodeString(*tag)
+		if err != nil {
+			return errors.Wrap(err, "decode ad tag")
+		}
+
+		opts.Middle, err = fetchMiddle(ctx, *secretURL, *configURL)
+		if err != nil {
+			return errors.Wrap(err, "middle proxies")
+		}
+		if *publicIP != "" {
+			opts.Middle.PublicIP = net.ParseIP(*publicIP)
+			if opts.Middle.PublicIP == nil {
+				return errors.Errorf("invalid public IP %q", *publicIP)
+			}
+		}
+	}
+
+	s, err := server.New(opts)
+	if err != nil {
+		return errors.Wrap(err, "create server")
+	}
+	for _, secret := range opts.Secrets {
+		logger.Info("Secret",
+			zap.String("secret", hex.EncodeToString(secret.Secret)),
+			zap.Int("type", int(secret.Type)),
+		)
+	}
+
+	var lc net.ListenConfig
+	l, err := lc.Listen(ctx, "tcp", *addr)
+	if err != nil {
+		return errors.Wrap(err, "listen")
+	}
+
+	g := tdsync.NewCancellableGroup(ctx)
+	g.Go(func(ctx context.Context) error {
+		return s.Serve(ctx, l)
+	})
+	if *stats != "" {
+		g.Go(func(ctx context.Context) error {
+			return serveStats(ctx, *stats, s)
+		})
+	}
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := run(ctx); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(2)
+	}
+}
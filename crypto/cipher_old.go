@@ -0,0 +1,99 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/sha1" // #nosec G505
+	"io"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/ige"
+
+	"github.com/gotd/td/bin"
+)
+
+// OldMessageKey computes MTProto 1.0 message key for provided plaintext
+// without padding.
+//
+// msg_key = substr (SHA1 (plaintext), 4, 16);
+//
+// Warning: MTProto 1.0 is deprecated.
+func OldMessageKey(plaintext []byte) (v bin.Int128) {
+	h := sha1.Sum(plaintext) // #nosec G401
+	copy(v[:], h[4:4+16])
+	return v
+}
+
+// EncryptOld encrypts EncryptedMessageData using MTProto 1.0 scheme to given buffer.
+//
+// Warning: MTProto 1.0 is deprecated, it is used only to encrypt binding
+// message of auth.bindTempAuthKey.
+//
+// See https://core.telegram.org/method/auth.bindTempAuthKey.
+func (c Cipher) EncryptOld(k AuthKey, data EncryptedMessageData, b *bin.Buffer) error {
+	b.Reset()
+	if err := data.EncodeWithoutCopy(b); err != nil {
+		return err
+	}
+
+	messageKey := OldMessageKey(b.Buf)
+	offset := len(b.Buf)
+	b.Buf = append(b.Buf, make([]byte, (16-offset%16)%16)...)
+	if _, err := io.ReadFull(c.rand, b.Buf[offset:]); err != nil {
+		return err
+	}
+
+	key, iv := OldKeys(k.Value, messageKey, c.encryptSide)
+	aesBlock, err := aes.NewCipher(key[:])
+	if err != nil {
+		return err
+	}
+	msg := EncryptedMessage{
+		AuthKeyID:     k.ID,
+		MsgKey:        messageKey,
+		EncryptedData: make([]byte, len(b.Buf)),
+	}
+	ige.EncryptBlocks(aesBlock, iv[:], msg.EncryptedData, b.Buf)
+
+	b.Reset()
+	return msg.Encode(b)
+}
+
+// DecryptOld decrypts data from message encrypted using MTProto 1.0 scheme.
+//
+// Warning: MTProto 1.0 is deprecated.
+func (c Cipher) DecryptOld(k AuthKey, encrypted *EncryptedMessage) (*EncryptedMessageData, error) {
+	if k.ID != encrypted.AuthKeyID {
+		return nil, errors.New("unknown auth key id")
+	}
+	if len(encrypted.EncryptedData)%16 != 0 {
+		return nil, errors.New("invalid encrypted data padding")
+	}
+
+	key, iv := OldKeys(k.Value, encrypted.MsgKey, c.encryptSide.DecryptSide())
+	aesBlock, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(encrypted.EncryptedData))
+	ige.DecryptBlocks(aesBlock, iv[:], plaintext, encrypted.EncryptedData)
+
+	msg := &EncryptedMessageData{}
+	if err := msg.DecodeWithoutCopy(&bin.Buffer{Buf: plaintext}); err != nil {
+		return nil, err
+	}
+
+	// Header is salt, session_id, msg_id, seq_no and length.
+	const headerLen = 8 + 8 + 8 + 4 + 4
+	n := int(msg.MessageDataLen)
+	if n < 0 || headerLen+n > len(plaintext) {
+		return nil, errors.Errorf("message length is invalid: %d", n)
+	}
+	if len(plaintext)-headerLen-n >= 16 {
+		return nil, errors.Errorf("padding %d of message is too big", len(plaintext)-headerLen-n)
+	}
+	if OldMessageKey(plaintext[:headerLen+n]) != encrypted.MsgKey {
+		return nil, errors.New("msg_key is invalid")
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,50 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+)
+
+func TestCipher_EncryptOld(t *testing.T) {
+	a := require.New(t)
+	var authKey Key
+	for i := 0; i < 256; i++ {
+		authKey[i] = byte(i)
+	}
+	k := authKey.WithID()
+
+	client := NewClientCipher(rand.Reader)
+	server := NewServerCipher(rand.Reader)
+
+	for _, payload := range [][]byte{
+		{1, 2, 3, 4},
+		make([]byte, 16),
+		make([]byte, 36),
+	} {
+		var b bin.Buffer
+		a.NoError(client.EncryptOld(k, EncryptedMessageData{
+			Salt:                   1,
+			SessionID:              2,
+			MessageID:              3,
+			MessageDataLen:         int32(len(payload)),
+			MessageDataWithPadding: payload,
+		}, &b))
+
+		var msg EncryptedMessage
+		a.NoError(msg.Decode(&b))
+		data, err := server.DecryptOld(k, &msg)
+		a.NoError(err)
+		a.Equal(int64(1), data.Salt)
+		a.Equal(int64(2), data.SessionID)
+		a.Equal(int64(3), data.MessageID)
+		a.Equal(payload, data.Data())
+
+		msg.EncryptedData[0] ^= 1
+		_, err = server.DecryptOld(k, &msg)
+		a.Error(err)
+	}
+}
@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"io"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -16,6 +17,8 @@ type ClientExchange struct {
 
 	keys []PublicKey
 	dc   int
+	// expiresIn is lifetime of temporary key, zero for permanent key.
+	expiresIn time.Duration
 }
 
 // ClientExchangeResult contains client part of key exchange result.
@@ -86,15 +86,31 @@ Loop:
 		return ClientExchangeResult{}, errors.Wrap(err, "generate new nonce")
 	}
 
-	var encryptedData []byte
-	pqInnerData := &mt.PQInnerDataDC{
-		Pq:          res.Pq,
-		Nonce:       nonce,
-		NewNonce:    newNonce,
-		ServerNonce: serverNonce,
-		P:           pBytes,
-		Q:           qBytes,
-		DC:          c.dc,
+	var (
+		encryptedData []byte
+		pqInnerData   mt.PQInnerDataClass
+	)
+	if c.expiresIn > 0 {
+		pqInnerData = &mt.PQInnerDataTempDC{
+			Pq:          res.Pq,
+			Nonce:       nonce,
+			NewNonce:    newNonce,
+			ServerNonce: serverNonce,
+			P:           pBytes,
+			Q:           qBytes,
+			DC:          c.dc,
+			ExpiresIn:   int(c.expiresIn.Seconds()),
+		}
+	} else {
+		pqInnerData = &mt.PQInnerDataDC{
+			Pq:          res.Pq,
+			Nonce:       nonce,
+			NewNonce:    newNonce,
+			ServerNonce: serverNonce,
+			P:           pBytes,
+			Q:           qBytes,
+			DC:          c.dc,
+		}
 	}
 	b.Reset()
 	if err := pqInnerData.Encode(b); err != nil {
@@ -26,6 +26,8 @@ type Exchanger struct {
 	log     *zap.Logger
 	timeout time.Duration
 	dc      int
+
+	expiresIn time.Duration
 }
 
 // WithClock sets exchange flow clock.
@@ -52,6 +54,15 @@ func (e Exchanger) WithTimeout(timeout time.Duration) Exchanger {
 	return e
 }
 
+// WithTempKey makes client exchange to generate temporary key
+// with given lifetime.
+//
+// See https://core.telegram.org/api/pfs.
+func (e Exchanger) WithTempKey(expiresIn time.Duration) Exchanger {
+	e.expiresIn = expiresIn
+	return e
+}
+
 // NewExchanger creates new Exchanger.
 func NewExchanger(conn transport.Conn, dc int) Exchanger {
 	return Exchanger{
@@ -82,10 +93,11 @@ func (e Exchanger) Client(keys []PublicKey) ClientExchange {
 			proto.MessageServerResponse,
 			proto.MessageFromClient,
 		),
-		rand: e.rand,
-		log:  e.log,
-		keys: keys,
-		dc:   e.dc,
+		rand:      e.rand,
+		log:       e.log,
+		keys:      keys,
+		dc:        e.dc,
+		expiresIn: e.expiresIn,
 	}
 }
 
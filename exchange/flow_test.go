@@ -18,7 +18,7 @@ import (
 	"github.com/gotd/td/transport"
 )
 
-func testExchange(rsaPad bool) func(t *testing.T) {
+func testExchange(rsaPad bool, expiresIn time.Duration) func(t *testing.T) {
 	return func(t *testing.T) {
 		a := require.New(t)
 		log := zaptest.NewLogger(t)
@@ -42,6 +42,7 @@ func testExchange(rsaPad bool) func(t *testing.T) {
 			_, err := NewExchanger(client, dc).
 				WithLogger(log.Named("client")).
 				WithRand(reader).
+				WithTempKey(expiresIn).
 				Client([]PublicKey{privateKey.Public()}).
 				Run(ctx)
 			return err
@@ -61,8 +62,9 @@ func testExchange(rsaPad bool) func(t *testing.T) {
 }
 
 func TestExchange(t *testing.T) {
-	t.Run("PQInnerData", testExchange(false))
-	t.Run("PQInnerDataDC", testExchange(true))
+	t.Run("PQInnerData", testExchange(false, 0))
+	t.Run("PQInnerDataDC", testExchange(true, 0))
+	t.Run("PQInnerDataTempDC", testExchange(true, time.Hour))
 }
 
 func TestExchangeCorpus(t *testing.T) {
@@ -168,10 +168,17 @@ SendResPQ:
 			return ServerExchangeResult{}, err
 		}
 
-		if innerDataDC, ok := d.(*mt.PQInnerDataDC); ok && innerDataDC.DC != s.dc {
+		dc := s.dc
+		switch d := d.(type) {
+		case *mt.PQInnerDataDC:
+			dc = d.DC
+		case *mt.PQInnerDataTempDC:
+			dc = d.DC
+		}
+		if dc != s.dc {
 			err := errors.Errorf(
 				"wrong DC ID, want %d, got %d",
-				s.dc, innerDataDC.DC,
+				s.dc, dc,
 			)
 			return ServerExchangeResult{}, serverError(codec.CodeWrongDC, err)
 		}
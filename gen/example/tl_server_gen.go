@@ -31,11 +31,20 @@ var (
 	_ = tdjson.Encoder{}
 )
 
+// ServerDispatcher dispatches RPC requests to typed handlers.
+//
+// Handlers get decoded request and return result, which is packed into
+// encoder of method result type. Errors are returned as-is, so
+// *tgerr.Error can be sent as RPC error by server.
 type ServerDispatcher struct {
 	fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 	handlers map[uint32]func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 }
 
+// NewServerDispatcher creates new ServerDispatcher.
+//
+// Fallback is called for methods without handler. If fallback is nil,
+// INPUT_METHOD_INVALID error is returned.
 func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)) *ServerDispatcher {
 	return &ServerDispatcher{
 		fallback: fallback,
@@ -43,6 +52,7 @@ func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.
 	}
 }
 
+// Handle decodes request from given buffer and calls handler of method.
 func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 	id, err := b.PeekID()
 	if err != nil {
@@ -51,12 +61,28 @@ func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encod
 
 	f, ok := s.handlers[id]
 	if !ok {
+		if s.fallback == nil {
+			return nil, tgerr.New(400, "INPUT_METHOD_INVALID")
+		}
 		return s.fallback(ctx, b)
 	}
 
 	return f(ctx, b)
 }
 
+// TypeIDs returns sorted type IDs of methods with handler.
+func (s *ServerDispatcher) TypeIDs() []uint32 {
+	r := make([]uint32, 0, len(s.handlers))
+	for id := range s.handlers {
+		r = append(r, id)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// OnPing sets handler of ping#ce73048f method.
+//
+// See https://localhost:80/doc/constructor/ping for reference.
 func (s *ServerDispatcher) OnPing(f func(ctx context.Context, id int32) error) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PingRequest
@@ -74,6 +100,9 @@ func (s *ServerDispatcher) OnPing(f func(ctx context.Context, id int32) error) {
 	s.handlers[PingRequestTypeID] = handler
 }
 
+// OnSend sets handler of send#f74488a method.
+//
+// See https://localhost:80/doc/method/send for reference.
 func (s *ServerDispatcher) OnSend(f func(ctx context.Context, msg SMS) (*SMS, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SendRequest
@@ -91,6 +120,9 @@ func (s *ServerDispatcher) OnSend(f func(ctx context.Context, msg SMS) (*SMS, er
 	s.handlers[SendRequestTypeID] = handler
 }
 
+// OnSendMultipleSMS sets handler of sendMultipleSMS#df18e5ca method.
+//
+// See https://localhost:80/doc/constructor/sendMultipleSMS for reference.
 func (s *ServerDispatcher) OnSendMultipleSMS(f func(ctx context.Context, messages []SMS) error) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SendMultipleSMSRequest
@@ -108,6 +140,9 @@ func (s *ServerDispatcher) OnSendMultipleSMS(f func(ctx context.Context, message
 	s.handlers[SendMultipleSMSRequestTypeID] = handler
 }
 
+// OnDoAuth sets handler of doAuth#fd2f6687 method.
+//
+// See https://localhost:80/doc/method/doAuth for reference.
 func (s *ServerDispatcher) OnDoAuth(f func(ctx context.Context) (AuthClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request DoAuthRequest
@@ -125,6 +160,9 @@ func (s *ServerDispatcher) OnDoAuth(f func(ctx context.Context) (AuthClass, erro
 	s.handlers[DoAuthRequestTypeID] = handler
 }
 
+// OnEchoVector sets handler of echoVector#d4785939 method.
+//
+// See https://localhost:80/doc/method/echoVector for reference.
 func (s *ServerDispatcher) OnEchoVector(f func(ctx context.Context, ids []int) ([]int, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request EchoVectorRequest
@@ -0,0 +1,62 @@
+// Package atomicfile implements crash-safe replacement of files.
+package atomicfile
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-faster/errors"
+)
+
+// Write atomically replaces file at given path with data written by f.
+//
+// Data is written to temporary file in the same directory, synced and
+// renamed over the target, then directory is synced to persist rename.
+// So after crash file contains either old or new data, never partial.
+//
+// New file is created with 0600 permissions.
+func Write(path string, f func(w io.Writer) error) (rErr error) {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "create temp file")
+	}
+	defer func() {
+		if rErr != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if err := f(tmp); err != nil {
+		return errors.Wrap(err, "write")
+	}
+	if err := tmp.Sync(); err != nil {
+		return errors.Wrap(err, "sync")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "close")
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrap(err, "rename")
+	}
+
+	// Not every platform supports syncing directories, so errors
+	// are ignored.
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		_ = d.Close()
+	}
+	return nil
+}
+
+// WriteFile atomically replaces file at given path with data.
+//
+// See Write.
+func WriteFile(path string, data []byte) error {
+	return Write(path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
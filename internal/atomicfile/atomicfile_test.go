@@ -0,0 +1,51 @@
+package atomicfile
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFile(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data")
+
+	a.NoError(WriteFile(path, []byte("old")))
+	a.NoError(WriteFile(path, []byte("new")))
+
+	data, err := os.ReadFile(path)
+	a.NoError(err)
+	a.Equal("new", string(data))
+
+	entries, err := os.ReadDir(dir)
+	a.NoError(err)
+	a.Len(entries, 1, "temporary file left")
+}
+
+func TestWriteFailed(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data")
+	a.NoError(WriteFile(path, []byte("old")))
+
+	testErr := errors.New("test")
+	a.ErrorIs(Write(path, func(w io.Writer) error {
+		if _, err := w.Write([]byte("partial")); err != nil {
+			return err
+		}
+		return testErr
+	}), testErr)
+
+	data, err := os.ReadFile(path)
+	a.NoError(err)
+	a.Equal("old", string(data))
+
+	entries, err := os.ReadDir(dir)
+	a.NoError(err)
+	a.Len(entries, 1, "temporary file left")
+}
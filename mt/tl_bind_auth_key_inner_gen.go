@@ -0,0 +1,252 @@
+// Code generated by gotdgen, DO NOT EDIT.
+
+package mt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tdjson"
+	"github.com/gotd/td/tdp"
+	"github.com/gotd/td/tgerr"
+)
+
+// No-op definition for keeping imports.
+var (
+	_ = bin.Buffer{}
+	_ = context.Background()
+	_ = fmt.Stringer(nil)
+	_ = strings.Builder{}
+	_ = errors.Is
+	_ = multierr.AppendInto
+	_ = sort.Ints
+	_ = tdp.Format
+	_ = tgerr.Error{}
+	_ = tdjson.Encoder{}
+)
+
+// BindAuthKeyInner represents TL type `bind_auth_key_inner#75a3f765`.
+type BindAuthKeyInner struct {
+	// Nonce field of BindAuthKeyInner.
+	Nonce int64
+	// TempAuthKeyID field of BindAuthKeyInner.
+	TempAuthKeyID int64
+	// PermAuthKeyID field of BindAuthKeyInner.
+	PermAuthKeyID int64
+	// TempSessionID field of BindAuthKeyInner.
+	TempSessionID int64
+	// ExpiresAt field of BindAuthKeyInner.
+	ExpiresAt int
+}
+
+// BindAuthKeyInnerTypeID is TL type id of BindAuthKeyInner.
+const BindAuthKeyInnerTypeID = 0x75a3f765
+
+// Ensuring interfaces in compile-time for BindAuthKeyInner.
+var (
+	_ bin.Encoder     = &BindAuthKeyInner{}
+	_ bin.Decoder     = &BindAuthKeyInner{}
+	_ bin.BareEncoder = &BindAuthKeyInner{}
+	_ bin.BareDecoder = &BindAuthKeyInner{}
+)
+
+func (b *BindAuthKeyInner) Zero() bool {
+	if b == nil {
+		return true
+	}
+	if !(b.Nonce == 0) {
+		return false
+	}
+	if !(b.TempAuthKeyID == 0) {
+		return false
+	}
+	if !(b.PermAuthKeyID == 0) {
+		return false
+	}
+	if !(b.TempSessionID == 0) {
+		return false
+	}
+	if !(b.ExpiresAt == 0) {
+		return false
+	}
+
+	return true
+}
+
+// String implements fmt.Stringer.
+func (b *BindAuthKeyInner) String() string {
+	if b == nil {
+		return "BindAuthKeyInner(nil)"
+	}
+	type Alias BindAuthKeyInner
+	return fmt.Sprintf("BindAuthKeyInner%+v", Alias(*b))
+}
+
+// TypeID returns type id in TL schema.
+//
+// See https://core.telegram.org/mtproto/TL-tl#remarks.
+func (*BindAuthKeyInner) TypeID() uint32 {
+	return BindAuthKeyInnerTypeID
+}
+
+// TypeName returns name of type in TL schema.
+func (*BindAuthKeyInner) TypeName() string {
+	return "bind_auth_key_inner"
+}
+
+// TypeInfo returns info about TL type.
+func (b *BindAuthKeyInner) TypeInfo() tdp.Type {
+	typ := tdp.Type{
+		Name: "bind_auth_key_inner",
+		ID:   BindAuthKeyInnerTypeID,
+	}
+	if b == nil {
+		typ.Null = true
+		return typ
+	}
+	typ.Fields = []tdp.Field{
+		{
+			Name:       "Nonce",
+			SchemaName: "nonce",
+		},
+		{
+			Name:       "TempAuthKeyID",
+			SchemaName: "temp_auth_key_id",
+		},
+		{
+			Name:       "PermAuthKeyID",
+			SchemaName: "perm_auth_key_id",
+		},
+		{
+			Name:       "TempSessionID",
+			SchemaName: "temp_session_id",
+		},
+		{
+			Name:       "ExpiresAt",
+			SchemaName: "expires_at",
+		},
+	}
+	return typ
+}
+
+// Encode implements bin.Encoder.
+func (b *BindAuthKeyInner) Encode(buf *bin.Buffer) error {
+	if b == nil {
+		return fmt.Errorf("can't encode bind_auth_key_inner#75a3f765 as nil")
+	}
+	buf.PutID(BindAuthKeyInnerTypeID)
+	return b.EncodeBare(buf)
+}
+
+// EncodeBare implements bin.BareEncoder.
+func (b *BindAuthKeyInner) EncodeBare(buf *bin.Buffer) error {
+	if b == nil {
+		return fmt.Errorf("can't encode bind_auth_key_inner#75a3f765 as nil")
+	}
+	buf.PutLong(b.Nonce)
+	buf.PutLong(b.TempAuthKeyID)
+	buf.PutLong(b.PermAuthKeyID)
+	buf.PutLong(b.TempSessionID)
+	buf.PutInt(b.ExpiresAt)
+	return nil
+}
+
+// Decode implements bin.Decoder.
+func (b *BindAuthKeyInner) Decode(buf *bin.Buffer) error {
+	if b == nil {
+		return fmt.Errorf("can't decode bind_auth_key_inner#75a3f765 to nil")
+	}
+	if err := buf.ConsumeID(BindAuthKeyInnerTypeID); err != nil {
+		return fmt.Errorf("unable to decode bind_auth_key_inner#75a3f765: %w", err)
+	}
+	return b.DecodeBare(buf)
+}
+
+// DecodeBare implements bin.BareDecoder.
+func (b *BindAuthKeyInner) DecodeBare(buf *bin.Buffer) error {
+	if b == nil {
+		return fmt.Errorf("can't decode bind_auth_key_inner#75a3f765 to nil")
+	}
+	{
+		value, err := buf.Long()
+		if err != nil {
+			return fmt.Errorf("unable to decode bind_auth_key_inner#75a3f765: field nonce: %w", err)
+		}
+		b.Nonce = value
+	}
+	{
+		value, err := buf.Long()
+		if err != nil {
+			return fmt.Errorf("unable to decode bind_auth_key_inner#75a3f765: field temp_auth_key_id: %w", err)
+		}
+		b.TempAuthKeyID = value
+	}
+	{
+		value, err := buf.Long()
+		if err != nil {
+			return fmt.Errorf("unable to decode bind_auth_key_inner#75a3f765: field perm_auth_key_id: %w", err)
+		}
+		b.PermAuthKeyID = value
+	}
+	{
+		value, err := buf.Long()
+		if err != nil {
+			return fmt.Errorf("unable to decode bind_auth_key_inner#75a3f765: field temp_session_id: %w", err)
+		}
+		b.TempSessionID = value
+	}
+	{
+		value, err := buf.Int()
+		if err != nil {
+			return fmt.Errorf("unable to decode bind_auth_key_inner#75a3f765: field expires_at: %w", err)
+		}
+		b.ExpiresAt = value
+	}
+	return nil
+}
+
+// GetNonce returns value of Nonce field.
+func (b *BindAuthKeyInner) GetNonce() (value int64) {
+	if b == nil {
+		return
+	}
+	return b.Nonce
+}
+
+// GetTempAuthKeyID returns value of TempAuthKeyID field.
+func (b *BindAuthKeyInner) GetTempAuthKeyID() (value int64) {
+	if b == nil {
+		return
+	}
+	return b.TempAuthKeyID
+}
+
+// GetPermAuthKeyID returns value of PermAuthKeyID field.
+func (b *BindAuthKeyInner) GetPermAuthKeyID() (value int64) {
+	if b == nil {
+		return
+	}
+	return b.PermAuthKeyID
+}
+
+// GetTempSessionID returns value of TempSessionID field.
+func (b *BindAuthKeyInner) GetTempSessionID() (value int64) {
+	if b == nil {
+		return
+	}
+	return b.TempSessionID
+}
+
+// GetExpiresAt returns value of ExpiresAt field.
+func (b *BindAuthKeyInner) GetExpiresAt() (value int) {
+	if b == nil {
+		return
+	}
+	return b.ExpiresAt
+}
@@ -45,6 +45,7 @@ func TypesMap() map[uint32]string {
 		DhGenOkTypeID:                    "dh_gen_ok#3bcbf734",
 		DhGenRetryTypeID:                 "dh_gen_retry#46dc1fb9",
 		DhGenFailTypeID:                  "dh_gen_fail#a69dae02",
+		BindAuthKeyInnerTypeID:           "bind_auth_key_inner#75a3f765",
 		RPCResultTypeID:                  "rpc_result#f35c6d01",
 		RPCErrorTypeID:                   "rpc_error#2144ca19",
 		RPCAnswerUnknownTypeID:           "rpc_answer_unknown#5e2ad36e",
@@ -96,6 +97,7 @@ func NamesMap() map[string]uint32 {
 		"dh_gen_ok":                  DhGenOkTypeID,
 		"dh_gen_retry":               DhGenRetryTypeID,
 		"dh_gen_fail":                DhGenFailTypeID,
+		"bind_auth_key_inner":        BindAuthKeyInnerTypeID,
 		"rpc_result":                 RPCResultTypeID,
 		"rpc_error":                  RPCErrorTypeID,
 		"rpc_answer_unknown":         RPCAnswerUnknownTypeID,
@@ -147,6 +149,7 @@ func TypesConstructorMap() map[uint32]func() bin.Object {
 		DhGenOkTypeID:                    func() bin.Object { return &DhGenOk{} },
 		DhGenRetryTypeID:                 func() bin.Object { return &DhGenRetry{} },
 		DhGenFailTypeID:                  func() bin.Object { return &DhGenFail{} },
+		BindAuthKeyInnerTypeID:           func() bin.Object { return &BindAuthKeyInner{} },
 		RPCResultTypeID:                  func() bin.Object { return &RPCResult{} },
 		RPCErrorTypeID:                   func() bin.Object { return &RPCError{} },
 		RPCAnswerUnknownTypeID:           func() bin.Object { return &RPCAnswerUnknown{} },
@@ -54,6 +54,7 @@ type Conn struct {
 	dialer        Dialer
 	conn          transport.Conn
 	handler       Handler
+	hooks         Hooks
 	rpc           *rpc.Engine
 	rsaPublicKeys []exchange.PublicKey
 	types         *tmap.Map
@@ -73,6 +74,22 @@ type Conn struct {
 	salt       int64
 	sessionID  int64
 
+	// Perfect Forward Secrecy state, see pfs.go.
+	//
+	// tempKey is used to encrypt messages instead of authKey if pfs is set,
+	// prevSession holds rotated temporary key session to decrypt messages
+	// sent before rotation, tempKeyBound is signaled when current key is
+	// bound by request with bindMsgID and connection is initialized by
+	// onTempKeyBound (immutable). Guarded by sessionMux.
+	pfs            bool
+	tempKeyTTL     time.Duration
+	tempKey        crypto.AuthKey
+	tempKeyExpires time.Time
+	tempKeyBound   *tdsync.Ready
+	bindMsgID      int64
+	onTempKeyBound func(ctx context.Context) error
+	prevSession    Session
+
 	// server salts fetched by getSalts.
 	salts salts.Salts
 
@@ -135,11 +152,16 @@ func New(dialer Dialer, opt Options) *Conn {
 
 		rsaPublicKeys: opt.PublicKeys,
 		handler:       opt.Handler,
+		hooks:         opt.Hooks,
 		types:         opt.Types,
 
 		authKey: opt.Key,
 		salt:    opt.Salt,
 
+		pfs:            opt.EnablePFS,
+		tempKeyTTL:     opt.TempKeyTTL,
+		onTempKeyBound: opt.OnTempKeyBound,
+
 		ping:         map[int64]chan struct{}{},
 		pingTimeout:  opt.PingTimeout,
 		pingInterval: opt.PingInterval,
@@ -200,6 +222,8 @@ func (c *Conn) Run(ctx context.Context, f func(ctx context.Context) error) error
 	if err := c.connect(ctx); err != nil {
 		return errors.Wrap(err, "start")
 	}
+	c.hooks.OnConnect(c.dcID)
+	defer c.hooks.OnDisconnect(c.dcID)
 	{
 		// All goroutines are bound to current call.
 		g := tdsync.NewLogGroup(ctx, c.log.Named("group"))
@@ -207,7 +231,22 @@ func (c *Conn) Run(ctx context.Context, f func(ctx context.Context) error) error
 		g.Go("pingLoop", c.pingLoop)
 		g.Go("ackLoop", c.ackLoop)
 		g.Go("saltsLoop", c.saltLoop)
-		g.Go("userCallback", f)
+		if _, ok := c.conn.(longPollConn); ok {
+			g.Go("httpWaitLoop", c.httpWaitLoop)
+		}
+		if c.pfs {
+			g.Go("tempKeyLoop", c.tempKeyLoop)
+		}
+		g.Go("userCallback", func(ctx context.Context) error {
+			if c.pfs {
+				if err := c.bindTempKey(ctx); err != nil {
+					return errors.Wrap(err, "bind temp key")
+				}
+				// Connection is initialized by callback.
+				c.tempKeyReady()
+			}
+			return f(ctx)
+		})
 		g.Go("readLoop", c.readLoop)
 
 		if err := g.Wait(); err != nil {
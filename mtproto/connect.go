@@ -38,10 +38,13 @@ func (c *Conn) connect(ctx context.Context) (rErr error) {
 		c.log.Info("Auth key generated",
 			zap.Duration("duration", c.clock.Now().Sub(start)),
 		)
-		return nil
+		return c.connectTemp(ctx)
 	}
 
 	c.log.Info("Key already exists")
+	if c.pfs {
+		return c.connectTemp(ctx)
+	}
 	if session.ID == 0 {
 		// NB: Telegram can return 404 error if session id is zero.
 		//
@@ -73,12 +76,14 @@ func (c *Conn) createAuthKey(ctx context.Context) error {
 		ce.Write(fields...)
 	}
 
+	start := c.clock.Now()
 	r, err := exchange.NewExchanger(c.conn, c.dcID).
 		WithClock(c.clock).
 		WithLogger(c.log.Named("exchange")).
 		WithTimeout(c.exchangeTimeout).
 		WithRand(c.rand).
 		Client(c.rsaPublicKeys).Run(ctx)
+	c.hooks.OnKeyExchange(c.dcID, false, c.clock.Now().Sub(start), err)
 	if err != nil {
 		return err
 	}
@@ -46,6 +46,7 @@ func TestConn_connect(t *testing.T) {
 				clock: clock.System,
 				rand:  rand.Reader,
 				log:   zap.NewNop(),
+				hooks: nopHooks{},
 			}
 
 			a.Error(c.connect(context.Background()))
@@ -63,8 +64,9 @@ func TestConn_connect(t *testing.T) {
 				authKey: crypto.AuthKey{
 					ID: [8]byte{1}, // Skip exchange.
 				},
-				rand: iotest.ErrReader(io.EOF),
-				log:  zap.NewNop(),
+				rand:  iotest.ErrReader(io.EOF),
+				log:   zap.NewNop(),
+				hooks: nopHooks{},
 			}
 
 			a.Error(c.connect(context.Background()))
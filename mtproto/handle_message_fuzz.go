@@ -69,6 +69,7 @@ func init() {
 		log:       zap.NewNop(),
 		messageID: proto.NewMessageIDGen(time.Now),
 		handler:   handler,
+		hooks:     nopHooks{},
 	}
 
 	conn = c
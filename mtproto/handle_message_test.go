@@ -57,6 +57,7 @@ func TestConnHandleMessage(t *testing.T) {
 		rand:    Zero{},
 		log:     zap.NewNop(),
 		handler: newTestHandler(),
+		hooks:   nopHooks{},
 	}
 
 	for i, input := range []string{
@@ -100,6 +101,7 @@ func TestConnHandleMessageCorpus(t *testing.T) {
 
 	c := &Conn{
 		handler:    newTestHandler(),
+		hooks:      nopHooks{},
 		rpc:        rpc.New(rpc.NopSend, rpc.Options{}),
 		clock:      neo.NewTime(time.Now()),
 		rand:       Zero{},
@@ -30,6 +30,7 @@ func TestConn_handleSessionCreated(t *testing.T) {
 			log:        zap.New(logger),
 			gotSession: gotSession,
 			handler:    newTestHandler(),
+			hooks:      nopHooks{},
 		}
 
 		buf := bin.Buffer{}
@@ -0,0 +1,31 @@
+package mtproto
+
+import "time"
+
+// Hooks are called on connection events, like key exchange or salt update.
+//
+// Can be used to collect metrics. Hooks must not block.
+type Hooks interface {
+	// OnConnect is called when connection is established.
+	OnConnect(dc int)
+	// OnDisconnect is called when established connection is closed.
+	OnDisconnect(dc int)
+	// OnKeyExchange is called when key exchange is finished.
+	// Temp is true if temporary key was generated (see Options.EnablePFS).
+	OnKeyExchange(dc int, temp bool, d time.Duration, err error)
+	// OnSaltUpdate is called when server salt is changed.
+	OnSaltUpdate(dc int)
+	// OnSend is called when message of given size is sent.
+	OnSend(dc, bytes int)
+	// OnReceive is called when message of given size is received.
+	OnReceive(dc, bytes int)
+}
+
+type nopHooks struct{}
+
+func (nopHooks) OnConnect(dc int)                                            {}
+func (nopHooks) OnDisconnect(dc int)                                         {}
+func (nopHooks) OnKeyExchange(dc int, temp bool, d time.Duration, err error) {}
+func (nopHooks) OnSaltUpdate(dc int)                                         {}
+func (nopHooks) OnSend(dc, bytes int)                                        {}
+func (nopHooks) OnReceive(dc, bytes int)                                     {}
@@ -0,0 +1,46 @@
+package mtproto
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/mt"
+)
+
+// longPollConn is implemented by transports which are able to receive
+// messages only as responses to sent ones, like HTTP transport.
+type longPollConn interface {
+	// MaxWait returns maximum time server may hold http_wait request.
+	MaxWait() time.Duration
+}
+
+// httpWaitLoop sends http_wait requests to let server push messages.
+//
+// See https://core.telegram.org/mtproto/service_messages#long-poll-for-http-transport.
+func (c *Conn) httpWaitLoop(ctx context.Context) error {
+	maxWait := c.conn.(longPollConn).MaxWait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "http wait loop")
+		default:
+		}
+
+		if err := func() error {
+			// Server holds request no longer than max_wait.
+			ctx, cancel := context.WithTimeout(ctx, maxWait+c.pingTimeout)
+			defer cancel()
+
+			return c.writeLongPoll(ctx, &mt.HTTPWaitRequest{
+				MaxDelay:  0,
+				WaitAfter: 0,
+				MaxWait:   int(maxWait.Milliseconds()),
+			})
+		}(); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return errors.Wrap(err, "http wait")
+		}
+	}
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func (c *Conn) newEncryptedMessage(id int64, seq int32, payload bin.Encoder, b *bin.Buffer) error {
-	s := c.session()
+	s := c.encryptionSession()
 
 	// TODO(tdakkota): Smarter gzip.
 	// 	1) Generate Length() method for every encoder, to count length without encoding.
@@ -1,6 +1,7 @@
 package mtproto
 
 import (
+	"context"
 	"io"
 	"time"
 
@@ -33,6 +34,9 @@ type Options struct {
 	Logger *zap.Logger
 	// Handler will be called on received message.
 	Handler Handler
+	// Hooks will be called on connection events. Can be used to collect
+	// metrics, see oteltg package.
+	Hooks Hooks
 
 	// AckBatchSize is maximum ack-s to buffer.
 	AckBatchSize int
@@ -74,6 +78,24 @@ type Options struct {
 	// Salt from server that can be used to restore previous connection.
 	Salt int64
 
+	// EnablePFS enables Perfect Forward Secrecy mode.
+	//
+	// In this mode connection generates temporary key, binds it to the
+	// permanent key and encrypts all traffic using temporary key only.
+	//
+	// See https://core.telegram.org/api/pfs.
+	EnablePFS bool
+	// TempKeyTTL is lifetime of temporary key in PFS mode.
+	// Key is rotated before expiration. Defaults to 24 hours.
+	TempKeyTTL time.Duration
+	// OnTempKeyBound is called in PFS mode after rotated temporary key is
+	// bound to the permanent key.
+	//
+	// Server does not keep connection parameters for new key, so callback
+	// should repeat invokeWithLayer(initConnection). Other requests are
+	// delayed until callback returns.
+	OnTempKeyBound func(ctx context.Context) error
+
 	// Tracer for OTEL.
 	Tracer trace.Tracer
 
@@ -156,6 +178,12 @@ func (opt *Options) setDefaults() {
 	if opt.Handler == nil {
 		opt.Handler = nopHandler{}
 	}
+	if opt.Hooks == nil {
+		opt.Hooks = nopHooks{}
+	}
+	if opt.TempKeyTTL == 0 {
+		opt.TempKeyTTL = 24 * time.Hour
+	}
 	if opt.Cipher == nil {
 		opt.Cipher = crypto.NewClientCipher(opt.Random)
 	}
@@ -0,0 +1,263 @@
+package mtproto
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/exchange"
+	"github.com/gotd/td/mt"
+	"github.com/gotd/td/rpc"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/transport"
+)
+
+// connectTemp generates temporary key using current connection if PFS mode
+// is enabled.
+//
+// Key should be bound by bindTempKey after connection start.
+func (c *Conn) connectTemp(ctx context.Context) error {
+	if !c.pfs {
+		return nil
+	}
+
+	c.exchangeLock.Lock()
+	defer c.exchangeLock.Unlock()
+
+	c.log.Info("Generating new temporary key")
+	start := c.clock.Now()
+	r, err := c.tempKeyExchange(ctx, c.conn)
+	if err != nil {
+		return errors.Wrap(err, "create temp key")
+	}
+	c.setTempKey(r, start)
+
+	c.log.Info("Temporary key generated",
+		zap.Duration("duration", c.clock.Now().Sub(start)),
+	)
+	return nil
+}
+
+func (c *Conn) tempKeyExchange(ctx context.Context, conn transport.Conn) (exchange.ClientExchangeResult, error) {
+	start := c.clock.Now()
+	r, err := exchange.NewExchanger(conn, c.dcID).
+		WithClock(c.clock).
+		WithLogger(c.log.Named("exchange")).
+		WithTimeout(c.exchangeTimeout).
+		WithRand(c.rand).
+		WithTempKey(c.tempKeyTTL).
+		Client(c.rsaPublicKeys).Run(ctx)
+	c.hooks.OnKeyExchange(c.dcID, true, c.clock.Now().Sub(start), err)
+	return r, err
+}
+
+// setTempKey replaces current temporary key and session.
+//
+// Previous temporary key is kept to decrypt in-flight messages.
+func (c *Conn) setTempKey(r exchange.ClientExchangeResult, start time.Time) {
+	c.sessionMux.Lock()
+	c.prevSession = Session{
+		ID:   c.sessionID,
+		Key:  c.tempKey,
+		Salt: c.salt,
+	}
+	c.tempKey = r.AuthKey
+	// Using exchange start time, because server counts lifetime
+	// from the moment it has received p_q_inner_data_temp_dc.
+	c.tempKeyExpires = start.Add(c.tempKeyTTL)
+	c.tempKeyBound = tdsync.NewReady()
+	c.sessionID = r.SessionID
+	c.salt = r.ServerSalt
+	c.sessionMux.Unlock()
+
+	// Salts and sequence numbers are bound to session.
+	c.salts.Reset()
+	c.reqMux.Lock()
+	c.sentContentMessages = 0
+	c.reqMux.Unlock()
+}
+
+// bindTempKey binds current temporary key to the permanent key.
+//
+// See https://core.telegram.org/method/auth.bindTempAuthKey.
+func (c *Conn) bindTempKey(ctx context.Context) error {
+	// Binding message must have the same msg_id as the request.
+	msgID, seqNo := c.nextMsgSeq(true)
+
+	c.sessionMux.Lock()
+	var (
+		perm      = c.authKey
+		temp      = c.tempKey
+		sessionID = c.sessionID
+		expiresAt = c.tempKeyExpires
+	)
+	c.bindMsgID = msgID
+	c.sessionMux.Unlock()
+
+	var random [3]int64
+	for i := range random {
+		v, err := crypto.RandInt64(c.rand)
+		if err != nil {
+			return errors.Wrap(err, "generate random")
+		}
+		random[i] = v
+	}
+	nonce, salt, randomSessionID := random[0], random[1], random[2]
+
+	b := new(bin.Buffer)
+	if err := crypto.NewClientCipher(c.rand).EncryptOld(perm, crypto.EncryptedMessageData{
+		Salt:      salt,
+		SessionID: randomSessionID,
+		MessageID: msgID,
+		Message: &mt.BindAuthKeyInner{
+			Nonce:         nonce,
+			TempAuthKeyID: authKeyID(temp),
+			PermAuthKeyID: authKeyID(perm),
+			TempSessionID: sessionID,
+			ExpiresAt:     int(expiresAt.Unix()),
+		},
+	}, b); err != nil {
+		return errors.Wrap(err, "encrypt binding message")
+	}
+
+	var result tg.BoolBox
+	if err := c.invoke(ctx, rpc.Request{
+		MsgID: msgID,
+		SeqNo: seqNo,
+		Input: &tg.AuthBindTempAuthKeyRequest{
+			PermAuthKeyID:    authKeyID(perm),
+			Nonce:            nonce,
+			ExpiresAt:        int(expiresAt.Unix()),
+			EncryptedMessage: b.Raw(),
+		},
+		Output: &result,
+	}); err != nil {
+		return errors.Wrap(err, "invoke")
+	}
+	if _, ok := result.Bool.(*tg.BoolTrue); !ok {
+		return errors.New("server refused to bind temporary key")
+	}
+
+	c.log.Info("Temporary key bound", zap.Time("expires_at", expiresAt))
+	return nil
+}
+
+// tempKeyInit marks context of requests initializing connection after
+// temporary key binding. Such requests are not delayed by waitTempKeyBound.
+type tempKeyInit struct{}
+
+// waitTempKeyBound blocks until current temporary key is bound and
+// connection is initialized, unless message with given id is the binding
+// request or context belongs to initialization.
+func (c *Conn) waitTempKeyBound(ctx context.Context, msgID int64) error {
+	c.sessionMux.RLock()
+	bound, bindMsgID := c.tempKeyBound, c.bindMsgID
+	c.sessionMux.RUnlock()
+
+	if bound == nil || msgID == bindMsgID || ctx.Value(tempKeyInit{}) != nil {
+		return nil
+	}
+
+	select {
+	case <-bound.Ready():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// tempKeyReady allows requests to use current temporary key.
+func (c *Conn) tempKeyReady() {
+	c.sessionMux.RLock()
+	bound := c.tempKeyBound
+	c.sessionMux.RUnlock()
+
+	bound.Signal()
+}
+
+// rotateTempKey generates new temporary key, switches connection to it,
+// binds it to the permanent key and initializes connection again.
+//
+// Key exchange is done using separate connection, so current one is able to
+// handle messages during exchange.
+func (c *Conn) rotateTempKey(ctx context.Context) error {
+	start := c.clock.Now()
+	r, err := c.dialTempKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Prevent message sending with inconsistent session.
+	c.exchangeLock.Lock()
+	c.setTempKey(r, start)
+	c.exchangeLock.Unlock()
+	// Release delayed requests even on error, connection is closed then.
+	defer c.tempKeyReady()
+
+	if err := c.bindTempKey(ctx); err != nil {
+		return err
+	}
+
+	// Server does not keep layer and client parameters for new key.
+	if c.onTempKeyBound != nil {
+		if err := c.onTempKeyBound(context.WithValue(ctx, tempKeyInit{}, struct{}{})); err != nil {
+			return errors.Wrap(err, "initialize connection")
+		}
+	}
+	return nil
+}
+
+// dialTempKey generates new temporary key using new connection.
+func (c *Conn) dialTempKey(ctx context.Context) (_ exchange.ClientExchangeResult, rErr error) {
+	ctx, cancel := context.WithTimeout(ctx, c.dialTimeout)
+	defer cancel()
+
+	conn, err := c.dialer(ctx)
+	if err != nil {
+		return exchange.ClientExchangeResult{}, errors.Wrap(err, "dial failed")
+	}
+	defer func() {
+		multierr.AppendInto(&rErr, conn.Close())
+	}()
+
+	r, err := c.tempKeyExchange(ctx, conn)
+	if err != nil {
+		return exchange.ClientExchangeResult{}, errors.Wrap(err, "create temp key")
+	}
+	return r, nil
+}
+
+// tempKeyLoop rotates temporary key before its expiration.
+func (c *Conn) tempKeyLoop(ctx context.Context) error {
+	for {
+		c.sessionMux.RLock()
+		expires := c.tempKeyExpires
+		c.sessionMux.RUnlock()
+
+		// Rotate key when 90% of its lifetime passed.
+		timer := c.clock.Timer(expires.Sub(c.clock.Now()) - c.tempKeyTTL/10)
+		select {
+		case <-ctx.Done():
+			clock.StopTimer(timer)
+			return ctx.Err()
+		case <-timer.C():
+		}
+
+		c.log.Info("Rotating temporary key")
+		if err := c.rotateTempKey(ctx); err != nil {
+			return errors.Wrap(err, "rotate temp key")
+		}
+	}
+}
+
+func authKeyID(k crypto.AuthKey) int64 {
+	return int64(binary.LittleEndian.Uint64(k.ID[:]))
+}
@@ -50,7 +50,12 @@ func checkMessageID(now time.Time, rawID int64) error {
 }
 
 func (c *Conn) decryptMessage(b *bin.Buffer) (*crypto.EncryptedMessageData, error) {
-	session := c.session()
+	var authKeyID [8]byte
+	if err := b.PeekN(authKeyID[:], len(authKeyID)); err != nil {
+		return nil, errors.Wrap(err, "peek auth key id")
+	}
+
+	session := c.decryptionSession(authKeyID)
 	msg, err := c.cipher.DecryptFromBuffer(session.Key, b)
 	if err != nil {
 		return nil, errors.Wrap(err, "decrypt")
@@ -112,6 +117,11 @@ func (c *Conn) noUpdates(err error) bool {
 }
 
 func (c *Conn) handleAuthKeyNotFound(ctx context.Context) error {
+	if c.pfs {
+		// Temporary key can't be re-created here: binding requires working
+		// read loop, so let the caller reconnect with a new one.
+		return errors.New("temporary key is not found by server")
+	}
 	if c.session().ID == 0 {
 		// The 404 error can also be caused by zero session id.
 		// See https://github.com/gotd/td/issues/107
@@ -193,6 +203,7 @@ func (c *Conn) readLoop(ctx context.Context) (err error) {
 				return errors.Wrap(err, "read")
 			}
 		}
+		c.hooks.OnReceive(c.dcID, buf.Len())
 
 		handlers.Add(1)
 		go func() {
@@ -91,6 +91,7 @@ func benchRead(payloadSize int) func(b *testing.B) {
 				counter: b.N,
 			},
 			handler:           nopHandler{},
+			hooks:             nopHooks{},
 			clock:             c,
 			rand:              random,
 			cipher:            crypto.NewClientCipher(random),
@@ -16,13 +16,15 @@ import (
 // NOTE: Assuming that call contains content message (seqno increment).
 func (c *Conn) Invoke(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
 	msgID, seqNo := c.nextMsgSeq(true)
-	req := rpc.Request{
+	return c.invoke(ctx, rpc.Request{
 		MsgID:  msgID,
 		SeqNo:  seqNo,
 		Input:  input,
 		Output: output,
-	}
+	})
+}
 
+func (c *Conn) invoke(ctx context.Context, req rpc.Request) error {
 	log := c.log.With(
 		zap.Int64("msg_id", req.MsgID),
 	)
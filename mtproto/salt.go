@@ -18,6 +18,7 @@ func (c *Conn) storeSalt(salt int64) {
 	c.sessionMux.Unlock()
 
 	if salt != oldSalt {
+		c.hooks.OnSaltUpdate(c.dcID)
 		c.log.Info("Salt updated", zap.Int64("old", oldSalt), zap.Int64("new", salt))
 	}
 }
@@ -22,6 +22,40 @@ func (c *Conn) session() Session {
 	}
 }
 
+// encryptionSession returns session with key used to encrypt messages.
+//
+// In PFS mode it is the temporary key, otherwise the permanent one.
+func (c *Conn) encryptionSession() Session {
+	c.updateSalt()
+
+	c.sessionMux.RLock()
+	defer c.sessionMux.RUnlock()
+	s := Session{
+		Key:  c.authKey,
+		Salt: c.salt,
+		ID:   c.sessionID,
+	}
+	if c.pfs {
+		s.Key = c.tempKey
+	}
+	return s
+}
+
+// decryptionSession returns session to decrypt message encrypted by given
+// auth key.
+func (c *Conn) decryptionSession(authKeyID [8]byte) Session {
+	if c.pfs {
+		c.sessionMux.RLock()
+		prev := c.prevSession
+		c.sessionMux.RUnlock()
+
+		if !prev.Key.Zero() && prev.Key.ID == authKeyID {
+			return prev
+		}
+	}
+	return c.encryptionSession()
+}
+
 // newSessionID sets session id to random value.
 func (c *Conn) newSessionID() error {
 	id, err := crypto.RandInt64(c.rand)
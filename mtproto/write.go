@@ -7,6 +7,12 @@ import (
 )
 
 func (c *Conn) writeContentMessage(ctx context.Context, msgID int64, seqNo int32, message bin.Encoder) error {
+	if c.pfs {
+		// Server rejects most of the requests encrypted by unbound key.
+		if err := c.waitTempKeyBound(ctx, msgID); err != nil {
+			return err
+		}
+	}
 	return c.write(ctx, msgID, seqNo, message)
 }
 
@@ -29,10 +35,33 @@ func (c *Conn) write(ctx context.Context, msgID int64, seqNo int32, message bin.
 	if err := c.newEncryptedMessage(msgID, seqNo, message, b); err != nil {
 		return err
 	}
+	return c.send(ctx, b)
+}
+
+// writeLongPoll writes long poll request, like http_wait.
+//
+// Server holds such request up to max_wait, so exchange lock is held only
+// during encryption, otherwise key exchange waits for it.
+func (c *Conn) writeLongPoll(ctx context.Context, message bin.Encoder) error {
+	msgID, seqNo := c.nextMsgSeq(false)
+
+	b := bufPool.Get()
+	defer bufPool.Put(b)
+
+	c.exchangeLock.RLock()
+	err := c.newEncryptedMessage(msgID, seqNo, message, b)
+	c.exchangeLock.RUnlock()
+	if err != nil {
+		return err
+	}
+	return c.send(ctx, b)
+}
 
+func (c *Conn) send(ctx context.Context, b *bin.Buffer) error {
 	if err := c.conn.Send(ctx, b); err != nil {
 		return err
 	}
+	c.hooks.OnSend(c.dcID, b.Len())
 
 	return nil
 }
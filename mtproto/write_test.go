@@ -12,10 +12,84 @@ import (
 
 	"github.com/gotd/neo"
 
+	"github.com/gotd/td/bin"
 	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/proto"
 	"github.com/gotd/td/testutil"
 )
 
+// blockingConn blocks Send until context is done.
+type blockingConn struct {
+	constantConn
+	sending chan struct{}
+}
+
+func (c *blockingConn) Send(ctx context.Context, b *bin.Buffer) error {
+	close(c.sending)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestConnWrite(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		write  func(ctx context.Context, c *Conn) error
+		locked bool
+	}{
+		{
+			name: "Message",
+			write: func(ctx context.Context, c *Conn) error {
+				return c.write(ctx, 1, 1, &testPayload{Data: []byte{1, 2, 3, 4}})
+			},
+			// Message must not be sent during key exchange.
+			locked: true,
+		},
+		{
+			name: "LongPoll",
+			write: func(ctx context.Context, c *Conn) error {
+				return c.writeLongPoll(ctx, &testPayload{Data: []byte{1, 2, 3, 4}})
+			},
+			// Key exchange must not wait for http_wait.
+			locked: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			clock := neo.NewTime(time.Now())
+			conn := &blockingConn{sending: make(chan struct{})}
+			c := &Conn{
+				conn:              conn,
+				hooks:             nopHooks{},
+				clock:             clock,
+				messageID:         proto.NewMessageIDGen(clock.Now),
+				rand:              rand.Reader,
+				cipher:            crypto.NewClientCipher(rand.Reader),
+				log:               zap.NewNop(),
+				authKey:           crypto.Key{}.WithID(),
+				compressThreshold: -1,
+			}
+
+			done := make(chan error, 1)
+			go func() {
+				done <- tt.write(ctx, c)
+			}()
+			<-conn.sending
+
+			locked := !c.exchangeLock.TryLock()
+			if !locked {
+				c.exchangeLock.Unlock()
+			}
+			a.Equal(tt.locked, locked)
+
+			cancel()
+			a.ErrorIs(<-done, context.Canceled)
+		})
+	}
+}
+
 func benchWrite(payloadSize int) func(b *testing.B) {
 	return func(b *testing.B) {
 		a := require.New(b)
@@ -35,6 +109,7 @@ func benchWrite(payloadSize int) func(b *testing.B) {
 
 		conn := Conn{
 			conn:              &constantConn{},
+			hooks:             nopHooks{},
 			clock:             c,
 			rand:              random,
 			cipher:            crypto.NewClientCipher(random),
@@ -73,15 +73,23 @@ func (o *FakeTLS) Write(b []byte) (n int, err error) {
 		}
 		o.firstPacket = true
 	}
-	n, err = writeRecord(o.conn, record{
-		Type:    RecordTypeApplication,
-		Version: o.version,
-		Data:    b,
-	})
-	if err != nil {
-		return 0, errors.Wrap(err, "write TLS record")
+	for len(b) > 0 {
+		chunk := b
+		if len(chunk) > maxRecordDataLength {
+			chunk = chunk[:maxRecordDataLength]
+		}
+		written, err := writeRecord(o.conn, record{
+			Type:    RecordTypeApplication,
+			Version: o.version,
+			Data:    chunk,
+		})
+		n += written
+		if err != nil {
+			return n, errors.Wrap(err, "write TLS record")
+		}
+		b = b[len(chunk):]
 	}
-	return
+	return n, nil
 }
 
 // Read implements io.Reader.
@@ -93,20 +101,25 @@ func (o *FakeTLS) Read(b []byte) (n int, err error) {
 		return o.readBuf.Read(b)
 	}
 
-	rec, err := readRecord(o.conn)
-	if err != nil {
-		return 0, errors.Wrap(err, "read TLS record")
-	}
+	for {
+		rec, err := readRecord(o.conn)
+		if err != nil {
+			return 0, errors.Wrap(err, "read TLS record")
+		}
 
-	switch rec.Type {
-	case RecordTypeChangeCipherSpec:
-	case RecordTypeApplication:
-	case RecordTypeHandshake:
-		return 0, errors.New("unexpected record type handshake")
-	default:
-		return 0, errors.Errorf("unsupported record type %v", rec.Type)
-	}
-	o.readBuf.Write(rec.Data)
+		switch rec.Type {
+		case RecordTypeChangeCipherSpec:
+			// Client sends ChangeCipherSpec before first application record,
+			// it does not contain any data.
+			continue
+		case RecordTypeApplication:
+		case RecordTypeHandshake:
+			return 0, errors.New("unexpected record type handshake")
+		default:
+			return 0, errors.Errorf("unsupported record type %v", rec.Type)
+		}
+		o.readBuf.Write(rec.Data)
 
-	return o.readBuf.Read(b)
+		return o.readBuf.Read(b)
+	}
 }
@@ -8,7 +8,12 @@ import (
 	"github.com/go-faster/errors"
 )
 
-const maxTLSRecordDataLength = 16384 + 24
+const (
+	// maxRecordDataLength is a maximum length of TLS record data.
+	maxRecordDataLength = 16384
+	// maxTLSRecordDataLength is a maximum accepted length of TLS record data.
+	maxTLSRecordDataLength = maxRecordDataLength + 24
+)
 
 type record struct {
 	Type    RecordType
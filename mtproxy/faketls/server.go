@@ -0,0 +1,185 @@
+package faketls
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/clock"
+)
+
+// clientRandomOffset is an offset of ClientRandom in ClientHello record.
+//
+// `$record_header = type 1 byte + version 2 bytes + payload_length 2 bytes = 5 bytes`
+// `$client_hello_header = type 1 bytes + length 3 bytes + version 2 bytes = 6 bytes`
+const clientRandomOffset = 11
+
+// ClientHello is a faketls ClientHello received by server.
+type ClientHello struct {
+	// Random is a ClientRandom, which contains digest and timestamp.
+	Random [32]byte
+	// SessionID is a TLS session ID, server must echo it in ServerHello.
+	SessionID []byte
+
+	raw []byte
+}
+
+// ReadClientHello reads faketls ClientHello.
+//
+// ClientHello must be verified using Verify before sending ServerHello.
+func ReadClientHello(r io.Reader) (*ClientHello, error) {
+	packetBuf := bytes.NewBuffer(nil)
+	rec, err := readRecord(io.TeeReader(r, packetBuf))
+	if err != nil {
+		return nil, errors.Wrap(err, "read record")
+	}
+	if rec.Type != RecordTypeHandshake {
+		return nil, errors.Errorf("unexpected record type %#x", rec.Type)
+	}
+
+	// Handshake type, length, version, random and session ID length.
+	const headerLength = 4 + 2 + 32 + 1
+	data := rec.Data
+	if len(data) < headerLength {
+		return nil, errors.Errorf("ClientHello is too short (%d)", len(data))
+	}
+	if HandshakeType(data[0]) != HandshakeTypeClient {
+		return nil, errors.Errorf("unexpected handshake type %#x", data[0])
+	}
+	sessionIDLength := int(data[headerLength-1])
+	if len(data) < headerLength+sessionIDLength {
+		return nil, errors.Errorf("invalid session ID length %d", sessionIDLength)
+	}
+
+	h := &ClientHello{
+		SessionID: append([]byte(nil), data[headerLength:headerLength+sessionIDLength]...),
+		raw:       packetBuf.Bytes(),
+	}
+	copy(h.Random[:], h.raw[clientRandomOffset:clientRandomOffset+32])
+	return h, nil
+}
+
+// Verify checks ClientHello digest using given secret and returns
+// timestamp of client.
+//
+// Caller must check timestamp and uniqueness of Random to prevent replay
+// attacks.
+func (h *ClientHello) Verify(secret []byte) (time.Time, bool) {
+	packet := append([]byte(nil), h.raw...)
+	var zeros [32]byte
+	copy(packet[clientRandomOffset:clientRandomOffset+32], zeros[:])
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(packet)
+	digest := mac.Sum(nil)
+
+	// Client replaces last 4 bytes of digest with digest ^ timestamp.
+	for i := range digest {
+		digest[i] ^= h.Random[i]
+	}
+	if subtle.ConstantTimeCompare(digest[:28], zeros[:28]) != 1 {
+		return time.Time{}, false
+	}
+
+	ts := binary.LittleEndian.Uint32(digest[28:32])
+	return time.Unix(int64(ts), 0), true
+}
+
+// createServerHello creates faketls ServerHello with zero ServerRandom.
+//
+// ServerHello consists of handshake record, ChangeCipherSpec record and
+// application record with random data, which mimics encrypted certificate.
+func createServerHello(rand io.Reader, sessionID []byte) ([]byte, error) {
+	key, err := ecdh.X25519().GenerateKey(rand)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate key")
+	}
+	publicKey := key.PublicKey().Bytes()
+
+	var hello bytes.Buffer
+	hello.WriteByte(byte(HandshakeTypeServer))
+	hello.Write([]byte{0, 0, 0}) // Length, set later.
+	hello.Write(Version12Bytes[:])
+	hello.Write(make([]byte, 32)) // ServerRandom.
+	hello.WriteByte(byte(len(sessionID)))
+	hello.Write(sessionID)
+	hello.Write([]byte{
+		0x13, 0x01, // TLS_AES_128_GCM_SHA256
+		0x00,       // No compression.
+		0x00, 0x2e, // Extensions length.
+		0x00, 0x33, 0x00, 0x24, // Key share.
+		0x00, 0x1d, 0x00, 0x20, // X25519.
+	})
+	hello.Write(publicKey)
+	hello.Write([]byte{
+		0x00, 0x2b, 0x00, 0x02, // Supported versions.
+		0x03, 0x04, // TLS 1.3.
+	})
+	data := hello.Bytes()
+	length := len(data) - 4
+	data[1], data[2], data[3] = byte(length>>16), byte(length>>8), byte(length)
+
+	// Size of fake certificate, 1024-4095 bytes.
+	var size [2]byte
+	if _, err := io.ReadFull(rand, size[:]); err != nil {
+		return nil, errors.Wrap(err, "generate certificate size")
+	}
+	cert := make([]byte, 1024+int(binary.LittleEndian.Uint16(size[:]))%3072)
+	if _, err := io.ReadFull(rand, cert); err != nil {
+		return nil, errors.Wrap(err, "generate certificate")
+	}
+
+	var packet bytes.Buffer
+	for _, r := range []record{
+		{Type: RecordTypeHandshake, Version: Version12Bytes, Data: data},
+		{Type: RecordTypeChangeCipherSpec, Version: Version12Bytes, Data: []byte{0x01}},
+		{Type: RecordTypeApplication, Version: Version12Bytes, Data: cert},
+	} {
+		if _, err := writeRecord(&packet, r); err != nil {
+			return nil, err
+		}
+	}
+	return packet.Bytes(), nil
+}
+
+// writeServerHello writes faketls ServerHello.
+func writeServerHello(w io.Writer, rand io.Reader, clientRandom [32]byte, sessionID, secret []byte) error {
+	packet, err := createServerHello(rand, sessionID)
+	if err != nil {
+		return err
+	}
+
+	// See readServerHello.
+	const serverRandomOffset = 11
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(clientRandom[:])
+	_, _ = mac.Write(packet)
+	copy(packet[serverRandomOffset:serverRandomOffset+32], mac.Sum(nil))
+
+	_, err = w.Write(packet)
+	return err
+}
+
+// Accept sends ServerHello in response to given verified ClientHello and
+// returns FakeTLS for server side of connection.
+func Accept(rand io.Reader, conn io.ReadWriter, h *ClientHello, secret []byte) (*FakeTLS, error) {
+	if err := writeServerHello(conn, rand, h.Random, h.SessionID, secret); err != nil {
+		return nil, errors.Wrap(err, "send ServerHello")
+	}
+
+	return &FakeTLS{
+		rand:    rand,
+		clock:   clock.System,
+		conn:    conn,
+		version: Version12Bytes,
+		// ChangeCipherSpec is already sent in ServerHello.
+		firstPacket: true,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package faketls
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/neo"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/mtproxy"
+)
+
+func TestServer(t *testing.T) {
+	a := require.New(t)
+	now := time.Date(2010, 10, 10, 1, 1, 1, 0, time.UTC)
+	secret := mtproxy.Secret{
+		Secret:    bytes.Repeat([]byte{1}, 16),
+		CloakHost: "google.com",
+		Type:      mtproxy.TLS,
+	}
+
+	client, server := net.Pipe()
+	defer func() {
+		_ = client.Close()
+		_ = server.Close()
+	}()
+
+	payload := bytes.Repeat([]byte{42}, 3*maxRecordDataLength/2)
+	done := make(chan error, 1)
+	go func() {
+		done <- func() error {
+			c := NewFakeTLS(crypto.DefaultRand(), client)
+			c.clock = neo.NewTime(now)
+			if err := c.Handshake([4]byte{}, 2, secret); err != nil {
+				return err
+			}
+			if _, err := c.Write(payload); err != nil {
+				return err
+			}
+			_, err := io.Copy(io.Discard, io.LimitReader(c, int64(len(payload))))
+			return err
+		}()
+	}()
+
+	h, err := ReadClientHello(server)
+	a.NoError(err)
+	a.Len(h.SessionID, 32)
+
+	_, ok := h.Verify([]byte("bad secret"))
+	a.False(ok)
+	ts, ok := h.Verify(secret.Secret)
+	a.True(ok)
+	a.Equal(now.Unix(), ts.Unix())
+
+	s, err := Accept(crypto.DefaultRand(), server, h, secret.Secret)
+	a.NoError(err)
+
+	got := make([]byte, len(payload))
+	_, err = io.ReadFull(s, got)
+	a.NoError(err)
+	a.Equal(payload, got)
+
+	_, err = s.Write(payload)
+	a.NoError(err)
+	a.NoError(<-done)
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/mtproxy"
 )
 
 type OneChar struct {
@@ -83,3 +85,26 @@ func Test_getDecryptInit(t *testing.T) {
 	}
 	a.Equal(expected, r)
 }
+
+func TestShortRead(t *testing.T) {
+	a := require.New(t)
+	secret := bytes.Repeat([]byte{'a'}, 16)
+
+	var conn bytes.Buffer
+	client := NewObfuscated2(OneChar{char: 'a'}, &conn)
+	a.NoError(client.Handshake([4]byte{0xdd, 0xdd, 0xdd, 0xdd}, 2, mtproxy.Secret{Secret: secret}))
+	server, _, err := Accept(&conn, secret)
+	a.NoError(err)
+
+	payload := []byte("abcdefgh")
+	for i := 0; i < 2; i++ {
+		_, err := client.Write(payload)
+		a.NoError(err)
+
+		// Buffer is bigger than available data.
+		buf := make([]byte, 64)
+		n, err := server.Read(buf)
+		a.NoError(err)
+		a.Equal(payload, buf[:n])
+	}
+}
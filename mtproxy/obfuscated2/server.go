@@ -3,6 +3,8 @@ package obfuscated2
 import (
 	"encoding/binary"
 	"io"
+
+	"github.com/go-faster/errors"
 )
 
 // Metadata represents metadata received from header.
@@ -13,24 +15,34 @@ type Metadata struct {
 
 // Accept creates new io.ReadWriter for server-side deobfuscation.
 func Accept(conn io.ReadWriter, secret []byte) (io.ReadWriter, Metadata, error) {
-	var (
-		buf  = make([]byte, 64)
-		meta Metadata
-	)
+	buf := make([]byte, 64)
 	if _, err := io.ReadFull(conn, buf); err != nil {
-		return nil, meta, err
+		return nil, Metadata{}, err
+	}
+
+	return AcceptHeader(conn, buf, secret)
+}
+
+// AcceptHeader is like Accept, but uses given 64-byte header, which is
+// already read from conn.
+//
+// Header is not validated, so caller should check Metadata to find out
+// whether the secret matches.
+func AcceptHeader(conn io.ReadWriter, header, secret []byte) (io.ReadWriter, Metadata, error) {
+	var meta Metadata
+	if len(header) != 64 {
+		return nil, meta, errors.Errorf("invalid header length %d", len(header))
 	}
 
 	var k keys
-	if err := k.createStreams(buf, secret); err != nil {
+	if err := k.createStreams(header, secret); err != nil {
 		return nil, meta, err
 	}
 	// Swap to match client's streams.
 	k.encrypt, k.decrypt = k.decrypt, k.encrypt
 
 	var decrypted [64]byte
-	k.decrypt.XORKeyStream(decrypted[:], buf)
-
+	k.decrypt.XORKeyStream(decrypted[:], header)
 	copy(meta.Protocol[:], decrypted[56:60])
 	meta.DC = binary.LittleEndian.Uint16(decrypted[60:62])
 
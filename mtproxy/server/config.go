@@ -0,0 +1,119 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// Middle is a configuration of Telegram middle proxies.
+//
+// Middle proxies are required to use ad tag. Only IPv4 middle proxies
+// are supported.
+type Middle struct {
+	// Secret is a proxy secret, see https://core.telegram.org/getProxySecret.
+	Secret []byte
+	// Config is a list of middle proxies, see ParseProxyConfig.
+	Config ProxyConfig
+	// PublicIP is a public IPv4 address of server, used if server is behind
+	// NAT. Defaults to local address of connection.
+	PublicIP net.IP
+}
+
+// Zero returns true if this Middle is zero value.
+func (m Middle) Zero() bool {
+	return m.Secret == nil && m.Config.Addrs == nil && m.PublicIP == nil
+}
+
+func (m Middle) validate() error {
+	// First 4 bytes of secret are used as key selector.
+	if len(m.Secret) < 4 {
+		return errors.Errorf("invalid secret length %d", len(m.Secret))
+	}
+	if len(m.Config.Addrs) == 0 {
+		return errors.New("no middle proxies")
+	}
+	if m.PublicIP != nil && m.PublicIP.To4() == nil {
+		return errors.Errorf("public IP %s is not IPv4", m.PublicIP)
+	}
+	return nil
+}
+
+// ProxyConfig is a list of Telegram middle proxies.
+type ProxyConfig struct {
+	// Default is a DC ID to use if there is no middle proxy for requested DC.
+	Default int
+	// Addrs is a list of middle proxy addresses by DC ID.
+	Addrs map[int][]string
+}
+
+// addrs returns middle proxy addresses for given DC.
+func (c ProxyConfig) addrs(dc int) []string {
+	if r, ok := c.Addrs[dc]; ok {
+		return r
+	}
+	return c.Addrs[c.Default]
+}
+
+// ParseProxyConfig parses list of middle proxies.
+//
+// See https://core.telegram.org/getProxyConfig.
+func ParseProxyConfig(data []byte) (ProxyConfig, error) {
+	// Remove comments.
+	var b strings.Builder
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	if err := s.Err(); err != nil {
+		return ProxyConfig{}, err
+	}
+
+	r := ProxyConfig{
+		Addrs: map[int][]string{},
+	}
+	for _, stmt := range strings.Split(b.String(), ";") {
+		fields := strings.Fields(stmt)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "default":
+			if len(fields) != 2 {
+				return ProxyConfig{}, errors.Errorf("invalid statement %q", stmt)
+			}
+			dc, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return ProxyConfig{}, errors.Wrapf(err, "parse default DC %q", fields[1])
+			}
+			r.Default = dc
+		case "proxy_for":
+			if len(fields) != 3 {
+				return ProxyConfig{}, errors.Errorf("invalid statement %q", stmt)
+			}
+			dc, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return ProxyConfig{}, errors.Wrapf(err, "parse DC %q", fields[1])
+			}
+			addr := fields[2]
+			if _, _, err := net.SplitHostPort(addr); err != nil {
+				return ProxyConfig{}, errors.Wrapf(err, "parse address %q", addr)
+			}
+			r.Addrs[dc] = append(r.Addrs[dc], addr)
+		default:
+			// Skip unknown statements.
+		}
+	}
+	if len(r.Addrs) == 0 {
+		return ProxyConfig{}, errors.New("no middle proxies")
+	}
+
+	return r, nil
+}
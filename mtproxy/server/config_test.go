@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProxyConfig(t *testing.T) {
+	a := require.New(t)
+
+	cfg, err := ParseProxyConfig([]byte(`# force_probability 10 10
+default 2;
+proxy_for 1 149.154.175.50:8888;
+proxy_for -1 149.154.175.50:8888;
+proxy_for 2 149.154.161.144:8888;
+proxy_for 2 149.154.161.145:8888; # comment
+timeout 1000;
+`))
+	a.NoError(err)
+	a.Equal(ProxyConfig{
+		Default: 2,
+		Addrs: map[int][]string{
+			1:  {"149.154.175.50:8888"},
+			-1: {"149.154.175.50:8888"},
+			2:  {"149.154.161.144:8888", "149.154.161.145:8888"},
+		},
+	}, cfg)
+	a.Equal([]string{"149.154.175.50:8888"}, cfg.addrs(1))
+	a.Equal(cfg.Addrs[2], cfg.addrs(5))
+
+	for _, input := range []string{
+		"",
+		"default 2;",
+		"proxy_for 1;",
+		"proxy_for a 149.154.175.50:8888;",
+		"proxy_for 1 149.154.175.50;",
+		"default a; proxy_for 1 149.154.175.50:8888;",
+	} {
+		_, err := ParseProxyConfig([]byte(input))
+		a.Error(err, input)
+	}
+}
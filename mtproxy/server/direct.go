@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/obfuscator"
+	"github.com/gotd/td/telegram/dcs"
+)
+
+// dcAddrs returns addresses of given DC, media DC addresses go first.
+func (s *Server) dcAddrs(dc int) []string {
+	id, media := dc, false
+	if id < 0 {
+		id, media = -id, true
+	}
+
+	var primary, mediaOnly []string
+	for _, o := range dcs.FindDCs(s.dcs.Options, id, s.preferIPv6) {
+		if o.CDN {
+			continue
+		}
+		if !s.preferIPv6 && o.Ipv6 {
+			continue
+		}
+
+		addr := net.JoinHostPort(o.IPAddress, strconv.Itoa(o.Port))
+		if o.MediaOnly {
+			mediaOnly = append(mediaOnly, addr)
+		} else {
+			primary = append(primary, addr)
+		}
+	}
+
+	if media {
+		return append(mediaOnly, primary...)
+	}
+	return primary
+}
+
+// dialAny connects to the first available address.
+func (s *Server) dialAny(ctx context.Context, addrs []string) (conn net.Conn, rErr error) {
+	for _, addr := range addrs {
+		conn, err := s.dial(ctx, "tcp", addr)
+		if err == nil {
+			return conn, nil
+		}
+		multierr.AppendInto(&rErr, errors.Wrapf(err, "dial %q", addr))
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, rErr
+}
+
+// dialDirect connects to DC using obfuscated2 protocol.
+func (s *Server) dialDirect(ctx context.Context, deadline time.Time, c client) (_ net.Conn, _ io.ReadWriter, rErr error) {
+	addrs := s.dcAddrs(c.dc)
+	if len(addrs) == 0 {
+		return nil, nil, errors.Errorf("no addresses for DC %d", c.dc)
+	}
+
+	conn, err := s.dialAny(ctx, addrs)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if rErr != nil {
+			multierr.AppendInto(&rErr, conn.Close())
+		}
+	}()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, nil, errors.Wrap(err, "set deadline")
+	}
+
+	obfs := obfuscator.Obfuscated2(s.rand, conn)
+	if err := obfs.Handshake(c.protocol, c.dc, mtproxy.Secret{}); err != nil {
+		return nil, nil, errors.Wrap(err, "handshake")
+	}
+	return conn, obfs, nil
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/proto/codec"
+)
+
+// maxMessageLength is a maximum length of client message.
+const maxMessageLength = 1 << 24
+
+// framer reads and writes messages using client transport protocol.
+//
+// It is used by middle proxy mode, which forwards messages instead of raw
+// stream.
+type framer struct {
+	protocol [4]byte
+	rand     io.Reader
+}
+
+// parse parses first message of given buffer.
+//
+// Returns zero n if buffer does not contain full message.
+func (f framer) parse(buf []byte) (msg []byte, quickAck bool, n int, err error) {
+	var length, header int
+	switch f.protocol {
+	case codec.Abridged{}.ObfuscatedTag():
+		if len(buf) < 1 {
+			return nil, false, 0, nil
+		}
+		quickAck = buf[0]&0x80 != 0
+		length, header = int(buf[0]&0x7f), 1
+		if length == 0x7f {
+			if len(buf) < 4 {
+				return nil, false, 0, nil
+			}
+			length, header = int(buf[1])|int(buf[2])<<8|int(buf[3])<<16, 4
+		}
+		length *= 4
+	default:
+		if len(buf) < bin.Word {
+			return nil, false, 0, nil
+		}
+		v := binary.LittleEndian.Uint32(buf)
+		quickAck = v&0x80000000 != 0
+		length, header = int(v&0x7fffffff), bin.Word
+	}
+	if length > maxMessageLength {
+		return nil, false, 0, errors.Errorf("message is too big (%d bytes)", length)
+	}
+
+	n = header + length
+	if len(buf) < n {
+		return nil, false, 0, nil
+	}
+	msg = buf[header:n]
+	if f.protocol == codec.PaddedIntermediateClientStart {
+		// Trim random padding.
+		msg = msg[:length-length%bin.Word]
+	}
+	if len(msg)%bin.Word != 0 {
+		return nil, false, 0, errors.Errorf("invalid message length %d", len(msg))
+	}
+	return msg, quickAck, n, nil
+}
+
+// encode appends encoded message to given buffer.
+func (f framer) encode(b []byte, msg []byte) ([]byte, error) {
+	switch f.protocol {
+	case codec.Abridged{}.ObfuscatedTag():
+		length := len(msg) / 4
+		if length < 0x7f {
+			b = append(b, byte(length))
+		} else {
+			b = append(b, 0x7f, byte(length), byte(length>>8), byte(length>>16))
+		}
+		return append(b, msg...), nil
+	case codec.PaddedIntermediateClientStart:
+		var pad [1]byte
+		if _, err := io.ReadFull(f.rand, pad[:]); err != nil {
+			return nil, errors.Wrap(err, "generate padding length")
+		}
+		padding := make([]byte, int(pad[0]%4))
+		if _, err := io.ReadFull(f.rand, padding); err != nil {
+			return nil, errors.Wrap(err, "generate padding")
+		}
+
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(msg)+len(padding)))
+		b = append(b, msg...)
+		return append(b, padding...), nil
+	default:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(msg)))
+		return append(b, msg...), nil
+	}
+}
+
+// encodeAck appends encoded quick acknowledgement to given buffer.
+func (f framer) encodeAck(b []byte, confirm [4]byte) []byte {
+	if f.protocol == (codec.Abridged{}).ObfuscatedTag() {
+		return append(b, confirm[3], confirm[2], confirm[1], confirm[0])
+	}
+	return append(b, confirm[:]...)
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/testutil"
+)
+
+func TestFramer(t *testing.T) {
+	for _, protocol := range [][4]byte{
+		codec.Abridged{}.ObfuscatedTag(),
+		codec.IntermediateClientStart,
+		codec.PaddedIntermediateClientStart,
+	} {
+		f := framer{
+			protocol: protocol,
+			rand:     testutil.Rand(nil),
+		}
+		t.Run(fmt.Sprintf("%x", protocol), func(t *testing.T) {
+			a := require.New(t)
+
+			var (
+				buf  []byte
+				msgs [][]byte
+			)
+			for _, size := range []int{4, 16, 0x7f * 4, 1024} {
+				msg := bytes.Repeat([]byte{byte(size)}, size)
+				msgs = append(msgs, msg)
+
+				var err error
+				buf, err = f.encode(buf, msg)
+				a.NoError(err)
+			}
+
+			// Incomplete message.
+			_, _, n, err := f.parse(buf[:2])
+			a.NoError(err)
+			a.Zero(n)
+
+			for _, expected := range msgs {
+				msg, quickAck, n, err := f.parse(buf)
+				a.NoError(err)
+				a.False(quickAck)
+				a.Equal(expected, msg)
+				buf = buf[n:]
+			}
+			a.Empty(buf)
+		})
+	}
+}
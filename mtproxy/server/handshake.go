@@ -0,0 +1,152 @@
+package server
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/faketls"
+	"github.com/gotd/td/mtproxy/obfuscated2"
+	"github.com/gotd/td/proto/codec"
+)
+
+var (
+	errUnknownSecret = errors.New("unknown secret")
+	errReplay        = errors.New("replay attack detected")
+	errTimeSkew      = errors.New("client time skew is too big")
+)
+
+// client is an accepted client connection.
+type client struct {
+	// conn is a deobfuscated client stream.
+	conn io.ReadWriter
+	// secret is an index of matched secret.
+	secret int
+	// protocol is a transport protocol tag.
+	protocol [4]byte
+	// dc is a requested DC ID. Negative ID denotes media DC.
+	dc int
+}
+
+// obfuscatedHeaderLength is a length of obfuscated2 header.
+const obfuscatedHeaderLength = 64
+
+// accept performs handshake with client.
+func (s *Server) accept(conn io.ReadWriter) (client, error) {
+	header := make([]byte, obfuscatedHeaderLength)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return client{}, errors.Wrap(err, "read header")
+	}
+
+	c, err := s.acceptHeader(conn, header)
+	if err != nil {
+		switch {
+		case errors.Is(err, errReplay):
+			s.stats.replays.Inc()
+		case errors.Is(err, errUnknownSecret), errors.Is(err, errTimeSkew):
+			s.stats.handshakeErrors.Inc()
+		}
+		return client{}, err
+	}
+	return c, nil
+}
+
+func (s *Server) acceptHeader(conn io.ReadWriter, header []byte) (client, error) {
+	// ClientHello record starts with handshake type and TLS 1.0 version.
+	// Note that obfuscated2 clients never send such header.
+	if bytes.HasPrefix(header, []byte{byte(faketls.RecordTypeHandshake), 0x03, 0x01}) {
+		return s.acceptTLS(conn, header)
+	}
+
+	return s.acceptObfuscated(conn, header, func(idx int) bool {
+		return s.secrets[idx].Type != mtproxy.TLS
+	})
+}
+
+// acceptTLS performs faketls handshake and then obfuscated2 handshake.
+func (s *Server) acceptTLS(conn io.ReadWriter, header []byte) (client, error) {
+	hello, err := faketls.ReadClientHello(io.MultiReader(bytes.NewReader(header), conn))
+	if err != nil {
+		return client{}, errors.Wrap(err, "read ClientHello")
+	}
+
+	for idx, secret := range s.secrets {
+		if secret.Type != mtproxy.TLS {
+			continue
+		}
+		ts, ok := hello.Verify(secret.Secret)
+		if !ok {
+			continue
+		}
+
+		if skew := s.clock.Now().Sub(ts); skew > s.timeSkew || skew < -s.timeSkew {
+			return client{}, errors.Wrapf(errTimeSkew, "skew %s", skew)
+		}
+		if s.replays.add(hello.Random[:]) {
+			return client{}, errors.Wrap(errReplay, "ClientHello")
+		}
+
+		tls, err := faketls.Accept(s.rand, conn, hello, secret.Secret)
+		if err != nil {
+			return client{}, errors.Wrap(err, "faketls")
+		}
+
+		header := make([]byte, obfuscatedHeaderLength)
+		if _, err := io.ReadFull(tls, header); err != nil {
+			return client{}, errors.Wrap(err, "read obfuscated2 header")
+		}
+		// Client must use the same secret.
+		return s.acceptObfuscated(tls, header, func(i int) bool {
+			return i == idx
+		})
+	}
+
+	return client{}, errors.Wrap(errUnknownSecret, "faketls")
+}
+
+// acceptObfuscated performs obfuscated2 handshake using secrets with
+// indexes matching given predicate.
+func (s *Server) acceptObfuscated(conn io.ReadWriter, header []byte, match func(idx int) bool) (client, error) {
+	for idx, secret := range s.secrets {
+		if !match(idx) {
+			continue
+		}
+
+		rw, meta, err := obfuscated2.AcceptHeader(conn, header, secret.Secret)
+		if err != nil {
+			return client{}, errors.Wrap(err, "obfuscated2")
+		}
+		if !validProtocol(secret.Type, meta.Protocol) {
+			continue
+		}
+
+		// Key and IV of obfuscated2 header.
+		if s.replays.add(header[8:56]) {
+			return client{}, errors.Wrap(errReplay, "obfuscated2")
+		}
+
+		return client{
+			conn:     rw,
+			secret:   idx,
+			protocol: meta.Protocol,
+			dc:       int(int16(meta.DC)),
+		}, nil
+	}
+
+	return client{}, errors.Wrap(errUnknownSecret, "obfuscated2")
+}
+
+// validProtocol reports whether given protocol can be used with secret type.
+func validProtocol(t mtproxy.SecretType, protocol [4]byte) bool {
+	switch protocol {
+	case codec.PaddedIntermediateClientStart:
+		return true
+	case codec.Abridged{}.ObfuscatedTag(), codec.IntermediateClientStart:
+		// Secured secret requires padding.
+		return t != mtproxy.Secured
+	default:
+		return false
+	}
+}
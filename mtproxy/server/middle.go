@@ -0,0 +1,229 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/proto/codec"
+)
+
+// RPC_PROXY_REQ flags.
+const (
+	flagNotEncrypted uint32 = 0x2
+	flagHasAdTag     uint32 = 0x8
+	flagMagic        uint32 = 0x1000
+	flagExtMode2     uint32 = 0x20000
+	flagPad          uint32 = 0x8000000
+	flagIntermediate uint32 = 0x20000000
+	flagAbridged     uint32 = 0x40000000
+	flagQuickAck     uint32 = 0x80000000
+)
+
+// tcpAddr returns TCP address of connection endpoint, replacing IP with
+// given public IP if set.
+func tcpAddr(addr net.Addr, public net.IP) (*net.TCPAddr, error) {
+	tcp, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return nil, errors.Errorf("unexpected address %s", addr)
+	}
+	if public == nil {
+		return tcp, nil
+	}
+	return &net.TCPAddr{IP: public, Port: tcp.Port}, nil
+}
+
+// dialMiddle connects to Telegram middle proxy.
+//
+// Given client connection is used to obtain client and server addresses.
+func (s *Server) dialMiddle(
+	ctx context.Context, deadline time.Time, clientConn net.Conn, c client,
+) (_ net.Conn, _ io.ReadWriter, rErr error) {
+	addrs := s.middle.Config.addrs(c.dc)
+	if len(addrs) == 0 {
+		return nil, nil, errors.Errorf("no middle proxies for DC %d", c.dc)
+	}
+
+	remote, err := tcpAddr(clientConn.RemoteAddr(), nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "client address")
+	}
+	our, err := tcpAddr(clientConn.LocalAddr(), s.middle.PublicIP)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "server address")
+	}
+
+	conn, err := s.dialAny(ctx, addrs)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if rErr != nil {
+			multierr.AppendInto(&rErr, conn.Close())
+		}
+	}()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, nil, errors.Wrap(err, "set deadline")
+	}
+
+	local, err := tcpAddr(conn.LocalAddr(), s.middle.PublicIP)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "local address")
+	}
+	proxy, err := tcpAddr(conn.RemoteAddr(), nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "middle proxy address")
+	}
+
+	rpc := newRPCConn(conn)
+	if err := rpc.handshake(s.rand, s.clock.Now(), s.middle.Secret, local, proxy); err != nil {
+		return nil, nil, errors.Wrap(err, "handshake")
+	}
+
+	m := &middleStream{
+		rpc: rpc,
+		framer: framer{
+			protocol: c.protocol,
+			rand:     s.rand,
+		},
+		flags:  flagHasAdTag | flagMagic | flagExtMode2,
+		remote: encodeIPPort(remote),
+		our:    encodeIPPort(our),
+		adTag:  s.adTag,
+	}
+	switch c.protocol {
+	case codec.Abridged{}.ObfuscatedTag():
+		m.flags |= flagAbridged
+	case codec.IntermediateClientStart:
+		m.flags |= flagIntermediate
+	case codec.PaddedIntermediateClientStart:
+		m.flags |= flagIntermediate | flagPad
+	}
+	if _, err := io.ReadFull(s.rand, m.connID[:]); err != nil {
+		return nil, nil, errors.Wrap(err, "generate connection ID")
+	}
+
+	return conn, m, nil
+}
+
+// encodeIPPort encodes address as IPv6 (or IPv4-mapped IPv6) address and
+// 4-byte port.
+func encodeIPPort(addr *net.TCPAddr) []byte {
+	b := make([]byte, 0, net.IPv6len+bin.Word)
+	b = append(b, addr.IP.To16()...)
+	return binary.LittleEndian.AppendUint32(b, uint32(addr.Port))
+}
+
+// middleStream converts client transport stream to middle proxy RPC
+// messages and vice versa.
+//
+// Write and Read may be called concurrently.
+type middleStream struct {
+	rpc    *rpcConn
+	framer framer
+
+	connID [8]byte
+	flags  uint32
+	remote []byte
+	our    []byte
+	adTag  []byte
+
+	in  []byte // buffered client data
+	out []byte // encoded, but not consumed client data
+}
+
+// Write sends client messages to middle proxy.
+func (m *middleStream) Write(p []byte) (int, error) {
+	m.in = append(m.in, p...)
+	for {
+		msg, quickAck, n, err := m.framer.parse(m.in)
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			break
+		}
+		if err := m.rpc.writeFrame(m.proxyRequest(msg, quickAck)); err != nil {
+			return 0, errors.Wrap(err, "write request")
+		}
+		m.in = m.in[n:]
+	}
+	// Prevent unbounded growth of underlying array.
+	m.in = append([]byte(nil), m.in...)
+
+	return len(p), nil
+}
+
+// proxyRequest encodes RPC_PROXY_REQ.
+func (m *middleStream) proxyRequest(msg []byte, quickAck bool) []byte {
+	flags := m.flags
+	if quickAck {
+		flags |= flagQuickAck
+	}
+	// Unencrypted message starts with zero auth key ID.
+	if len(msg) >= 8 && bytes.Equal(msg[:8], make([]byte, 8)) {
+		flags |= flagNotEncrypted
+	}
+
+	b := &bin.Buffer{Buf: make([]byte, 0, 96+len(msg))}
+	b.PutUint32(rpcProxyReq)
+	b.PutUint32(flags)
+	b.Put(m.connID[:])
+	b.Put(m.remote)
+	b.Put(m.our)
+	// Extra: size, proxy tag and TL string with ad tag.
+	b.PutUint32(24)
+	b.PutUint32(rpcProxyTag)
+	b.PutBytes(m.adTag)
+	b.Put(msg)
+	return b.Buf
+}
+
+// Read receives messages from middle proxy.
+func (m *middleStream) Read(p []byte) (int, error) {
+	for len(m.out) == 0 {
+		frame, err := m.rpc.readFrame()
+		if err != nil {
+			return 0, err
+		}
+		if len(frame) < bin.Word {
+			return 0, errors.Errorf("invalid frame length %d", len(frame))
+		}
+
+		switch binary.LittleEndian.Uint32(frame) {
+		case rpcProxyAns:
+			// Type, flags, connection ID.
+			if len(frame) < 16 || !bytes.Equal(frame[8:16], m.connID[:]) {
+				continue
+			}
+			out, err := m.framer.encode(m.out, frame[16:])
+			if err != nil {
+				return 0, err
+			}
+			m.out = out
+		case rpcSimpleAck:
+			// Type, connection ID, confirm.
+			if len(frame) < 16 || !bytes.Equal(frame[4:12], m.connID[:]) {
+				continue
+			}
+			var confirm [4]byte
+			copy(confirm[:], frame[12:16])
+			m.out = m.framer.encodeAck(m.out, confirm)
+		case rpcCloseExt:
+			return 0, io.EOF
+		default:
+			// Skip unknown messages.
+		}
+	}
+
+	n := copy(p, m.out)
+	m.out = m.out[n:]
+	return n, nil
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/obfuscator"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/testutil"
+)
+
+// serveMiddle is a fake middle proxy, which echoes received messages.
+func serveMiddle(conn net.Conn, secret, adTag []byte) (rErr error) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	r := newRPCConn(conn)
+	req, err := r.readFrame()
+	if err != nil {
+		return errors.Wrap(err, "read nonce")
+	}
+	if len(req) != 32 || binary.LittleEndian.Uint32(req) != rpcNonce {
+		return errors.New("invalid nonce")
+	}
+	var serverNonce, clientNonce [16]byte
+	copy(clientNonce[:], req[16:32])
+	ts := binary.LittleEndian.Uint32(req[12:16])
+
+	b := &bin.Buffer{}
+	b.Put(req[:16])
+	b.Put(serverNonce[:])
+	if err := r.writeFrame(b.Buf); err != nil {
+		return errors.Wrap(err, "write nonce")
+	}
+
+	// Purposes are swapped for middle proxy.
+	server, client := conn.LocalAddr().(*net.TCPAddr), conn.RemoteAddr().(*net.TCPAddr)
+	newCBC := func(purpose string, f func(b cipher.Block, iv []byte) cipher.BlockMode) cipher.BlockMode {
+		key, iv := rpcKeys(purpose, serverNonce, clientNonce, ts, server, client, secret)
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			panic(err)
+		}
+		return f(block, iv)
+	}
+	r.enc = newCBC("SERVER", cipher.NewCBCEncrypter)
+	r.dec = newCBC("CLIENT", cipher.NewCBCDecrypter)
+
+	req, err = r.readFrame()
+	if err != nil {
+		return errors.Wrap(err, "read handshake")
+	}
+	if len(req) != 32 || binary.LittleEndian.Uint32(req) != rpcHandshake {
+		return errors.New("invalid handshake")
+	}
+	if err := r.writeFrame(req); err != nil {
+		return errors.Wrap(err, "write handshake")
+	}
+
+	for {
+		req, err := r.readFrame()
+		if err != nil {
+			return errors.Wrap(err, "read request")
+		}
+
+		// Type, flags, connection ID, two ip_port, extra size and proxy tag.
+		const extra = 4 + 4 + 8 + 2*20 + 4 + 4
+		if len(req) < extra+20 || binary.LittleEndian.Uint32(req) != rpcProxyReq {
+			return errors.New("invalid request")
+		}
+		flags := binary.LittleEndian.Uint32(req[4:8])
+		if flags&flagHasAdTag == 0 || flags&flagPad == 0 {
+			return errors.Errorf("invalid flags %x", flags)
+		}
+		if !bytes.Equal(req[extra+1:extra+17], adTag) {
+			return errors.New("invalid ad tag")
+		}
+
+		b.Reset()
+		b.PutUint32(rpcProxyAns)
+		b.PutUint32(0)
+		b.Put(req[8:16])
+		b.Put(req[extra+20:])
+		if err := r.writeFrame(b.Buf); err != nil {
+			return errors.Wrap(err, "write answer")
+		}
+	}
+}
+
+func TestServerMiddle(t *testing.T) {
+	a := require.New(t)
+	log := zaptest.NewLogger(t)
+	defer func() { _ = log.Sync() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var (
+		proxySecret = bytes.Repeat([]byte{1}, 128)
+		adTag       = bytes.Repeat([]byte{2}, adTagLength)
+		secret      = mtproxy.Secret{
+			Secret: bytes.Repeat([]byte{3}, 16),
+			Tag:    0xdd,
+			Type:   mtproxy.Secured,
+		}
+	)
+
+	middleListener, err := net.Listen("tcp4", "127.0.0.1:0")
+	a.NoError(err)
+	proxyListener, err := net.Listen("tcp4", "127.0.0.1:0")
+	a.NoError(err)
+
+	s, err := New(Options{
+		Secrets: []mtproxy.Secret{secret},
+		AdTag:   adTag,
+		Middle: Middle{
+			Secret: proxySecret,
+			Config: ProxyConfig{
+				Default: 2,
+				Addrs: map[int][]string{
+					2: {middleListener.Addr().String()},
+				},
+			},
+		},
+		Logger: log.Named("proxy"),
+	})
+	a.NoError(err)
+
+	g := tdsync.NewCancellableGroup(ctx)
+	g.Go(func(ctx context.Context) error {
+		conn, err := middleListener.Accept()
+		if err != nil {
+			return err
+		}
+		_ = serveMiddle(conn, proxySecret, adTag)
+		return nil
+	})
+	g.Go(func(ctx context.Context) error {
+		return s.Serve(ctx, proxyListener)
+	})
+	g.Go(func(ctx context.Context) error {
+		defer g.Cancel()
+
+		conn, err := net.Dial("tcp4", proxyListener.Addr().String())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		obfs := obfuscator.Obfuscated2(testutil.Rand(nil), conn)
+		if err := obfs.Handshake(codec.PaddedIntermediateClientStart, 2, secret); err != nil {
+			return err
+		}
+
+		var cdc codec.PaddedIntermediate
+		for _, size := range []int{16, 1024, 64 * 1024} {
+			msg := bytes.Repeat([]byte{byte(size)}, size)
+			if err := cdc.Write(obfs, &bin.Buffer{Buf: msg}); err != nil {
+				return errors.Wrap(err, "write")
+			}
+
+			var b bin.Buffer
+			if err := cdc.Read(obfs, &b); err != nil {
+				return errors.Wrap(err, "read")
+			}
+			a.Equal(msg, b.Buf)
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		a.NoError(err)
+	}
+	a.Equal(int64(1), s.Stats().Secrets[0].Accepted)
+}
+
+func TestNewMiddle(t *testing.T) {
+	a := require.New(t)
+	secret := mtproxy.Secret{
+		Secret: make([]byte, 16),
+		Type:   mtproxy.Simple,
+	}
+	middle := Middle{
+		Secret: make([]byte, 16),
+		Config: ProxyConfig{
+			Addrs: map[int][]string{1: {"127.0.0.1:8888"}},
+		},
+	}
+
+	_, err := New(Options{
+		Secrets: []mtproxy.Secret{secret},
+		AdTag:   make([]byte, adTagLength),
+	})
+	a.Error(err, "ad tag requires middle proxies")
+
+	_, err = New(Options{
+		Secrets: []mtproxy.Secret{secret},
+		Middle:  middle,
+	})
+	a.Error(err, "middle proxies require ad tag")
+
+	_, err = New(Options{
+		Secrets: []mtproxy.Secret{secret},
+		AdTag:   make([]byte, adTagLength),
+		Middle:  middle,
+	})
+	a.NoError(err)
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"io"
+	"net"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/telegram/dcs"
+)
+
+// Options of Server.
+type Options struct {
+	// Secrets is a list of accepted secrets, at least one is required.
+	//
+	// Simple and Secured (dd) secrets are accepted using obfuscated2,
+	// TLS (ee) secrets are accepted using faketls.
+	Secrets []mtproxy.Secret
+	// AdTag is a promotion tag from @MTProxybot (16 bytes).
+	//
+	// Ad tag is passed to Telegram middle proxies, so Middle must be set too.
+	AdTag []byte
+	// Middle is a configuration of Telegram middle proxies.
+	// If zero, traffic is forwarded directly to DCs.
+	Middle Middle
+	// DCs is a list of DC addresses to forward traffic to.
+	// Defaults to dcs.Prod().
+	DCs dcs.List
+	// PreferIPv6 gives IPv6 DCs higher precedence.
+	PreferIPv6 bool
+	// Dial specifies the dial function for creating connections to Telegram.
+	// If Dial is nil, then the server dials using package net.
+	Dial dcs.DialFunc
+	// TimeSkew is a maximum allowed difference between client and server
+	// time in faketls handshake. Defaults to 5 minutes.
+	TimeSkew time.Duration
+	// ReplayCacheSize is a number of remembered handshakes to detect replay
+	// attacks. Defaults to 65536.
+	ReplayCacheSize int
+	// HandshakeTimeout is a timeout of client handshake and connection to
+	// Telegram. Defaults to 10 seconds.
+	HandshakeTimeout time.Duration
+	// Rand is random source. Defaults to crypto.DefaultRand().
+	Rand io.Reader
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger is instance of zap.Logger. No logs by default.
+	Logger *zap.Logger
+}
+
+func (opt *Options) setDefaults() {
+	// It's okay to use zero value AdTag.
+	// It's okay to use zero value Middle.
+	if opt.DCs.Zero() {
+		opt.DCs = dcs.Prod()
+	}
+	// It's okay to use zero value PreferIPv6.
+	if opt.Dial == nil {
+		var d net.Dialer
+		opt.Dial = d.DialContext
+	}
+	if opt.TimeSkew == 0 {
+		opt.TimeSkew = 5 * time.Minute
+	}
+	if opt.ReplayCacheSize == 0 {
+		opt.ReplayCacheSize = 1 << 16
+	}
+	if opt.HandshakeTimeout == 0 {
+		opt.HandshakeTimeout = 10 * time.Second
+	}
+	if opt.Rand == nil {
+		opt.Rand = crypto.DefaultRand()
+	}
+	if opt.Clock == nil {
+		opt.Clock = clock.System
+	}
+	if opt.Logger == nil {
+		opt.Logger = zap.NewNop()
+	}
+}
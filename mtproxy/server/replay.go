@@ -0,0 +1,41 @@
+package server
+
+import "sync"
+
+// replayCache remembers handshakes to detect replay attacks.
+//
+// Cache has fixed size, the oldest entries are evicted first.
+type replayCache struct {
+	mux  sync.Mutex
+	seen map[string]struct{}
+	ring []string
+	next int
+}
+
+func newReplayCache(size int) *replayCache {
+	return &replayCache{
+		seen: make(map[string]struct{}, size),
+		ring: make([]string, size),
+	}
+}
+
+// add adds given handshake key to cache and reports whether it was already
+// seen.
+func (c *replayCache) add(key []byte) bool {
+	k := string(key)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if _, ok := c.seen[k]; ok {
+		return true
+	}
+
+	if old := c.ring[c.next]; old != "" {
+		delete(c.seen, old)
+	}
+	c.ring[c.next] = k
+	c.next = (c.next + 1) % len(c.ring)
+	c.seen[k] = struct{}{}
+	return false
+}
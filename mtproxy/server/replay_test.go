@@ -0,0 +1,20 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayCache(t *testing.T) {
+	a := require.New(t)
+	c := newReplayCache(2)
+
+	a.False(c.add([]byte("a")))
+	a.True(c.add([]byte("a")))
+	a.False(c.add([]byte("b")))
+	a.False(c.add([]byte("c")))
+	// "a" is evicted.
+	a.False(c.add([]byte("a")))
+	a.True(c.add([]byte("c")))
+}
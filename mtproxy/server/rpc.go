@@ -0,0 +1,268 @@
+package server
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"  // #nosec G501
+	"crypto/sha1" // #nosec G505
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+)
+
+// Middle proxy RPC constants.
+//
+// See https://github.com/TelegramMessenger/MTProxy.
+const (
+	rpcNonce     uint32 = 0x7acb87aa
+	rpcHandshake uint32 = 0x7682eef5
+	rpcProxyReq  uint32 = 0x36cef1ee
+	rpcProxyAns  uint32 = 0x4403da0d
+	rpcCloseExt  uint32 = 0x5eb634a2
+	rpcSimpleAck uint32 = 0x3bac409b
+
+	rpcCryptoAES uint32 = 1
+	rpcProxyTag  uint32 = 0xdb1e26ae
+
+	// rpcStartSeq is a sequence number of first frame.
+	rpcStartSeq = -2
+	// rpcMaxFrameLength is a maximum length of RPC frame.
+	rpcMaxFrameLength = 1 << 24
+	// rpcPadding is a filler used to align encrypted frames to AES block
+	// size.
+	rpcPadding uint32 = 4
+)
+
+// rpcPID is a process ID sent in handshake.
+var rpcPID = []byte("IPIPPRPDTIME")
+
+// rpcConn is a connection to Telegram middle proxy.
+//
+// Data is sent in frames of MTProto full transport, which are encrypted
+// using AES-CBC after handshake.
+type rpcConn struct {
+	conn io.ReadWriter
+
+	// Write state.
+	enc      cipher.BlockMode
+	writeSeq int32
+
+	// Read state.
+	dec     cipher.BlockMode
+	readSeq int32
+	buf     []byte // decrypted, but not consumed data
+}
+
+func newRPCConn(conn io.ReadWriter) *rpcConn {
+	return &rpcConn{
+		conn:     conn,
+		writeSeq: rpcStartSeq,
+		readSeq:  rpcStartSeq,
+	}
+}
+
+// fill reads data until buffer contains at least n bytes.
+func (r *rpcConn) fill(n int) error {
+	for len(r.buf) < n {
+		need := n - len(r.buf)
+		if r.dec != nil {
+			bs := r.dec.BlockSize()
+			need = (need + bs - 1) / bs * bs
+		}
+
+		chunk := make([]byte, need)
+		if _, err := io.ReadFull(r.conn, chunk); err != nil {
+			return err
+		}
+		if r.dec != nil {
+			r.dec.CryptBlocks(chunk, chunk)
+		}
+		r.buf = append(r.buf, chunk...)
+	}
+	return nil
+}
+
+// readFrame reads frame and returns its payload.
+func (r *rpcConn) readFrame() ([]byte, error) {
+	for {
+		if err := r.fill(bin.Word); err != nil {
+			return nil, errors.Wrap(err, "read length")
+		}
+		length := int(binary.LittleEndian.Uint32(r.buf))
+		if length == int(rpcPadding) {
+			r.buf = r.buf[bin.Word:]
+			continue
+		}
+		// Length, sequence number and checksum.
+		if length < 3*bin.Word || length > rpcMaxFrameLength || length%bin.Word != 0 {
+			return nil, errors.Errorf("invalid frame length %d", length)
+		}
+
+		if err := r.fill(length); err != nil {
+			return nil, errors.Wrap(err, "read frame")
+		}
+		frame := r.buf[:length]
+		r.buf = r.buf[length:]
+
+		if seq := int32(binary.LittleEndian.Uint32(frame[4:8])); seq != r.readSeq {
+			return nil, errors.Errorf("unexpected sequence number %d (expected %d)", seq, r.readSeq)
+		}
+		r.readSeq++
+
+		checksum := binary.LittleEndian.Uint32(frame[length-bin.Word:])
+		if crc32.ChecksumIEEE(frame[:length-bin.Word]) != checksum {
+			return nil, errors.New("checksum mismatch")
+		}
+
+		return append([]byte(nil), frame[8:length-bin.Word]...), nil
+	}
+}
+
+// writeFrame writes frame with given payload. Payload must be aligned
+// to 4 bytes.
+func (r *rpcConn) writeFrame(data []byte) error {
+	if len(data)%bin.Word != 0 {
+		return errors.Errorf("payload length %d is not aligned", len(data))
+	}
+
+	length := len(data) + 3*bin.Word
+	b := &bin.Buffer{Buf: make([]byte, 0, length+aes.BlockSize)}
+	b.PutInt(length)
+	b.PutInt32(r.writeSeq)
+	b.Put(data)
+	b.PutUint32(crc32.ChecksumIEEE(b.Buf))
+	r.writeSeq++
+
+	if r.enc != nil {
+		// Encrypted frames are aligned to block size.
+		for b.Len()%r.enc.BlockSize() != 0 {
+			b.PutUint32(rpcPadding)
+		}
+		r.enc.CryptBlocks(b.Buf, b.Buf)
+	}
+	_, err := r.conn.Write(b.Buf)
+	return err
+}
+
+// rpcKeys derives AES key and IV of middle proxy connection.
+//
+// Purpose is "CLIENT" for data sent by client and "SERVER" for data sent
+// by middle proxy. Client is the MTProxy server itself.
+func rpcKeys(
+	purpose string,
+	serverNonce, clientNonce [16]byte,
+	ts uint32,
+	server, client *net.TCPAddr,
+	secret []byte,
+) (key, iv []byte) {
+	ip := func(addr *net.TCPAddr) []byte {
+		v4 := addr.IP.To4()
+		return []byte{v4[3], v4[2], v4[1], v4[0]}
+	}
+	port := func(addr *net.TCPAddr) []byte {
+		return binary.LittleEndian.AppendUint16(nil, uint16(addr.Port))
+	}
+
+	var s bytes.Buffer
+	s.Write(serverNonce[:])
+	s.Write(clientNonce[:])
+	s.Write(binary.LittleEndian.AppendUint32(nil, ts))
+	s.Write(ip(server))
+	s.Write(port(client))
+	s.WriteString(purpose)
+	s.Write(ip(client))
+	s.Write(port(server))
+	s.Write(secret)
+	s.Write(serverNonce[:])
+	s.Write(clientNonce[:])
+	data := s.Bytes()
+
+	md5Sum := md5.Sum(data[1:]) // #nosec G401
+	sha1Sum := sha1.Sum(data)   // #nosec G401
+	key = append(md5Sum[:12:12], sha1Sum[:]...)
+	ivSum := md5.Sum(data[2:]) // #nosec G401
+	return key, ivSum[:]
+}
+
+// handshake performs handshake with middle proxy.
+//
+// Local is a public address of connection, remote is an address of middle
+// proxy.
+func (r *rpcConn) handshake(rand io.Reader, now time.Time, secret []byte, local, remote *net.TCPAddr) error {
+	if local.IP.To4() == nil || remote.IP.To4() == nil {
+		return errors.Errorf("IPv6 is not supported (%s -> %s)", local, remote)
+	}
+
+	var clientNonce [16]byte
+	if _, err := io.ReadFull(rand, clientNonce[:]); err != nil {
+		return errors.Wrap(err, "generate nonce")
+	}
+	ts := uint32(now.Unix())
+	keySelector := secret[:4]
+
+	b := &bin.Buffer{}
+	b.PutUint32(rpcNonce)
+	b.Put(keySelector)
+	b.PutUint32(rpcCryptoAES)
+	b.PutUint32(ts)
+	b.Put(clientNonce[:])
+	if err := r.writeFrame(b.Buf); err != nil {
+		return errors.Wrap(err, "write nonce")
+	}
+
+	ans, err := r.readFrame()
+	if err != nil {
+		return errors.Wrap(err, "read nonce")
+	}
+	if len(ans) != 32 ||
+		binary.LittleEndian.Uint32(ans[0:4]) != rpcNonce ||
+		!bytes.Equal(ans[4:8], keySelector) ||
+		binary.LittleEndian.Uint32(ans[8:12]) != rpcCryptoAES {
+		return errors.New("invalid nonce answer")
+	}
+	var serverNonce [16]byte
+	copy(serverNonce[:], ans[16:32])
+
+	newCBC := func(purpose string, f func(b cipher.Block, iv []byte) cipher.BlockMode) (cipher.BlockMode, error) {
+		key, iv := rpcKeys(purpose, serverNonce, clientNonce, ts, remote, local, secret)
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return f(block, iv), nil
+	}
+	if r.enc, err = newCBC("CLIENT", cipher.NewCBCEncrypter); err != nil {
+		return errors.Wrap(err, "create encrypter")
+	}
+	if r.dec, err = newCBC("SERVER", cipher.NewCBCDecrypter); err != nil {
+		return errors.Wrap(err, "create decrypter")
+	}
+
+	b.Reset()
+	b.PutUint32(rpcHandshake)
+	b.PutUint32(0) // Flags.
+	b.Put(rpcPID)  // Sender PID.
+	b.Put(rpcPID)  // Peer PID.
+	if err := r.writeFrame(b.Buf); err != nil {
+		return errors.Wrap(err, "write handshake")
+	}
+
+	ans, err = r.readFrame()
+	if err != nil {
+		return errors.Wrap(err, "read handshake")
+	}
+	if len(ans) != 32 ||
+		binary.LittleEndian.Uint32(ans[0:4]) != rpcHandshake ||
+		!bytes.Equal(ans[20:32], rpcPID) {
+		return errors.New("invalid handshake answer")
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+// Package server contains MTProxy server implementation.
+//
+// Server accepts clients using Simple, Secured (dd) and TLS (ee) secrets
+// and forwards traffic directly to Telegram DCs or, if ad tag is used,
+// through Telegram middle proxies.
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram/dcs"
+)
+
+// adTagLength is a length of ad tag.
+const adTagLength = 16
+
+// Server is a MTProxy server.
+type Server struct {
+	secrets    []mtproxy.Secret
+	adTag      []byte
+	middle     Middle
+	dcs        dcs.List
+	preferIPv6 bool
+	dial       dcs.DialFunc
+	timeSkew   time.Duration
+	timeout    time.Duration
+	rand       io.Reader
+	clock      clock.Clock
+	log        *zap.Logger
+
+	replays *replayCache
+	stats   counters
+}
+
+// New creates new Server.
+func New(opts Options) (*Server, error) {
+	if len(opts.Secrets) == 0 {
+		return nil, errors.New("no secrets")
+	}
+	for i, s := range opts.Secrets {
+		if len(s.Secret) != 16 {
+			return nil, errors.Errorf("secret %d: invalid length %d", i, len(s.Secret))
+		}
+	}
+	if opts.AdTag != nil {
+		if len(opts.AdTag) != adTagLength {
+			return nil, errors.Errorf("invalid ad tag length %d", len(opts.AdTag))
+		}
+		if opts.Middle.Zero() {
+			return nil, errors.New("ad tag requires middle proxies")
+		}
+	}
+	if !opts.Middle.Zero() {
+		if opts.AdTag == nil {
+			return nil, errors.New("middle proxies require ad tag")
+		}
+		if err := opts.Middle.validate(); err != nil {
+			return nil, errors.Wrap(err, "middle")
+		}
+	}
+	opts.setDefaults()
+
+	return &Server{
+		secrets:    opts.Secrets,
+		adTag:      opts.AdTag,
+		middle:     opts.Middle,
+		dcs:        opts.DCs,
+		preferIPv6: opts.PreferIPv6,
+		dial:       opts.Dial,
+		timeSkew:   opts.TimeSkew,
+		timeout:    opts.HandshakeTimeout,
+		rand:       opts.Rand,
+		clock:      opts.Clock,
+		log:        opts.Logger,
+		replays:    newReplayCache(opts.ReplayCacheSize),
+		stats: counters{
+			secrets: make([]secretCounters, len(opts.Secrets)),
+		},
+	}, nil
+}
+
+// Serve accepts client connections using given listener until context is
+// done.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	s.log.Info("Serving", zap.Stringer("addr", l.Addr()))
+	defer func() {
+		s.log.Info("Stopping")
+	}()
+
+	grp := tdsync.NewCancellableGroup(ctx)
+	grp.Go(func(context.Context) error {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					return nil
+				}
+				return errors.Wrap(err, "accept")
+			}
+
+			grp.Go(func(ctx context.Context) error {
+				s.serveConn(ctx, conn)
+				return nil
+			})
+		}
+	})
+	grp.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return l.Close()
+	})
+	return grp.Wait()
+}
+
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	s.stats.accepted.Inc()
+	s.stats.connections.Inc()
+	defer s.stats.connections.Dec()
+
+	lg := s.log.With(zap.Stringer("remote", conn.RemoteAddr()))
+	defer func() {
+		if err := conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			lg.Debug("Close error", zap.Error(err))
+		}
+	}()
+	conn = countConn{
+		Conn: conn,
+		in:   &s.stats.bytesIn,
+		out:  &s.stats.bytesOut,
+	}
+
+	// Network deadlines use wall clock.
+	deadline := time.Now().Add(s.timeout)
+	if err := conn.SetDeadline(deadline); err != nil {
+		lg.Debug("Set deadline", zap.Error(err))
+		return
+	}
+	c, err := s.accept(conn)
+	if err != nil {
+		lg.Debug("Handshake failed", zap.Error(err))
+		return
+	}
+	lg = lg.With(zap.Int("dc_id", c.dc), zap.Int("secret", c.secret))
+
+	counter := &s.stats.secrets[c.secret]
+	counter.accepted.Inc()
+	counter.connections.Inc()
+	defer counter.connections.Dec()
+
+	lg.Debug("Client connected")
+	if err := s.relay(ctx, deadline, conn, c); err != nil {
+		lg.Debug("Relay error", zap.Error(err))
+		return
+	}
+	lg.Debug("Client disconnected")
+}
+
+// relay forwards traffic between client and Telegram.
+//
+// Given deadline is applied to connection to Telegram.
+func (s *Server) relay(ctx context.Context, deadline time.Time, conn net.Conn, c client) error {
+	dialCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	var (
+		upstream net.Conn
+		rw       io.ReadWriter
+		err      error
+	)
+	if s.middle.Zero() {
+		upstream, rw, err = s.dialDirect(dialCtx, deadline, c)
+	} else {
+		upstream, rw, err = s.dialMiddle(dialCtx, deadline, conn, c)
+	}
+	if err != nil {
+		s.stats.upstreamErrors.Inc()
+		return errors.Wrap(err, "connect to Telegram")
+	}
+	defer func() {
+		_ = upstream.Close()
+	}()
+
+	// Handshake is done, remove deadlines.
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return errors.Wrap(err, "reset deadline")
+	}
+	if err := upstream.SetDeadline(time.Time{}); err != nil {
+		return errors.Wrap(err, "reset upstream deadline")
+	}
+
+	return pipe(ctx, func() {
+		_ = conn.Close()
+		_ = upstream.Close()
+	}, c.conn, rw)
+}
+
+// pipe copies data between client and upstream until one of them is closed
+// or context is done. Connections are closed using given function.
+func pipe(ctx context.Context, closeAll func(), client, upstream io.ReadWriter) error {
+	errs := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(upstream, client)
+		errs <- errors.Wrap(err, "client to upstream")
+	}()
+	go func() {
+		_, err := io.Copy(client, upstream)
+		errs <- errors.Wrap(err, "upstream to client")
+	}()
+
+	var (
+		err  error
+		done int
+	)
+	select {
+	case err = <-errs:
+		done++
+	case <-ctx.Done():
+	}
+	closeAll()
+	for ; done < 2; done++ {
+		<-errs
+	}
+
+	if ctx.Err() != nil || isClosed(err) {
+		return nil
+	}
+	return err
+}
+
+// isClosed reports whether error is caused by closed connection.
+func isClosed(err error) bool {
+	return err == nil ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed)
+}
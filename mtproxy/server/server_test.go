@@ -0,0 +1,201 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/mtproxy"
+	"github.com/gotd/td/mtproxy/obfuscator"
+	"github.com/gotd/td/mtproxy/server"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/dcs"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgtest/cluster"
+	"github.com/gotd/td/transport"
+)
+
+func listen(t *testing.T) net.Listener {
+	ln, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	return ln
+}
+
+func dcList(addr net.Addr) dcs.List {
+	tcp := addr.(*net.TCPAddr)
+	return dcs.List{
+		Options: []tg.DCOption{{
+			ID:        2,
+			IPAddress: tcp.IP.String(),
+			Port:      tcp.Port,
+		}},
+	}
+}
+
+func TestServer(t *testing.T) {
+	a := require.New(t)
+	log := zaptest.NewLogger(t)
+	defer func() { _ = log.Sync() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	secrets := map[string][]byte{
+		"Secured": append([]byte{0xdd}, make([]byte, 16)...),
+		"TLS":     append(append([]byte{0xee}, make([]byte, 16)...), "example.com"...),
+	}
+	var parsed []mtproxy.Secret
+	for _, secret := range secrets {
+		// Make secrets unique.
+		secret[1] = byte(len(parsed) + 1)
+
+		s, err := mtproxy.ParseSecret(secret)
+		a.NoError(err)
+		parsed = append(parsed, s)
+	}
+
+	c := cluster.NewCluster(cluster.Options{
+		Logger: log.Named("cluster"),
+	})
+	dc, d := c.DC(2, "server")
+	h := tg.NewServerDispatcher(nil)
+	h.OnUsersGetUsers(func(ctx context.Context, id []tg.InputUserClass) ([]tg.UserClass, error) {
+		return []tg.UserClass{&tg.User{ID: 10}}, nil
+	})
+	d.HandleServer(h)
+	dcListener := listen(t)
+
+	proxy, err := server.New(server.Options{
+		Secrets: parsed,
+		DCs:     dcList(dcListener.Addr()),
+		Logger:  log.Named("proxy"),
+	})
+	a.NoError(err)
+	proxyListener := listen(t)
+
+	g := tdsync.NewCancellableGroup(ctx)
+	g.Go(func(ctx context.Context) error {
+		return dc.Serve(ctx, transport.ListenCodec(nil, transport.ObfuscatedListener(dcListener)))
+	})
+	g.Go(func(ctx context.Context) error {
+		return proxy.Serve(ctx, proxyListener)
+	})
+	g.Go(func(ctx context.Context) error {
+		defer g.Cancel()
+
+		for name, secret := range secrets {
+			resolver, err := dcs.MTProxy(proxyListener.Addr().String(), secret, dcs.MTProxyOptions{})
+			if err != nil {
+				return err
+			}
+
+			client := telegram.NewClient(telegram.TestAppID, telegram.TestAppHash, telegram.Options{
+				PublicKeys:     c.Keys(),
+				DC:             2,
+				DCList:         dcList(dcListener.Addr()),
+				Resolver:       resolver,
+				Logger:         log.Named(name),
+				SessionStorage: &session.StorageMemory{},
+			})
+			if err := client.Run(ctx, func(ctx context.Context) error {
+				users, err := client.API().UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+				if err != nil {
+					return err
+				}
+				a.Equal([]tg.UserClass{&tg.User{ID: 10}}, users)
+				return nil
+			}); err != nil {
+				return errors.Wrap(err, name)
+			}
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		a.NoError(err)
+	}
+
+	stats := proxy.Stats()
+	a.Equal(int64(0), stats.HandshakeErrors)
+	a.Positive(stats.BytesIn)
+	a.Positive(stats.BytesOut)
+	for _, s := range stats.Secrets {
+		a.Positive(s.Accepted)
+	}
+}
+
+func TestServerReject(t *testing.T) {
+	a := require.New(t)
+	log := zaptest.NewLogger(t)
+	defer func() { _ = log.Sync() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	secret := mtproxy.Secret{
+		Secret: make([]byte, 16),
+		Type:   mtproxy.Simple,
+	}
+	proxy, err := server.New(server.Options{
+		Secrets: []mtproxy.Secret{secret},
+		// Nobody listens here.
+		DCs:    dcList(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1}),
+		Logger: log.Named("proxy"),
+	})
+	a.NoError(err)
+	ln := listen(t)
+
+	g := tdsync.NewCancellableGroup(ctx)
+	g.Go(func(ctx context.Context) error {
+		return proxy.Serve(ctx, ln)
+	})
+
+	// handshake connects to proxy and waits until connection is closed.
+	handshake := func(seed int64, secret mtproxy.Secret) {
+		conn, err := net.Dial("tcp4", ln.Addr().String())
+		a.NoError(err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		obfs := obfuscator.Obfuscated2(rand.New(rand.NewSource(seed)), conn)
+		a.NoError(obfs.Handshake(codec.PaddedIntermediateClientStart, 2, secret))
+
+		_, err = io.Copy(io.Discard, conn)
+		a.NoError(err)
+	}
+
+	// Valid secret, but DC is not available.
+	handshake(1, secret)
+	a.Equal(int64(1), proxy.Stats().UpstreamErrors)
+
+	// Same header.
+	handshake(1, secret)
+	a.Equal(int64(1), proxy.Stats().Replays)
+
+	// Unknown secret.
+	handshake(2, mtproxy.Secret{
+		Secret: []byte("0123456789abcdef"),
+		Type:   mtproxy.Simple,
+	})
+	a.Equal(int64(1), proxy.Stats().HandshakeErrors)
+
+	stats := proxy.Stats()
+	a.Equal(int64(3), stats.Accepted)
+	a.Equal(int64(1), stats.Secrets[0].Accepted)
+
+	g.Cancel()
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		a.NoError(err)
+	}
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"net"
+
+	"go.uber.org/atomic"
+)
+
+// Stats is a snapshot of server statistics.
+type Stats struct {
+	// Connections is a number of active client connections.
+	Connections int64 `json:"connections"`
+	// Accepted is a total number of client connections.
+	Accepted int64 `json:"accepted"`
+	// HandshakeErrors is a number of client connections with invalid
+	// handshake, including unknown secrets and time skew.
+	HandshakeErrors int64 `json:"handshake_errors"`
+	// Replays is a number of detected replay attacks.
+	Replays int64 `json:"replays"`
+	// UpstreamErrors is a number of failed connections to Telegram.
+	UpstreamErrors int64 `json:"upstream_errors"`
+	// BytesIn is a number of bytes received from clients.
+	BytesIn int64 `json:"bytes_in"`
+	// BytesOut is a number of bytes sent to clients.
+	BytesOut int64 `json:"bytes_out"`
+	// Secrets contains statistics of every secret, in order of
+	// Options.Secrets.
+	Secrets []SecretStats `json:"secrets"`
+}
+
+// SecretStats is a snapshot of secret statistics.
+type SecretStats struct {
+	// Connections is a number of active client connections.
+	Connections int64 `json:"connections"`
+	// Accepted is a total number of accepted client connections.
+	Accepted int64 `json:"accepted"`
+}
+
+type secretCounters struct {
+	connections atomic.Int64
+	accepted    atomic.Int64
+}
+
+type counters struct {
+	connections     atomic.Int64
+	accepted        atomic.Int64
+	handshakeErrors atomic.Int64
+	replays         atomic.Int64
+	upstreamErrors  atomic.Int64
+	bytesIn         atomic.Int64
+	bytesOut        atomic.Int64
+	secrets         []secretCounters
+}
+
+// Stats returns snapshot of server statistics.
+func (s *Server) Stats() Stats {
+	c := &s.stats
+	r := Stats{
+		Connections:     c.connections.Load(),
+		Accepted:        c.accepted.Load(),
+		HandshakeErrors: c.handshakeErrors.Load(),
+		Replays:         c.replays.Load(),
+		UpstreamErrors:  c.upstreamErrors.Load(),
+		BytesIn:         c.bytesIn.Load(),
+		BytesOut:        c.bytesOut.Load(),
+		Secrets:         make([]SecretStats, len(c.secrets)),
+	}
+	for i := range c.secrets {
+		r.Secrets[i] = SecretStats{
+			Connections: c.secrets[i].connections.Load(),
+			Accepted:    c.secrets[i].accepted.Load(),
+		}
+	}
+	return r
+}
+
+// countConn counts bytes received from and sent to client.
+type countConn struct {
+	net.Conn
+	in, out *atomic.Int64
+}
+
+func (c countConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.in.Add(int64(n))
+	return n, err
+}
+
+func (c countConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	c.out.Add(int64(n))
+	return n, err
+}
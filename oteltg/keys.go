@@ -1 +1,17 @@
 package oteltg
+
+import "go.opentelemetry.io/otel/attribute"
+
+// Attribute keys of metrics.
+const (
+	// MethodKey is TL name of RPC method, like "help.getConfig".
+	MethodKey = attribute.Key("tg.method")
+	// ErrorTypeKey is type of RPC error, like "FLOOD_WAIT".
+	ErrorTypeKey = attribute.Key("tg.error.type")
+	// DCKey is datacenter ID.
+	DCKey = attribute.Key("tg.dc")
+	// TempKeyKey reports whether temporary key was generated.
+	TempKeyKey = attribute.Key("tg.mtproto.temp_key")
+	// SuccessKey reports whether operation succeeded.
+	SuccessKey = attribute.Key("tg.success")
+)
@@ -0,0 +1,225 @@
+package oteltg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/mt"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tmap"
+)
+
+// unknownErrorType is ErrorTypeKey value of errors which are not RPC errors,
+// like network errors or context cancellation.
+const unknownErrorType = "UNKNOWN"
+
+var (
+	typesMap  *tmap.Map
+	typesOnce sync.Once
+)
+
+func getTypesMapping() *tmap.Map {
+	typesOnce.Do(func() {
+		typesMap = tmap.New(
+			tg.TypesMap(),
+			mt.TypesMap(),
+		)
+	})
+	return typesMap
+}
+
+// methodName returns TL name of request without type id.
+func methodName(input bin.Encoder) string {
+	t, ok := input.(interface{ TypeID() uint32 })
+	if !ok {
+		return fmt.Sprintf("%T", input)
+	}
+	name := getTypesMapping().Get(t.TypeID())
+	if name == "" {
+		return fmt.Sprintf("0x%x", t.TypeID())
+	}
+	if idx := strings.IndexByte(name, '#'); idx >= 0 {
+		name = name[:idx]
+	}
+	return name
+}
+
+var (
+	_ telegram.Middleware = (*Metrics)(nil)
+	_ telegram.Hooks      = (*Metrics)(nil)
+)
+
+// Metrics is OpenTelemetry metrics instrumentation of telegram.Client.
+//
+// Metrics is telegram.Middleware which collects RPC metrics and
+// telegram.Hooks which collects connection metrics:
+//
+//	m, err := oteltg.NewMetrics(provider)
+//	if err != nil {
+//		return err
+//	}
+//	client := telegram.NewClient(appID, appHash, telegram.Options{
+//		Middlewares: []telegram.Middleware{m},
+//		Hooks:       m,
+//	})
+type Metrics struct {
+	rpcDuration metric.Float64Histogram
+	rpcErrors   metric.Int64Counter
+	rpcInFlight metric.Int64UpDownCounter
+
+	reconnects  metric.Int64Counter
+	connections metric.Int64UpDownCounter
+
+	exchanges        metric.Int64Counter
+	exchangeDuration metric.Float64Histogram
+	saltUpdates      metric.Int64Counter
+	sentBytes        metric.Int64Counter
+	receivedBytes    metric.Int64Counter
+}
+
+// NewMetrics creates new Metrics using given provider.
+func NewMetrics(provider metric.MeterProvider) (*Metrics, error) {
+	meter := provider.Meter(Name)
+
+	var (
+		m   Metrics
+		err error
+	)
+	if m.rpcDuration, err = meter.Float64Histogram("tg.rpc.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of RPC calls"),
+	); err != nil {
+		return nil, errors.Wrap(err, "rpc duration")
+	}
+	if m.rpcErrors, err = meter.Int64Counter("tg.rpc.errors",
+		metric.WithDescription("Number of failed RPC calls"),
+	); err != nil {
+		return nil, errors.Wrap(err, "rpc errors")
+	}
+	if m.rpcInFlight, err = meter.Int64UpDownCounter("tg.rpc.in_flight",
+		metric.WithDescription("Number of RPC calls in progress"),
+	); err != nil {
+		return nil, errors.Wrap(err, "rpc in flight")
+	}
+	if m.reconnects, err = meter.Int64Counter("tg.conn.reconnects",
+		metric.WithDescription("Number of primary connection restarts"),
+	); err != nil {
+		return nil, errors.Wrap(err, "reconnects")
+	}
+	if m.connections, err = meter.Int64UpDownCounter("tg.conn.active",
+		metric.WithDescription("Number of established connections, including pools"),
+	); err != nil {
+		return nil, errors.Wrap(err, "connections")
+	}
+	if m.exchanges, err = meter.Int64Counter("tg.mtproto.key_exchanges",
+		metric.WithDescription("Number of key exchanges"),
+	); err != nil {
+		return nil, errors.Wrap(err, "key exchanges")
+	}
+	if m.exchangeDuration, err = meter.Float64Histogram("tg.mtproto.key_exchange.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of key exchanges"),
+	); err != nil {
+		return nil, errors.Wrap(err, "key exchange duration")
+	}
+	if m.saltUpdates, err = meter.Int64Counter("tg.mtproto.salt_updates",
+		metric.WithDescription("Number of server salt updates"),
+	); err != nil {
+		return nil, errors.Wrap(err, "salt updates")
+	}
+	if m.sentBytes, err = meter.Int64Counter("tg.mtproto.sent",
+		metric.WithUnit("By"),
+		metric.WithDescription("Number of sent bytes"),
+	); err != nil {
+		return nil, errors.Wrap(err, "sent bytes")
+	}
+	if m.receivedBytes, err = meter.Int64Counter("tg.mtproto.received",
+		metric.WithUnit("By"),
+		metric.WithDescription("Number of received bytes"),
+	); err != nil {
+		return nil, errors.Wrap(err, "received bytes")
+	}
+
+	return &m, nil
+}
+
+// Handle implements telegram.Middleware.
+func (m *Metrics) Handle(next tg.Invoker) telegram.InvokeFunc {
+	return func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+		method := metric.WithAttributes(MethodKey.String(methodName(input)))
+
+		m.rpcInFlight.Add(ctx, 1, method)
+		start := time.Now()
+		err := next.Invoke(ctx, input, output)
+		// Using background context, so metrics are recorded even
+		// if request is canceled.
+		m.rpcDuration.Record(context.Background(), time.Since(start).Seconds(), method)
+		m.rpcInFlight.Add(context.Background(), -1, method)
+
+		if err != nil {
+			errType := unknownErrorType
+			if rpcErr, ok := tgerr.As(err); ok {
+				errType = rpcErr.Type
+			}
+			m.rpcErrors.Add(context.Background(), 1, method,
+				metric.WithAttributes(ErrorTypeKey.String(errType)),
+			)
+		}
+		return err
+	}
+}
+
+func dc(id int) metric.MeasurementOption {
+	return metric.WithAttributes(DCKey.Int(id))
+}
+
+// OnConnect implements telegram.Hooks.
+func (m *Metrics) OnConnect(id int) {
+	m.connections.Add(context.Background(), 1, dc(id))
+}
+
+// OnDisconnect implements telegram.Hooks.
+func (m *Metrics) OnDisconnect(id int) {
+	m.connections.Add(context.Background(), -1, dc(id))
+}
+
+// OnKeyExchange implements telegram.Hooks.
+func (m *Metrics) OnKeyExchange(id int, temp bool, d time.Duration, err error) {
+	attrs := metric.WithAttributeSet(attribute.NewSet(
+		DCKey.Int(id),
+		TempKeyKey.Bool(temp),
+		SuccessKey.Bool(err == nil),
+	))
+	m.exchanges.Add(context.Background(), 1, attrs)
+	m.exchangeDuration.Record(context.Background(), d.Seconds(), attrs)
+}
+
+// OnSaltUpdate implements telegram.Hooks.
+func (m *Metrics) OnSaltUpdate(id int) {
+	m.saltUpdates.Add(context.Background(), 1, dc(id))
+}
+
+// OnSend implements telegram.Hooks.
+func (m *Metrics) OnSend(id, bytes int) {
+	m.sentBytes.Add(context.Background(), int64(bytes), dc(id))
+}
+
+// OnReceive implements telegram.Hooks.
+func (m *Metrics) OnReceive(id, bytes int) {
+	m.receivedBytes.Add(context.Background(), int64(bytes), dc(id))
+}
+
+// OnReconnect implements telegram.Hooks.
+func (m *Metrics) OnReconnect(id int, err error) {
+	m.reconnects.Add(context.Background(), 1, dc(id))
+}
@@ -0,0 +1,103 @@
+package oteltg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/oteltg"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+func collect(t *testing.T, reader sdkmetric.Reader) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	r := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		require.Equal(t, oteltg.Name, sm.Scope.Name)
+		for _, m := range sm.Metrics {
+			r[m.Name] = m.Data
+		}
+	}
+	return r
+}
+
+func TestMetrics(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	reader := sdkmetric.NewManualReader()
+	m, err := oteltg.NewMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	a.NoError(err)
+
+	invoker := m.Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+		switch input.(type) {
+		case *tg.HelpGetConfigRequest:
+			return nil
+		case *tg.MessagesSendMessageRequest:
+			return tgerr.New(420, "FLOOD_WAIT_10")
+		default:
+			return errors.New("network error")
+		}
+	}))
+	a.NoError(invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil))
+	a.Error(invoker.Invoke(ctx, &tg.MessagesSendMessageRequest{Peer: &tg.InputPeerSelf{}}, nil))
+	a.Error(invoker.Invoke(ctx, &tg.HelpGetNearestDCRequest{}, nil))
+
+	m.OnConnect(2)
+	m.OnConnect(2)
+	m.OnDisconnect(2)
+	m.OnKeyExchange(2, false, time.Second, nil)
+	m.OnKeyExchange(2, true, time.Second, errors.New("failed"))
+	m.OnSaltUpdate(2)
+	m.OnSend(2, 100)
+	m.OnReceive(2, 200)
+	m.OnReconnect(2, errors.New("failed"))
+
+	data := collect(t, reader)
+
+	duration := data["tg.rpc.duration"].(metricdata.Histogram[float64])
+	a.Len(duration.DataPoints, 3)
+	for _, p := range duration.DataPoints {
+		a.Equal(uint64(1), p.Count)
+	}
+
+	errs := data["tg.rpc.errors"].(metricdata.Sum[int64])
+	types := map[string]string{}
+	for _, p := range errs.DataPoints {
+		method, _ := p.Attributes.Value(oteltg.MethodKey)
+		errType, _ := p.Attributes.Value(oteltg.ErrorTypeKey)
+		types[method.AsString()] = errType.AsString()
+	}
+	a.Equal(map[string]string{
+		"messages.sendMessage": "FLOOD_WAIT",
+		"help.getNearestDc":    "UNKNOWN",
+	}, types)
+
+	inFlight := data["tg.rpc.in_flight"].(metricdata.Sum[int64])
+	for _, p := range inFlight.DataPoints {
+		a.Zero(p.Value)
+	}
+
+	sum := func(name string) int64 {
+		var r int64
+		for _, p := range data[name].(metricdata.Sum[int64]).DataPoints {
+			r += p.Value
+		}
+		return r
+	}
+	a.Equal(int64(1), sum("tg.conn.active"))
+	a.Equal(int64(2), sum("tg.mtproto.key_exchanges"))
+	a.Equal(int64(1), sum("tg.mtproto.salt_updates"))
+	a.Equal(int64(100), sum("tg.mtproto.sent"))
+	a.Equal(int64(200), sum("tg.mtproto.received"))
+	a.Equal(int64(1), sum("tg.conn.reconnects"))
+}
@@ -0,0 +1,52 @@
+package session
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/dcs"
+)
+
+// defaultPort is a port of Telegram DC if address does not contain it.
+const defaultPort = 443
+
+// builtinDCs returns built-in DC list for given environment.
+func builtinDCs(test bool) dcs.List {
+	if test {
+		return dcs.Test()
+	}
+	return dcs.Prod()
+}
+
+// dcAddr returns IP address and port of session DC.
+//
+// If session address is not an IP, address is taken from built-in DC list.
+func dcAddr(data *Data) (net.IP, int, error) {
+	host, port := data.Addr, defaultPort
+	if h, p, err := net.SplitHostPort(data.Addr); err == nil {
+		host = h
+		if port, err = strconv.Atoi(p); err != nil {
+			return nil, 0, errors.Wrapf(err, "parse port %q", p)
+		}
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip, port, nil
+	}
+
+	addr := findDCAddr(builtinDCs(data.Config.TestMode).Options, data.DC)
+	if addr == "" {
+		return nil, 0, errors.Errorf("can't find address for DC %d", data.DC)
+	}
+	return net.ParseIP(addr), defaultPort, nil
+}
+
+// sessionKey returns auth key of session.
+func sessionKey(data *Data) ([]byte, error) {
+	if l := len(data.AuthKey); l != 256 {
+		return nil, errors.Errorf("invalid auth key length %d", l)
+	}
+	return data.AuthKey, nil
+}
@@ -0,0 +1,143 @@
+package session
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/crypto"
+)
+
+// Pyrogram session string layouts.
+//
+// See https://github.com/pyrogram/pyrogram/blob/master/pyrogram/storage/storage.py#L31-L34.
+const (
+	// ">BI?256sQ?": DC ID, API ID, test mode, auth key, user ID, is bot.
+	pyrogramSize = 1 + 4 + 1 + 256 + 8 + 1
+	// ">B?256sI?": DC ID, test mode, auth key, user ID, is bot.
+	pyrogramOldSize = 1 + 1 + 256 + 4 + 1
+	// ">B?256sQ?": DC ID, test mode, auth key, user ID, is bot.
+	pyrogramOld64Size = 1 + 1 + 256 + 8 + 1
+)
+
+// PyrogramInfo is a part of Pyrogram session which is not stored in Data.
+type PyrogramInfo struct {
+	// APIID is an app_id of Telegram app.
+	APIID int
+	// UserID is an ID of authorized user. Pyrogram considers session
+	// without user ID as unauthorized.
+	UserID int64
+	// Bot denotes that authorized user is a bot.
+	Bot bool
+}
+
+// PyrogramSession decodes Pyrogram's session string to the Data.
+// Notice that Pyrogram does not save DC address, tg.Config and server salt,
+// address is taken from built-in DC list.
+//
+// See https://docs.pyrogram.org/topics/storage-engines#session-strings.
+func PyrogramSession(s string) (*Data, error) {
+	data, _, err := decodePyrogram(s)
+	return data, err
+}
+
+// PyrogramSessionInfo is like PyrogramSession, but also returns PyrogramInfo.
+func PyrogramSessionInfo(s string) (*Data, PyrogramInfo, error) {
+	return decodePyrogram(s)
+}
+
+func decodePyrogram(s string) (*Data, PyrogramInfo, error) {
+	s = strings.TrimRight(strings.TrimSpace(s), "=")
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, PyrogramInfo{}, errors.Wrap(err, "decode base64")
+	}
+
+	var (
+		info     PyrogramInfo
+		testMode bool
+		key      crypto.Key
+	)
+	switch len(raw) {
+	case pyrogramSize:
+		info.APIID = int(binary.BigEndian.Uint32(raw[1:5]))
+		testMode = raw[5] != 0
+		copy(key[:], raw[6:262])
+		info.UserID = int64(binary.BigEndian.Uint64(raw[262:270]))
+		info.Bot = raw[270] != 0
+	case pyrogramOldSize:
+		testMode = raw[1] != 0
+		copy(key[:], raw[2:258])
+		info.UserID = int64(binary.BigEndian.Uint32(raw[258:262]))
+		info.Bot = raw[262] != 0
+	case pyrogramOld64Size:
+		testMode = raw[1] != 0
+		copy(key[:], raw[2:258])
+		info.UserID = int64(binary.BigEndian.Uint64(raw[258:266]))
+		info.Bot = raw[266] != 0
+	default:
+		return nil, PyrogramInfo{}, errors.Errorf("decoded data has invalid length: %d", len(raw))
+	}
+	dc := int(raw[0])
+
+	addr := findDCAddr(builtinDCs(testMode).Options, dc)
+	if addr == "" {
+		return nil, PyrogramInfo{}, errors.Errorf("can't find address for DC %d", dc)
+	}
+	id := key.WithID().ID
+
+	return &Data{
+		Config: Config{
+			TestMode: testMode,
+			ThisDC:   dc,
+		},
+		DC:        dc,
+		Addr:      net.JoinHostPort(addr, strconv.Itoa(defaultPort)),
+		AuthKey:   key[:],
+		AuthKeyID: id[:],
+	}, info, nil
+}
+
+// PyrogramString encodes Data to Pyrogram's session string.
+//
+// Pyrogram session contains user info, which is not stored in Data,
+// so it should be provided. UserID is required.
+//
+// See https://github.com/pyrogram/pyrogram/blob/master/pyrogram/storage/storage.py#L72-L83.
+func PyrogramString(data *Data, info PyrogramInfo) (string, error) {
+	key, err := sessionKey(data)
+	if err != nil {
+		return "", err
+	}
+	if info.UserID == 0 {
+		return "", errors.New("user ID is required")
+	}
+	if data.DC < 0 || data.DC > math.MaxUint8 {
+		return "", errors.Errorf("invalid DC ID %d", data.DC)
+	}
+	if info.APIID < 0 || int64(info.APIID) > math.MaxUint32 {
+		return "", errors.Errorf("invalid API ID %d", info.APIID)
+	}
+
+	buf := make([]byte, 0, pyrogramSize)
+	buf = append(buf, byte(data.DC))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(info.APIID))
+	buf = append(buf, boolByte(data.Config.TestMode))
+	buf = append(buf, key...)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(info.UserID))
+	buf = append(buf, boolByte(info.Bot))
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func boolByte(v bool) byte {
+	if v {
+		return 1
+	}
+	return 0
+}
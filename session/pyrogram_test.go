@@ -0,0 +1,106 @@
+package session
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/telegram/dcs"
+)
+
+func TestPyrogramString(t *testing.T) {
+	a := require.New(t)
+
+	var key crypto.Key
+	copy(key[:], testKey)
+	authKey := key.WithID()
+
+	data := &Data{
+		Config: Config{
+			ThisDC: 2,
+		},
+		DC:        2,
+		Addr:      findDCAddr(dcs.Prod().Options, 2) + ":443",
+		AuthKey:   authKey.Value[:],
+		AuthKeyID: authKey.ID[:],
+	}
+	info := PyrogramInfo{
+		APIID:  17349,
+		UserID: 309570373,
+		Bot:    true,
+	}
+
+	s, err := PyrogramString(data, info)
+	a.NoError(err)
+	// Pyrogram strips padding.
+	a.NotContains(s, "=")
+
+	got, gotInfo, err := PyrogramSessionInfo(s)
+	a.NoError(err)
+	a.Equal(data, got)
+	a.Equal(info, gotInfo)
+
+	_, err = PyrogramString(data, PyrogramInfo{})
+	a.Error(err, "user ID is required")
+	_, err = PyrogramString(&Data{}, info)
+	a.Error(err, "auth key is required")
+}
+
+func TestPyrogramSession(t *testing.T) {
+	var key crypto.Key
+	copy(key[:], testKey)
+	authKey := key.WithID()
+
+	encode := func(data []byte) string {
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	// ">B?256sI?"
+	old := append([]byte{2, 1}, testKey...)
+	old = binary.BigEndian.AppendUint32(old, 10)
+	old = append(old, 0)
+	// ">B?256sQ?"
+	old64 := append([]byte{2, 1}, testKey...)
+	old64 = binary.BigEndian.AppendUint64(old64, 10)
+	old64 = append(old64, 0)
+
+	testAddr := findDCAddr(dcs.Test().Options, 2) + ":443"
+	tests := []struct {
+		name    string
+		s       string
+		want    *Data
+		wantErr bool
+	}{
+		{"Old", encode(old), &Data{
+			Config:    Config{TestMode: true, ThisDC: 2},
+			DC:        2,
+			Addr:      testAddr,
+			AuthKey:   authKey.Value[:],
+			AuthKeyID: authKey.ID[:],
+		}, false},
+		{"Old64", encode(old64), &Data{
+			Config:    Config{TestMode: true, ThisDC: 2},
+			DC:        2,
+			Addr:      testAddr,
+			AuthKey:   authKey.Value[:],
+			AuthKeyID: authKey.ID[:],
+		}, false},
+		{"Empty", "", nil, true},
+		{"InvalidLength", encode(repeat(100)), nil, true},
+		{"InvalidBase64", "!!!", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+			got, err := PyrogramSession(tt.s)
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tt.want, got)
+		})
+	}
+}
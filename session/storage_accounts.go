@@ -0,0 +1,180 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/internal/atomicfile"
+)
+
+// AccountsFile keeps sessions of multiple named accounts in one file
+// encrypted like EncryptedStorage.
+//
+// File is rewritten atomically on every change. AccountsFile is
+// goroutine-safe, but file must not be shared between processes.
+type AccountsFile struct {
+	// Path is a path to the file.
+	Path string
+	// Keys provides key to encrypt and decrypt file.
+	Keys KeyProvider
+	// Previous are providers which are used to decrypt file
+	// if Keys can't do it, e.g. after key rotation.
+	Previous []KeyProvider
+
+	mux sync.Mutex
+}
+
+type accountsData struct {
+	Version  int
+	Accounts map[string][]byte
+}
+
+func (f *AccountsFile) encryption() *EncryptedStorage {
+	return &EncryptedStorage{
+		Keys:     f.Keys,
+		Previous: f.Previous,
+	}
+}
+
+func (f *AccountsFile) load(ctx context.Context) (map[string][]byte, error) {
+	data, err := os.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return map[string][]byte{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "read")
+	}
+
+	decrypted, err := f.encryption().decrypt(ctx, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt")
+	}
+	var v accountsData
+	if err := json.Unmarshal(decrypted, &v); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	if v.Version != latestVersion {
+		return nil, errors.Errorf("version mismatch (%d != %d)", v.Version, latestVersion)
+	}
+	if v.Accounts == nil {
+		v.Accounts = map[string][]byte{}
+	}
+	return v.Accounts, nil
+}
+
+func (f *AccountsFile) save(ctx context.Context, accounts map[string][]byte) error {
+	data, err := json.Marshal(accountsData{
+		Version:  latestVersion,
+		Accounts: accounts,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	encrypted, err := f.encryption().encrypt(ctx, data)
+	if err != nil {
+		return errors.Wrap(err, "encrypt")
+	}
+
+	// File is replaced atomically, because partial write loses
+	// all accounts.
+	if err := atomicfile.WriteFile(f.Path, encrypted); err != nil {
+		return errors.Wrap(err, "write")
+	}
+	return nil
+}
+
+func (f *AccountsFile) update(ctx context.Context, cb func(accounts map[string][]byte) error) error {
+	if f == nil {
+		return errors.New("nil session storage is invalid")
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	accounts, err := f.load(ctx)
+	if err != nil {
+		return errors.Wrap(err, "load")
+	}
+	if err := cb(accounts); err != nil {
+		return err
+	}
+	return f.save(ctx, accounts)
+}
+
+// Accounts returns sorted names of stored accounts.
+func (f *AccountsFile) Accounts(ctx context.Context) ([]string, error) {
+	if f == nil {
+		return nil, errors.New("nil session storage is invalid")
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	accounts, err := f.load(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "load")
+	}
+	names := make([]string, 0, len(accounts))
+	for name := range accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Delete deletes account session.
+func (f *AccountsFile) Delete(ctx context.Context, name string) error {
+	return f.update(ctx, func(accounts map[string][]byte) error {
+		delete(accounts, name)
+		return nil
+	})
+}
+
+// Rotate re-encrypts file using current key.
+func (f *AccountsFile) Rotate(ctx context.Context) error {
+	return f.update(ctx, func(map[string][]byte) error {
+		return nil
+	})
+}
+
+// Account returns Storage for account with given name.
+func (f *AccountsFile) Account(name string) Storage {
+	return accountStorage{file: f, name: name}
+}
+
+type accountStorage struct {
+	file *AccountsFile
+	name string
+}
+
+func (s accountStorage) LoadSession(ctx context.Context) ([]byte, error) {
+	f := s.file
+	if f == nil {
+		return nil, errors.New("nil session storage is invalid")
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	accounts, err := f.load(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "load")
+	}
+	data, ok := accounts[s.name]
+	if !ok || len(data) == 0 {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
+
+func (s accountStorage) StoreSession(ctx context.Context, data []byte) error {
+	return s.file.update(ctx, func(accounts map[string][]byte) error {
+		accounts[s.name] = append([]byte(nil), data...)
+		return nil
+	})
+}
@@ -0,0 +1,50 @@
+package session
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "accounts")
+	f := &AccountsFile{Path: path, Keys: testKeys("old")}
+
+	t.Run("First", testStorage(f.Account("first")))
+	t.Run("Second", testStorage(f.Account("second")))
+
+	a := require.New(t)
+	ctx := context.Background()
+
+	names, err := f.Accounts(ctx)
+	a.NoError(err)
+	a.Equal([]string{"first", "second"}, names)
+
+	a.NoError(f.Delete(ctx, "first"))
+	_, err = f.Account("first").LoadSession(ctx)
+	a.ErrorIs(err, ErrNotFound)
+
+	// Key rotation.
+	second, err := f.Account("second").LoadSession(ctx)
+	a.NoError(err)
+	rotated := &AccountsFile{Path: path, Keys: testKeys("new")}
+	a.NoError(rotated.Rotate(ctx))
+	_, err = (&AccountsFile{Path: path, Keys: StaticKeys{
+		Keys: map[string][]byte{"old": testKeys("").Keys["old"]},
+	}}).Accounts(ctx)
+	a.ErrorIs(err, ErrKeyNotFound)
+	got, err := rotated.Account("second").LoadSession(ctx)
+	a.NoError(err)
+	a.Equal(second, got)
+
+	// Tampered file.
+	raw, err := os.ReadFile(path)
+	a.NoError(err)
+	raw[len(raw)-1] ^= 1
+	a.NoError(os.WriteFile(path, raw, 0o600))
+	_, err = rotated.Account("second").LoadSession(ctx)
+	a.ErrorIs(err, ErrDecrypt)
+}
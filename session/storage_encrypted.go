@@ -0,0 +1,295 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/go-faster/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+var (
+	// ErrDecrypt means that encrypted session can't be decrypted: key is wrong
+	// or data is tampered.
+	ErrDecrypt = errors.New("session storage: decrypt failed")
+	// ErrKeyNotFound means that KeyProvider does not have key with given ID.
+	ErrKeyNotFound = errors.New("session storage: key not found")
+)
+
+// KeyProvider provides keys for EncryptedStorage.
+//
+// Keys are AES keys, so they must be 16, 24 or 32 bytes long.
+type KeyProvider interface {
+	// EncryptionKey returns current key and its ID. ID is stored
+	// along with encrypted data and passed to DecryptionKey on load.
+	EncryptionKey(ctx context.Context) (id string, key []byte, _ error)
+	// DecryptionKey returns key by ID. Should return ErrKeyNotFound if
+	// provider does not know such key.
+	DecryptionKey(ctx context.Context, id string) ([]byte, error)
+}
+
+// StaticKeys is KeyProvider with fixed set of keys.
+//
+// To rotate key, add new key to Keys and set Current to its ID.
+type StaticKeys struct {
+	// Current is an ID of key used for encryption.
+	Current string
+	// Keys is a map of keys by ID.
+	Keys map[string][]byte
+}
+
+var _ KeyProvider = StaticKeys{}
+
+// EncryptionKey implements KeyProvider.
+func (s StaticKeys) EncryptionKey(ctx context.Context) (string, []byte, error) {
+	key, err := s.DecryptionKey(ctx, s.Current)
+	if err != nil {
+		return "", nil, err
+	}
+	return s.Current, key, nil
+}
+
+// DecryptionKey implements KeyProvider.
+func (s StaticKeys) DecryptionKey(_ context.Context, id string) ([]byte, error) {
+	key, ok := s.Keys[id]
+	if !ok {
+		return nil, errors.Wrapf(ErrKeyNotFound, "key %q", id)
+	}
+	return key, nil
+}
+
+// Passphrase parameters.
+//
+// See https://pkg.go.dev/golang.org/x/crypto/scrypt#Key.
+const (
+	passphraseN       = 1 << 15
+	passphraseR       = 8
+	passphraseP       = 1
+	passphraseKeySize = 32
+	passphraseSalt    = 16
+	passphrasePrefix  = "scrypt:"
+)
+
+// Passphrase is KeyProvider which derives keys from passphrase using scrypt.
+//
+// Salt is generated once per Passphrase and stored as key ID, so every
+// Passphrase instance encrypts with its own key.
+type Passphrase struct {
+	passphrase []byte
+	rand       io.Reader
+
+	mux     sync.Mutex
+	current string
+	keys    map[string][]byte // key ID -> derived key
+}
+
+var _ KeyProvider = (*Passphrase)(nil)
+
+// NewPassphrase creates new Passphrase.
+func NewPassphrase(passphrase []byte) *Passphrase {
+	return &Passphrase{
+		passphrase: append([]byte(nil), passphrase...),
+		rand:       rand.Reader,
+		keys:       map[string][]byte{},
+	}
+}
+
+func (p *Passphrase) derive(id string, salt []byte) ([]byte, error) {
+	if key, ok := p.keys[id]; ok {
+		return key, nil
+	}
+	key, err := scrypt.Key(p.passphrase, salt, passphraseN, passphraseR, passphraseP, passphraseKeySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "derive key")
+	}
+	p.keys[id] = key
+	return key, nil
+}
+
+// EncryptionKey implements KeyProvider.
+func (p *Passphrase) EncryptionKey(context.Context) (string, []byte, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.current == "" {
+		salt := make([]byte, passphraseSalt)
+		if _, err := io.ReadFull(p.rand, salt); err != nil {
+			return "", nil, errors.Wrap(err, "generate salt")
+		}
+		p.current = passphrasePrefix + base64.RawStdEncoding.EncodeToString(salt)
+	}
+
+	salt, _ := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(p.current, passphrasePrefix))
+	key, err := p.derive(p.current, salt)
+	if err != nil {
+		return "", nil, err
+	}
+	return p.current, key, nil
+}
+
+// DecryptionKey implements KeyProvider.
+func (p *Passphrase) DecryptionKey(_ context.Context, id string) ([]byte, error) {
+	encoded, ok := strings.CutPrefix(id, passphrasePrefix)
+	if !ok {
+		return nil, errors.Wrapf(ErrKeyNotFound, "key %q", id)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode salt")
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.derive(id, salt)
+}
+
+// encryptedMagic is a header of encrypted session.
+//
+// Layout is
+//
+//	magic | uint8 len(key ID) | key ID | nonce | AES-GCM ciphertext
+//
+// Everything before nonce is used as additional data.
+const encryptedMagic = "gotdenc1"
+
+// EncryptedStorage is a Storage decorator which encrypts session
+// using AES-GCM.
+type EncryptedStorage struct {
+	// Storage is an underlying storage.
+	Storage Storage
+	// Keys provides key to encrypt and decrypt session.
+	Keys KeyProvider
+	// Previous are providers which are used to decrypt session
+	// if Keys can't do it, e.g. after key rotation.
+	Previous []KeyProvider
+}
+
+var _ Storage = (*EncryptedStorage)(nil)
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "create cipher")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "create GCM")
+	}
+	return gcm, nil
+}
+
+func (s *EncryptedStorage) encrypt(ctx context.Context, data []byte) ([]byte, error) {
+	if s.Keys == nil {
+		return nil, errors.New("no key provider")
+	}
+	id, key, err := s.Keys.EncryptionKey(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get key")
+	}
+	if len(id) > 255 {
+		return nil, errors.Errorf("key ID is too long (%d)", len(id))
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, len(encryptedMagic)+1+len(id)+gcm.NonceSize())
+	header = append(header, encryptedMagic...)
+	header = append(header, byte(len(id)))
+	header = append(header, id...)
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "generate nonce")
+	}
+	r := append(header, nonce...)
+	return gcm.Seal(r, nonce, data, header), nil
+}
+
+func (s *EncryptedStorage) decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte(encryptedMagic)) {
+		return nil, errors.Wrap(ErrDecrypt, "invalid header")
+	}
+	idEnd := len(encryptedMagic) + 1
+	if len(data) < idEnd {
+		return nil, errors.Wrap(ErrDecrypt, "invalid header")
+	}
+	idEnd += int(data[len(encryptedMagic)])
+	if len(data) < idEnd {
+		return nil, errors.Wrap(ErrDecrypt, "invalid header")
+	}
+	id := string(data[len(encryptedMagic)+1 : idEnd])
+	header, body := data[:idEnd], data[idEnd:]
+
+	providers := append([]KeyProvider{s.Keys}, s.Previous...)
+	found := false
+	for _, p := range providers {
+		if p == nil {
+			continue
+		}
+		key, err := p.DecryptionKey(ctx, id)
+		if errors.Is(err, ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "get key")
+		}
+		found = true
+
+		gcm, err := newGCM(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) < gcm.NonceSize() {
+			return nil, errors.Wrap(ErrDecrypt, "invalid nonce")
+		}
+		nonce, ciphertext := body[:gcm.NonceSize()], body[gcm.NonceSize():]
+		if r, err := gcm.Open(nil, nonce, ciphertext, header); err == nil {
+			return r, nil
+		}
+	}
+	if !found {
+		return nil, errors.Wrapf(ErrKeyNotFound, "key %q", id)
+	}
+	return nil, ErrDecrypt
+}
+
+// LoadSession loads and decrypts session.
+func (s *EncryptedStorage) LoadSession(ctx context.Context) ([]byte, error) {
+	data, err := s.Storage.LoadSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNotFound
+	}
+	return s.decrypt(ctx, data)
+}
+
+// StoreSession encrypts and stores session.
+func (s *EncryptedStorage) StoreSession(ctx context.Context, data []byte) error {
+	encrypted, err := s.encrypt(ctx, data)
+	if err != nil {
+		return errors.Wrap(err, "encrypt")
+	}
+	return s.Storage.StoreSession(ctx, encrypted)
+}
+
+// Rotate re-encrypts stored session using current key.
+//
+// Data encrypted with old key is loaded using Previous providers.
+func (s *EncryptedStorage) Rotate(ctx context.Context) error {
+	data, err := s.LoadSession(ctx)
+	if err != nil {
+		return errors.Wrap(err, "load")
+	}
+	return s.StoreSession(ctx, data)
+}
@@ -0,0 +1,106 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testKeys(current string) StaticKeys {
+	return StaticKeys{
+		Current: current,
+		Keys: map[string][]byte{
+			"old": bytes.Repeat([]byte{1}, 32),
+			"new": bytes.Repeat([]byte{2}, 16),
+		},
+	}
+}
+
+func TestEncryptedStorage(t *testing.T) {
+	t.Run("Static", testStorage(&EncryptedStorage{
+		Storage: &StorageMemory{},
+		Keys:    testKeys("old"),
+	}))
+	t.Run("Passphrase", testStorage(&EncryptedStorage{
+		Storage: &StorageMemory{},
+		Keys:    NewPassphrase([]byte("secret")),
+	}))
+}
+
+func TestEncryptedStorage_Encrypted(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	data := []byte(`{"Version":1}`)
+
+	mem := &StorageMemory{}
+	s := &EncryptedStorage{Storage: mem, Keys: testKeys("old")}
+	a.NoError(s.StoreSession(ctx, data))
+
+	raw, err := mem.Bytes(nil)
+	a.NoError(err)
+	a.NotContains(string(raw), string(data))
+
+	got, err := s.LoadSession(ctx)
+	a.NoError(err)
+	a.Equal(data, got)
+
+	// Tampered ciphertext.
+	tampered := append([]byte(nil), raw...)
+	tampered[len(tampered)-1] ^= 1
+	a.NoError(mem.StoreSession(ctx, tampered))
+	_, err = s.LoadSession(ctx)
+	a.ErrorIs(err, ErrDecrypt)
+
+	// Tampered key ID.
+	tampered = append([]byte(nil), raw...)
+	copy(tampered[len(encryptedMagic)+1:], "new")
+	a.NoError(mem.StoreSession(ctx, tampered))
+	_, err = s.LoadSession(ctx)
+	a.ErrorIs(err, ErrDecrypt)
+
+	// Plaintext.
+	a.NoError(mem.StoreSession(ctx, data))
+	_, err = s.LoadSession(ctx)
+	a.ErrorIs(err, ErrDecrypt)
+
+	// Unknown key.
+	a.NoError(mem.StoreSession(ctx, raw))
+	_, err = (&EncryptedStorage{Storage: mem, Keys: StaticKeys{}}).LoadSession(ctx)
+	a.ErrorIs(err, ErrKeyNotFound)
+}
+
+func TestEncryptedStorage_Rotate(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	data := []byte("session")
+
+	mem := &StorageMemory{}
+	a.NoError((&EncryptedStorage{Storage: mem, Keys: testKeys("old")}).StoreSession(ctx, data))
+
+	// Rotate static key.
+	s := &EncryptedStorage{Storage: mem, Keys: testKeys("new")}
+	a.NoError(s.Rotate(ctx))
+	got, err := (&EncryptedStorage{
+		Storage: mem,
+		Keys:    StaticKeys{Current: "new", Keys: map[string][]byte{"new": testKeys("").Keys["new"]}},
+	}).LoadSession(ctx)
+	a.NoError(err)
+	a.Equal(data, got)
+
+	// Switch to passphrase.
+	s = &EncryptedStorage{
+		Storage:  mem,
+		Keys:     NewPassphrase([]byte("secret")),
+		Previous: []KeyProvider{testKeys("new")},
+	}
+	a.NoError(s.Rotate(ctx))
+
+	got, err = (&EncryptedStorage{Storage: mem, Keys: NewPassphrase([]byte("secret"))}).LoadSession(ctx)
+	a.NoError(err)
+	a.Equal(data, got)
+
+	_, err = (&EncryptedStorage{Storage: mem, Keys: NewPassphrase([]byte("wrong"))}).LoadSession(ctx)
+	a.ErrorIs(err, ErrDecrypt)
+}
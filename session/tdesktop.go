@@ -3,6 +3,7 @@ package session
 import (
 	"github.com/go-faster/errors"
 
+	"github.com/gotd/td/crypto"
 	"github.com/gotd/td/session/tdesktop"
 	"github.com/gotd/td/telegram/dcs"
 	"github.com/gotd/td/tg"
@@ -86,3 +87,30 @@ func TDesktopSession(account tdesktop.Account) (*Data, error) {
 		AuthKeyID: keyID[:],
 	}, nil
 }
+
+// TDesktopAccount converts Data to TDesktop's Account, which can be written
+// using tdesktop.Write.
+//
+// Telegram Desktop considers account without user ID as unauthorized,
+// so userID is required.
+func TDesktopAccount(data *Data, userID int64) (tdesktop.Account, error) {
+	key, err := sessionKey(data)
+	if err != nil {
+		return tdesktop.Account{}, err
+	}
+	if userID == 0 {
+		return tdesktop.Account{}, errors.New("user ID is required")
+	}
+
+	var k crypto.Key
+	copy(k[:], key)
+	return tdesktop.Account{
+		Authorization: tdesktop.MTPAuthorization{
+			UserID: uint64(userID),
+			MainDC: data.DC,
+			Keys: map[int]crypto.Key{
+				data.DC: k,
+			},
+		},
+	}, nil
+}
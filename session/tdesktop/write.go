@@ -0,0 +1,184 @@
+package tdesktop
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/crypto"
+)
+
+// fileVersion is a Telegram Desktop version written to file header (v2.9.8).
+var fileVersion = [4]byte{0xb0, 0xa7, 0x1e, 0x00}
+
+// Write writes accounts to given Telegram Desktop tdata root, so they
+// can be read by Read or used by Telegram Desktop itself. Files are encrypted
+// using given passcode, which may be empty.
+//
+// Only key_data and MTProto authorization files are written, Account.Config
+// is ignored. Telegram Desktop uses built-in config if it is missing.
+func Write(root string, passcode []byte, accounts []Account) error {
+	return write(root, passcode, accounts, rand.Reader)
+}
+
+func write(root string, passcode []byte, accounts []Account, random io.Reader) error {
+	if len(accounts) < 1 {
+		return ErrNoAccounts
+	}
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return errors.Wrap(err, "create root")
+	}
+
+	var localKey crypto.Key
+	if _, err := io.ReadFull(random, localKey[:]); err != nil {
+		return errors.Wrap(err, "generate local key")
+	}
+
+	seen := make(map[uint32]struct{}, len(accounts))
+	idx := make([]uint32, 0, len(accounts))
+	for _, account := range accounts {
+		if _, ok := seen[account.IDx]; ok {
+			return errors.Errorf("duplicate account index %d", account.IDx)
+		}
+		seen[account.IDx] = struct{}{}
+		idx = append(idx, account.IDx)
+
+		data, err := writeMTPData(account.Authorization, localKey, random)
+		if err != nil {
+			return errors.Wrapf(err, "encode account %d", account.IDx)
+		}
+		if err := saveFile(filepath.Join(root, accountFileKey(account.IDx)), data); err != nil {
+			return errors.Wrapf(err, "save account %d", account.IDx)
+		}
+	}
+
+	data, err := writeKeyData(keyData{
+		localKey:    localKey,
+		accountsIDx: idx,
+	}, passcode, random)
+	if err != nil {
+		return errors.Wrap(err, "encode key_data")
+	}
+	if err := saveFile(filepath.Join(root, "key_data"), data); err != nil {
+		return errors.Wrap(err, "save key_data")
+	}
+	return nil
+}
+
+func accountFileKey(idx uint32) string {
+	if idx > 0 {
+		return fileKey(fmt.Sprintf("data#%d", idx+1))
+	}
+	return fileKey("data")
+}
+
+// saveFile writes data to file with given name, adding "s" suffix like
+// Telegram Desktop does.
+func saveFile(name string, data []byte) (rerr error) {
+	f, err := os.OpenFile(name+"s", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "create")
+	}
+	defer multierr.AppendInvoke(&rerr, multierr.Close(f))
+
+	return writeFile(f, data, fileVersion)
+}
+
+// encryptedDescriptor prepends length to data and pads it with random
+// bytes to 16.
+//
+// See https://github.com/telegramdesktop/tdesktop/blob/v2.9.8/Telegram/SourceFiles/storage/details/storage_file_utilities.cpp#L565.
+func encryptedDescriptor(data []byte, random io.Reader) ([]byte, error) {
+	size := 4 + len(data)
+	full := size
+	if rem := full % 16; rem != 0 {
+		full += 16 - rem
+	}
+
+	r := make([]byte, full)
+	binary.LittleEndian.PutUint32(r, uint32(size))
+	copy(r[4:], data)
+	if _, err := io.ReadFull(random, r[size:]); err != nil {
+		return nil, errors.Wrap(err, "generate padding")
+	}
+	return r, nil
+}
+
+func writeEncrypted(w io.Writer, data []byte, key crypto.Key, random io.Reader) error {
+	decrypted, err := encryptedDescriptor(data, random)
+	if err != nil {
+		return err
+	}
+	encrypted, err := encryptLocal(decrypted, key)
+	if err != nil {
+		return errors.Wrap(err, "encrypt")
+	}
+	return writeArray(w, encrypted, binary.BigEndian)
+}
+
+// See https://github.com/telegramdesktop/tdesktop/blob/v2.9.8/Telegram/SourceFiles/storage/storage_domain.cpp#L169-L198.
+func writeKeyData(kd keyData, passcode []byte, random io.Reader) ([]byte, error) {
+	salt := make([]byte, localEncryptSaltSize)
+	if _, err := io.ReadFull(random, salt); err != nil {
+		return nil, errors.Wrap(err, "generate salt")
+	}
+
+	var b bytes.Buffer
+	if err := writeArray(&b, salt, binary.BigEndian); err != nil {
+		return nil, errors.Wrap(err, "write salt")
+	}
+	if err := writeEncrypted(&b, kd.localKey[:], createLocalKey(passcode, salt), random); err != nil {
+		return nil, errors.Wrap(err, "write key")
+	}
+
+	info := binary.BigEndian.AppendUint32(nil, uint32(len(kd.accountsIDx)))
+	for _, idx := range kd.accountsIDx {
+		info = binary.BigEndian.AppendUint32(info, idx)
+	}
+	if err := writeEncrypted(&b, info, kd.localKey, random); err != nil {
+		return nil, errors.Wrap(err, "write info")
+	}
+	return b.Bytes(), nil
+}
+
+func writeMTPData(m MTPAuthorization, localKey crypto.Key, random io.Reader) ([]byte, error) {
+	var b bytes.Buffer
+	if err := writeEncrypted(&b, m.serialize(), localKey, random); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// See https://github.com/telegramdesktop/tdesktop/blob/v2.9.8/Telegram/SourceFiles/main/main_account.cpp#L297.
+func (m MTPAuthorization) serialize() []byte {
+	dcs := make([]int, 0, len(m.Keys))
+	for dc := range m.Keys {
+		dcs = append(dcs, dc)
+	}
+	sort.Ints(dcs)
+
+	var body []byte
+	body = binary.BigEndian.AppendUint64(body, kWideIdsTag)
+	body = binary.BigEndian.AppendUint64(body, m.UserID)
+	body = binary.BigEndian.AppendUint32(body, uint32(m.MainDC))
+	body = binary.BigEndian.AppendUint32(body, uint32(len(dcs)))
+	for _, dc := range dcs {
+		key := m.Keys[dc]
+		body = binary.BigEndian.AppendUint32(body, uint32(dc))
+		body = append(body, key[:]...)
+	}
+	// Count of keys to destroy.
+	body = binary.BigEndian.AppendUint32(body, 0)
+
+	r := binary.BigEndian.AppendUint32(nil, dbiMtpAuthorization)
+	r = binary.BigEndian.AppendUint32(r, uint32(len(body)))
+	return append(r, body...)
+}
@@ -0,0 +1,64 @@
+package tdesktop
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/crypto"
+)
+
+func TestWrite(t *testing.T) {
+	accounts := []Account{
+		{
+			IDx: 0,
+			Authorization: MTPAuthorization{
+				UserID: 309570373,
+				MainDC: 2,
+				Keys: map[int]crypto.Key{
+					1: {1},
+					2: {2},
+				},
+			},
+		},
+		{
+			IDx: 2,
+			Authorization: MTPAuthorization{
+				UserID: 10,
+				MainDC: 4,
+				Keys: map[int]crypto.Key{
+					4: {4},
+				},
+			},
+		},
+	}
+
+	for _, passcode := range [][]byte{nil, []byte("passcode")} {
+		t.Run(string(passcode), func(t *testing.T) {
+			a := require.New(t)
+			root := t.TempDir()
+
+			a.NoError(Write(root, passcode, accounts))
+
+			got, err := Read(root, passcode)
+			a.NoError(err)
+			a.Equal(accounts, got)
+
+			if len(passcode) > 0 {
+				_, err := Read(root, []byte("wrong"))
+				a.Error(err)
+			}
+		})
+	}
+}
+
+func TestWrite_Errors(t *testing.T) {
+	a := require.New(t)
+
+	a.ErrorIs(Write(t.TempDir(), nil, nil), ErrNoAccounts)
+	a.Error(Write(t.TempDir(), nil, []Account{{}, {}}))
+
+	// Random source failure.
+	a.Error(write(t.TempDir(), nil, []Account{{}}, bytes.NewReader(nil)))
+}
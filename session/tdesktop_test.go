@@ -60,3 +60,37 @@ func TestTDesktopSession(t *testing.T) {
 		})
 	}
 }
+
+func TestTDesktopAccount(t *testing.T) {
+	a := require.New(t)
+
+	var key crypto.Key
+	copy(key[:], testKey)
+	authKey := key.WithID()
+	data := &Data{
+		DC:        2,
+		Config:    Config{ThisDC: 2},
+		Addr:      findDCAddr(dcs.Prod().Options, 2),
+		AuthKey:   authKey.Value[:],
+		AuthKeyID: authKey.ID[:],
+	}
+
+	account, err := TDesktopAccount(data, 309570373)
+	a.NoError(err)
+
+	root := t.TempDir()
+	a.NoError(tdesktop.Write(root, nil, []tdesktop.Account{account}))
+	accounts, err := tdesktop.Read(root, nil)
+	a.NoError(err)
+	a.Len(accounts, 1)
+	a.Equal(uint64(309570373), accounts[0].Authorization.UserID)
+
+	got, err := TDesktopSession(accounts[0])
+	a.NoError(err)
+	a.Equal(data, got)
+
+	_, err = TDesktopAccount(data, 0)
+	a.Error(err)
+	_, err = TDesktopAccount(&Data{}, 1)
+	a.Error(err)
+}
@@ -3,6 +3,7 @@ package session
 import (
 	"encoding/base64"
 	"encoding/binary"
+	"math"
 	"net"
 	"strconv"
 
@@ -85,3 +86,31 @@ func decodeStringSession(data []byte) (*Data, error) {
 		AuthKeyID: id[:],
 	}, nil
 }
+
+// TelethonString encodes Data to Telethon's StringSession string.
+//
+// See https://github.com/LonamiWebs/Telethon/blob/master/telethon/sessions/string.py#L48-L61.
+func TelethonString(data *Data) (string, error) {
+	key, err := sessionKey(data)
+	if err != nil {
+		return "", err
+	}
+	ip, port, err := dcAddr(data)
+	if err != nil {
+		return "", err
+	}
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	if data.DC < 0 || data.DC > math.MaxUint8 {
+		return "", errors.Errorf("invalid DC ID %d", data.DC)
+	}
+
+	buf := make([]byte, 0, 275)
+	buf = append(buf, byte(data.DC))
+	buf = append(buf, ip...)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(port))
+	buf = append(buf, key...)
+
+	return string(latestTelethonVersion) + base64.URLEncoding.EncodeToString(buf), nil
+}
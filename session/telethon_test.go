@@ -102,3 +102,58 @@ func Test_decodeStringSession(t *testing.T) {
 		})
 	}
 }
+
+func TestTelethonString(t *testing.T) {
+	var key crypto.Key
+	copy(key[:], testKey)
+	authKey := key.WithID()
+
+	tests := []struct {
+		name    string
+		data    *Data
+		want    []byte
+		wantErr bool
+	}{
+		{"IPv4", &Data{
+			DC:      2,
+			Addr:    "192.168.0.1:443",
+			AuthKey: authKey.Value[:],
+		}, ipv4, false},
+		{"IPv6", &Data{
+			DC:      2,
+			Addr:    "[2001:db8::]:443",
+			AuthKey: authKey.Value[:],
+		}, ipv6, false},
+		{"NoPort", &Data{
+			DC:      2,
+			Addr:    "192.168.0.1",
+			AuthKey: authKey.Value[:],
+		}, ipv4, false},
+		{"InvalidKey", &Data{
+			DC:      2,
+			Addr:    "192.168.0.1:443",
+			AuthKey: repeat(10),
+		}, nil, true},
+		{"UnknownDC", &Data{
+			DC:      200,
+			AuthKey: authKey.Value[:],
+		}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+			got, err := TelethonString(tt.data)
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal("1"+base64.URLEncoding.EncodeToString(tt.want), got)
+
+			decoded, err := TelethonSession(got)
+			a.NoError(err)
+			a.Equal(tt.data.AuthKey, decoded.AuthKey)
+			a.Equal(tt.data.DC, decoded.DC)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Package accounts implements manager of multiple Telegram accounts.
+//
+// Every account gets its own telegram.Client, updates.Manager and
+// peers.Manager, while DC resolver and, optionally, message ID source are
+// shared. Crashed clients are restarted with backoff.
+package accounts
+
+import (
+	"context"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/telegram/updates"
+)
+
+// Account describes account managed by Manager.
+type Account struct {
+	// ID is a unique account identifier. Updates and statuses are
+	// tagged with it.
+	ID string
+	// AppID and AppHash of Telegram app.
+	AppID   int
+	AppHash string
+	// Options of account client.
+	//
+	// Resolver, UpdateHandler and Logger are set by Manager. MessageID
+	// is set if Manager has shared one.
+	Options telegram.Options
+	// Auth is called if account session is not authorized, e.g.
+	//
+	//	func(ctx context.Context, c *auth.Client) error {
+	//		_, err := c.Bot(ctx, token)
+	//		return err
+	//	}
+	//
+	// If Auth is nil, unauthorized account gets StatusAuthKeyRevoked.
+	Auth func(ctx context.Context, c *auth.Client) error
+	// UpdatesStorage is a state storage of updates.Manager (optional).
+	UpdatesStorage updates.StateStorage
+	// Peers are options of peers.Manager (optional).
+	Peers peers.Options
+}
+
+// Client is a running client of account.
+//
+// Client is re-created on every restart.
+type Client struct {
+	// ID of account.
+	ID string
+	// Telegram is a Telegram client.
+	Telegram *telegram.Client
+	// Peers is a peer manager of account.
+	Peers *peers.Manager
+	// Updates is an updates manager of account.
+	Updates *updates.Manager
+}
@@ -0,0 +1,84 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/updates"
+	updhook "github.com/gotd/td/telegram/updates/hook"
+	"github.com/gotd/td/tg"
+)
+
+// runClient creates and runs client of account.
+func (m *Manager) runClient(ctx context.Context, a Account, ready func(c *Client, userID int64)) error {
+	lg := m.log.With(zap.String("account", a.ID))
+
+	// Updates manager requires peer manager, which requires client,
+	// so handler is bound after creation.
+	var gaps *updates.Manager
+	handle := func(ctx context.Context, u tg.UpdatesClass) error {
+		return gaps.Handle(ctx, u)
+	}
+
+	opts := a.Options
+	opts.Resolver = m.resolver
+	if m.messageID != nil {
+		opts.MessageID = m.messageID
+	}
+	opts.Logger = lg.Named("client")
+	opts.UpdateHandler = telegram.UpdateHandlerFunc(handle)
+	opts.Middlewares = append(opts.Middlewares[:len(opts.Middlewares):len(opts.Middlewares)],
+		updhook.UpdateHook(handle),
+	)
+	client := telegram.NewClient(a.AppID, a.AppHash, opts)
+
+	peerOpts := a.Peers
+	if peerOpts.Logger == nil {
+		peerOpts.Logger = lg.Named("peers")
+	}
+	peerManager := peerOpts.Build(client.API())
+	gaps = updates.New(updates.Config{
+		Handler: peerManager.UpdateHook(accountHandler{
+			id:      a.ID,
+			handler: m.handler,
+		}),
+		Storage:      a.UpdatesStorage,
+		AccessHasher: peerManager,
+		Logger:       lg.Named("updates"),
+	})
+
+	return client.Run(ctx, func(ctx context.Context) error {
+		status, err := client.Auth().Status(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get auth status")
+		}
+		if !status.Authorized {
+			if a.Auth == nil {
+				return errUnauthorized
+			}
+			if err := a.Auth(ctx, client.Auth()); err != nil {
+				return errors.Wrap(err, "auth")
+			}
+		}
+
+		self, err := peerManager.Self(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get self")
+		}
+		lg.Info("Authorized", zap.Int64("user_id", self.ID()))
+
+		ready(&Client{
+			ID:       a.ID,
+			Telegram: client,
+			Peers:    peerManager,
+			Updates:  gaps,
+		}, self.ID())
+
+		return gaps.Run(ctx, client.API(), self.ID(), updates.AuthOptions{
+			IsBot: self.Raw().Bot,
+		})
+	})
+}
@@ -0,0 +1,59 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+)
+
+// Handler handles updates of all accounts.
+type Handler interface {
+	Handle(ctx context.Context, account string, u tg.UpdatesClass) error
+}
+
+// HandlerFunc is functional adapter for Handler.
+type HandlerFunc func(ctx context.Context, account string, u tg.UpdatesClass) error
+
+// Handle implements Handler.
+func (f HandlerFunc) Handle(ctx context.Context, account string, u tg.UpdatesClass) error {
+	return f(ctx, account, u)
+}
+
+type accountKey struct{}
+
+// AccountID returns ID of account which received update.
+//
+// Context passed to Handler and to telegram.UpdateHandler returned by
+// Route contains account ID.
+func AccountID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(accountKey{}).(string)
+	return id, ok
+}
+
+func withAccountID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, accountKey{}, id)
+}
+
+// Route returns Handler which routes updates to handler returned by
+// get for account. Updates of accounts for which get returns nil
+// are ignored.
+func Route(get func(account string) telegram.UpdateHandler) Handler {
+	return HandlerFunc(func(ctx context.Context, account string, u tg.UpdatesClass) error {
+		h := get(account)
+		if h == nil {
+			return nil
+		}
+		return h.Handle(ctx, u)
+	})
+}
+
+// accountHandler tags updates with account ID.
+type accountHandler struct {
+	id      string
+	handler Handler
+}
+
+func (h accountHandler) Handle(ctx context.Context, u tg.UpdatesClass) error {
+	return h.handler.Handle(withAccountID(ctx, h.id), h.id, u)
+}
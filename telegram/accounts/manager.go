@@ -0,0 +1,293 @@
+package accounts
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/mtproto"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/telegram/dcs"
+)
+
+// errUnauthorized is returned by client if account is not authorized and
+// Account.Auth is nil.
+var errUnauthorized = errors.New("account is not authorized")
+
+type entry struct {
+	account Account
+	state   State
+	client  *Client
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// runFunc runs client of account and blocks until it stops.
+// ready is called after authorization.
+type runFunc func(ctx context.Context, a Account, ready func(c *Client, userID int64)) error
+
+// Manager runs multiple accounts.
+type Manager struct {
+	handler   Handler
+	resolver  dcs.Resolver
+	messageID mtproto.MessageIDSource
+	backoff   func() backoff.BackOff
+	onState   func(id string, s State)
+	clock     clock.Clock
+	log       *zap.Logger
+
+	run runFunc // immutable
+
+	mux      sync.Mutex
+	accounts map[string]*entry
+	sv       *tdsync.Supervisor // nil if not running
+}
+
+// New creates new Manager.
+func New(opts Options) *Manager {
+	opts.setDefaults()
+	m := &Manager{
+		handler:   opts.Handler,
+		resolver:  opts.Resolver,
+		messageID: opts.MessageID,
+		backoff:   opts.Backoff,
+		onState:   opts.OnState,
+		clock:     opts.Clock,
+		log:       opts.Logger,
+		accounts:  map[string]*entry{},
+	}
+	m.run = m.runClient
+	return m
+}
+
+// Add adds account to manager. If manager is running, account is started
+// immediately, otherwise it is started by Run.
+func (m *Manager) Add(a Account) error {
+	if a.ID == "" {
+		return errors.New("account ID is empty")
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if _, ok := m.accounts[a.ID]; ok {
+		return errors.Errorf("account %q already exists", a.ID)
+	}
+	e := &entry{
+		account: a,
+		state: State{
+			Status: StatusStopped,
+			Since:  m.clock.Now(),
+		},
+	}
+	m.accounts[a.ID] = e
+	if m.sv != nil {
+		m.start(e)
+	}
+	return nil
+}
+
+// Remove stops and removes account from manager.
+func (m *Manager) Remove(ctx context.Context, id string) error {
+	m.mux.Lock()
+	e, ok := m.accounts[id]
+	if !ok {
+		m.mux.Unlock()
+		return errors.Errorf("account %q not found", id)
+	}
+	delete(m.accounts, id)
+	stop, done := e.stop, e.done
+	m.mux.Unlock()
+
+	if stop == nil {
+		// Never started.
+		return nil
+	}
+	close(stop)
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// State returns state of account.
+func (m *Manager) State(id string) (State, bool) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	e, ok := m.accounts[id]
+	if !ok {
+		return State{}, false
+	}
+	return e.state, true
+}
+
+// States returns states of all accounts.
+func (m *Manager) States() map[string]State {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	r := make(map[string]State, len(m.accounts))
+	for id, e := range m.accounts {
+		r[id] = e.state
+	}
+	return r
+}
+
+// Accounts returns sorted IDs of all accounts.
+func (m *Manager) Accounts() []string {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	r := make([]string, 0, len(m.accounts))
+	for id := range m.accounts {
+		r = append(r, id)
+	}
+	sort.Strings(r)
+	return r
+}
+
+// Client returns client of account if it is authorized.
+func (m *Manager) Client(id string) (*Client, bool) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	e, ok := m.accounts[id]
+	if !ok || e.client == nil {
+		return nil, false
+	}
+	return e.client, true
+}
+
+// Run starts all accounts and blocks until context is done.
+func (m *Manager) Run(ctx context.Context) error {
+	sv := tdsync.NewSupervisor(ctx).WithErrorHandler(func(err error) {
+		m.log.Warn("Account stopped", zap.Error(err))
+	})
+
+	m.mux.Lock()
+	if m.sv != nil {
+		m.mux.Unlock()
+		return errors.New("manager is already running")
+	}
+	m.sv = sv
+	for _, e := range m.accounts {
+		m.start(e)
+	}
+	m.mux.Unlock()
+
+	<-ctx.Done()
+
+	m.mux.Lock()
+	m.sv = nil
+	m.mux.Unlock()
+
+	sv.Cancel()
+	_ = sv.Wait()
+	return ctx.Err()
+}
+
+// start starts account. Must be called with m.mux held.
+func (m *Manager) start(e *entry) {
+	stop, done := make(chan struct{}), make(chan struct{})
+	e.stop, e.done = stop, done
+
+	a := e.account
+	m.sv.Go(func(ctx context.Context) error {
+		defer close(done)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-stop:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		return m.supervise(ctx, e, a)
+	})
+}
+
+func (m *Manager) setState(e *entry, f func(s *State)) {
+	m.mux.Lock()
+	f(&e.state)
+	e.state.Since = m.clock.Now()
+	if e.state.Status != StatusAuthorized {
+		e.client = nil
+	}
+	state := e.state
+	m.mux.Unlock()
+
+	m.onState(e.account.ID, state)
+}
+
+func (m *Manager) setStatus(e *entry, status Status, err error) {
+	m.setState(e, func(s *State) {
+		s.Status = status
+		s.Err = err
+	})
+}
+
+// supervise runs client of account, restarting it with backoff.
+func (m *Manager) supervise(ctx context.Context, e *entry, a Account) error {
+	lg := m.log.With(zap.String("account", a.ID))
+	b := m.backoff()
+
+	for {
+		m.setStatus(e, StatusConnecting, nil)
+
+		err := m.run(ctx, a, func(c *Client, userID int64) {
+			b.Reset()
+			m.mux.Lock()
+			e.client = c
+			m.mux.Unlock()
+			m.setState(e, func(s *State) {
+				s.Status = StatusAuthorized
+				s.Err = nil
+				s.UserID = userID
+			})
+		})
+		if ctx.Err() != nil {
+			m.setStatus(e, StatusStopped, nil)
+			return nil
+		}
+		if errors.Is(err, errUnauthorized) || auth.IsUnauthorized(err) {
+			lg.Warn("Account is not authorized", zap.Error(err))
+			m.setStatus(e, StatusAuthKeyRevoked, err)
+			return errors.Wrapf(err, "account %q", a.ID)
+		}
+
+		d := b.NextBackOff()
+		if d == backoff.Stop {
+			m.setStatus(e, StatusFailed, err)
+			return errors.Wrapf(err, "account %q", a.ID)
+		}
+		lg.Warn("Client stopped, restarting", zap.Error(err), zap.Duration("backoff", d))
+		m.setState(e, func(s *State) {
+			s.Status = StatusRestarting
+			s.Err = err
+			s.Restarts++
+		})
+
+		timer := m.clock.Timer(d)
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			clock.StopTimer(timer)
+			m.setStatus(e, StatusStopped, nil)
+			return nil
+		}
+	}
+}
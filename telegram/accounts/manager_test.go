@@ -0,0 +1,180 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+type stateChange struct {
+	id    string
+	state State
+}
+
+func testManager(t *testing.T, run runFunc) (*Manager, *neo.Time, <-chan stateChange) {
+	clk := neo.NewTime(time.Unix(0, 0))
+	states := make(chan stateChange, 100)
+	m := New(Options{
+		Clock: clk,
+		OnState: func(id string, s State) {
+			states <- stateChange{id: id, state: s}
+		},
+	})
+	m.run = run
+	return m, clk, states
+}
+
+func waitStatus(t *testing.T, states <-chan stateChange, id string, status Status) State {
+	t.Helper()
+	for {
+		select {
+		case c := <-states:
+			if c.id == id && c.state.Status == status {
+				return c.state
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q to be %s", id, status)
+		}
+	}
+}
+
+func TestManager_Restart(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testErr := errors.New("test")
+	attempts := 0
+	m, clk, states := testManager(t, func(ctx context.Context, acc Account, ready func(c *Client, userID int64)) error {
+		attempts++
+		if attempts < 3 {
+			return testErr
+		}
+		ready(&Client{ID: acc.ID}, 10)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	a.NoError(m.Add(Account{ID: "user"}))
+	a.Error(m.Add(Account{ID: "user"}))
+	a.Error(m.Add(Account{}))
+
+	done := make(chan error, 1)
+	go func() { done <- m.Run(ctx) }()
+
+	for i := 1; i < 3; i++ {
+		observe := clk.Observe()
+		s := waitStatus(t, states, "user", StatusRestarting)
+		a.ErrorIs(s.Err, testErr)
+		a.Equal(i, s.Restarts)
+		<-observe
+		clk.Travel(time.Minute)
+	}
+
+	s := waitStatus(t, states, "user", StatusAuthorized)
+	a.Equal(int64(10), s.UserID)
+	a.NoError(s.Err)
+	c, ok := m.Client("user")
+	a.True(ok)
+	a.Equal("user", c.ID)
+
+	cancel()
+	a.ErrorIs(<-done, context.Canceled)
+	waitStatus(t, states, "user", StatusStopped)
+	_, ok = m.Client("user")
+	a.False(ok)
+}
+
+func TestManager_Revoked(t *testing.T) {
+	for _, err := range []error{
+		errUnauthorized,
+		&tgerr.Error{Code: 401, Type: "AUTH_KEY_UNREGISTERED"},
+	} {
+		t.Run(err.Error(), func(t *testing.T) {
+			a := require.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			m, _, states := testManager(t, func(ctx context.Context, acc Account, ready func(c *Client, userID int64)) error {
+				return err
+			})
+			a.NoError(m.Add(Account{ID: "user"}))
+
+			done := make(chan error, 1)
+			go func() { done <- m.Run(ctx) }()
+
+			s := waitStatus(t, states, "user", StatusAuthKeyRevoked)
+			a.ErrorIs(s.Err, err)
+			state, ok := m.State("user")
+			a.True(ok)
+			a.Equal(StatusAuthKeyRevoked, state.Status)
+
+			cancel()
+			a.ErrorIs(<-done, context.Canceled)
+		})
+	}
+}
+
+func TestManager_AddRemove(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m, _, states := testManager(t, func(ctx context.Context, acc Account, ready func(c *Client, userID int64)) error {
+		ready(&Client{ID: acc.ID}, 1)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	a.NoError(m.Add(Account{ID: "first"}))
+
+	done := make(chan error, 1)
+	go func() { done <- m.Run(ctx) }()
+	waitStatus(t, states, "first", StatusAuthorized)
+
+	a.NoError(m.Add(Account{ID: "second"}))
+	waitStatus(t, states, "second", StatusAuthorized)
+	a.Equal([]string{"first", "second"}, m.Accounts())
+	a.Len(m.States(), 2)
+
+	a.NoError(m.Remove(ctx, "first"))
+	waitStatus(t, states, "first", StatusStopped)
+	a.Equal([]string{"second"}, m.Accounts())
+	a.Error(m.Remove(ctx, "first"))
+
+	cancel()
+	a.ErrorIs(<-done, context.Canceled)
+}
+
+func TestRoute(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	var got []string
+	h := accountHandler{
+		id: "user",
+		handler: Route(func(account string) telegram.UpdateHandler {
+			if account != "user" {
+				return nil
+			}
+			return telegram.UpdateHandlerFunc(func(ctx context.Context, u tg.UpdatesClass) error {
+				id, ok := AccountID(ctx)
+				a.True(ok)
+				got = append(got, id)
+				return nil
+			})
+		}),
+	}
+	a.NoError(h.Handle(ctx, &tg.UpdatesTooLong{}))
+	a.NoError(accountHandler{id: "bot", handler: h.handler}.Handle(ctx, &tg.UpdatesTooLong{}))
+	a.Equal([]string{"user"}, got)
+
+	_, ok := AccountID(ctx)
+	a.False(ok)
+}
@@ -0,0 +1,63 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/mtproto"
+	"github.com/gotd/td/telegram/dcs"
+	"github.com/gotd/td/tg"
+)
+
+// Options of Manager.
+type Options struct {
+	// Handler is called on every update of every account.
+	// Updates are ignored by default.
+	Handler Handler
+	// Resolver is shared DC resolver. Defaults to dcs.DefaultResolver.
+	Resolver dcs.Resolver
+	// MessageID is shared message ID source (optional).
+	// If not provided, every client uses its own.
+	MessageID mtproto.MessageIDSource
+	// Backoff configures and returns restart backoff of account.
+	// Backoff is reset after successful authorization.
+	Backoff func() backoff.BackOff
+	// OnState is called on every account state change (optional).
+	OnState func(id string, s State)
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger to use. No logs by default.
+	Logger *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.Handler == nil {
+		o.Handler = HandlerFunc(func(context.Context, string, tg.UpdatesClass) error {
+			return nil
+		})
+	}
+	if o.Resolver == nil {
+		o.Resolver = dcs.DefaultResolver()
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Backoff == nil {
+		c := o.Clock
+		o.Backoff = func() backoff.BackOff {
+			b := backoff.NewExponentialBackOff()
+			b.Clock = c
+			b.MaxElapsedTime = 0
+			return b
+		}
+	}
+	if o.OnState == nil {
+		o.OnState = func(string, State) {}
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
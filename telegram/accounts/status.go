@@ -0,0 +1,60 @@
+package accounts
+
+import (
+	"fmt"
+	"time"
+)
+
+// Status is a state of account.
+type Status int
+
+const (
+	// StatusStopped means that account is not running.
+	StatusStopped Status = iota
+	// StatusConnecting means that client is connecting or authorizing.
+	StatusConnecting
+	// StatusAuthorized means that client is authorized and receives updates.
+	StatusAuthorized
+	// StatusRestarting means that client is crashed and waits for restart.
+	StatusRestarting
+	// StatusAuthKeyRevoked means that session is not authorized anymore,
+	// e.g. auth key was revoked or session was terminated, and it can't be
+	// authorized again. Account is not restarted.
+	StatusAuthKeyRevoked
+	// StatusFailed means that restart backoff is exhausted.
+	StatusFailed
+)
+
+// String implements fmt.Stringer.
+func (s Status) String() string {
+	switch s {
+	case StatusStopped:
+		return "stopped"
+	case StatusConnecting:
+		return "connecting"
+	case StatusAuthorized:
+		return "authorized"
+	case StatusRestarting:
+		return "restarting"
+	case StatusAuthKeyRevoked:
+		return "auth key revoked"
+	case StatusFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+}
+
+// State is a state of account.
+type State struct {
+	// Status of account.
+	Status Status
+	// Since is a time of last status change.
+	Since time.Time
+	// Err is a last error of client, if any.
+	Err error
+	// Restarts is a count of client restarts.
+	Restarts int
+	// UserID is an ID of authorized user, if any.
+	UserID int64
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/gotd/td/bin"
 	"github.com/gotd/td/clock"
 	"github.com/gotd/td/mtproto"
-	"github.com/gotd/td/oteltg"
 	"github.com/gotd/td/pool"
 	"github.com/gotd/td/session"
 	"github.com/gotd/td/tdsync"
@@ -40,6 +39,11 @@ func (f UpdateHandlerFunc) Handle(ctx context.Context, u tg.UpdatesClass) error
 	return f(ctx, u)
 }
 
+// tracerName is OpenTelemetry instrumentation name, same as oteltg.Name.
+//
+// Not using oteltg.Name directly, because oteltg depends on telegram.
+const tracerName = "github.com/gotd/td"
+
 type clientStorage interface {
 	Load(ctx context.Context) (*session.Data, error)
 	Save(ctx context.Context, data *session.Data) error
@@ -128,6 +132,8 @@ type Client struct {
 
 	// Tracing.
 	tracer trace.Tracer
+	// Connection events hooks.
+	hooks Hooks
 
 	// onTransfer is called in transfer.
 	onTransfer AuthTransferHandler
@@ -165,9 +171,10 @@ func NewClient(appID int, appHash string, opt Options) *Client {
 		noUpdatesMode:    opt.NoUpdates,
 		mw:               opt.Middlewares,
 		onTransfer:       opt.OnTransfer,
+		hooks:            opt.Hooks,
 	}
 	if opt.TracerProvider != nil {
-		client.tracer = opt.TracerProvider.Tracer(oteltg.Name)
+		client.tracer = opt.TracerProvider.Tracer(tracerName)
 	}
 	client.init()
 
@@ -195,6 +202,9 @@ func NewClient(appID int, appHash string, opt Options) *Client {
 		ExchangeTimeout:   opt.ExchangeTimeout,
 		DialTimeout:       opt.DialTimeout,
 		Clock:             opt.Clock,
+		EnablePFS:         opt.EnablePFS,
+		TempKeyTTL:        opt.TempKeyTTL,
+		Hooks:             opt.Hooks,
 
 		Types: getTypesMapping(),
 
@@ -213,6 +223,9 @@ func (c *Client) init() {
 	if c.cfg == nil {
 		c.cfg = manager.NewAtomicConfig(tg.Config{})
 	}
+	if c.hooks == nil {
+		c.hooks = nopHooks{}
+	}
 	c.ready = tdsync.NewResetReady()
 	c.restart = make(chan struct{})
 	c.migration = make(chan struct{}, 1)
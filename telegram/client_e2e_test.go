@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/gotd/neo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/gotd/td/clock"
 	"github.com/gotd/td/session"
 	"github.com/gotd/td/tdsync"
 	"github.com/gotd/td/telegram"
@@ -49,6 +51,18 @@ func testCluster(
 	ws bool,
 	setup func(q clusterSetup),
 	run func(ctx context.Context, c clientSetup) error,
+) func(t *testing.T) {
+	return testClusterClock(p, ws, clock.System, setup, run)
+}
+
+// testClusterClock is like testCluster, but uses given clock for cluster
+// and client.
+func testClusterClock(
+	p dcs.Protocol,
+	ws bool,
+	clk clock.Clock,
+	setup func(q clusterSetup),
+	run func(ctx context.Context, c clientSetup) error,
 ) func(t *testing.T) {
 	return func(t *testing.T) {
 		t.Parallel()
@@ -64,6 +78,7 @@ func testCluster(
 			Web:      ws,
 			Logger:   log.Named("cluster"),
 			Protocol: p,
+			Clock:    clk,
 		})
 		setup(clusterSetup{
 			TB:      t,
@@ -92,6 +107,7 @@ func testCluster(
 					Logger:         log.Named("client"),
 					SessionStorage: &session.StorageMemory{},
 					DCList:         c.List(),
+					Clock:          clk,
 				},
 				Complete: cancel,
 			})
@@ -285,3 +301,78 @@ func testFiles(p dcs.Protocol) func(t *testing.T) {
 func TestFiles(t *testing.T) {
 	t.Run("Intermediate", testFiles(transport.Intermediate))
 }
+
+// realTickers is a fake clock with real tickers.
+//
+// Tickers of neo are not safe to create concurrently with Travel.
+type realTickers struct {
+	*neo.Time
+}
+
+func (realTickers) Ticker(d time.Duration) clock.Ticker {
+	return clock.System.Ticker(d)
+}
+
+func TestClientPFSE2E(t *testing.T) {
+	// Auth key IDs of initConnection requests.
+	inits := make(chan [8]byte, 10)
+	clk := neo.NewTime(time.Now())
+	testClusterClock(transport.Intermediate, false, realTickers{Time: clk}, func(s clusterSetup) {
+		s.Cluster.Dispatch(2, "server").
+			Vector(tg.UsersGetUsersRequestTypeID, user).
+			HandleFunc(tg.HelpGetConfigRequestTypeID, func(server *tgtest.Server, req *tgtest.Request) error {
+				inits <- req.Session.AuthKey.ID
+				return s.Cluster.Common().OnMessage(server, req)
+			})
+	}, func(ctx context.Context, c clientSetup) error {
+		opts := c.Options
+		opts.EnablePFS = true
+		client := telegram.NewClient(1, "hash", opts)
+
+		getUser := func(ctx context.Context) error {
+			users, err := client.API().UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+			if err != nil {
+				return errors.Wrap(err, "get users")
+			}
+			if len(users) != 1 {
+				return errors.Errorf("unexpected users count %d", len(users))
+			}
+			return nil
+		}
+		waitInit := func(ctx context.Context) ([8]byte, error) {
+			select {
+			case id := <-inits:
+				return id, nil
+			case <-ctx.Done():
+				return [8]byte{}, ctx.Err()
+			}
+		}
+
+		return client.Run(ctx, func(ctx context.Context) error {
+			first, err := waitInit(ctx)
+			if err != nil {
+				return err
+			}
+			if err := getUser(ctx); err != nil {
+				return err
+			}
+
+			// Trigger key rotation, connection must be initialized
+			// again using new key.
+			clk.Travel(24 * time.Hour)
+			second, err := waitInit(ctx)
+			if err != nil {
+				return errors.Wrap(err, "wait for initialization")
+			}
+			if first == second {
+				return errors.New("connection is initialized using old key")
+			}
+
+			if err := getUser(ctx); err != nil {
+				return errors.Wrap(err, "after rotation")
+			}
+			c.Complete()
+			return nil
+		})
+	})(t)
+}
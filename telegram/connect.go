@@ -73,6 +73,7 @@ func (c *Client) reconnectUntilClosed(ctx context.Context) error {
 		return nil
 	}, b, func(err error, timeout time.Duration) {
 		c.log.Info("Restarting connection", zap.Error(err), zap.Duration("backoff", timeout))
+		c.hooks.OnReconnect(c.session.Load().DC, err)
 
 		c.connMux.Lock()
 		c.conn = c.createPrimaryConn(nil)
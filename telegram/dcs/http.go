@@ -0,0 +1,106 @@
+package dcs
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/transport"
+)
+
+var _ Resolver = httpResolver{}
+
+type httpResolver struct {
+	client     *http.Client
+	maxWait    time.Duration
+	port       int
+	preferIPv6 bool
+}
+
+func (h httpResolver) connect(dc int, list List) (transport.Conn, error) {
+	candidates := FindPrimaryDCs(list.Options, dc, h.preferIPv6)
+	// HTTP transport does not support obfuscation.
+	n := 0
+	for _, x := range candidates {
+		if !x.TCPObfuscatedOnly {
+			candidates[n] = x
+			n++
+		}
+	}
+	if n == 0 {
+		return nil, errors.Errorf("no addresses for DC %d", dc)
+	}
+	option := candidates[0]
+
+	port := option.Port
+	if h.port != 0 {
+		port = h.port
+	}
+	u := url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(option.IPAddress, strconv.Itoa(port)),
+		Path:   "/api",
+	}
+
+	return transport.HTTP(u.String(), transport.HTTPOptions{
+		Client:  h.client,
+		MaxWait: h.maxWait,
+	}), nil
+}
+
+func (h httpResolver) Primary(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return h.connect(dc, list)
+}
+
+func (h httpResolver) MediaOnly(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return nil, errors.Errorf("can't resolve %d: MediaOnly is unsupported", dc)
+}
+
+func (h httpResolver) CDN(ctx context.Context, dc int, list List) (transport.Conn, error) {
+	return nil, errors.Errorf("can't resolve %d: CDN is unsupported", dc)
+}
+
+// HTTPOptions is HTTP resolver creation options.
+type HTTPOptions struct {
+	// Client is HTTP client to use.
+	//
+	// Use client with configured proxy to connect through it.
+	// Defaults to http.DefaultClient, which respects HTTP_PROXY environment
+	// variable.
+	Client *http.Client
+	// MaxWait is the maximum time server may hold long poll request.
+	// Defaults to 25 seconds.
+	MaxWait time.Duration
+	// Port overrides port of DC option, e.g. 80.
+	Port int
+	// PreferIPv6 gives IPv6 DCs higher precedence.
+	// Default is to prefer IPv4 DCs over IPv6.
+	PreferIPv6 bool
+}
+
+func (m *HTTPOptions) setDefaults() {
+	if m.Client == nil {
+		m.Client = http.DefaultClient
+	}
+	if m.MaxWait == 0 {
+		m.MaxWait = 25 * time.Second
+	}
+}
+
+// HTTP creates HTTP DC resolver.
+//
+// See https://core.telegram.org/mtproto/transports#http.
+func HTTP(opts HTTPOptions) Resolver {
+	opts.setDefaults()
+	return httpResolver{
+		client:     opts.Client,
+		maxWait:    opts.MaxWait,
+		port:       opts.Port,
+		preferIPv6: opts.PreferIPv6,
+	}
+}
@@ -20,6 +20,9 @@ type Builder struct {
 	hashes  []tg.FileHash
 	verify  bool
 	threads int
+	offset  int64
+	refresh RefreshFunc
+	resume  bool
 }
 
 func newBuilder(downloader *Downloader, schema schema) *Builder {
@@ -46,27 +49,83 @@ func (b *Builder) WithVerify(verify bool) *Builder {
 	return b
 }
 
-func (b *Builder) reader() *reader {
+// WithOffset sets offset of first downloaded byte.
+// Must be divisible by part size (see Downloader.WithPartSize).
+//
+// Stream writes file starting from offset, Parallel writes
+// parts using absolute offsets.
+func (b *Builder) WithOffset(offset int64) *Builder {
+	b.offset = offset
+	return b
+}
+
+// WithRefresh sets file location refresh callback, which will be
+// called on FILE_REFERENCE_EXPIRED error.
+//
+// Only supported by Downloader.Download.
+func (b *Builder) WithRefresh(f RefreshFunc) *Builder {
+	b.refresh = f
+	return b
+}
+
+// WithResume sets resume parameter of ToPath.
+// If resume is true, ToPath stores list of downloaded parts in sidecar
+// manifest file (path + ManifestSuffix) and continues partially downloaded
+// file instead of starting over. Manifest is removed after successful download.
+//
+// Manifest records location of file, so leftover of another file at the
+// same path is downloaded again.
+func (b *Builder) WithResume(resume bool) *Builder {
+	b.resume = resume
+	return b
+}
+
+func (b *Builder) reader() (*reader, error) {
+	if b.offset < 0 || b.offset%int64(b.downloader.partSize) != 0 {
+		return nil, errors.Errorf("offset %d is not divisible by part size %d", b.offset, b.downloader.partSize)
+	}
+
+	sch := b.schema
+	if b.refresh != nil {
+		r, err := newRefreshable(sch, b.refresh)
+		if err != nil {
+			return nil, err
+		}
+		sch = r
+	}
+
 	if b.verify {
-		return verifiedReader(b.schema, newVerifier(b.schema, b.hashes...))
+		return verifiedReader(sch, newVerifier(sch, b.hashes...), b.offset), nil
 	}
 
-	return plainReader(b.schema, b.downloader.partSize)
+	return plainReader(sch, b.downloader.partSize, b.offset), nil
 }
 
 // Stream downloads file to given io.Writer.
 // NB: in this mode download can't be parallel.
 func (b *Builder) Stream(ctx context.Context, output io.Writer) (tg.StorageFileTypeClass, error) {
-	return b.downloader.stream(ctx, b.reader(), output)
+	r, err := b.reader()
+	if err != nil {
+		return nil, err
+	}
+	return b.downloader.stream(ctx, r, output)
 }
 
 // Parallel downloads file to given io.WriterAt.
 func (b *Builder) Parallel(ctx context.Context, output io.WriterAt) (tg.StorageFileTypeClass, error) {
-	return b.downloader.parallel(ctx, b.reader(), b.threads, output)
+	r, err := b.reader()
+	if err != nil {
+		return nil, err
+	}
+	return b.downloader.parallel(ctx, r, b.threads, output)
 }
 
 // ToPath downloads file to given path.
 func (b *Builder) ToPath(ctx context.Context, path string) (_ tg.StorageFileTypeClass, err error) {
+	if b.resume {
+		return b.resumeToPath(ctx, filepath.Clean(path))
+	}
+
 	f, err := os.Create(filepath.Clean(path))
 	if err != nil {
 		return nil, errors.Wrap(err, "create output file")
@@ -27,25 +27,37 @@ type reader struct {
 	sch      schema    // immutable
 	verifier *verifier // immutable
 	partSize int       // immutable
+	// start is offset of first downloaded byte.
+	start int64 // immutable
+	// skip reports whether given range is already downloaded, can be nil.
+	skip func(from, to int64) bool // immutable
 
 	offset    int64
 	offsetMux sync.Mutex
 }
 
-func verifiedReader(sch schema, verifier *verifier) *reader {
+func verifiedReader(sch schema, verifier *verifier, start int64) *reader {
+	verifier.offset = start
 	return &reader{
 		sch:      sch,
 		verifier: verifier,
+		start:    start,
 	}
 }
 
-func plainReader(sch schema, partSize int) *reader {
+func plainReader(sch schema, partSize int, start int64) *reader {
 	return &reader{
 		sch:      sch,
 		partSize: partSize,
+		start:    start,
+		offset:   start,
 	}
 }
 
+func (r *reader) skipped(from, to int64) bool {
+	return r.skip != nil && r.skip(from, to)
+}
+
 func (r *reader) Next(ctx context.Context) (block, error) {
 	if r.verifier != nil {
 		return r.nextHashed(ctx)
@@ -56,12 +68,22 @@ func (r *reader) Next(ctx context.Context) (block, error) {
 
 func (r *reader) nextHashed(ctx context.Context) (block, error) {
 	// Fetch next hashes.
-	hash, ok, err := r.verifier.next(ctx)
-	if err != nil {
-		return block{}, err
-	}
-	if !ok {
-		return block{}, nil
+	var hash tg.FileHash
+	for {
+		h, ok, err := r.verifier.next(ctx)
+		if err != nil {
+			return block{}, err
+		}
+		if !ok {
+			return block{}, nil
+		}
+
+		end := h.Offset + int64(h.Limit)
+		if end <= r.start || r.skipped(h.Offset, end) {
+			continue
+		}
+		hash = h
+		break
 	}
 
 	// Get next chunk.
@@ -75,6 +97,15 @@ func (r *reader) nextHashed(ctx context.Context) (block, error) {
 		return block{}, ErrHashMismatch
 	}
 
+	// Hashed part can start before requested offset, so cut leading bytes.
+	if cut := r.start - b.offset; cut > 0 {
+		if cut > int64(len(b.data)) {
+			cut = int64(len(b.data))
+		}
+		b.data = b.data[cut:]
+		b.offset = r.start
+	}
+
 	return b, nil
 }
 
@@ -82,6 +113,10 @@ func (r *reader) nextPlain(ctx context.Context) (block, error) {
 	r.offsetMux.Lock()
 	offset := r.offset
 	r.offset += int64(r.partSize)
+	for r.skipped(offset, offset+int64(r.partSize)) {
+		offset = r.offset
+		r.offset += int64(r.partSize)
+	}
 	r.offsetMux.Unlock()
 
 	return r.next(ctx, offset, r.partSize)
@@ -0,0 +1,104 @@
+package downloader
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// RefreshFunc returns new location of downloading file.
+//
+// Called when server returns FILE_REFERENCE_EXPIRED error, so it should
+// fetch object (message, document, photo) again to get fresh file reference.
+type RefreshFunc func(ctx context.Context) (tg.InputFileLocationClass, error)
+
+// relocatable is schema which location can be replaced.
+type relocatable interface {
+	schema
+	withLocation(location tg.InputFileLocationClass) schema
+}
+
+func (c master) withLocation(location tg.InputFileLocationClass) schema {
+	c.location = location
+	return c
+}
+
+// refreshable is download schema which refreshes file reference on
+// FILE_REFERENCE_EXPIRED error.
+type refreshable struct {
+	refresh RefreshFunc // immutable
+
+	sch schema
+	// gen is incremented on every refresh, used to
+	// refresh location once for concurrent requests.
+	gen int
+	mux sync.Mutex
+}
+
+var _ schema = (*refreshable)(nil)
+
+func newRefreshable(sch schema, refresh RefreshFunc) (*refreshable, error) {
+	if _, ok := sch.(relocatable); !ok {
+		return nil, errors.Errorf("schema %T does not support location refresh", sch)
+	}
+	return &refreshable{
+		refresh: refresh,
+		sch:     sch,
+	}, nil
+}
+
+func (r *refreshable) current() (schema, int) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.sch, r.gen
+}
+
+// update refreshes location if it was not refreshed since generation gen.
+func (r *refreshable) update(ctx context.Context, gen int) (schema, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.gen != gen {
+		// Already refreshed by another request.
+		return r.sch, nil
+	}
+
+	location, err := r.refresh(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "refresh location")
+	}
+	r.sch = r.sch.(relocatable).withLocation(location)
+	r.gen++
+
+	return r.sch, nil
+}
+
+func (r *refreshable) Chunk(ctx context.Context, offset int64, limit int) (chunk, error) {
+	sch, gen := r.current()
+	ch, err := sch.Chunk(ctx, offset, limit)
+	if !tg.IsFileReferenceExpired(err) {
+		return ch, err
+	}
+
+	if sch, err = r.update(ctx, gen); err != nil {
+		return chunk{}, err
+	}
+	return sch.Chunk(ctx, offset, limit)
+}
+
+func (r *refreshable) Hashes(ctx context.Context, offset int64) ([]tg.FileHash, error) {
+	sch, gen := r.current()
+	hashes, err := sch.Hashes(ctx, offset)
+	if !tg.IsFileReferenceExpired(err) {
+		return hashes, err
+	}
+
+	if sch, err = r.update(ctx, gen); err != nil {
+		return nil, err
+	}
+	return sch.Hashes(ctx, offset)
+}
@@ -0,0 +1,291 @@
+package downloader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/internal/atomicfile"
+	"github.com/gotd/td/tg"
+)
+
+// ManifestSuffix is suffix of sidecar manifest file, which is used
+// by Builder.ToPath to resume downloads.
+const ManifestSuffix = ".manifest"
+
+const (
+	manifestVersion = 1
+	// manifestSaveParts is count of written parts between manifest saves.
+	manifestSaveParts = 16
+)
+
+// spans is sorted list of non-overlapping [from, to) ranges.
+type spans [][2]int64
+
+// has reports whether [from, to) range is fully covered.
+func (s spans) has(from, to int64) bool {
+	// Ranges are merged, so only first range ending after
+	// given one can contain it.
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i][1] >= to
+	})
+	return i < len(s) && s[i][0] <= from
+}
+
+// add adds [from, to) range, merging adjacent ranges.
+func (s spans) add(from, to int64) spans {
+	r := make(spans, 0, len(s)+1)
+
+	i := 0
+	for ; i < len(s) && s[i][1] < from; i++ {
+		r = append(r, s[i])
+	}
+	for ; i < len(s) && s[i][0] <= to; i++ {
+		from = min(from, s[i][0])
+		to = max(to, s[i][1])
+	}
+	r = append(r, [2]int64{from, to})
+
+	return append(r, s[i:]...)
+}
+
+// manifest describes state of partially downloaded file.
+type manifest struct {
+	Version  int  `json:"version"`
+	PartSize int  `json:"part_size"`
+	Verify   bool `json:"verify"`
+	// File is identity of downloading file, see fileID.
+	File string `json:"file"`
+	// Size is size of output file when manifest was saved.
+	Size int64 `json:"size"`
+	Done spans `json:"done"`
+}
+
+// compatible reports whether download can be continued using manifest.
+func (m manifest) compatible(other manifest) bool {
+	return m.Version == other.Version &&
+		m.PartSize == other.PartSize &&
+		m.Verify == other.Verify &&
+		m.File == other.File
+}
+
+// withoutReference returns copy of location without file reference,
+// which changes on refresh.
+func withoutReference(location tg.InputFileLocationClass) tg.InputFileLocationClass {
+	switch l := location.(type) {
+	case *tg.InputFileLocation:
+		r := *l
+		r.FileReference = nil
+		return &r
+	case *tg.InputDocumentFileLocation:
+		r := *l
+		r.FileReference = nil
+		return &r
+	case *tg.InputPhotoFileLocation:
+		r := *l
+		r.FileReference = nil
+		return &r
+	case *tg.InputPhotoLegacyFileLocation:
+		r := *l
+		r.FileReference = nil
+		return &r
+	default:
+		return location
+	}
+}
+
+// fileID returns identity of downloading file, which is hash of
+// its location.
+func (b *Builder) fileID() (string, error) {
+	var location bin.Encoder
+	switch s := b.schema.(type) {
+	case master:
+		if s.location != nil {
+			location = withoutReference(s.location)
+		}
+	case web:
+		if s.location != nil {
+			location = s.location
+		}
+	}
+	if location == nil {
+		return "", nil
+	}
+
+	var buf bin.Buffer
+	if err := location.Encode(&buf); err != nil {
+		return "", errors.Wrap(err, "encode location")
+	}
+	h := sha256.Sum256(buf.Buf)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// resumable reports whether output file at given path can be continued
+// using manifest.
+func resumable(path string, state, expected manifest) (bool, error) {
+	if !state.compatible(expected) {
+		return false, nil
+	}
+
+	st, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "stat output file")
+	}
+	// Output file only grows after manifest save, smaller file
+	// was truncated or replaced.
+	return st.Size() >= state.Size, nil
+}
+
+// readManifest reads manifest from given path.
+// Returns false, if manifest does not exist or invalid.
+func readManifest(path string) (manifest, bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return manifest{}, false, nil
+		}
+		return manifest{}, false, errors.Wrap(err, "read")
+	}
+
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		// Broken manifest, probably interrupted write, start over.
+		return manifest{}, false, nil
+	}
+	return m, true, nil
+}
+
+// writeManifest atomically writes manifest to given path.
+func writeManifest(path string, m manifest) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "encode")
+	}
+	return atomicfile.WriteFile(path, data)
+}
+
+// resumeWriter is io.WriterAt which tracks written ranges and
+// periodically saves them to manifest.
+type resumeWriter struct {
+	file *os.File // immutable
+	path string   // immutable
+
+	state manifest
+	parts int
+	mux   sync.Mutex
+}
+
+func (w *resumeWriter) WriteAt(p []byte, off int64) (int, error) {
+	n, err := w.file.WriteAt(p, off)
+	if err != nil {
+		return n, err
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	w.state.Done = w.state.Done.add(off, off+int64(n))
+	w.parts++
+	if w.parts%manifestSaveParts == 0 {
+		if err := w.save(); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// save flushes file and writes manifest.
+//
+// Caller must hold mux.
+func (w *resumeWriter) save() error {
+	// Sync file before manifest, otherwise manifest can
+	// mark parts as written which are lost on crash.
+	if err := w.file.Sync(); err != nil {
+		return errors.Wrap(err, "sync")
+	}
+	st, err := w.file.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat")
+	}
+	w.state.Size = st.Size()
+	if err := writeManifest(w.path, w.state); err != nil {
+		return errors.Wrap(err, "save manifest")
+	}
+	return nil
+}
+
+func (b *Builder) resumeToPath(ctx context.Context, path string) (_ tg.StorageFileTypeClass, err error) {
+	r, err := b.reader()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := b.fileID()
+	if err != nil {
+		return nil, err
+	}
+	manifestPath := path + ManifestSuffix
+	expected := manifest{
+		Version:  manifestVersion,
+		PartSize: b.downloader.partSize,
+		Verify:   b.verify,
+		File:     id,
+	}
+	state, ok, err := readManifest(manifestPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "read manifest")
+	}
+	if ok {
+		if ok, err = resumable(path, state, expected); err != nil {
+			return nil, err
+		}
+	}
+
+	flags := os.O_RDWR | os.O_CREATE
+	if !ok {
+		// Nothing to resume, start over.
+		state = expected
+		flags |= os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(path, flags, 0o666)
+	if err != nil {
+		return nil, errors.Wrap(err, "open output file")
+	}
+	defer func() {
+		multierr.AppendInto(&err, f.Close())
+	}()
+
+	// Reader uses snapshot of ranges, so it does not race with writer.
+	r.skip = state.Done.has
+	w := &resumeWriter{
+		file:  f,
+		path:  manifestPath,
+		state: state,
+	}
+
+	typ, err := b.downloader.parallel(ctx, r, b.threads, w)
+	if err != nil {
+		w.mux.Lock()
+		multierr.AppendInto(&err, w.save())
+		w.mux.Unlock()
+		return nil, err
+	}
+
+	if err := os.Remove(manifestPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrap(err, "remove manifest")
+	}
+	return typ, nil
+}
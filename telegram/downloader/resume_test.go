@@ -0,0 +1,281 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/syncio"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+func TestSpans(t *testing.T) {
+	a := require.New(t)
+
+	var s spans
+	s = s.add(10, 20)
+	s = s.add(30, 40)
+	s = s.add(0, 5)
+	a.Equal(spans{{0, 5}, {10, 20}, {30, 40}}, s)
+
+	a.True(s.has(10, 20))
+	a.True(s.has(12, 15))
+	a.False(s.has(5, 10))
+	a.False(s.has(15, 35))
+
+	// Adjacent and overlapping ranges are merged.
+	s = s.add(20, 30)
+	a.Equal(spans{{0, 5}, {10, 40}}, s)
+	s = s.add(3, 12)
+	a.Equal(spans{{0, 40}}, s)
+	a.True(s.has(15, 35))
+}
+
+// offsetsMock records requested offsets and fails on given one.
+type offsetsMock struct {
+	mock
+
+	failOn int64
+	// beforeFail is called before failure, if set.
+	beforeFail func()
+	requested  []int64
+	mux        sync.Mutex
+}
+
+func (m *offsetsMock) UploadGetFile(ctx context.Context, request *tg.UploadGetFileRequest) (tg.UploadFileClass, error) {
+	m.mux.Lock()
+	m.requested = append(m.requested, request.Offset)
+	m.mux.Unlock()
+
+	if m.failOn >= 0 && request.Offset == m.failOn {
+		if m.beforeFail != nil {
+			m.beforeFail()
+		}
+		return nil, testErr
+	}
+	return m.mock.UploadGetFile(ctx, request)
+}
+
+func TestBuilder_WithOffset(t *testing.T) {
+	ctx := context.Background()
+	const partSize = 1024
+	data := make([]byte, partSize*3+10)
+	_, err := io.ReadFull(rand.Reader, data)
+	require.NoError(t, err)
+
+	t.Run("Stream", func(t *testing.T) {
+		a := require.New(t)
+		output := new(bytes.Buffer)
+		_, err := NewDownloader().WithPartSize(partSize).
+			Download(mock{data: data}, nil).
+			WithOffset(partSize).
+			Stream(ctx, output)
+		a.NoError(err)
+		a.Equal(data[partSize:], output.Bytes())
+	})
+	t.Run("Parallel", func(t *testing.T) {
+		a := require.New(t)
+		output := new(syncio.BufWriterAt)
+		_, err := NewDownloader().WithPartSize(partSize).
+			Download(mock{data: data}, nil).
+			WithOffset(2*partSize).
+			WithThreads(2).
+			Parallel(ctx, output)
+		a.NoError(err)
+		a.Equal(data[2*partSize:], output.Bytes()[2*partSize:])
+	})
+	t.Run("Verify", func(t *testing.T) {
+		a := require.New(t)
+		output := new(bytes.Buffer)
+		_, err := NewDownloader().WithPartSize(partSize).
+			Download(mock{
+				data:   data,
+				hashes: mockHashes{ranges: countHashes(data, 2*partSize)},
+			}, nil).
+			WithVerify(true).
+			WithOffset(partSize).
+			Stream(ctx, output)
+		a.NoError(err)
+		a.Equal(data[partSize:], output.Bytes())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		a := require.New(t)
+		_, err := NewDownloader().WithPartSize(partSize).
+			Download(mock{data: data}, nil).
+			WithOffset(10).
+			Stream(ctx, io.Discard)
+		a.Error(err)
+	})
+}
+
+// refreshMock returns FILE_REFERENCE_EXPIRED for outdated file reference.
+type refreshMock struct {
+	mock
+	reference []byte
+}
+
+func (m refreshMock) UploadGetFile(ctx context.Context, request *tg.UploadGetFileRequest) (tg.UploadFileClass, error) {
+	loc, ok := request.Location.(*tg.InputDocumentFileLocation)
+	if !ok || !bytes.Equal(loc.FileReference, m.reference) {
+		return nil, tgerr.New(400, tg.ErrFileReferenceExpired)
+	}
+	return m.mock.UploadGetFile(ctx, request)
+}
+
+func TestBuilder_WithRefresh(t *testing.T) {
+	ctx := context.Background()
+	data := bytes.Repeat([]byte{1, 2, 3, 4}, 1024)
+	client := refreshMock{
+		mock:      mock{data: data},
+		reference: []byte{2},
+	}
+
+	t.Run("Refresh", func(t *testing.T) {
+		a := require.New(t)
+		var (
+			calls int
+			mux   sync.Mutex
+		)
+		output := new(syncio.BufWriterAt)
+		_, err := NewDownloader().WithPartSize(1024).
+			Download(client, &tg.InputDocumentFileLocation{FileReference: []byte{1}}).
+			WithRefresh(func(ctx context.Context) (tg.InputFileLocationClass, error) {
+				mux.Lock()
+				calls++
+				mux.Unlock()
+				return &tg.InputDocumentFileLocation{FileReference: []byte{2}}, nil
+			}).
+			WithThreads(4).
+			Parallel(ctx, output)
+		a.NoError(err)
+		a.Equal(data, output.Bytes())
+		a.Equal(1, calls)
+	})
+	t.Run("NoRefresh", func(t *testing.T) {
+		a := require.New(t)
+		_, err := NewDownloader().WithPartSize(1024).
+			Download(client, &tg.InputDocumentFileLocation{FileReference: []byte{1}}).
+			Stream(ctx, io.Discard)
+		a.True(tg.IsFileReferenceExpired(err))
+	})
+	t.Run("Web", func(t *testing.T) {
+		a := require.New(t)
+		_, err := NewDownloader().
+			Web(client, nil).
+			WithRefresh(func(ctx context.Context) (tg.InputFileLocationClass, error) {
+				return nil, nil
+			}).
+			Stream(ctx, io.Discard)
+		a.Error(err)
+	})
+}
+
+func TestBuilder_WithResume(t *testing.T) {
+	ctx := context.Background()
+	const partSize = 1024
+	data := make([]byte, partSize*40+10)
+	_, err := io.ReadFull(rand.Reader, data)
+	require.NoError(t, err)
+
+	t.Run("Interrupted", func(t *testing.T) {
+		a := require.New(t)
+		path := filepath.Join(t.TempDir(), "file")
+		d := NewDownloader().WithPartSize(partSize)
+		const failOn = 20 * partSize
+
+		// First attempt fails in the middle of file. Single thread downloads
+		// parts in order, so all previous parts are written before failure.
+		client := &offsetsMock{mock: mock{data: data}, failOn: failOn}
+		client.beforeFail = func() {
+			a.Eventually(func() bool {
+				st, err := os.Stat(path)
+				return err == nil && st.Size() == failOn
+			}, time.Second*5, time.Millisecond)
+		}
+		_, err := d.Download(client, nil).WithResume(true).WithThreads(1).ToPath(ctx, path)
+		a.ErrorIs(err, testErr)
+
+		state, ok, err := readManifest(path + ManifestSuffix)
+		a.NoError(err)
+		a.True(ok)
+		a.Equal(spans{{0, failOn}}, state.Done)
+		a.Equal(int64(failOn), state.Size)
+
+		// Second attempt does not download saved parts.
+		client = &offsetsMock{mock: mock{data: data}, failOn: -1}
+		_, err = d.Download(client, nil).WithResume(true).WithThreads(4).ToPath(ctx, path)
+		a.NoError(err)
+		a.Contains(client.requested, int64(failOn))
+		for _, offset := range client.requested {
+			a.GreaterOrEqual(offset, int64(failOn))
+		}
+
+		result, err := os.ReadFile(path)
+		a.NoError(err)
+		a.Equal(data, result)
+		a.NoFileExists(path + ManifestSuffix)
+	})
+	t.Run("OtherFile", func(t *testing.T) {
+		a := require.New(t)
+		path := filepath.Join(t.TempDir(), "file")
+		d := NewDownloader().WithPartSize(partSize)
+
+		client := &offsetsMock{mock: mock{data: data}, failOn: 20 * partSize}
+		_, err := d.Download(client, &tg.InputDocumentFileLocation{ID: 1}).
+			WithResume(true).
+			ToPath(ctx, path)
+		a.Error(err)
+
+		// New file reference of the same file is resumed.
+		client = &offsetsMock{mock: mock{data: data}, failOn: 30 * partSize}
+		_, err = d.Download(client, &tg.InputDocumentFileLocation{ID: 1, FileReference: []byte{1}}).
+			WithResume(true).
+			ToPath(ctx, path)
+		a.Error(err)
+		a.NotContains(client.requested, int64(0))
+
+		// Leftover of another file is not resumed.
+		client = &offsetsMock{mock: mock{data: data}, failOn: -1}
+		_, err = d.Download(client, &tg.InputDocumentFileLocation{ID: 2}).
+			WithResume(true).
+			ToPath(ctx, path)
+		a.NoError(err)
+		a.Contains(client.requested, int64(0))
+
+		result, err := os.ReadFile(path)
+		a.NoError(err)
+		a.Equal(data, result)
+	})
+	t.Run("Mismatch", func(t *testing.T) {
+		a := require.New(t)
+		path := filepath.Join(t.TempDir(), "file")
+		a.NoError(os.WriteFile(path, bytes.Repeat([]byte{1}, len(data)*2), 0o600))
+		a.NoError(writeManifest(path+ManifestSuffix, manifest{
+			Version:  manifestVersion,
+			PartSize: partSize * 2,
+			Done:     spans{{0, 20 * partSize}},
+		}))
+
+		client := &offsetsMock{mock: mock{data: data}, failOn: -1}
+		_, err := NewDownloader().WithPartSize(partSize).
+			Download(client, nil).
+			WithResume(true).
+			WithThreads(4).
+			ToPath(ctx, path)
+		a.NoError(err)
+		a.Contains(client.requested, int64(0))
+
+		result, err := os.ReadFile(path)
+		a.NoError(err)
+		a.Equal(data, result)
+	})
+}
@@ -0,0 +1,364 @@
+package export
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/gotd/td/telegram/message/peer"
+	"github.com/gotd/td/tg"
+)
+
+const (
+	// dateLayout is a layout of message dates.
+	dateLayout = "2006-01-02T15:04:05"
+	// deletedName is a name of deleted account.
+	deletedName = "Deleted Account"
+)
+
+// converter converts messages to export format.
+type converter struct {
+	entities peer.Entities
+	loc      *time.Location
+}
+
+func (c converter) date(unix int) string {
+	return time.Unix(int64(unix), 0).In(c.loc).Format(dateLayout)
+}
+
+func userName(u *tg.User) string {
+	if u.Deleted {
+		return deletedName
+	}
+	return strings.TrimSpace(u.FirstName + " " + u.LastName)
+}
+
+// peerID returns peer ID in format of Telegram Desktop, like "user123".
+func peerID(p tg.PeerClass) string {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		return "user" + strconv.FormatInt(p.UserID, 10)
+	case *tg.PeerChat:
+		return "chat" + strconv.FormatInt(p.ChatID, 10)
+	case *tg.PeerChannel:
+		return "channel" + strconv.FormatInt(p.ChannelID, 10)
+	default:
+		return ""
+	}
+}
+
+func (c converter) userName(id int64) string {
+	u, ok := c.entities.User(id)
+	if !ok {
+		return ""
+	}
+	return userName(u)
+}
+
+func (c converter) userNames(ids []int64) []string {
+	r := make([]string, 0, len(ids))
+	for _, id := range ids {
+		r = append(r, c.userName(id))
+	}
+	return r
+}
+
+func (c converter) peerName(p tg.PeerClass) string {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		return c.userName(p.UserID)
+	case *tg.PeerChat:
+		if chat, ok := c.entities.Chat(p.ChatID); ok {
+			return chat.Title
+		}
+	case *tg.PeerChannel:
+		if ch, ok := c.entities.Channel(p.ChannelID); ok {
+			return ch.Title
+		}
+	}
+	return ""
+}
+
+// sender returns sender of message.
+//
+// Messages without sender are sent by peer itself, like channel posts or
+// incoming private messages.
+func sender(from, to tg.PeerClass) tg.PeerClass {
+	if from != nil {
+		return from
+	}
+	return to
+}
+
+func (c converter) message(msg *tg.Message) Message {
+	from := sender(msg.FromID, msg.PeerID)
+	m := Message{
+		ID:           msg.ID,
+		Type:         TypeMessage,
+		Date:         c.date(msg.Date),
+		DateUnixtime: strconv.Itoa(msg.Date),
+		From:         c.peerName(from),
+		FromID:       peerID(from),
+		Author:       msg.PostAuthor,
+	}
+	if msg.EditDate != 0 {
+		m.Edited = c.date(msg.EditDate)
+		m.EditedUnixtime = strconv.Itoa(msg.EditDate)
+	}
+	if fwd, ok := msg.GetFwdFrom(); ok {
+		m.ForwardedFrom = fwd.FromName
+		if fwd.FromID != nil && m.ForwardedFrom == "" {
+			m.ForwardedFrom = c.peerName(fwd.FromID)
+		}
+		if fwd.SavedFromPeer != nil {
+			m.SavedFrom = c.peerName(fwd.SavedFromPeer)
+		}
+	}
+	if reply, ok := msg.ReplyTo.(*tg.MessageReplyHeader); ok {
+		m.ReplyToMessageID = reply.ReplyToMsgID
+		if reply.ReplyToPeerID != nil {
+			m.ReplyToPeerID = peerID(reply.ReplyToPeerID)
+		}
+	}
+	if msg.ViaBotID != 0 {
+		if bot, ok := c.entities.User(msg.ViaBotID); ok && bot.Username != "" {
+			m.ViaBot = "@" + bot.Username
+		}
+	}
+	m.Text, m.TextEntities = textParts(msg.Message, msg.Entities)
+	m.Reactions = reactions(msg.Reactions)
+	return m
+}
+
+func (c converter) service(msg *tg.MessageService) Message {
+	from := sender(msg.FromID, msg.PeerID)
+	m := Message{
+		ID:           msg.ID,
+		Type:         TypeService,
+		Date:         c.date(msg.Date),
+		DateUnixtime: strconv.Itoa(msg.Date),
+		Actor:        c.peerName(from),
+		ActorID:      peerID(from),
+		TextEntities: []TextPart{},
+	}
+	if reply, ok := msg.ReplyTo.(*tg.MessageReplyHeader); ok {
+		m.ReplyToMessageID = reply.ReplyToMsgID
+	}
+	c.action(&m, msg.Action)
+	return m
+}
+
+// action fills action fields of service message.
+//
+// nolint:gocyclo
+func (c converter) action(m *Message, action tg.MessageActionClass) {
+	switch a := action.(type) {
+	case *tg.MessageActionChatCreate:
+		m.Action = "create_group"
+		m.Title = a.Title
+		m.Members = c.userNames(a.Users)
+	case *tg.MessageActionChatEditTitle:
+		m.Action = "edit_group_title"
+		m.Title = a.Title
+	case *tg.MessageActionChatEditPhoto:
+		m.Action = "edit_group_photo"
+	case *tg.MessageActionChatDeletePhoto:
+		m.Action = "delete_group_photo"
+	case *tg.MessageActionChatAddUser:
+		m.Action = "invite_members"
+		m.Members = c.userNames(a.Users)
+	case *tg.MessageActionChatDeleteUser:
+		m.Action = "remove_members"
+		m.Members = c.userNames([]int64{a.UserID})
+	case *tg.MessageActionChatJoinedByLink:
+		m.Action = "join_group_by_link"
+		m.Inviter = c.userName(a.InviterID)
+	case *tg.MessageActionChannelCreate:
+		m.Action = "create_channel"
+		m.Title = a.Title
+	case *tg.MessageActionChatMigrateTo:
+		m.Action = "migrate_to_supergroup"
+	case *tg.MessageActionChannelMigrateFrom:
+		m.Action = "migrate_from_group"
+		m.Title = a.Title
+	case *tg.MessageActionPinMessage:
+		m.Action = "pin_message"
+		m.MessageID = m.ReplyToMessageID
+		m.ReplyToMessageID = 0
+	case *tg.MessageActionHistoryClear:
+		m.Action = "clear_history"
+	case *tg.MessageActionGameScore:
+		m.Action = "score_in_game"
+		m.GameMessageID = m.ReplyToMessageID
+		m.ReplyToMessageID = 0
+		m.Score = a.Score
+	case *tg.MessageActionPaymentSent:
+		m.Action = "send_payment"
+		m.Amount = a.TotalAmount
+		m.Currency = a.Currency
+		m.InvoiceMessageID = m.ReplyToMessageID
+		m.ReplyToMessageID = 0
+	case *tg.MessageActionPhoneCall:
+		m.Action = "phone_call"
+		m.DurationSeconds = a.Duration
+		m.DiscardReason = discardReason(a.Reason)
+	case *tg.MessageActionScreenshotTaken:
+		m.Action = "take_screenshot"
+	case *tg.MessageActionBotAllowed:
+		m.Action = "allow_sending_messages"
+		m.ReasonDomain = a.Domain
+	case *tg.MessageActionContactSignUp:
+		m.Action = "joined_telegram"
+	case *tg.MessageActionGroupCall:
+		m.Action = "group_call"
+		m.DurationSeconds = a.Duration
+	case *tg.MessageActionInviteToGroupCall:
+		m.Action = "invite_to_group_call"
+		m.Members = c.userNames(a.Users)
+	case *tg.MessageActionSetMessagesTTL:
+		m.Action = "set_messages_ttl"
+		m.Period = a.Period
+	case *tg.MessageActionGroupCallScheduled:
+		m.Action = "group_call_scheduled"
+		m.ScheduleDate = c.date(a.ScheduleDate)
+	case *tg.MessageActionSetChatTheme:
+		m.Action = "edit_chat_theme"
+		m.Emoticon = a.Emoticon
+	case *tg.MessageActionChatJoinedByRequest:
+		m.Action = "joined_by_request"
+	case *tg.MessageActionTopicCreate:
+		m.Action = "topic_created"
+		m.Title = a.Title
+	case *tg.MessageActionTopicEdit:
+		m.Action = "topic_edit"
+		m.NewTitle = a.Title
+	case *tg.MessageActionCustomAction:
+		m.Action = "custom_action"
+		m.Text = Text{{Type: PlainType, Text: a.Message}}
+		m.TextEntities = []TextPart{{Type: PlainType, Text: a.Message}}
+	default:
+		m.Action = "unsupported"
+	}
+}
+
+func discardReason(r tg.PhoneCallDiscardReasonClass) string {
+	switch r.(type) {
+	case *tg.PhoneCallDiscardReasonMissed:
+		return "missed"
+	case *tg.PhoneCallDiscardReasonBusy:
+		return "busy"
+	case *tg.PhoneCallDiscardReasonHangup:
+		return "hangup"
+	case *tg.PhoneCallDiscardReasonDisconnect:
+		return "disconnect"
+	default:
+		return ""
+	}
+}
+
+func reactions(r tg.MessageReactions) []Reaction {
+	var result []Reaction
+	for _, count := range r.Results {
+		switch reaction := count.Reaction.(type) {
+		case *tg.ReactionEmoji:
+			result = append(result, Reaction{
+				Type:  "emoji",
+				Count: count.Count,
+				Emoji: reaction.Emoticon,
+			})
+		case *tg.ReactionCustomEmoji:
+			result = append(result, Reaction{
+				Type:       "custom_emoji",
+				Count:      count.Count,
+				DocumentID: strconv.FormatInt(reaction.DocumentID, 10),
+			})
+		}
+	}
+	return result
+}
+
+// entityPart returns TextPart of entity without text.
+func entityPart(e tg.MessageEntityClass) TextPart {
+	switch e := e.(type) {
+	case *tg.MessageEntityMention:
+		return TextPart{Type: "mention"}
+	case *tg.MessageEntityHashtag:
+		return TextPart{Type: "hashtag"}
+	case *tg.MessageEntityBotCommand:
+		return TextPart{Type: "bot_command"}
+	case *tg.MessageEntityURL:
+		return TextPart{Type: "link"}
+	case *tg.MessageEntityEmail:
+		return TextPart{Type: "email"}
+	case *tg.MessageEntityBold:
+		return TextPart{Type: "bold"}
+	case *tg.MessageEntityItalic:
+		return TextPart{Type: "italic"}
+	case *tg.MessageEntityCode:
+		return TextPart{Type: "code"}
+	case *tg.MessageEntityPre:
+		return TextPart{Type: "pre", Language: e.Language}
+	case *tg.MessageEntityTextURL:
+		return TextPart{Type: "text_link", Href: e.URL}
+	case *tg.MessageEntityMentionName:
+		return TextPart{Type: "mention_name", UserID: e.UserID}
+	case *tg.MessageEntityPhone:
+		return TextPart{Type: "phone"}
+	case *tg.MessageEntityCashtag:
+		return TextPart{Type: "cashtag"}
+	case *tg.MessageEntityUnderline:
+		return TextPart{Type: "underline"}
+	case *tg.MessageEntityStrike:
+		return TextPart{Type: "strikethrough"}
+	case *tg.MessageEntityBlockquote:
+		return TextPart{Type: "blockquote"}
+	case *tg.MessageEntityBankCard:
+		return TextPart{Type: "bank_card"}
+	case *tg.MessageEntitySpoiler:
+		return TextPart{Type: "spoiler"}
+	case *tg.MessageEntityCustomEmoji:
+		return TextPart{Type: "custom_emoji", DocumentID: strconv.FormatInt(e.DocumentID, 10)}
+	default:
+		return TextPart{Type: "unknown"}
+	}
+}
+
+// textParts splits message text to parts by entities.
+//
+// Like Telegram Desktop, parts are not nested, so entities overlapping
+// with previous ones are skipped.
+func textParts(text string, entities []tg.MessageEntityClass) (Text, []TextPart) {
+	var (
+		u     = utf16.Encode([]rune(text))
+		parts = make([]TextPart, 0, 2*len(entities)+1)
+		pos   = 0
+	)
+	sub := func(from, to int) string {
+		return string(utf16.Decode(u[from:to]))
+	}
+
+	sorted := append([]tg.MessageEntityClass(nil), entities...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetOffset() < sorted[j].GetOffset()
+	})
+	for _, e := range sorted {
+		from, to := e.GetOffset(), e.GetOffset()+e.GetLength()
+		if from < pos || from >= to || to > len(u) {
+			continue
+		}
+		if from > pos {
+			parts = append(parts, TextPart{Type: PlainType, Text: sub(pos, from)})
+		}
+		p := entityPart(e)
+		p.Text = sub(from, to)
+		parts = append(parts, p)
+		pos = to
+	}
+	if pos < len(u) {
+		parts = append(parts, TextPart{Type: PlainType, Text: sub(pos, len(u))})
+	}
+
+	return Text(parts), parts
+}
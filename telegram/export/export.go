@@ -0,0 +1,381 @@
+// Package export implements chat history export compatible with
+// "Export chat history" of Telegram Desktop in JSON format.
+//
+// History is written to directory with result.json and media files in
+// subdirectories, like photos and files. Interrupted export is resumed
+// from spool file, which is removed after result.json is written.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/internal/atomicfile"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/telegram/message/peer"
+	"github.com/gotd/td/telegram/query/messages"
+	"github.com/gotd/td/tg"
+)
+
+// ResultName is a name of export result file.
+const ResultName = "result.json"
+
+// Options of Exporter.
+type Options struct {
+	// BatchSize is a count of messages requested at once.
+	//
+	// Defaults to 100.
+	BatchSize int
+	// SkipMedia disables download of media files.
+	SkipMedia bool
+	// MaxFileSize is a maximum size of downloaded file, bigger files are
+	// skipped. Zero means no limit.
+	MaxFileSize int64
+	// Location is a time zone of dates.
+	//
+	// Defaults to time.Local, like Telegram Desktop.
+	Location *time.Location
+	// Downloader to use.
+	//
+	// Defaults to downloader.NewDownloader().
+	Downloader *downloader.Downloader
+	// FloodWait is options of flood wait handling.
+	//
+	// Clock defaults to Clock, waits are logged if OnWait is nil.
+	FloodWait telegram.FloodWaiterOptions
+	// Clock to use for flood waits.
+	Clock clock.Clock
+	// Logger to use.
+	Logger *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.BatchSize == 0 {
+		o.BatchSize = 100
+	}
+	if o.Location == nil {
+		o.Location = time.Local
+	}
+	if o.Downloader == nil {
+		o.Downloader = downloader.NewDownloader()
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.FloodWait.Clock == nil {
+		o.FloodWait.Clock = o.Clock
+	}
+	if o.FloodWait.OnWait == nil {
+		log := o.Logger
+		o.FloodWait.OnWait = func(ctx context.Context, e telegram.FloodWaitEvent) {
+			log.Info("Flood wait",
+				zap.String("method", e.Method),
+				zap.Duration("duration", e.Duration),
+			)
+		}
+	}
+}
+
+// Exporter exports chat history.
+type Exporter struct {
+	api         *tg.Client
+	batchSize   int
+	skipMedia   bool
+	maxFileSize int64
+	loc         *time.Location
+	downloader  *downloader.Downloader
+	log         *zap.Logger
+}
+
+// New creates new Exporter.
+//
+// Requests failed with FLOOD_WAIT are retried after required delay
+// using telegram.FloodWaiter.
+func New(api *tg.Client, opts Options) *Exporter {
+	opts.setDefaults()
+	return &Exporter{
+		api:         tg.NewClient(telegram.NewFloodWaiter(opts.FloodWait).Handle(api.Invoker())),
+		batchSize:   opts.BatchSize,
+		skipMedia:   opts.SkipMedia,
+		maxFileSize: opts.MaxFileSize,
+		loc:         opts.Location,
+		downloader:  opts.Downloader,
+		log:         opts.Logger,
+	}
+}
+
+// Export exports full history of given chat to directory.
+//
+// If previous export to the same directory was interrupted, it is
+// continued. Note that history is exported as of first attempt: messages
+// sent after it are not included.
+func (e *Exporter) Export(ctx context.Context, p tg.InputPeerClass, dir string) (rerr error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return errors.Wrap(err, "create directory")
+	}
+
+	result, err := e.chat(ctx, p)
+	if err != nil {
+		return errors.Wrap(err, "get chat")
+	}
+
+	spoolPath := filepath.Join(dir, ResultName+SpoolSuffix)
+	s, err := openSpool(spoolPath)
+	if err != nil {
+		return errors.Wrap(err, "open spool")
+	}
+	defer func() {
+		if s != nil {
+			multierr.AppendInto(&rerr, s.Close())
+		}
+	}()
+	if s.minID != 0 {
+		e.log.Info("Resuming export", zap.Int("offset_id", s.minID))
+	}
+
+	iter := messages.NewQueryBuilder(e.api).
+		GetHistory(p).
+		BatchSize(e.batchSize).
+		OffsetID(s.minID).
+		Iter()
+	for iter.Next(ctx) {
+		elem := iter.Value()
+		m, err := e.message(ctx, p, dir, elem, s.names)
+		if err != nil {
+			return errors.Wrapf(err, "export message %d", elem.Msg.GetID())
+		}
+		if err := s.Write(m); err != nil {
+			return errors.Wrap(err, "write spool")
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return errors.Wrap(err, "iterate history")
+	}
+
+	result.Messages, err = s.Messages()
+	if err != nil {
+		return errors.Wrap(err, "read spool")
+	}
+	if err := writeResult(filepath.Join(dir, ResultName), result); err != nil {
+		return errors.Wrap(err, "write result")
+	}
+
+	closeErr := s.Close()
+	s = nil
+	if closeErr != nil {
+		return errors.Wrap(closeErr, "close spool")
+	}
+	if err := os.Remove(spoolPath); err != nil {
+		return errors.Wrap(err, "remove spool")
+	}
+
+	e.log.Info("Export done", zap.Int("messages", len(result.Messages)))
+	return nil
+}
+
+func (e *Exporter) message(
+	ctx context.Context,
+	p tg.InputPeerClass,
+	dir string,
+	elem messages.Elem,
+	names map[string]struct{},
+) (Message, error) {
+	c := converter{
+		entities: elem.Entities,
+		loc:      e.loc,
+	}
+
+	switch msg := elem.Msg.(type) {
+	case *tg.Message:
+		m := c.message(msg)
+		a, ok := c.media(&m, msg.Media)
+		if !ok {
+			return m, nil
+		}
+
+		name := NotIncluded
+		if !e.skipMedia && (e.maxFileSize == 0 || a.size <= e.maxFileSize) {
+			name = uniqueName(names, a.fileName(msg.ID, time.Unix(int64(msg.Date), 0).In(e.loc)))
+			if err := e.download(ctx, p, msg.ID, a, filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+				return Message{}, errors.Wrapf(err, "download %q", name)
+			}
+		}
+		if a.photo {
+			m.Photo = name
+		} else {
+			m.File = name
+		}
+		return m, nil
+	case *tg.MessageService:
+		return c.service(msg), nil
+	default:
+		return Message{}, errors.Errorf("unexpected type %T", msg)
+	}
+}
+
+func (e *Exporter) download(ctx context.Context, p tg.InputPeerClass, msgID int, a attachment, to string) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0o750); err != nil {
+		return errors.Wrap(err, "create directory")
+	}
+
+	_, err := e.downloader.Download(e.api, a.location).
+		WithResume(true).
+		WithRefresh(func(ctx context.Context) (tg.InputFileLocationClass, error) {
+			return e.refresh(ctx, p, msgID)
+		}).
+		ToPath(ctx, to)
+	return err
+}
+
+// refresh fetches message again to get new file reference.
+func (e *Exporter) refresh(ctx context.Context, p tg.InputPeerClass, msgID int) (tg.InputFileLocationClass, error) {
+	var (
+		ids    = []tg.InputMessageClass{&tg.InputMessageID{ID: msgID}}
+		result tg.MessagesMessagesClass
+		err    error
+	)
+	if ch, ok := peer.ToInputChannel(p); ok {
+		result, err = e.api.ChannelsGetMessages(ctx, &tg.ChannelsGetMessagesRequest{
+			Channel: ch,
+			ID:      ids,
+		})
+	} else {
+		result, err = e.api.MessagesGetMessages(ctx, ids)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "get message")
+	}
+
+	modified, ok := result.AsModified()
+	if !ok {
+		return nil, errors.Errorf("unexpected type %T", result)
+	}
+	for _, m := range modified.GetMessages() {
+		msg, ok := m.(*tg.Message)
+		if !ok || msg.ID != msgID {
+			continue
+		}
+		if loc, ok := location(msg.Media); ok {
+			return loc, nil
+		}
+	}
+	return nil, errors.Errorf("message %d has no media", msgID)
+}
+
+// chat returns Result with chat info.
+func (e *Exporter) chat(ctx context.Context, p tg.InputPeerClass) (Result, error) {
+	switch p := p.(type) {
+	case *tg.InputPeerSelf:
+		return e.user(ctx, &tg.InputUserSelf{})
+	case *tg.InputPeerUser:
+		return e.user(ctx, &tg.InputUser{
+			UserID:     p.UserID,
+			AccessHash: p.AccessHash,
+		})
+	case *tg.InputPeerChat:
+		chats, err := e.api.MessagesGetChats(ctx, []int64{p.ChatID})
+		if err != nil {
+			return Result{}, err
+		}
+		for _, c := range chats.GetChats() {
+			if c, ok := c.(*tg.Chat); ok && c.ID == p.ChatID {
+				return Result{
+					Name: c.Title,
+					Type: ChatPrivateGroup,
+					ID:   c.ID,
+				}, nil
+			}
+		}
+		return Result{}, errors.Errorf("chat %d not found", p.ChatID)
+	case *tg.InputPeerChannel:
+		chats, err := e.api.ChannelsGetChannels(ctx, []tg.InputChannelClass{
+			&tg.InputChannel{
+				ChannelID:  p.ChannelID,
+				AccessHash: p.AccessHash,
+			},
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		for _, c := range chats.GetChats() {
+			if c, ok := c.(*tg.Channel); ok && c.ID == p.ChannelID {
+				return Result{
+					Name: c.Title,
+					Type: channelType(c),
+					ID:   c.ID,
+				}, nil
+			}
+		}
+		return Result{}, errors.Errorf("channel %d not found", p.ChannelID)
+	default:
+		return Result{}, errors.Errorf("unsupported peer %T", p)
+	}
+}
+
+func (e *Exporter) user(ctx context.Context, input tg.InputUserClass) (Result, error) {
+	users, err := e.api.UsersGetUsers(ctx, []tg.InputUserClass{input})
+	if err != nil {
+		return Result{}, err
+	}
+	if len(users) < 1 {
+		return Result{}, errors.New("user not found")
+	}
+	u, ok := users[0].AsNotEmpty()
+	if !ok {
+		return Result{}, errors.New("user not found")
+	}
+
+	r := Result{
+		Name: userName(u),
+		Type: ChatPersonal,
+		ID:   u.ID,
+	}
+	switch {
+	case u.Self:
+		r.Type = ChatSavedMessages
+	case u.Bot:
+		r.Type = ChatBot
+	}
+	return r, nil
+}
+
+func channelType(c *tg.Channel) ChatType {
+	public := c.Username != "" || len(c.Usernames) > 0
+	switch {
+	case c.Megagroup && public:
+		return ChatPublicSupergroup
+	case c.Megagroup:
+		return ChatPrivateSupergroup
+	case public:
+		return ChatPublicChannel
+	default:
+		return ChatPrivateChannel
+	}
+}
+
+// writeResult atomically writes result to given path.
+func writeResult(path string, r Result) error {
+	if r.Messages == nil {
+		r.Messages = []Message{}
+	}
+
+	return atomicfile.Write(path, func(w io.Writer) error {
+		e := json.NewEncoder(w)
+		e.SetEscapeHTML(false)
+		e.SetIndent("", " ")
+		return e.Encode(r)
+	})
+}
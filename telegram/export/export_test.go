@@ -0,0 +1,275 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func testMessages() []tg.MessageClass {
+	peer := &tg.PeerUser{UserID: 10}
+	return []tg.MessageClass{
+		&tg.Message{
+			ID:      4,
+			PeerID:  peer,
+			Date:    40,
+			Message: "hello world",
+			Entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 6, Length: 5},
+			},
+			ReplyTo: &tg.MessageReplyHeader{ReplyToMsgID: 3},
+			Reactions: tg.MessageReactions{
+				Results: []tg.ReactionCount{
+					{Reaction: &tg.ReactionEmoji{Emoticon: "👍"}, Count: 2},
+				},
+			},
+		},
+		&tg.Message{
+			ID:     3,
+			Out:    true,
+			FromID: &tg.PeerUser{UserID: 1},
+			PeerID: peer,
+			Date:   30,
+			Media: &tg.MessageMediaDocument{
+				Document: &tg.Document{
+					ID:       100,
+					MimeType: "application/pdf",
+					Size:     4,
+					Attributes: []tg.DocumentAttributeClass{
+						&tg.DocumentAttributeFilename{FileName: "report.pdf"},
+					},
+				},
+			},
+		},
+		&tg.Message{
+			ID:      2,
+			PeerID:  peer,
+			Date:    20,
+			Message: "forwarded",
+			FwdFrom: tg.MessageFwdHeader{FromName: "Hidden User"},
+		},
+		&tg.MessageService{
+			ID:      1,
+			PeerID:  peer,
+			Date:    10,
+			ReplyTo: &tg.MessageReplyHeader{ReplyToMsgID: 2},
+			Action:  &tg.MessageActionPinMessage{},
+		},
+	}
+}
+
+func testUsers() []tg.UserClass {
+	return []tg.UserClass{
+		&tg.User{ID: 10, AccessHash: 1, FirstName: "Alice"},
+		&tg.User{ID: 1, Self: true, FirstName: "Bob", LastName: "Smith"},
+	}
+}
+
+func readResult(t *testing.T, dir string) Result {
+	data, err := os.ReadFile(filepath.Join(dir, ResultName))
+	require.NoError(t, err)
+
+	var r Result
+	require.NoError(t, json.Unmarshal(data, &r))
+	return r
+}
+
+func TestExporter_Export(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	mock := tgmock.New(t)
+	mock.ExpectFunc(func(b bin.Encoder) {
+		a.IsType(&tg.UsersGetUsersRequest{}, b)
+	}).ThenResult(&tg.UserClassVector{Elems: testUsers()[:1]})
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesGetHistoryRequest)
+		a.True(ok)
+		a.Zero(req.OffsetID)
+	}).ThenResult(&tg.MessagesMessages{
+		Messages: testMessages(),
+		Users:    testUsers(),
+	})
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.UploadGetFileRequest)
+		a.True(ok)
+		a.Equal(&tg.InputDocumentFileLocation{ID: 100}, req.Location)
+	}).ThenResult(&tg.UploadFile{
+		Type:  &tg.StorageFileUnknown{},
+		Bytes: []byte("data"),
+	})
+	// Iterator requests next batch after last one.
+	mock.ExpectFunc(func(b bin.Encoder) {
+		a.IsType(&tg.MessagesGetHistoryRequest{}, b)
+	}).ThenResult(&tg.MessagesMessages{})
+
+	e := New(tg.NewClient(mock), Options{Location: time.UTC})
+	a.NoError(e.Export(ctx, &tg.InputPeerUser{UserID: 10, AccessHash: 1}, dir))
+
+	r := readResult(t, dir)
+	a.Equal("Alice", r.Name)
+	a.Equal(ChatPersonal, r.Type)
+	a.Equal(int64(10), r.ID)
+	a.Len(r.Messages, 4)
+
+	service := r.Messages[0]
+	a.Equal(TypeService, service.Type)
+	a.Equal("pin_message", service.Action)
+	a.Equal(2, service.MessageID)
+	a.Equal("Alice", service.Actor)
+	a.Equal("user10", service.ActorID)
+	a.Equal("1970-01-01T00:00:10", service.Date)
+	a.Equal("10", service.DateUnixtime)
+
+	a.Equal("Hidden User", r.Messages[1].ForwardedFrom)
+	a.Equal("forwarded", r.Messages[1].Text.String())
+
+	doc := r.Messages[2]
+	a.Equal("Bob Smith", doc.From)
+	a.Equal("user1", doc.FromID)
+	a.Equal("files/report.pdf", doc.File)
+	a.Equal("report.pdf", doc.FileName)
+	a.Equal("application/pdf", doc.MimeType)
+	data, err := os.ReadFile(filepath.Join(dir, "files", "report.pdf"))
+	a.NoError(err)
+	a.Equal("data", string(data))
+
+	text := r.Messages[3]
+	a.Equal(3, text.ReplyToMessageID)
+	a.Equal(Text{
+		{Type: PlainType, Text: "hello "},
+		{Type: "bold", Text: "world"},
+	}, text.Text)
+	a.Equal([]Reaction{{Type: "emoji", Count: 2, Emoji: "👍"}}, text.Reactions)
+
+	_, err = os.Stat(filepath.Join(dir, ResultName+SpoolSuffix))
+	a.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestExporter_Resume(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// Simulate interrupted export: newest message is exported and
+	// last line is partially written.
+	s, err := openSpool(filepath.Join(dir, ResultName+SpoolSuffix))
+	a.NoError(err)
+	a.NoError(s.Write(Message{ID: 5, Type: TypeMessage, Text: Text{{Type: PlainType, Text: "newest"}}}))
+	_, err = s.file.WriteString(`{"id":4,"ty`)
+	a.NoError(err)
+	a.NoError(s.Close())
+
+	mock := tgmock.New(t)
+	mock.ExpectFunc(func(b bin.Encoder) {
+		a.IsType(&tg.UsersGetUsersRequest{}, b)
+	}).ThenResult(&tg.UserClassVector{Elems: testUsers()[:1]})
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesGetHistoryRequest)
+		a.True(ok)
+		a.Equal(5, req.OffsetID)
+	}).ThenResult(&tg.MessagesMessages{
+		Messages: testMessages()[1:],
+		Users:    testUsers(),
+	})
+	mock.ExpectFunc(func(b bin.Encoder) {
+		a.IsType(&tg.MessagesGetHistoryRequest{}, b)
+	}).ThenResult(&tg.MessagesMessages{})
+
+	e := New(tg.NewClient(mock), Options{SkipMedia: true})
+	a.NoError(e.Export(ctx, &tg.InputPeerUser{UserID: 10, AccessHash: 1}, dir))
+
+	r := readResult(t, dir)
+	ids := make([]int, 0, len(r.Messages))
+	for _, m := range r.Messages {
+		ids = append(ids, m.ID)
+	}
+	a.Equal([]int{1, 2, 3, 5}, ids)
+	a.Equal(NotIncluded, r.Messages[2].File)
+	a.Equal("newest", r.Messages[3].Text.String())
+}
+
+func TestExporter_FloodWait(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	clk := neo.NewTime(time.Unix(0, 0))
+
+	mock := tgmock.New(t)
+	mock.ExpectCall(&tg.UsersGetUsersRequest{
+		ID: []tg.InputUserClass{&tg.InputUserSelf{}},
+	}).ThenFlood(10)
+	mock.ExpectCall(&tg.UsersGetUsersRequest{
+		ID: []tg.InputUserClass{&tg.InputUserSelf{}},
+	}).ThenResult(&tg.UserClassVector{Elems: testUsers()[1:]})
+
+	e := New(tg.NewClient(mock), Options{Clock: clk})
+
+	observe := clk.Observe()
+	done := make(chan error, 1)
+	go func() {
+		_, err := e.chat(ctx, &tg.InputPeerSelf{})
+		done <- err
+	}()
+	<-observe
+	clk.Travel(11 * time.Second)
+	a.NoError(<-done)
+}
+
+func TestText_JSON(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		text Text
+		json string
+	}{
+		{"Empty", nil, `""`},
+		{"Plain", Text{{Type: PlainType, Text: "text"}}, `"text"`},
+		{
+			"Formatted",
+			Text{
+				{Type: PlainType, Text: "see "},
+				{Type: "text_link", Text: "link", Href: "https://example.com"},
+			},
+			`["see ",{"type":"text_link","text":"link","href":"https://example.com"}]`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+			data, err := json.Marshal(tt.text)
+			a.NoError(err)
+			a.JSONEq(tt.json, string(data))
+
+			var decoded Text
+			a.NoError(json.Unmarshal(data, &decoded))
+			a.Equal(tt.text, decoded)
+		})
+	}
+}
+
+func TestTextParts(t *testing.T) {
+	a := require.New(t)
+
+	text, entities := textParts("😀 bold code", []tg.MessageEntityClass{
+		&tg.MessageEntityCode{Offset: 8, Length: 4},
+		&tg.MessageEntityBold{Offset: 3, Length: 4},
+		// Nested entity is skipped.
+		&tg.MessageEntityItalic{Offset: 3, Length: 2},
+	})
+	a.Equal(Text{
+		{Type: PlainType, Text: "😀 "},
+		{Type: "bold", Text: "bold"},
+		{Type: PlainType, Text: " "},
+		{Type: "code", Text: "code"},
+	}, text)
+	a.Equal([]TextPart(text), entities)
+}
@@ -0,0 +1,302 @@
+package export
+
+import (
+	"bytes"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotd/td/tg"
+)
+
+// NotIncluded is a placeholder of file which was not downloaded.
+const NotIncluded = "(File not included. Change data exporting settings to download.)"
+
+// Media directories of Telegram Desktop export.
+const (
+	dirPhotos       = "photos"
+	dirFiles        = "files"
+	dirVideos       = "video_files"
+	dirVoices       = "voice_messages"
+	dirRoundVideos  = "round_video_messages"
+	dirStickers     = "stickers"
+	fileDateLayout  = "02-01-2006_15-04-05"
+	defaultPhotoExt = ".jpg"
+)
+
+// attachment is a downloadable file of message.
+type attachment struct {
+	// dir is a directory of file.
+	dir string
+	// prefix of generated name.
+	prefix string
+	// name is an original file name, if any.
+	name string
+	// ext is an extension of generated name.
+	ext string
+	// photo is true if file is a photo.
+	photo    bool
+	size     int64
+	location tg.InputFileLocationClass
+}
+
+// fileName returns name of file in export directory.
+func (a attachment) fileName(msgID int, date time.Time) string {
+	if name := sanitizeName(a.name); name != "" {
+		return path.Join(a.dir, name)
+	}
+	return path.Join(a.dir, a.prefix+"_"+strconv.Itoa(msgID)+"@"+date.Format(fileDateLayout)+a.ext)
+}
+
+// sanitizeName returns base name of file, or empty string if name is unsafe.
+func sanitizeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', 0:
+			return '_'
+		default:
+			return r
+		}
+	}, name)
+	if name == "." || name == ".." {
+		return ""
+	}
+	return name
+}
+
+// uniqueName returns name which is not used yet, adding " (N)" suffix
+// if needed, and marks it as used.
+func uniqueName(used map[string]struct{}, name string) string {
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	r := name
+	for i := 1; ; i++ {
+		if _, ok := used[r]; !ok {
+			break
+		}
+		r = base + " (" + strconv.Itoa(i) + ")" + ext
+	}
+	used[r] = struct{}{}
+	return r
+}
+
+type sizedPhoto interface {
+	GetW() int
+	GetH() int
+}
+
+// largestSize returns largest photo size.
+func largestSize(p *tg.Photo) (typ string, w, h, size int, ok bool) {
+	for _, s := range p.Sizes {
+		sized, isSized := s.(sizedPhoto)
+		if !isSized || sized.GetW()*sized.GetH() < w*h {
+			continue
+		}
+		typ, w, h, ok = s.GetType(), sized.GetW(), sized.GetH(), true
+		switch s := s.(type) {
+		case *tg.PhotoSize:
+			size = s.Size
+		case *tg.PhotoCachedSize:
+			size = len(s.Bytes)
+		case *tg.PhotoSizeProgressive:
+			if len(s.Sizes) > 0 {
+				size = s.Sizes[len(s.Sizes)-1]
+			}
+		}
+	}
+	return typ, w, h, size, ok
+}
+
+func photoAttachment(p *tg.Photo) (attachment, int, int, bool) {
+	typ, w, h, size, ok := largestSize(p)
+	if !ok {
+		return attachment{}, 0, 0, false
+	}
+	return attachment{
+		dir:    dirPhotos,
+		prefix: "photo",
+		ext:    defaultPhotoExt,
+		photo:  true,
+		size:   int64(size),
+		location: &tg.InputPhotoFileLocation{
+			ID:            p.ID,
+			AccessHash:    p.AccessHash,
+			FileReference: p.FileReference,
+			ThumbSize:     typ,
+		},
+	}, w, h, true
+}
+
+// documentExt returns extension of generated document name.
+func documentExt(mimeType, fallback string) string {
+	switch mimeType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/webp":
+		return ".webp"
+	case "image/gif":
+		return ".gif"
+	case "video/mp4":
+		return ".mp4"
+	case "video/webm":
+		return ".webm"
+	case "audio/ogg":
+		return ".ogg"
+	case "audio/mpeg":
+		return ".mp3"
+	case "application/x-tgsticker":
+		return ".tgs"
+	default:
+		return fallback
+	}
+}
+
+// location returns file location of message media.
+func location(media tg.MessageMediaClass) (tg.InputFileLocationClass, bool) {
+	switch media := media.(type) {
+	case *tg.MessageMediaPhoto:
+		p, ok := media.Photo.AsNotEmpty()
+		if !ok {
+			return nil, false
+		}
+		a, _, _, ok := photoAttachment(p)
+		return a.location, ok
+	case *tg.MessageMediaDocument:
+		doc, ok := media.Document.AsNotEmpty()
+		if !ok {
+			return nil, false
+		}
+		return doc.AsInputDocumentFileLocation(), true
+	default:
+		return nil, false
+	}
+}
+
+// media fills media fields of message and returns attachment to download.
+//
+// nolint:gocyclo
+func (c converter) media(m *Message, media tg.MessageMediaClass) (attachment, bool) {
+	switch media := media.(type) {
+	case *tg.MessageMediaPhoto:
+		p, ok := media.Photo.AsNotEmpty()
+		if !ok {
+			return attachment{}, false
+		}
+		a, w, h, ok := photoAttachment(p)
+		if !ok {
+			return attachment{}, false
+		}
+		m.Width, m.Height = w, h
+		return a, true
+	case *tg.MessageMediaDocument:
+		doc, ok := media.Document.AsNotEmpty()
+		if !ok {
+			return attachment{}, false
+		}
+		a := attachment{
+			dir:      dirFiles,
+			prefix:   "file",
+			ext:      documentExt(doc.MimeType, ""),
+			size:     doc.Size,
+			location: doc.AsInputDocumentFileLocation(),
+		}
+		m.MimeType = doc.MimeType
+		m.FileSize = doc.Size
+
+		var sticker, animated bool
+		for _, attr := range doc.Attributes {
+			switch attr := attr.(type) {
+			case *tg.DocumentAttributeFilename:
+				a.name = attr.FileName
+				m.FileName = attr.FileName
+			case *tg.DocumentAttributeSticker:
+				sticker = true
+				m.StickerEmoji = attr.Alt
+			case *tg.DocumentAttributeAnimated:
+				animated = true
+			case *tg.DocumentAttributeImageSize:
+				m.Width, m.Height = attr.W, attr.H
+			case *tg.DocumentAttributeVideo:
+				m.Width, m.Height = attr.W, attr.H
+				m.DurationSeconds = int(attr.Duration)
+				switch {
+				case attr.RoundMessage:
+					m.MediaType = "video_message"
+					a.dir, a.prefix = dirRoundVideos, "file"
+				case m.MediaType == "":
+					m.MediaType = "video_file"
+					a.dir, a.prefix = dirVideos, "video"
+				}
+			case *tg.DocumentAttributeAudio:
+				m.DurationSeconds = attr.Duration
+				if attr.Voice {
+					m.MediaType = "voice_message"
+					a.dir, a.prefix = dirVoices, "audio"
+					continue
+				}
+				m.MediaType = "audio_file"
+				m.Performer = attr.Performer
+				m.Title = attr.Title
+			}
+		}
+		switch {
+		case sticker:
+			m.MediaType = "sticker"
+			a.dir, a.prefix = dirStickers, "sticker"
+		case animated:
+			m.MediaType = "animation"
+			a.dir, a.prefix = dirVideos, "video"
+		}
+		return a, true
+	case *tg.MessageMediaGeo:
+		m.LocationInformation = geoLocation(media.Geo)
+	case *tg.MessageMediaGeoLive:
+		m.LocationInformation = geoLocation(media.Geo)
+	case *tg.MessageMediaVenue:
+		m.LocationInformation = geoLocation(media.Geo)
+		m.Title = media.Title
+	case *tg.MessageMediaContact:
+		m.ContactInformation = &Contact{
+			FirstName:   media.FirstName,
+			LastName:    media.LastName,
+			PhoneNumber: media.PhoneNumber,
+		}
+	case *tg.MessageMediaPoll:
+		m.Poll = poll(media.Poll, media.Results)
+	}
+	return attachment{}, false
+}
+
+func geoLocation(g tg.GeoPointClass) *Location {
+	p, ok := g.AsNotEmpty()
+	if !ok {
+		return nil
+	}
+	return &Location{
+		Latitude:  p.Lat,
+		Longitude: p.Long,
+	}
+}
+
+func poll(p tg.Poll, results tg.PollResults) *Poll {
+	r := &Poll{
+		Question:    p.Question,
+		Closed:      p.Closed,
+		TotalVoters: results.TotalVoters,
+		Answers:     make([]PollAnswer, 0, len(p.Answers)),
+	}
+	for _, answer := range p.Answers {
+		a := PollAnswer{Text: answer.Text}
+		for _, v := range results.Results {
+			if bytes.Equal(v.Option, answer.Option) {
+				a.Voters = v.Voters
+				a.Chosen = v.Chosen
+			}
+		}
+		r.Answers = append(r.Answers, a)
+	}
+	return r
+}
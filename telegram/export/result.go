@@ -0,0 +1,236 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// ChatType is a type of exported chat.
+type ChatType string
+
+// Chat types of Telegram Desktop export.
+const (
+	ChatSavedMessages     ChatType = "saved_messages"
+	ChatPersonal          ChatType = "personal_chat"
+	ChatBot               ChatType = "bot_chat"
+	ChatPrivateGroup      ChatType = "private_group"
+	ChatPrivateSupergroup ChatType = "private_supergroup"
+	ChatPublicSupergroup  ChatType = "public_supergroup"
+	ChatPrivateChannel    ChatType = "private_channel"
+	ChatPublicChannel     ChatType = "public_channel"
+)
+
+// Message types of Telegram Desktop export.
+const (
+	TypeMessage = "message"
+	TypeService = "service"
+)
+
+// Result is a content of result.json.
+type Result struct {
+	Name     string    `json:"name"`
+	Type     ChatType  `json:"type"`
+	ID       int64     `json:"id"`
+	Messages []Message `json:"messages"`
+}
+
+// Message is an exported message.
+//
+// Fields are named after Telegram Desktop export, only fields relevant
+// for message type and action are set.
+type Message struct {
+	ID             int    `json:"id"`
+	Type           string `json:"type"`
+	Date           string `json:"date"`
+	DateUnixtime   string `json:"date_unixtime"`
+	Edited         string `json:"edited,omitempty"`
+	EditedUnixtime string `json:"edited_unixtime,omitempty"`
+
+	// Service message fields.
+	Actor            string   `json:"actor,omitempty"`
+	ActorID          string   `json:"actor_id,omitempty"`
+	Action           string   `json:"action,omitempty"`
+	Title            string   `json:"title,omitempty"`
+	NewTitle         string   `json:"new_title,omitempty"`
+	Members          []string `json:"members,omitempty"`
+	Inviter          string   `json:"inviter,omitempty"`
+	MessageID        int      `json:"message_id,omitempty"`
+	GameMessageID    int      `json:"game_message_id,omitempty"`
+	Score            int      `json:"score,omitempty"`
+	Amount           int64    `json:"amount,omitempty"`
+	Currency         string   `json:"currency,omitempty"`
+	InvoiceMessageID int      `json:"invoice_message_id,omitempty"`
+	DiscardReason    string   `json:"discard_reason,omitempty"`
+	ReasonDomain     string   `json:"reason_domain,omitempty"`
+	Period           int      `json:"period,omitempty"`
+	ScheduleDate     string   `json:"schedule_date,omitempty"`
+	Emoticon         string   `json:"emoticon,omitempty"`
+
+	// Regular message fields.
+	From             string `json:"from,omitempty"`
+	FromID           string `json:"from_id,omitempty"`
+	Author           string `json:"author,omitempty"`
+	ForwardedFrom    string `json:"forwarded_from,omitempty"`
+	SavedFrom        string `json:"saved_from,omitempty"`
+	ReplyToMessageID int    `json:"reply_to_message_id,omitempty"`
+	ReplyToPeerID    string `json:"reply_to_peer_id,omitempty"`
+	ViaBot           string `json:"via_bot,omitempty"`
+
+	// Media fields.
+	Photo               string    `json:"photo,omitempty"`
+	File                string    `json:"file,omitempty"`
+	FileName            string    `json:"file_name,omitempty"`
+	FileSize            int64     `json:"file_size,omitempty"`
+	MediaType           string    `json:"media_type,omitempty"`
+	StickerEmoji        string    `json:"sticker_emoji,omitempty"`
+	Performer           string    `json:"performer,omitempty"`
+	MimeType            string    `json:"mime_type,omitempty"`
+	DurationSeconds     int       `json:"duration_seconds,omitempty"`
+	Width               int       `json:"width,omitempty"`
+	Height              int       `json:"height,omitempty"`
+	LocationInformation *Location `json:"location_information,omitempty"`
+	ContactInformation  *Contact  `json:"contact_information,omitempty"`
+	Poll                *Poll     `json:"poll,omitempty"`
+
+	Text         Text       `json:"text"`
+	TextEntities []TextPart `json:"text_entities"`
+	Reactions    []Reaction `json:"reactions,omitempty"`
+}
+
+// Location is a geo point of message.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Contact is a shared contact.
+type Contact struct {
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	PhoneNumber string `json:"phone_number"`
+}
+
+// Poll is a poll of message.
+type Poll struct {
+	Question    string       `json:"question"`
+	Closed      bool         `json:"closed"`
+	TotalVoters int          `json:"total_voters"`
+	Answers     []PollAnswer `json:"answers"`
+}
+
+// PollAnswer is an answer option of Poll.
+type PollAnswer struct {
+	Text   string `json:"text"`
+	Voters int    `json:"voters"`
+	Chosen bool   `json:"chosen"`
+}
+
+// Reaction is a reaction counter of message.
+type Reaction struct {
+	// Type is "emoji" or "custom_emoji".
+	Type       string `json:"type"`
+	Count      int    `json:"count"`
+	Emoji      string `json:"emoji,omitempty"`
+	DocumentID string `json:"document_id,omitempty"`
+}
+
+// TextPart is a part of message text.
+//
+// Type is "plain" for text without formatting, otherwise it is a name of
+// entity, like "bold" or "text_link".
+type TextPart struct {
+	Type       string `json:"type"`
+	Text       string `json:"text"`
+	Language   string `json:"language,omitempty"`
+	Href       string `json:"href,omitempty"`
+	UserID     int64  `json:"user_id,omitempty"`
+	DocumentID string `json:"document_id,omitempty"`
+}
+
+// PlainType is a TextPart type of text without formatting.
+const PlainType = "plain"
+
+// Text is a message text.
+//
+// Encoded as string if text has no formatting, otherwise as array of
+// strings (plain parts) and TextPart objects, like Telegram Desktop does.
+type Text []TextPart
+
+// String returns text without formatting.
+func (t Text) String() string {
+	var b strings.Builder
+	for _, p := range t {
+		b.WriteString(p.Text)
+	}
+	return b.String()
+}
+
+func (t Text) plain() bool {
+	for _, p := range t {
+		if p.Type != PlainType {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Text) MarshalJSON() ([]byte, error) {
+	if t.plain() {
+		return json.Marshal(t.String())
+	}
+
+	parts := make([]interface{}, 0, len(t))
+	for _, p := range t {
+		if p.Type == PlainType {
+			parts = append(parts, p.Text)
+			continue
+		}
+		parts = append(parts, p)
+	}
+	return json.Marshal(parts)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Text) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*t = nil
+		if s != "" {
+			*t = Text{{Type: PlainType, Text: s}}
+		}
+		return nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r := make(Text, 0, len(raw))
+	for i, part := range raw {
+		part = bytes.TrimSpace(part)
+		if len(part) > 0 && part[0] == '"' {
+			p := TextPart{Type: PlainType}
+			if err := json.Unmarshal(part, &p.Text); err != nil {
+				return errors.Wrapf(err, "part %d", i)
+			}
+			r = append(r, p)
+			continue
+		}
+
+		var p TextPart
+		if err := json.Unmarshal(part, &p); err != nil {
+			return errors.Wrapf(err, "part %d", i)
+		}
+		r = append(r, p)
+	}
+	*t = r
+	return nil
+}
@@ -0,0 +1,147 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+)
+
+// SpoolSuffix is a suffix of spool file, which contains already exported
+// messages and is used to resume interrupted export.
+const SpoolSuffix = ".part"
+
+// spool is an append-only file of exported messages, one JSON per line.
+//
+// Messages are appended from newest to oldest, so last message is
+// the resume point.
+type spool struct {
+	file *os.File
+	// minID is an ID of oldest exported message, zero if spool is empty.
+	minID int
+	// names is a set of used media file names.
+	names map[string]struct{}
+}
+
+// openSpool opens or creates spool file.
+//
+// Truncated last line, left by interrupted write, is dropped.
+func openSpool(path string) (_ *spool, rerr error) {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, errors.Wrap(err, "open")
+	}
+	defer func() {
+		if rerr != nil {
+			multierr.AppendInto(&rerr, f.Close())
+		}
+	}()
+
+	s := &spool{
+		file:  f,
+		names: map[string]struct{}{},
+	}
+	var (
+		r     = bufio.NewReader(f)
+		valid int64
+	)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "read")
+		}
+
+		var m Message
+		if err := json.Unmarshal(line, &m); err != nil {
+			break
+		}
+		valid += int64(len(line))
+		s.add(m)
+	}
+
+	if err := f.Truncate(valid); err != nil {
+		return nil, errors.Wrap(err, "truncate")
+	}
+	if _, err := f.Seek(valid, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "seek")
+	}
+	return s, nil
+}
+
+func (s *spool) add(m Message) {
+	if s.minID == 0 || m.ID < s.minID {
+		s.minID = m.ID
+	}
+	for _, name := range []string{m.Photo, m.File} {
+		if name != "" && name != NotIncluded {
+			s.names[name] = struct{}{}
+		}
+	}
+}
+
+// Write appends message to spool.
+func (s *spool) Write(m Message) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "encode")
+	}
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return errors.Wrap(err, "write")
+	}
+	s.add(m)
+	return nil
+}
+
+// Messages returns all spooled messages, sorted by ID.
+func (s *spool) Messages() ([]Message, error) {
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "seek")
+	}
+	defer func() {
+		_, _ = s.file.Seek(0, io.SeekEnd)
+	}()
+
+	var (
+		r    = bufio.NewReader(s.file)
+		seen = map[int]struct{}{}
+		msgs []Message
+	)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "read")
+		}
+
+		var m Message
+		if err := json.Unmarshal(bytes.TrimSpace(line), &m); err != nil {
+			return nil, errors.Wrap(err, "decode")
+		}
+		if _, ok := seen[m.ID]; ok {
+			continue
+		}
+		seen[m.ID] = struct{}{}
+		msgs = append(msgs, m)
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].ID < msgs[j].ID
+	})
+	return msgs, nil
+}
+
+// Close syncs and closes spool file.
+func (s *spool) Close() error {
+	return multierr.Append(s.file.Sync(), s.file.Close())
+}
@@ -0,0 +1,293 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// Flood wait error types handled by FloodWaiter.
+const (
+	// ErrSlowModeWait is error type of "SLOWMODE_WAIT" error.
+	ErrSlowModeWait = "SLOWMODE_WAIT"
+	// ErrFloodPremiumWait is error type of "FLOOD_PREMIUM_WAIT" error.
+	ErrFloodPremiumWait = "FLOOD_PREMIUM_WAIT"
+)
+
+// FloodWaitEvent describes flood wait of request.
+type FloodWaitEvent struct {
+	// Input is request.
+	Input bin.Encoder
+	// TypeID is TL type id of request, zero if unknown.
+	TypeID uint32
+	// Method is TL definition name of request, like
+	// "help.getConfig#c4f9186b". Empty if unknown.
+	Method string
+	// Type is error type, like "FLOOD_WAIT".
+	Type string
+	// Duration to wait.
+	Duration time.Duration
+	// Attempt is number of failed attempts, zero if request is delayed
+	// because of cooldown caused by another request.
+	Attempt int
+}
+
+// FloodWaiterOptions is options of FloodWaiter.
+type FloodWaiterOptions struct {
+	// MaxRetries is maximum number of retries of single request.
+	// Defaults to 5.
+	MaxRetries int
+	// MaxWait is maximum duration to wait. If server requires to wait
+	// longer, error is returned immediately.
+	// Defaults to 1 minute.
+	MaxWait time.Duration
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+
+	// OnError is called when server returned flood wait error.
+	OnError func(ctx context.Context, e FloodWaitEvent)
+	// OnWait is called before waiting.
+	OnWait func(ctx context.Context, e FloodWaitEvent)
+}
+
+func (o *FloodWaiterOptions) setDefaults() {
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 5
+	}
+	if o.MaxWait == 0 {
+		o.MaxWait = time.Minute
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.OnError == nil {
+		o.OnError = func(ctx context.Context, e FloodWaitEvent) {}
+	}
+	if o.OnWait == nil {
+		o.OnWait = func(ctx context.Context, e FloodWaitEvent) {}
+	}
+}
+
+// floodKey is key of cooldown table.
+//
+// Method cooldowns have zero peer, peer cooldowns have zero method.
+type floodKey struct {
+	method uint32
+	peer   floodPeer
+}
+
+type floodPeer struct {
+	kind byte // 'u', 'c' or 'C' for users, chats and channels
+	id   int64
+}
+
+// floodCooldown is active cooldown.
+type floodCooldown struct {
+	until time.Time
+	typ   string
+}
+
+var _ Middleware = (*FloodWaiter)(nil)
+
+// FloodWaiter is Middleware which waits and retries requests failed with
+// FLOOD_WAIT, SLOWMODE_WAIT or FLOOD_PREMIUM_WAIT errors.
+//
+// FloodWaiter remembers cooldowns per method (FLOOD_WAIT and
+// FLOOD_PREMIUM_WAIT) and per peer (SLOWMODE_WAIT), so concurrent
+// requests wait for cooldown instead of hitting the same error.
+type FloodWaiter struct {
+	maxRetries int
+	maxWait    time.Duration
+	clock      clock.Clock
+	onError    func(ctx context.Context, e FloodWaitEvent)
+	onWait     func(ctx context.Context, e FloodWaitEvent)
+
+	cooldowns map[floodKey]floodCooldown
+	mux       sync.Mutex
+}
+
+// NewFloodWaiter creates new FloodWaiter.
+func NewFloodWaiter(opts FloodWaiterOptions) *FloodWaiter {
+	opts.setDefaults()
+	return &FloodWaiter{
+		maxRetries: opts.MaxRetries,
+		maxWait:    opts.MaxWait,
+		clock:      opts.Clock,
+		onError:    opts.OnError,
+		onWait:     opts.OnWait,
+		cooldowns:  map[floodKey]floodCooldown{},
+	}
+}
+
+// asFloodWait returns wait duration and error type if err is one of
+// handled flood wait errors.
+func asFloodWait(err error) (time.Duration, string, bool) {
+	rpcErr, ok := tgerr.As(err)
+	if !ok {
+		return 0, "", false
+	}
+	switch rpcErr.Type {
+	case ErrFloodWait, ErrSlowModeWait, ErrFloodPremiumWait:
+		return time.Duration(rpcErr.Argument) * time.Second, rpcErr.Type, true
+	default:
+		return 0, "", false
+	}
+}
+
+// requestPeer returns target peer of request, if any.
+func requestPeer(input bin.Encoder) (floodPeer, bool) {
+	var peer interface{}
+	switch r := input.(type) {
+	case interface{ GetPeer() tg.InputPeerClass }:
+		peer = r.GetPeer()
+	case interface{ GetToPeer() tg.InputPeerClass }:
+		peer = r.GetToPeer()
+	case interface{ GetChannel() tg.InputChannelClass }:
+		peer = r.GetChannel()
+	default:
+		return floodPeer{}, false
+	}
+
+	switch p := peer.(type) {
+	case interface{ GetChannelID() int64 }:
+		return floodPeer{kind: 'C', id: p.GetChannelID()}, true
+	case interface{ GetChatID() int64 }:
+		return floodPeer{kind: 'c', id: p.GetChatID()}, true
+	case interface{ GetUserID() int64 }:
+		return floodPeer{kind: 'u', id: p.GetUserID()}, true
+	default:
+		return floodPeer{}, false
+	}
+}
+
+// requestKeys returns method and peer cooldown keys of request.
+func requestKeys(input bin.Encoder) (method, peer floodKey, hasPeer bool) {
+	if t, ok := input.(interface{ TypeID() uint32 }); ok {
+		method.method = t.TypeID()
+	}
+	p, hasPeer := requestPeer(input)
+	peer.peer = p
+	return method, peer, hasPeer
+}
+
+// cooldown returns the longest active cooldown of given keys.
+func (f *FloodWaiter) cooldown(now time.Time, keys ...floodKey) (time.Duration, string) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	var (
+		wait time.Duration
+		typ  string
+	)
+	for _, k := range keys {
+		c, ok := f.cooldowns[k]
+		if !ok {
+			continue
+		}
+		if !c.until.After(now) {
+			delete(f.cooldowns, k)
+			continue
+		}
+		if d := c.until.Sub(now); d > wait {
+			wait, typ = d, c.typ
+		}
+	}
+	return wait, typ
+}
+
+// setCooldown extends cooldown of given key.
+func (f *FloodWaiter) setCooldown(k floodKey, c floodCooldown) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	if prev, ok := f.cooldowns[k]; ok && prev.until.After(c.until) {
+		return
+	}
+	f.cooldowns[k] = c
+}
+
+func (f *FloodWaiter) sleep(ctx context.Context, d time.Duration) error {
+	timer := f.clock.Timer(d)
+	defer clock.StopTimer(timer)
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Handle implements Middleware.
+func (f *FloodWaiter) Handle(next tg.Invoker) InvokeFunc {
+	return func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+		method, peer, hasPeer := requestKeys(input)
+		keys := []floodKey{method}
+		if hasPeer {
+			keys = append(keys, peer)
+		}
+		event := FloodWaitEvent{
+			Input:  input,
+			TypeID: method.method,
+			Method: getTypesMapping().Get(method.method),
+		}
+
+		for attempt := 0; ; {
+			if wait, typ := f.cooldown(f.clock.Now(), keys...); wait > 0 {
+				if wait > f.maxWait {
+					// Report remaining cooldown like server does.
+					seconds := int((wait + time.Second - 1) / time.Second)
+					return &tgerr.Error{
+						Code:     420,
+						Message:  fmt.Sprintf("%s_%d", typ, seconds),
+						Type:     typ,
+						Argument: seconds,
+					}
+				}
+
+				e := event
+				e.Type = typ
+				e.Duration = wait
+				e.Attempt = attempt
+				f.onWait(ctx, e)
+				if err := f.sleep(ctx, wait); err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := next.Invoke(ctx, input, output)
+			d, typ, ok := asFloodWait(err)
+			if !ok {
+				return err
+			}
+			attempt++
+
+			e := event
+			e.Type = typ
+			e.Duration = d
+			e.Attempt = attempt
+			f.onError(ctx, e)
+
+			// Slow mode is applied to chat, other errors to method.
+			k := method
+			if typ == ErrSlowModeWait && hasPeer {
+				k = peer
+			}
+			f.setCooldown(k, floodCooldown{
+				until: f.clock.Now().Add(d),
+				typ:   typ,
+			})
+
+			if d > f.maxWait || attempt > f.maxRetries {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package telegram_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+func TestFloodWaiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Retry", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		var (
+			calls  atomic.Int64
+			events []telegram.FloodWaitEvent
+		)
+		invoker := telegram.NewFloodWaiter(telegram.FloodWaiterOptions{
+			Clock: clock,
+			OnError: func(ctx context.Context, e telegram.FloodWaitEvent) {
+				events = append(events, e)
+			},
+		}).Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+			if calls.Inc() == 1 {
+				return tgerr.New(420, "FLOOD_WAIT_2")
+			}
+			return nil
+		}))
+
+		observe := clock.Observe()
+		done := make(chan error, 1)
+		go func() {
+			done <- invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil)
+		}()
+		<-observe
+		clock.Travel(2 * time.Second)
+
+		a.NoError(<-done)
+		a.Equal(int64(2), calls.Load())
+		a.Len(events, 1)
+		a.Equal(uint32(tg.HelpGetConfigRequestTypeID), events[0].TypeID)
+		a.Equal("help.getConfig#c4f9186b", events[0].Method)
+		a.Equal(telegram.ErrFloodWait, events[0].Type)
+		a.Equal(2*time.Second, events[0].Duration)
+		a.Equal(1, events[0].Attempt)
+	})
+	t.Run("MaxWait", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		var calls atomic.Int64
+		invoker := telegram.NewFloodWaiter(telegram.FloodWaiterOptions{
+			Clock:   clock,
+			MaxWait: time.Minute,
+		}).Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+			calls.Inc()
+			return tgerr.New(420, "FLOOD_PREMIUM_WAIT_120")
+		}))
+
+		err := invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil)
+		a.True(tgerr.Is(err, telegram.ErrFloodPremiumWait))
+		a.Equal(int64(1), calls.Load())
+
+		// Cooldown is remembered, so request is not sent.
+		clock.Travel(20 * time.Second)
+		err = invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil)
+		rpcErr, ok := tgerr.As(err)
+		a.True(ok)
+		a.Equal(telegram.ErrFloodPremiumWait, rpcErr.Type)
+		a.Equal(100, rpcErr.Argument)
+		a.Equal(int64(1), calls.Load())
+	})
+	t.Run("MaxRetries", func(t *testing.T) {
+		a := require.New(t)
+		var calls atomic.Int64
+		invoker := telegram.NewFloodWaiter(telegram.FloodWaiterOptions{
+			MaxRetries: 2,
+		}).Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+			calls.Inc()
+			return tgerr.New(420, "FLOOD_WAIT_0")
+		}))
+
+		_, ok := telegram.AsFloodWait(invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil))
+		a.True(ok)
+		a.Equal(int64(3), calls.Load())
+	})
+	t.Run("SlowMode", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		invoker := telegram.NewFloodWaiter(telegram.FloodWaiterOptions{
+			Clock:   clock,
+			MaxWait: 5 * time.Second,
+		}).Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+			r := input.(*tg.MessagesSendMessageRequest)
+			if r.Peer.(*tg.InputPeerChannel).ChannelID == 1 {
+				return tgerr.New(420, "SLOWMODE_WAIT_10")
+			}
+			return nil
+		}))
+		send := func(channelID int64) error {
+			return invoker.Invoke(ctx, &tg.MessagesSendMessageRequest{
+				Peer: &tg.InputPeerChannel{ChannelID: channelID},
+			}, nil)
+		}
+
+		a.True(tgerr.Is(send(1), telegram.ErrSlowModeWait))
+		// Slow mode is applied only to the chat.
+		a.NoError(send(2))
+		// Cooldown of the chat is remembered.
+		rpcErr, ok := tgerr.As(send(1))
+		a.True(ok)
+		a.Equal(10, rpcErr.Argument)
+	})
+	t.Run("ToPeer", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		var calls atomic.Int64
+		invoker := telegram.NewFloodWaiter(telegram.FloodWaiterOptions{
+			Clock:   clock,
+			MaxWait: 5 * time.Second,
+		}).Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+			calls.Inc()
+			r, ok := input.(*tg.MessagesForwardMessagesRequest)
+			if ok && r.ToPeer.(*tg.InputPeerChannel).ChannelID == 1 {
+				return tgerr.New(420, "SLOWMODE_WAIT_10")
+			}
+			return nil
+		}))
+		forward := func(channelID int64) error {
+			return invoker.Invoke(ctx, &tg.MessagesForwardMessagesRequest{
+				FromPeer: &tg.InputPeerChannel{ChannelID: 3},
+				ToPeer:   &tg.InputPeerChannel{ChannelID: channelID},
+			}, nil)
+		}
+
+		a.True(tgerr.Is(forward(1), telegram.ErrSlowModeWait))
+		a.Equal(int64(1), calls.Load())
+		// Cooldown is keyed by destination chat.
+		a.NoError(forward(2))
+		a.Equal(int64(2), calls.Load())
+		// Other requests to the chat share cooldown.
+		rpcErr, ok := tgerr.As(invoker.Invoke(ctx, &tg.MessagesSendMessageRequest{
+			Peer: &tg.InputPeerChannel{ChannelID: 1},
+		}, nil))
+		a.True(ok)
+		a.Equal(telegram.ErrSlowModeWait, rpcErr.Type)
+		a.True(tgerr.Is(forward(1), telegram.ErrSlowModeWait))
+		a.Equal(int64(2), calls.Load())
+	})
+	t.Run("Cooldown", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		var (
+			calls atomic.Int64
+			waits = make(chan telegram.FloodWaitEvent, 1)
+		)
+		invoker := telegram.NewFloodWaiter(telegram.FloodWaiterOptions{
+			Clock:   clock,
+			MaxWait: 5 * time.Second,
+			OnWait: func(ctx context.Context, e telegram.FloodWaitEvent) {
+				waits <- e
+			},
+		}).Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+			if calls.Inc() == 1 {
+				return tgerr.New(420, "FLOOD_WAIT_10")
+			}
+			return nil
+		}))
+
+		a.Error(invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil))
+
+		// Wait is now in allowed range, so concurrent caller waits for
+		// cooldown instead of sending request.
+		clock.Travel(7 * time.Second)
+		observe := clock.Observe()
+		done := make(chan error, 1)
+		go func() {
+			done <- invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil)
+		}()
+		e := <-waits
+		a.Equal(3*time.Second, e.Duration)
+		a.Zero(e.Attempt)
+		<-observe
+		a.Equal(int64(1), calls.Load())
+		clock.Travel(3 * time.Second)
+
+		a.NoError(<-done)
+		a.Equal(int64(2), calls.Load())
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		invoker := telegram.NewFloodWaiter(telegram.FloodWaiterOptions{
+			Clock: clock,
+		}).Handle(telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+			return tgerr.New(420, "FLOOD_WAIT_10")
+		}))
+
+		ctx, cancel := context.WithCancel(ctx)
+		observe := clock.Observe()
+		done := make(chan error, 1)
+		go func() {
+			done <- invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil)
+		}()
+		<-observe
+		cancel()
+
+		a.ErrorIs(<-done, context.Canceled)
+	})
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"strings"
+	"unicode"
+)
+
+// BotCommand is a parsed bot command.
+type BotCommand struct {
+	// Name of command, without leading slash.
+	Name string
+	// Mention is a bot username after '@', if any.
+	Mention string
+	// Args is a text after command.
+	Args string
+}
+
+func isCommandChar(c byte) bool {
+	return c >= 'a' && c <= 'z' ||
+		c >= 'A' && c <= 'Z' ||
+		c >= '0' && c <= '9' ||
+		c == '_'
+}
+
+// parseCommand parses command like "/start@bot args".
+func parseCommand(text string) (BotCommand, bool) {
+	if !strings.HasPrefix(text, "/") {
+		return BotCommand{}, false
+	}
+	text = text[1:]
+
+	word, args := text, ""
+	if idx := strings.IndexFunc(text, unicode.IsSpace); idx >= 0 {
+		word, args = text[:idx], strings.TrimLeftFunc(text[idx:], unicode.IsSpace)
+	}
+	name, mention, _ := strings.Cut(word, "@")
+
+	// See https://core.telegram.org/bots/features#commands.
+	if name == "" || len(name) > 32 {
+		return BotCommand{}, false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isCommandChar(name[i]) {
+			return BotCommand{}, false
+		}
+	}
+
+	return BotCommand{
+		Name:    name,
+		Mention: mention,
+		Args:    args,
+	}, true
+}
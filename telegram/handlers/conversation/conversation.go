@@ -0,0 +1,129 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/constant"
+	"github.com/gotd/td/telegram/handlers"
+	"github.com/gotd/td/tg"
+)
+
+var (
+	// ErrTimeout is returned by Await if user did not respond in time.
+	ErrTimeout = errors.New("conversation timeout")
+	// ErrCanceled is returned by Await and is a cause of conversation
+	// context cancellation if user sent cancel command.
+	ErrCanceled = errors.New("conversation canceled")
+)
+
+// Key identifies dialog with user in chat.
+type Key struct {
+	// Chat is a TDLib ID of chat.
+	Chat constant.TDLibPeerID
+	// User is an ID of user.
+	User int64
+}
+
+// KeyOf returns Key of given update.
+//
+// Returns false if update has no user sender.
+func KeyOf(u *handlers.Update) (Key, bool) {
+	userID, ok := u.SenderID()
+	if !ok {
+		return Key{}, false
+	}
+
+	var k Key
+	switch p := u.Peer().(type) {
+	case *tg.PeerUser:
+		k.Chat.User(p.UserID)
+	case *tg.PeerChat:
+		k.Chat.Chat(p.ChatID)
+	case *tg.PeerChannel:
+		k.Chat.Channel(p.ChannelID)
+	default:
+		return Key{}, false
+	}
+	k.User = userID
+	return k, true
+}
+
+// Conversation is an active dialog with user.
+type Conversation struct {
+	m      *Manager
+	key    Key
+	first  *handlers.Update
+	inbox  chan *handlers.Update
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+}
+
+// Key returns key of conversation.
+func (c *Conversation) Key() Key {
+	return c.key
+}
+
+// Update returns update which started conversation.
+func (c *Conversation) Update() *handlers.Update {
+	return c.first
+}
+
+// Await waits for the next message or callback query from user
+// in the chat of conversation.
+//
+// Updates which do not match given filters are skipped.
+// Returns ErrTimeout if nothing matching received in time and
+// ErrCanceled if user sent cancel command.
+func (c *Conversation) Await(ctx context.Context, filters ...handlers.Filter) (*handlers.Update, error) {
+	timer := c.m.clock.Timer(c.m.timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, context.Cause(ctx)
+		case <-c.ctx.Done():
+			return nil, context.Cause(c.ctx)
+		case <-timer.C():
+			return nil, ErrTimeout
+		case u := <-c.inbox:
+			if !handlers.And(filters...)(u) {
+				continue
+			}
+			return u, nil
+		}
+	}
+}
+
+// AwaitMessage waits for the next message from user, see Await.
+func (c *Conversation) AwaitMessage(ctx context.Context, filters ...handlers.Filter) (*tg.Message, error) {
+	u, err := c.Await(ctx, append([]handlers.Filter{isMessage}, filters...)...)
+	if err != nil {
+		return nil, err
+	}
+	msg, _ := u.Message()
+	return msg, nil
+}
+
+func isMessage(u *handlers.Update) bool {
+	_, ok := u.Message()
+	return ok
+}
+
+// State returns saved state of dialog.
+// Returns zero State if there is no saved state.
+func (c *Conversation) State(ctx context.Context) (State, error) {
+	return c.m.State(ctx, c.key)
+}
+
+// SetState saves state of dialog.
+func (c *Conversation) SetState(ctx context.Context, state State) error {
+	return c.m.SetState(ctx, c.key, state)
+}
+
+// Reset deletes saved state of dialog.
+func (c *Conversation) Reset(ctx context.Context) error {
+	return c.m.Reset(ctx, c.key)
+}
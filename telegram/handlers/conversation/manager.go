@@ -0,0 +1,326 @@
+// Package conversation contains helpers for multi-step bot dialogs.
+//
+// Handler of conversation runs in separate goroutine, so it can wait
+// for the next update from user without blocking update processing.
+package conversation
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/telegram/handlers"
+)
+
+// Priority is a priority of handlers which deliver updates
+// to active conversations.
+const Priority = math.MaxInt32
+
+// ErrClosed is returned by handler of Start if Manager is closed.
+var ErrClosed = errors.New("conversation manager closed")
+
+// Handler handles conversation.
+type Handler func(ctx context.Context, c *Conversation) error
+
+// Options of Manager.
+type Options struct {
+	// Storage of dialog states.
+	//
+	// Defaults to InmemoryStorage.
+	Storage Storage
+	// Timeout of Await.
+	//
+	// Defaults to 5 minutes.
+	Timeout time.Duration
+	// CancelCommands are commands which cancel active conversation.
+	//
+	// Defaults to "cancel".
+	CancelCommands []string
+	// Buffer is a count of updates which are buffered while
+	// handler does not wait for them. Updates are dropped if
+	// buffer is full.
+	//
+	// Defaults to 16.
+	Buffer int
+	// Clock to use.
+	Clock clock.Clock
+	// Logger to use.
+	Logger *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.Storage == nil {
+		o.Storage = &InmemoryStorage{}
+	}
+	if o.Timeout == 0 {
+		o.Timeout = 5 * time.Minute
+	}
+	if len(o.CancelCommands) == 0 {
+		o.CancelCommands = []string{"cancel"}
+	}
+	if o.Buffer == 0 {
+		o.Buffer = 16
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
+
+// Manager manages active conversations.
+type Manager struct {
+	storage Storage
+	timeout time.Duration
+	cancel  handlers.Filter
+	buffer  int
+	clock   clock.Clock
+	log     *zap.Logger
+
+	// ctx is a parent context of conversations, canceled by Close.
+	ctx   context.Context
+	close context.CancelFunc
+
+	// router is used to dispatch updates which were delivered
+	// to conversation, but not handled.
+	router *handlers.Router
+
+	active map[Key]*Conversation
+	closed bool
+	mux    sync.Mutex
+	wg     sync.WaitGroup
+}
+
+// NewManager creates new Manager.
+func NewManager(opts Options) *Manager {
+	opts.setDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		storage: opts.Storage,
+		timeout: opts.Timeout,
+		cancel:  handlers.Command(opts.CancelCommands...),
+		buffer:  opts.Buffer,
+		clock:   opts.Clock,
+		log:     opts.Logger,
+		ctx:     ctx,
+		close:   cancel,
+		active:  map[Key]*Conversation{},
+	}
+}
+
+// Register registers handlers which deliver updates from users
+// to their active conversations.
+func (m *Manager) Register(r *handlers.Router) {
+	m.mux.Lock()
+	m.router = r
+	m.mux.Unlock()
+
+	r.OnMessage(m.deliver).Priority(Priority)
+	r.OnCallback(m.deliver).Priority(Priority)
+}
+
+// deliver passes update to active conversation, if any.
+func (m *Manager) deliver(ctx context.Context, u *handlers.Update) error {
+	key, ok := KeyOf(u)
+	if !ok {
+		return handlers.ErrContinue
+	}
+
+	// Update is pushed under lock, so it is not lost if conversation
+	// is finishing concurrently.
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	c, ok := m.active[key]
+	if !ok {
+		return handlers.ErrContinue
+	}
+
+	m.push(c, u)
+	return nil
+}
+
+// push passes update to conversation. Caller must hold mux.
+func (m *Manager) push(c *Conversation, u *handlers.Update) {
+	if m.cancel(u) {
+		c.cancel(ErrCanceled)
+		return
+	}
+
+	select {
+	case c.inbox <- u:
+	default:
+		m.log.Warn("Conversation buffer is full, update dropped",
+			zap.Int64("chat", c.key.Chat.ToPlain()),
+			zap.Int64("user", c.key.User),
+		)
+	}
+}
+
+// Start returns handler which starts conversation with sender of update.
+//
+// Conversation handler is called in separate goroutine, so returned
+// handler does not block. Context of handler is not derived from context
+// of update, because conversation outlives update handling. It is canceled
+// by Close. If sender already has active conversation in the chat, update
+// is passed to it. Returns ErrClosed if Manager is closed.
+func (m *Manager) Start(h Handler) handlers.Handler {
+	return func(ctx context.Context, u *handlers.Update) error {
+		key, ok := KeyOf(u)
+		if !ok {
+			return errors.New("update has no user sender")
+		}
+
+		m.mux.Lock()
+		if m.closed {
+			m.mux.Unlock()
+			return ErrClosed
+		}
+		if c, ok := m.active[key]; ok {
+			// Concurrent update from the same user.
+			m.push(c, u)
+			m.mux.Unlock()
+			return nil
+		}
+		c := &Conversation{
+			m:     m,
+			key:   key,
+			first: u,
+			inbox: make(chan *handlers.Update, m.buffer),
+		}
+		c.ctx, c.cancel = context.WithCancelCause(m.ctx)
+		m.active[key] = c
+		m.wg.Add(1)
+		m.mux.Unlock()
+
+		go m.run(c, h)
+		return nil
+	}
+}
+
+func (m *Manager) run(c *Conversation, h Handler) {
+	defer m.wg.Done()
+
+	err := h(c.ctx, c)
+	m.finish(c)
+	if err != nil &&
+		!errors.Is(err, ErrCanceled) &&
+		!errors.Is(err, ErrTimeout) {
+		m.log.Error("Conversation handler failed",
+			zap.Int64("chat", c.key.Chat.ToPlain()),
+			zap.Int64("user", c.key.User),
+			zap.Error(err),
+		)
+	}
+}
+
+// finish removes conversation from active ones and dispatches updates
+// which were delivered to it, but not received by handler.
+func (m *Manager) finish(c *Conversation) {
+	m.mux.Lock()
+	delete(m.active, c.key)
+	r := m.router
+	m.mux.Unlock()
+	c.cancel(nil)
+
+	// No updates are pushed to inbox after removal.
+	for {
+		select {
+		case u := <-c.inbox:
+			if r == nil {
+				continue
+			}
+			if err := r.Dispatch(m.ctx, u); err != nil {
+				m.log.Warn("Dispatch conversation update", zap.Error(err))
+			}
+		default:
+			return
+		}
+	}
+}
+
+// Active reports whether user has active conversation.
+func (m *Manager) Active(key Key) bool {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	_, ok := m.active[key]
+	return ok
+}
+
+// Wait waits until all active conversations are finished.
+func (m *Manager) Wait() {
+	m.wg.Wait()
+}
+
+// Close cancels all active conversations and waits until they are
+// finished. Conversations can't be started after Close.
+func (m *Manager) Close() {
+	m.mux.Lock()
+	m.closed = true
+	m.mux.Unlock()
+
+	m.close()
+	m.wg.Wait()
+}
+
+// State returns saved state of dialog.
+// Returns zero State if there is no saved state.
+func (m *Manager) State(ctx context.Context, key Key) (State, error) {
+	s, _, err := m.storage.Load(ctx, key)
+	if err != nil {
+		return State{}, errors.Wrap(err, "load state")
+	}
+	return s, nil
+}
+
+// SetState saves state of dialog.
+func (m *Manager) SetState(ctx context.Context, key Key, state State) error {
+	if err := m.storage.Save(ctx, key, state); err != nil {
+		return errors.Wrap(err, "save state")
+	}
+	return nil
+}
+
+// Reset deletes saved state of dialog.
+func (m *Manager) Reset(ctx context.Context, key Key) error {
+	if err := m.storage.Delete(ctx, key); err != nil {
+		return errors.Wrap(err, "delete state")
+	}
+	return nil
+}
+
+// InState returns filter which matches updates from users whose
+// saved dialog state has one of given names.
+//
+// It can be used to implement finite-state machine without waiting
+// for updates, so dialog survives restart if Storage is persistent.
+// States are loaded using context of Manager, which is canceled by Close.
+func (m *Manager) InState(names ...string) handlers.Filter {
+	return func(u *handlers.Update) bool {
+		key, ok := KeyOf(u)
+		if !ok {
+			return false
+		}
+		s, found, err := m.storage.Load(m.ctx, key)
+		if err != nil {
+			m.log.Warn("Load state", zap.Error(err))
+			return false
+		}
+		if !found {
+			return false
+		}
+		for _, name := range names {
+			if s.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,177 @@
+package conversation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/telegram/handlers"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+type result struct {
+	name, photo string
+	err         error
+}
+
+func newMessage(userID int64, text string) *tg.Updates {
+	return &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateNewMessage{
+				Message: &tg.Message{
+					ID:      1,
+					PeerID:  &tg.PeerChat{ChatID: 10},
+					FromID:  &tg.PeerUser{UserID: userID},
+					Message: text,
+				},
+			},
+		},
+	}
+}
+
+func testRouter(t *testing.T, opts Options) (*handlers.Router, *Manager, chan result) {
+	opts.Logger = zaptest.NewLogger(t)
+	m := NewManager(opts)
+	r := handlers.NewRouter(tg.NewClient(tgmock.New(t)), handlers.Options{
+		Username: "bot",
+		Logger:   zaptest.NewLogger(t),
+	})
+	m.Register(r)
+
+	results := make(chan result, 1)
+	r.OnMessage(m.Start(func(ctx context.Context, c *Conversation) error {
+		name, err := c.AwaitMessage(ctx, handlers.Text())
+		if err != nil {
+			results <- result{err: err}
+			return err
+		}
+		photo, err := c.AwaitMessage(ctx)
+		if err != nil {
+			results <- result{err: err}
+			return err
+		}
+		results <- result{name: name.Message, photo: photo.Message}
+		return nil
+	}), handlers.Command("form"))
+
+	return r, m, results
+}
+
+func TestManager(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	r, m, results := testRouter(t, Options{})
+
+	var other []string
+	r.OnMessage(func(ctx context.Context, u *handlers.Update) error {
+		other = append(other, u.Text())
+		return nil
+	})
+
+	a.NoError(r.Handle(ctx, newMessage(1, "/form")))
+	a.True(m.Active(Key{Chat: -10, User: 1}))
+	// Message from other user in the same chat is not intercepted.
+	a.NoError(r.Handle(ctx, newMessage(2, "hello")))
+	a.NoError(r.Handle(ctx, newMessage(1, "Alice")))
+	a.NoError(r.Handle(ctx, newMessage(1, "photo")))
+
+	a.Equal(result{name: "Alice", photo: "photo"}, <-results)
+	m.Wait()
+	a.False(m.Active(Key{Chat: -10, User: 1}))
+	a.Equal([]string{"hello"}, other)
+}
+
+func TestManager_Cancel(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	r, m, results := testRouter(t, Options{})
+
+	a.NoError(r.Handle(ctx, newMessage(1, "/form")))
+	a.NoError(r.Handle(ctx, newMessage(1, "/cancel@bot")))
+
+	res := <-results
+	a.ErrorIs(res.err, ErrCanceled)
+	m.Wait()
+}
+
+func TestManager_Timeout(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	clk := neo.NewTime(time.Unix(0, 0))
+	r, m, results := testRouter(t, Options{
+		Clock:   clk,
+		Timeout: time.Minute,
+	})
+
+	observe := clk.Observe()
+	a.NoError(r.Handle(ctx, newMessage(1, "/form")))
+	<-observe
+	clk.Travel(time.Minute)
+
+	res := <-results
+	a.ErrorIs(res.err, ErrTimeout)
+	m.Wait()
+}
+
+func TestManager_Close(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	r, m, results := testRouter(t, Options{})
+
+	a.NoError(r.Handle(ctx, newMessage(1, "/form")))
+	// Conversation outlives update handling.
+	cancel()
+	a.True(m.Active(Key{Chat: -10, User: 1}))
+
+	m.Close()
+	res := <-results
+	a.True(errors.Is(res.err, context.Canceled))
+}
+
+func TestManager_Redispatch(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	m := NewManager(Options{Logger: zaptest.NewLogger(t)})
+	r := handlers.NewRouter(tg.NewClient(tgmock.New(t)), handlers.Options{
+		Username: "bot",
+		Logger:   zaptest.NewLogger(t),
+	})
+	m.Register(r)
+
+	release := make(chan struct{})
+	r.OnMessage(m.Start(func(ctx context.Context, c *Conversation) error {
+		// Handler finishes without receiving delivered update.
+		<-release
+		return nil
+	}), handlers.Command("form"))
+	var other []string
+	r.OnMessage(func(ctx context.Context, u *handlers.Update) error {
+		other = append(other, u.Text())
+		return nil
+	})
+
+	a.NoError(r.Handle(ctx, newMessage(1, "/form")))
+	a.NoError(r.Handle(ctx, newMessage(1, "hello")))
+	a.Empty(other)
+
+	close(release)
+	m.Wait()
+	a.False(m.Active(Key{Chat: -10, User: 1}))
+	a.Equal([]string{"hello"}, other)
+}
+
+func TestManager_StartClosed(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	r, m, _ := testRouter(t, Options{})
+
+	m.Close()
+	a.ErrorIs(r.Handle(ctx, newMessage(1, "/form")), ErrClosed)
+	a.False(m.Active(Key{Chat: -10, User: 1}))
+}
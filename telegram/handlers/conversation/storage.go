@@ -0,0 +1,71 @@
+package conversation
+
+import (
+	"context"
+	"sync"
+)
+
+// State is a state of dialog with user.
+type State struct {
+	// Name of current step.
+	Name string `json:"name"`
+	// Data collected on previous steps.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// Storage stores dialog states.
+type Storage interface {
+	Load(ctx context.Context, key Key) (State, bool, error)
+	Save(ctx context.Context, key Key, state State) error
+	Delete(ctx context.Context, key Key) error
+}
+
+var _ Storage = (*InmemoryStorage)(nil)
+
+// InmemoryStorage is basic in-memory Storage implementation.
+type InmemoryStorage struct {
+	states map[Key]State
+	mux    sync.Mutex
+}
+
+func copyState(s State) State {
+	if s.Data == nil {
+		return s
+	}
+	data := make(map[string]string, len(s.Data))
+	for k, v := range s.Data {
+		data[k] = v
+	}
+	s.Data = data
+	return s
+}
+
+// Load implements Storage.
+func (s *InmemoryStorage) Load(ctx context.Context, key Key) (State, bool, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	state, ok := s.states[key]
+	return copyState(state), ok, nil
+}
+
+// Save implements Storage.
+func (s *InmemoryStorage) Save(ctx context.Context, key Key, state State) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.states == nil {
+		s.states = map[Key]State{}
+	}
+	s.states[key] = copyState(state)
+	return nil
+}
+
+// Delete implements Storage.
+func (s *InmemoryStorage) Delete(ctx context.Context, key Key) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.states, key)
+	return nil
+}
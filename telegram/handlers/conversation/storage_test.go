@@ -0,0 +1,64 @@
+package conversation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/handlers"
+)
+
+func TestInmemoryStorage(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	key := Key{Chat: 1, User: 1}
+
+	var s InmemoryStorage
+	_, found, err := s.Load(ctx, key)
+	a.NoError(err)
+	a.False(found)
+
+	data := map[string]string{"name": "Alice"}
+	a.NoError(s.Save(ctx, key, State{Name: "photo", Data: data}))
+	data["name"] = "Bob"
+
+	state, found, err := s.Load(ctx, key)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(State{Name: "photo", Data: map[string]string{"name": "Alice"}}, state)
+
+	a.NoError(s.Delete(ctx, key))
+	_, found, err = s.Load(ctx, key)
+	a.NoError(err)
+	a.False(found)
+}
+
+func TestManager_InState(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	r, m, _ := testRouter(t, Options{})
+
+	var steps []string
+	r.OnMessage(func(ctx context.Context, u *handlers.Update) error {
+		steps = append(steps, "name:"+u.Text())
+		key, _ := KeyOf(u)
+		return m.SetState(ctx, key, State{Name: "photo"})
+	}, m.InState("name"))
+	r.OnMessage(func(ctx context.Context, u *handlers.Update) error {
+		steps = append(steps, "photo:"+u.Text())
+		key, _ := KeyOf(u)
+		return m.Reset(ctx, key)
+	}, m.InState("photo"))
+
+	key := Key{Chat: -10, User: 1}
+	a.NoError(m.SetState(ctx, key, State{Name: "name"}))
+	a.NoError(r.Handle(ctx, newMessage(1, "Alice")))
+	a.NoError(r.Handle(ctx, newMessage(1, "photo")))
+	a.NoError(r.Handle(ctx, newMessage(1, "other")))
+	a.Equal([]string{"name:Alice", "photo:photo"}, steps)
+
+	state, err := m.State(ctx, key)
+	a.NoError(err)
+	a.Equal(State{}, state)
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// Filter reports whether handler should handle given update.
+type Filter func(u *Update) bool
+
+func matchAll(filters []Filter, u *Update) bool {
+	for _, f := range filters {
+		if !f(u) {
+			return false
+		}
+	}
+	return true
+}
+
+// And returns filter which matches if all given filters match.
+func And(filters ...Filter) Filter {
+	return func(u *Update) bool {
+		return matchAll(filters, u)
+	}
+}
+
+// Or returns filter which matches if any of given filters matches.
+func Or(filters ...Filter) Filter {
+	return func(u *Update) bool {
+		for _, f := range filters {
+			if f(u) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns filter which matches if given filter does not match.
+func Not(f Filter) Filter {
+	return func(u *Update) bool {
+		return !f(u)
+	}
+}
+
+// Command returns filter which matches bot commands with given names,
+// like "/start" or "/start@bot". Commands are compared case-insensitively.
+//
+// Commands with mention of another bot are not matched.
+// If names are empty, any command matches.
+func Command(names ...string) Filter {
+	return func(u *Update) bool {
+		cmd, ok := u.Command()
+		if !ok {
+			return false
+		}
+		if cmd.Mention != "" && (u.username == "" || !strings.EqualFold(cmd.Mention, u.username)) {
+			return false
+		}
+		if len(names) == 0 {
+			return true
+		}
+		for _, name := range names {
+			if strings.EqualFold(strings.TrimPrefix(name, "/"), cmd.Name) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Regex returns filter which matches message text using given regular expression.
+//
+// Submatches are available using Update.Matches.
+func Regex(re *regexp.Regexp) Filter {
+	return func(u *Update) bool {
+		msg, ok := u.Message()
+		if !ok {
+			return false
+		}
+		matches := re.FindStringSubmatch(msg.Message)
+		if matches == nil {
+			return false
+		}
+		u.matches = matches
+		return true
+	}
+}
+
+// Text returns filter which matches messages with non-empty text.
+func Text() Filter {
+	return func(u *Update) bool {
+		return u.Text() != ""
+	}
+}
+
+// Incoming returns filter which matches incoming messages and callback queries.
+func Incoming() Filter {
+	return func(u *Update) bool {
+		msg, ok := u.Message()
+		return !ok || !msg.Out
+	}
+}
+
+// ChatTypes returns filter which matches updates from chats of given types.
+func ChatTypes(types ...ChatType) Filter {
+	return func(u *Update) bool {
+		t := u.ChatType()
+		for _, typ := range types {
+			if t == typ {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// From returns filter which matches updates sent by users with given IDs.
+func From(userIDs ...int64) Filter {
+	return func(u *Update) bool {
+		id, ok := u.SenderID()
+		if !ok {
+			return false
+		}
+		for _, userID := range userIDs {
+			if id == userID {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// CallbackPrefix returns filter which matches callback queries with
+// data starting with given prefix.
+func CallbackPrefix(prefix string) Filter {
+	return func(u *Update) bool {
+		cb, ok := u.Callback()
+		return ok && bytes.HasPrefix(cb.Data, []byte(prefix))
+	}
+}
+
+// Media returns filter which matches messages with media of given types.
+// If types are empty, message with any media matches.
+func Media(types ...MediaType) Filter {
+	return func(u *Update) bool {
+		t := u.MediaType()
+		if t == MediaNone {
+			return false
+		}
+		if len(types) == 0 {
+			return true
+		}
+		for _, typ := range types {
+			if t == typ {
+				return true
+			}
+		}
+		return false
+	}
+}
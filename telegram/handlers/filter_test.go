@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestParseCommand(t *testing.T) {
+	for _, test := range []struct {
+		text     string
+		expected BotCommand
+		ok       bool
+	}{
+		{text: "/start", expected: BotCommand{Name: "start"}, ok: true},
+		{text: "/start@bot", expected: BotCommand{Name: "start", Mention: "bot"}, ok: true},
+		{text: "/start@bot  a b\nc", expected: BotCommand{Name: "start", Mention: "bot", Args: "a b\nc"}, ok: true},
+		{text: "/set_name\targ", expected: BotCommand{Name: "set_name", Args: "arg"}, ok: true},
+		{text: "start"},
+		{text: "/"},
+		{text: "/ start"},
+		{text: "/старт"},
+	} {
+		cmd, ok := parseCommand(test.text)
+		require.Equal(t, test.ok, ok, test.text)
+		require.Equal(t, test.expected, cmd, test.text)
+	}
+}
+
+func TestFilters(t *testing.T) {
+	msg := func(text string) *Update {
+		return &Update{msg: &tg.Message{
+			Message: text,
+			PeerID:  &tg.PeerUser{UserID: 1},
+		}}
+	}
+	withMedia := func(m tg.MessageMediaClass) *Update {
+		u := msg("")
+		u.msg.Media = m
+		return u
+	}
+
+	for i, test := range []struct {
+		filter   Filter
+		update   *Update
+		expected bool
+	}{
+		{Command(), msg("/any"), true},
+		{Command("start"), msg("/START"), true},
+		{Command("/start"), msg("/start"), true},
+		{Command("start"), msg("/help"), false},
+		{Command("start"), msg("/start@bot"), false},
+		{Command("start"), &Update{msg: &tg.Message{Message: "/start@Bot"}, username: "bot"}, true},
+		{And(Text(), From(1)), msg("text"), true},
+		{And(Text(), From(2)), msg("text"), false},
+		{Or(From(2), Text()), msg("text"), true},
+		{Not(Text()), msg("text"), false},
+		{Incoming(), &Update{msg: &tg.Message{Out: true}}, false},
+		{Incoming(), &Update{callback: &tg.UpdateBotCallbackQuery{}}, true},
+		{CallbackPrefix("a:"), &Update{callback: &tg.UpdateBotCallbackQuery{Data: []byte("a:1")}}, true},
+		{CallbackPrefix("a:"), msg("a:1"), false},
+		{Media(), msg("text"), false},
+		{Media(), withMedia(&tg.MessageMediaPhoto{}), true},
+		{Media(MediaPhoto), withMedia(&tg.MessageMediaDice{}), false},
+		{Media(MediaVoice), withMedia(&tg.MessageMediaDocument{
+			Document: &tg.Document{Attributes: []tg.DocumentAttributeClass{
+				&tg.DocumentAttributeAudio{Voice: true},
+			}},
+		}), true},
+		{Media(MediaAnimation), withMedia(&tg.MessageMediaDocument{
+			Document: &tg.Document{Attributes: []tg.DocumentAttributeClass{
+				&tg.DocumentAttributeVideo{},
+				&tg.DocumentAttributeAnimated{},
+			}},
+		}), true},
+		{Media(MediaVideoNote), withMedia(&tg.MessageMediaDocument{
+			Document: &tg.Document{Attributes: []tg.DocumentAttributeClass{
+				&tg.DocumentAttributeVideo{RoundMessage: true},
+			}},
+		}), true},
+	} {
+		require.Equal(t, test.expected, test.filter(test.update), i)
+	}
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import "context"
+
+// Handler handles routed update.
+type Handler func(ctx context.Context, u *Update) error
+
+// Middleware wraps Handler.
+type Middleware func(next Handler) Handler
+
+// Kind is a kind of routed update.
+type Kind int
+
+const (
+	// KindMessage is a new message.
+	KindMessage Kind = iota
+	// KindEditedMessage is an edited message.
+	KindEditedMessage
+	// KindCallback is a callback query from inline keyboard button.
+	KindCallback
+)
+
+// Route is a registered handler.
+type Route struct {
+	kind     Kind
+	group    *Group
+	filters  []Filter
+	h        Handler
+	priority int
+	seq      int
+}
+
+// Priority sets priority of handler. Handlers with greater priority
+// are tried first. Default priority is zero.
+func (r *Route) Priority(p int) *Route {
+	r.group.router.setPriority(r, p)
+	return r
+}
+
+// match reports whether all filters of route and its groups match update.
+func (r *Route) match(u *Update) bool {
+	for g := r.group; g != nil; g = g.parent {
+		if !matchAll(g.filters, u) {
+			return false
+		}
+	}
+	return matchAll(r.filters, u)
+}
+
+// handler returns route handler wrapped by middlewares of its groups.
+func (r *Route) handler() Handler {
+	h := r.h
+	for g := r.group; g != nil; g = g.parent {
+		for i := len(g.middlewares) - 1; i >= 0; i-- {
+			h = g.middlewares[i](h)
+		}
+	}
+	return h
+}
+
+// Group is a group of handlers with common filters and middlewares.
+type Group struct {
+	router      *Router
+	parent      *Group
+	filters     []Filter
+	middlewares []Middleware
+}
+
+// Use adds middlewares to group.
+//
+// Middlewares are applied to handlers of this group and nested groups,
+// including handlers registered before call of Use. Middlewares of parent
+// group are called first.
+func (g *Group) Use(middlewares ...Middleware) {
+	g.middlewares = append(g.middlewares, middlewares...)
+}
+
+// Group creates nested group. Handlers of nested group are called
+// only if given filters match.
+func (g *Group) Group(filters ...Filter) *Group {
+	return &Group{
+		router:  g.router,
+		parent:  g,
+		filters: filters,
+	}
+}
+
+func (g *Group) on(kind Kind, h Handler, filters []Filter) *Route {
+	return g.router.add(&Route{
+		kind:    kind,
+		group:   g,
+		filters: filters,
+		h:       h,
+	})
+}
+
+// OnMessage registers new message handler.
+func (g *Group) OnMessage(h Handler, filters ...Filter) *Route {
+	return g.on(KindMessage, h, filters)
+}
+
+// OnEditedMessage registers edited message handler.
+func (g *Group) OnEditedMessage(h Handler, filters ...Filter) *Route {
+	return g.on(KindEditedMessage, h, filters)
+}
+
+// OnCallback registers callback query handler.
+func (g *Group) OnCallback(h Handler, filters ...Filter) *Route {
+	return g.on(KindCallback, h, filters)
+}
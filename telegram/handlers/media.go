@@ -0,0 +1,88 @@
+package handlers
+
+import "github.com/gotd/td/tg"
+
+// MediaType is a type of message media.
+type MediaType int
+
+const (
+	// MediaNone denotes message without media.
+	MediaNone MediaType = iota
+	// MediaPhoto is a photo.
+	MediaPhoto
+	// MediaDocument is a generic document.
+	MediaDocument
+	// MediaVideo is a video.
+	MediaVideo
+	// MediaVideoNote is a round video message.
+	MediaVideoNote
+	// MediaAnimation is a GIF or silent video.
+	MediaAnimation
+	// MediaAudio is an audio file.
+	MediaAudio
+	// MediaVoice is a voice message.
+	MediaVoice
+	// MediaSticker is a sticker.
+	MediaSticker
+	// MediaContact is a contact.
+	MediaContact
+	// MediaGeo is a location or venue.
+	MediaGeo
+	// MediaPoll is a poll.
+	MediaPoll
+	// MediaDice is a dice.
+	MediaDice
+	// MediaOther is other media, like web page preview or game.
+	MediaOther
+)
+
+func documentType(doc *tg.Document) MediaType {
+	r := MediaDocument
+	for _, attr := range doc.Attributes {
+		switch attr := attr.(type) {
+		case *tg.DocumentAttributeSticker:
+			return MediaSticker
+		case *tg.DocumentAttributeAnimated:
+			return MediaAnimation
+		case *tg.DocumentAttributeVideo:
+			if attr.RoundMessage {
+				r = MediaVideoNote
+			} else {
+				r = MediaVideo
+			}
+		case *tg.DocumentAttributeAudio:
+			if attr.Voice {
+				r = MediaVoice
+			} else {
+				r = MediaAudio
+			}
+		}
+	}
+	return r
+}
+
+// mediaType returns type of given media.
+func mediaType(m tg.MessageMediaClass) MediaType {
+	switch m := m.(type) {
+	case nil, *tg.MessageMediaEmpty:
+		return MediaNone
+	case *tg.MessageMediaPhoto:
+		return MediaPhoto
+	case *tg.MessageMediaDocument:
+		doc, ok := m.Document.(*tg.Document)
+		if !ok {
+			return MediaDocument
+		}
+		return documentType(doc)
+	case *tg.MessageMediaContact:
+		return MediaContact
+	case *tg.MessageMediaGeo, *tg.MessageMediaGeoLive, *tg.MessageMediaVenue:
+		return MediaGeo
+	case *tg.MessageMediaPoll:
+		return MediaPoll
+	case *tg.MessageMediaDice:
+		return MediaDice
+	default:
+		return MediaOther
+	}
+}
@@ -0,0 +1,260 @@
+// Package handlers contains typed update router on top of tg.UpdateDispatcher.
+//
+// NB: this package is experimental, API may be changed.
+package handlers
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+// ErrContinue can be returned by handler to pass update to the next
+// matching handler.
+var ErrContinue = errors.New("continue")
+
+// Options of Router.
+type Options struct {
+	// Sender is used to reply to updates.
+	//
+	// Defaults to message.NewSender.
+	Sender *message.Sender
+	// Peers is used to resolve users and chats.
+	//
+	// Defaults to in-memory peers.Manager.
+	Peers *peers.Manager
+	// Username of current bot, used to match commands like "/start@bot".
+	//
+	// If empty, username is requested using Peers.
+	Username string
+	// Logger to use.
+	Logger *zap.Logger
+}
+
+func (o *Options) setDefaults(api *tg.Client) {
+	if o.Sender == nil {
+		o.Sender = message.NewSender(api)
+	}
+	if o.Peers == nil {
+		o.Peers = peers.Options{Logger: o.Logger}.Build(api)
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
+
+// Router routes updates to registered handlers.
+//
+// Handlers are tried in order of priority, handlers with the same
+// priority are tried in order of registration. First handler which
+// filters match the update handles it, unless it returns ErrContinue.
+type Router struct {
+	root *Group
+
+	api        *tg.Client
+	sender     *message.Sender
+	peers      *peers.Manager
+	log        *zap.Logger
+	dispatcher tg.UpdateDispatcher
+
+	username    string
+	usernameMux sync.Mutex
+
+	routes    []*Route
+	sorted    bool
+	routesMux sync.RWMutex
+}
+
+var _ telegram.UpdateHandler = (*Router)(nil)
+
+// NewRouter creates new Router.
+func NewRouter(api *tg.Client, opts Options) *Router {
+	opts.setDefaults(api)
+	r := &Router{
+		api:        api,
+		sender:     opts.Sender,
+		peers:      opts.Peers,
+		log:        opts.Logger,
+		dispatcher: tg.NewUpdateDispatcher(),
+		username:   strings.TrimPrefix(opts.Username, "@"),
+		sorted:     true,
+	}
+	r.root = &Group{router: r}
+	r.Register(r.dispatcher)
+	return r
+}
+
+// Register sets handlers of routed updates to given dispatcher.
+//
+// Useful if dispatcher is also used for other updates.
+func (r *Router) Register(d tg.UpdateDispatcher) {
+	d.OnNewMessage(func(ctx context.Context, e tg.Entities, u *tg.UpdateNewMessage) error {
+		return r.handleMessage(ctx, e, u, KindMessage)
+	})
+	d.OnNewChannelMessage(func(ctx context.Context, e tg.Entities, u *tg.UpdateNewChannelMessage) error {
+		return r.handleMessage(ctx, e, u, KindMessage)
+	})
+	d.OnEditMessage(func(ctx context.Context, e tg.Entities, u *tg.UpdateEditMessage) error {
+		return r.handleMessage(ctx, e, u, KindEditedMessage)
+	})
+	d.OnEditChannelMessage(func(ctx context.Context, e tg.Entities, u *tg.UpdateEditChannelMessage) error {
+		return r.handleMessage(ctx, e, u, KindEditedMessage)
+	})
+	d.OnBotCallbackQuery(func(ctx context.Context, e tg.Entities, u *tg.UpdateBotCallbackQuery) error {
+		return r.dispatch(ctx, &Update{
+			kind:     KindCallback,
+			raw:      u,
+			entities: e,
+			callback: u,
+			router:   r,
+		})
+	})
+}
+
+// Use adds middlewares to all handlers, see Group.Use.
+func (r *Router) Use(middlewares ...Middleware) {
+	r.root.Use(middlewares...)
+}
+
+// Group creates group of handlers, see Group.Group.
+func (r *Router) Group(filters ...Filter) *Group {
+	return r.root.Group(filters...)
+}
+
+// OnMessage registers new message handler.
+func (r *Router) OnMessage(h Handler, filters ...Filter) *Route {
+	return r.root.OnMessage(h, filters...)
+}
+
+// OnEditedMessage registers edited message handler.
+func (r *Router) OnEditedMessage(h Handler, filters ...Filter) *Route {
+	return r.root.OnEditedMessage(h, filters...)
+}
+
+// OnCallback registers callback query handler.
+func (r *Router) OnCallback(h Handler, filters ...Filter) *Route {
+	return r.root.OnCallback(h, filters...)
+}
+
+// Handle implements telegram.UpdateHandler.
+func (r *Router) Handle(ctx context.Context, u tg.UpdatesClass) error {
+	return r.dispatcher.Handle(ctx, u)
+}
+
+// messageUpdate is update which contains message.
+type messageUpdate interface {
+	tg.UpdateClass
+	message.AnswerableMessageUpdate
+}
+
+func (r *Router) handleMessage(ctx context.Context, e tg.Entities, upd messageUpdate, kind Kind) error {
+	msg, ok := upd.GetMessage().(*tg.Message)
+	if !ok {
+		// Service and empty messages are not routed.
+		return nil
+	}
+
+	u := &Update{
+		kind:     kind,
+		raw:      upd,
+		entities: e,
+		msg:      msg,
+		update:   upd,
+		router:   r,
+	}
+	if cmd, ok := parseCommand(msg.Message); ok && cmd.Mention != "" {
+		u.username = r.botUsername(ctx)
+	}
+	return r.dispatch(ctx, u)
+}
+
+// botUsername returns username of current bot, if any.
+func (r *Router) botUsername(ctx context.Context) string {
+	r.usernameMux.Lock()
+	defer r.usernameMux.Unlock()
+
+	if r.username != "" {
+		return r.username
+	}
+	self, err := r.peers.Self(ctx)
+	if err != nil {
+		r.log.Warn("Get self", zap.Error(err))
+		return ""
+	}
+	r.username, _ = self.Username()
+	return r.username
+}
+
+func (r *Router) add(route *Route) *Route {
+	r.routesMux.Lock()
+	defer r.routesMux.Unlock()
+
+	route.seq = len(r.routes)
+	r.routes = append(r.routes, route)
+	r.sorted = false
+	return route
+}
+
+func (r *Router) setPriority(route *Route, p int) {
+	r.routesMux.Lock()
+	defer r.routesMux.Unlock()
+
+	route.priority = p
+	r.sorted = false
+}
+
+// sortedRoutes returns routes ordered by priority.
+func (r *Router) sortedRoutes() []*Route {
+	r.routesMux.RLock()
+	if r.sorted {
+		defer r.routesMux.RUnlock()
+		return r.routes
+	}
+	r.routesMux.RUnlock()
+
+	r.routesMux.Lock()
+	defer r.routesMux.Unlock()
+
+	// Copy routes to not modify slice which may be used by concurrent dispatch.
+	routes := append([]*Route(nil), r.routes...)
+	sort.SliceStable(routes, func(i, j int) bool {
+		a, b := routes[i], routes[j]
+		if a.priority != b.priority {
+			return a.priority > b.priority
+		}
+		return a.seq < b.seq
+	})
+	r.routes = routes
+	r.sorted = true
+	return routes
+}
+
+// Dispatch passes update to matching routes.
+//
+// It can be used to handle update again, e.g. if it was intercepted,
+// but not processed.
+func (r *Router) Dispatch(ctx context.Context, u *Update) error {
+	return r.dispatch(ctx, u)
+}
+
+func (r *Router) dispatch(ctx context.Context, u *Update) error {
+	for _, route := range r.sortedRoutes() {
+		if route.kind != u.kind || !route.match(u) {
+			continue
+		}
+
+		if err := route.handler()(ctx, u); !errors.Is(err, ErrContinue) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package handlers_test
+
+import (
+	"context"
+	"os"
+	"regexp"
+
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/handlers"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+func ExampleRouter() {
+	logger := zap.NewExample()
+
+	var h telegram.UpdateHandler
+	client, err := telegram.ClientFromEnvironment(telegram.Options{
+		Logger: logger.Named("client"),
+		UpdateHandler: telegram.UpdateHandlerFunc(func(ctx context.Context, u tg.UpdatesClass) error {
+			return h.Handle(ctx, u)
+		}),
+	})
+	if err != nil {
+		panic(err)
+	}
+	peerManager := peers.Options{Logger: logger}.Build(client.API())
+
+	r := handlers.NewRouter(client.API(), handlers.Options{
+		Peers:  peerManager,
+		Logger: logger.Named("router"),
+	})
+	r.Use(func(next handlers.Handler) handlers.Handler {
+		return func(ctx context.Context, u *handlers.Update) error {
+			if err := next(ctx, u); err != nil {
+				logger.Error("Handler failed", zap.Error(err))
+			}
+			return nil
+		}
+	})
+
+	r.OnMessage(func(ctx context.Context, u *handlers.Update) error {
+		user, _, err := u.Sender(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = u.Reply().Textf(ctx, "Hello, %s!", user.VisibleName())
+		return err
+	}, handlers.Command("start"), handlers.ChatTypes(handlers.ChatPrivate))
+
+	r.OnMessage(func(ctx context.Context, u *handlers.Update) error {
+		_, err := u.Reply().Textf(ctx, "Bye, %s!", u.Matches()[1])
+		return err
+	}, handlers.Regex(regexp.MustCompile(`^bye (\w+)$`))).Priority(1)
+
+	r.OnCallback(func(ctx context.Context, u *handlers.Update) error {
+		return u.Answer(ctx, "Pressed "+string(u.Data()))
+	}, handlers.CallbackPrefix("btn:"))
+
+	admins := r.Group(handlers.From(123))
+	admins.OnMessage(func(ctx context.Context, u *handlers.Update) error {
+		_, err := u.Reply().Text(ctx, "Shutting down")
+		return err
+	}, handlers.Command("stop"))
+
+	h = peerManager.UpdateHook(r)
+	if err := client.Run(context.Background(), func(ctx context.Context) error {
+		_, err := client.Auth().Bot(ctx, os.Getenv("BOT_TOKEN"))
+		if err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgmock"
+)
+
+func testRouter(t *testing.T) (*tgmock.Mock, *Router) {
+	mock := tgmock.New(t)
+	return mock, NewRouter(tg.NewClient(mock), Options{
+		Username: "@thebot",
+		Logger:   zaptest.NewLogger(t),
+	})
+}
+
+func newMessage(text string) *tg.Updates {
+	return &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateNewMessage{
+				Message: &tg.Message{
+					ID:      10,
+					PeerID:  &tg.PeerUser{UserID: 1},
+					Message: text,
+				},
+			},
+		},
+		Users: []tg.UserClass{
+			&tg.User{ID: 1, AccessHash: 1, FirstName: "Alice"},
+		},
+	}
+}
+
+func newCallback(data string) *tg.Updates {
+	return &tg.Updates{
+		Updates: []tg.UpdateClass{
+			&tg.UpdateBotCallbackQuery{
+				QueryID: 5,
+				UserID:  1,
+				Peer:    &tg.PeerUser{UserID: 1},
+				MsgID:   10,
+				Data:    []byte(data),
+			},
+		},
+		Users: []tg.UserClass{
+			&tg.User{ID: 1, AccessHash: 1, FirstName: "Alice"},
+		},
+	}
+}
+
+func TestRouter(t *testing.T) {
+	ctx := context.Background()
+	_, r := testRouter(t)
+
+	var calls []string
+	record := func(name string) Handler {
+		return func(ctx context.Context, u *Update) error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+	r.OnMessage(record("start"), Command("start"))
+	r.OnMessage(record("echo"), Text())
+	r.OnMessage(record("hello"), Regex(regexp.MustCompile(`^hello (\w+)$`))).Priority(1)
+	r.OnCallback(record("callback"), CallbackPrefix("btn:"))
+
+	for _, test := range []struct {
+		updates *tg.Updates
+		calls   []string
+	}{
+		{newMessage("/start"), []string{"start"}},
+		{newMessage("/start@thebot arg"), []string{"start"}},
+		{newMessage("/start@otherbot"), []string{"echo"}},
+		{newMessage("hello world"), []string{"hello"}},
+		{newMessage("text"), []string{"echo"}},
+		{newMessage(""), nil},
+		{newCallback("btn:1"), []string{"callback"}},
+		{newCallback("other"), nil},
+	} {
+		calls = nil
+		require.NoError(t, r.Handle(ctx, test.updates))
+		require.Equal(t, test.calls, calls)
+	}
+}
+
+func TestRouter_Continue(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	_, r := testRouter(t)
+
+	var calls []string
+	r.OnMessage(func(ctx context.Context, u *Update) error {
+		calls = append(calls, "first")
+		return ErrContinue
+	})
+	r.OnMessage(func(ctx context.Context, u *Update) error {
+		calls = append(calls, "second")
+		return nil
+	})
+	r.OnMessage(func(ctx context.Context, u *Update) error {
+		calls = append(calls, "third")
+		return nil
+	})
+
+	a.NoError(r.Handle(ctx, newMessage("text")))
+	a.Equal([]string{"first", "second"}, calls)
+}
+
+func TestRouter_Group(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	_, r := testRouter(t)
+
+	var calls []string
+	middleware := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, u *Update) error {
+				calls = append(calls, name)
+				return next(ctx, u)
+			}
+		}
+	}
+	handler := func(ctx context.Context, u *Update) error {
+		calls = append(calls, "handler")
+		return nil
+	}
+
+	admin := r.Group(From(1))
+	admin.OnMessage(handler, Command("ban"))
+	r.Use(middleware("root"))
+	admin.Use(middleware("admin"))
+	r.OnMessage(func(ctx context.Context, u *Update) error {
+		calls = append(calls, "fallback")
+		return nil
+	})
+
+	a.NoError(r.Handle(ctx, newMessage("/ban")))
+	a.Equal([]string{"root", "admin", "handler"}, calls)
+
+	calls = nil
+	upd := newMessage("/ban")
+	upd.Updates[0].(*tg.UpdateNewMessage).Message.(*tg.Message).PeerID = &tg.PeerUser{UserID: 2}
+	a.NoError(r.Handle(ctx, upd))
+	a.Equal([]string{"root", "fallback"}, calls)
+}
@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/peers"
+	"github.com/gotd/td/tg"
+)
+
+// ChatType is a type of chat.
+type ChatType int
+
+const (
+	// ChatUnknown denotes that type of chat can't be determined.
+	ChatUnknown ChatType = iota
+	// ChatPrivate is a private chat with user.
+	ChatPrivate
+	// ChatGroup is a legacy group chat.
+	ChatGroup
+	// ChatSupergroup is a supergroup.
+	ChatSupergroup
+	// ChatChannel is a broadcast channel.
+	ChatChannel
+)
+
+// Update is a routed update with helpers to respond to it.
+type Update struct {
+	kind     Kind
+	raw      tg.UpdateClass
+	entities tg.Entities
+	router   *Router
+
+	// msg and update are set for message updates.
+	msg    *tg.Message
+	update message.AnswerableMessageUpdate
+	// callback is set for callback query updates.
+	callback *tg.UpdateBotCallbackQuery
+
+	// username of current bot, set if message contains command with mention.
+	username string
+	// matches of Regex filter.
+	matches []string
+}
+
+// Kind returns kind of update.
+func (u *Update) Kind() Kind {
+	return u.kind
+}
+
+// Raw returns raw update.
+func (u *Update) Raw() tg.UpdateClass {
+	return u.raw
+}
+
+// Entities returns entities of update.
+func (u *Update) Entities() tg.Entities {
+	return u.entities
+}
+
+// Message returns message of update, if any.
+func (u *Update) Message() (*tg.Message, bool) {
+	return u.msg, u.msg != nil
+}
+
+// Callback returns callback query of update, if any.
+func (u *Update) Callback() (*tg.UpdateBotCallbackQuery, bool) {
+	return u.callback, u.callback != nil
+}
+
+// Text returns message text, if any.
+func (u *Update) Text() string {
+	if u.msg == nil {
+		return ""
+	}
+	return u.msg.Message
+}
+
+// Data returns callback query data, if any.
+func (u *Update) Data() []byte {
+	if u.callback == nil {
+		return nil
+	}
+	return u.callback.Data
+}
+
+// Command returns parsed bot command, if message is a command.
+func (u *Update) Command() (BotCommand, bool) {
+	if u.msg == nil {
+		return BotCommand{}, false
+	}
+	return parseCommand(u.msg.Message)
+}
+
+// Matches returns submatches of last matched Regex filter.
+func (u *Update) Matches() []string {
+	return u.matches
+}
+
+// MediaType returns type of message media.
+func (u *Update) MediaType() MediaType {
+	if u.msg == nil {
+		return MediaNone
+	}
+	return mediaType(u.msg.Media)
+}
+
+// Peer returns peer of chat where update happened.
+func (u *Update) Peer() tg.PeerClass {
+	if u.callback != nil {
+		return u.callback.Peer
+	}
+	return u.msg.PeerID
+}
+
+// messageID returns ID of message of update.
+func (u *Update) messageID() int {
+	if u.callback != nil {
+		return u.callback.MsgID
+	}
+	return u.msg.ID
+}
+
+// SenderID returns ID of user which sent update.
+//
+// Returns false if message is sent on behalf of channel or if it is
+// outgoing message in private chat.
+func (u *Update) SenderID() (int64, bool) {
+	if u.callback != nil {
+		return u.callback.UserID, true
+	}
+
+	// Flags are not set for constructed messages, so GetFromID is not
+	// reliable.
+	from := u.msg.FromID
+	if from == nil {
+		// Incoming messages in private chats have no FromID.
+		if u.msg.Out {
+			return 0, false
+		}
+		from = u.msg.PeerID
+	}
+	p, ok := from.(*tg.PeerUser)
+	if !ok {
+		return 0, false
+	}
+	return p.UserID, true
+}
+
+// ChatType returns type of chat where update happened.
+func (u *Update) ChatType() ChatType {
+	switch p := u.Peer().(type) {
+	case *tg.PeerUser:
+		return ChatPrivate
+	case *tg.PeerChat:
+		return ChatGroup
+	case *tg.PeerChannel:
+		if ch, ok := u.entities.Channels[p.ChannelID]; ok {
+			if ch.Broadcast {
+				return ChatChannel
+			}
+			return ChatSupergroup
+		}
+		if u.msg != nil && u.msg.Post {
+			return ChatChannel
+		}
+	}
+	return ChatUnknown
+}
+
+// Sender returns user which sent update.
+//
+// Returns false if update has no user sender, see SenderID.
+func (u *Update) Sender(ctx context.Context) (peers.User, bool, error) {
+	id, ok := u.SenderID()
+	if !ok {
+		return peers.User{}, false, nil
+	}
+
+	m := u.router.peers
+	if user, ok := u.entities.Users[id]; ok {
+		return m.User(user), true, nil
+	}
+	user, err := m.ResolveUserID(ctx, id)
+	if err != nil {
+		return peers.User{}, false, errors.Wrapf(err, "resolve user %d", id)
+	}
+	return user, true, nil
+}
+
+// Channel returns channel or supergroup where update happened.
+//
+// Returns false if update happened not in channel.
+func (u *Update) Channel(ctx context.Context) (peers.Channel, bool, error) {
+	p, ok := u.Peer().(*tg.PeerChannel)
+	if !ok {
+		return peers.Channel{}, false, nil
+	}
+
+	m := u.router.peers
+	if ch, ok := u.entities.Channels[p.ChannelID]; ok {
+		return m.Channel(ch), true, nil
+	}
+	ch, err := m.ResolveChannelID(ctx, p.ChannelID)
+	if err != nil {
+		return peers.Channel{}, false, errors.Wrapf(err, "resolve channel %d", p.ChannelID)
+	}
+	return ch, true, nil
+}
+
+// Chat returns peer of chat where update happened.
+func (u *Update) Chat(ctx context.Context) (peers.Peer, error) {
+	m := u.router.peers
+	switch p := u.Peer().(type) {
+	case *tg.PeerUser:
+		if user, ok := u.entities.Users[p.UserID]; ok {
+			return m.User(user), nil
+		}
+	case *tg.PeerChat:
+		if chat, ok := u.entities.Chats[p.ChatID]; ok {
+			return m.Chat(chat), nil
+		}
+	case *tg.PeerChannel:
+		if ch, ok := u.entities.Channels[p.ChannelID]; ok {
+			return m.Channel(ch), nil
+		}
+	}
+
+	r, err := m.ResolvePeer(ctx, u.Peer())
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve peer")
+	}
+	return r, nil
+}
+
+// Respond returns builder to send message to chat where update happened.
+func (u *Update) Respond() *message.RequestBuilder {
+	s := u.router.sender
+	if u.callback != nil {
+		return s.Peer(u.entities, u.callback)
+	}
+	return s.Answer(u.entities, u.update)
+}
+
+// Reply returns builder to send reply to message of update.
+//
+// For callback queries, reply is sent to message with inline keyboard.
+func (u *Update) Reply() *message.Builder {
+	return u.Respond().Reply(u.messageID())
+}
+
+// Edit returns builder to edit message of update.
+//
+// For callback queries, message with inline keyboard is edited.
+func (u *Update) Edit() *message.EditMessageBuilder {
+	return u.Respond().Edit(u.messageID())
+}
+
+// ErrNotCallback is returned when callback query method is called
+// for other update.
+var ErrNotCallback = errors.New("update is not a callback query")
+
+func (u *Update) answer(ctx context.Context, req *tg.MessagesSetBotCallbackAnswerRequest) error {
+	if u.callback == nil {
+		return ErrNotCallback
+	}
+	req.QueryID = u.callback.QueryID
+
+	if _, err := u.router.api.MessagesSetBotCallbackAnswer(ctx, req); err != nil {
+		return errors.Wrap(err, "answer callback")
+	}
+	return nil
+}
+
+// Answer answers callback query with notification at the top of the chat screen.
+// If text is empty, nothing will be shown to the user.
+func (u *Update) Answer(ctx context.Context, text string) error {
+	return u.answer(ctx, &tg.MessagesSetBotCallbackAnswerRequest{
+		Message: text,
+	})
+}
+
+// Alert answers callback query with alert.
+func (u *Update) Alert(ctx context.Context, text string) error {
+	return u.answer(ctx, &tg.MessagesSetBotCallbackAnswerRequest{
+		Alert:   true,
+		Message: text,
+	})
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+)
+
+func TestUpdate_Reply(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, r := testRouter(t)
+
+	r.OnMessage(func(ctx context.Context, u *Update) error {
+		user, ok, err := u.Sender(ctx)
+		if err != nil || !ok {
+			return err
+		}
+		_, err = u.Reply().Text(ctx, "Hello, "+user.VisibleName())
+		return err
+	}, Command("start"), ChatTypes(ChatPrivate))
+
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesSendMessageRequest)
+		a.True(ok)
+		a.Equal(&tg.InputPeerUser{UserID: 1, AccessHash: 1}, req.Peer)
+		a.Equal("Hello, Alice", req.Message)
+		reply, ok := req.ReplyTo.(*tg.InputReplyToMessage)
+		a.True(ok)
+		a.Equal(10, reply.ReplyToMsgID)
+	}).ThenResult(&tg.Updates{})
+	a.NoError(r.Handle(ctx, newMessage("/start")))
+}
+
+func TestUpdate_Answer(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	mock, r := testRouter(t)
+
+	r.OnCallback(func(ctx context.Context, u *Update) error {
+		if err := u.Answer(ctx, "Done"); err != nil {
+			return err
+		}
+		_, err := u.Edit().Text(ctx, "Edited")
+		return err
+	})
+	r.OnMessage(func(ctx context.Context, u *Update) error {
+		return u.Alert(ctx, "Alert")
+	})
+
+	mock.ExpectCall(&tg.MessagesSetBotCallbackAnswerRequest{
+		QueryID: 5,
+		Message: "Done",
+	}).ThenTrue()
+	mock.ExpectFunc(func(b bin.Encoder) {
+		req, ok := b.(*tg.MessagesEditMessageRequest)
+		a.True(ok)
+		a.Equal(10, req.ID)
+		a.Equal("Edited", req.Message)
+	}).ThenResult(&tg.Updates{})
+	a.NoError(r.Handle(ctx, newCallback("data")))
+
+	a.ErrorIs(r.Handle(ctx, newMessage("text")), ErrNotCallback)
+}
+
+func TestUpdate_ChatType(t *testing.T) {
+	for _, test := range []struct {
+		peer     tg.PeerClass
+		channels map[int64]*tg.Channel
+		post     bool
+		expected ChatType
+	}{
+		{peer: &tg.PeerUser{UserID: 1}, expected: ChatPrivate},
+		{peer: &tg.PeerChat{ChatID: 1}, expected: ChatGroup},
+		{
+			peer:     &tg.PeerChannel{ChannelID: 1},
+			channels: map[int64]*tg.Channel{1: {ID: 1, Megagroup: true}},
+			expected: ChatSupergroup,
+		},
+		{
+			peer:     &tg.PeerChannel{ChannelID: 1},
+			channels: map[int64]*tg.Channel{1: {ID: 1, Broadcast: true}},
+			expected: ChatChannel,
+		},
+		{peer: &tg.PeerChannel{ChannelID: 1}, post: true, expected: ChatChannel},
+		{peer: &tg.PeerChannel{ChannelID: 1}, expected: ChatUnknown},
+	} {
+		u := &Update{
+			msg:      &tg.Message{PeerID: test.peer, Post: test.post},
+			entities: tg.Entities{Channels: test.channels},
+		}
+		require.Equal(t, test.expected, u.ChatType())
+	}
+}
+
+func TestUpdate_SenderID(t *testing.T) {
+	for _, test := range []struct {
+		msg *tg.Message
+		id  int64
+		ok  bool
+	}{
+		{msg: &tg.Message{PeerID: &tg.PeerUser{UserID: 1}}, id: 1, ok: true},
+		{msg: &tg.Message{Out: true, PeerID: &tg.PeerUser{UserID: 1}}},
+		{msg: &tg.Message{PeerID: &tg.PeerChat{ChatID: 1}, FromID: &tg.PeerUser{UserID: 2}}, id: 2, ok: true},
+		{msg: &tg.Message{PeerID: &tg.PeerChannel{ChannelID: 1}, FromID: &tg.PeerChannel{ChannelID: 1}}},
+	} {
+		id, ok := (&Update{msg: test.msg}).SenderID()
+		require.Equal(t, test.id, id)
+		require.Equal(t, test.ok, ok)
+	}
+}
@@ -0,0 +1,27 @@
+package telegram
+
+import (
+	"time"
+
+	"github.com/gotd/td/mtproto"
+)
+
+// Hooks are called on client connection events.
+//
+// Can be used to collect metrics, see oteltg.Metrics.
+type Hooks interface {
+	mtproto.Hooks
+	// OnReconnect is called when primary connection is restarted
+	// after error.
+	OnReconnect(dc int, err error)
+}
+
+type nopHooks struct{}
+
+func (nopHooks) OnConnect(dc int)                                            {}
+func (nopHooks) OnDisconnect(dc int)                                         {}
+func (nopHooks) OnKeyExchange(dc int, temp bool, d time.Duration, err error) {}
+func (nopHooks) OnSaltUpdate(dc int)                                         {}
+func (nopHooks) OnSend(dc, bytes int)                                        {}
+func (nopHooks) OnReceive(dc, bytes int)                                     {}
+func (nopHooks) OnReconnect(dc int, err error)                               {}
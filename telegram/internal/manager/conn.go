@@ -190,6 +190,44 @@ func (c *Conn) wrapRequest(req bin.Object) bin.Object {
 func (c *Conn) init(ctx context.Context) error {
 	c.log.Debug("Initializing")
 
+	cfg, err := c.initConnection(ctx)
+	if err != nil {
+		return errors.Wrap(err, "initConnection")
+	}
+
+	if c.setup != nil {
+		if err := c.setup(ctx, c); err != nil {
+			return errors.Wrap(err, "setup")
+		}
+	}
+
+	c.mux.Lock()
+	c.latest = c.clock.Now()
+	c.cfg = cfg
+	c.mux.Unlock()
+
+	c.gotConfig.Signal()
+	return nil
+}
+
+// reinit repeats initConnection, used after temporary key rotation.
+func (c *Conn) reinit(ctx context.Context) error {
+	c.log.Debug("Reinitializing")
+
+	cfg, err := c.initConnection(ctx)
+	if err != nil {
+		return errors.Wrap(err, "initConnection")
+	}
+
+	c.mux.Lock()
+	c.latest = c.clock.Now()
+	c.cfg = cfg
+	c.mux.Unlock()
+	return nil
+}
+
+// initConnection sends invokeWithLayer(initConnection(help.getConfig)).
+func (c *Conn) initConnection(ctx context.Context) (tg.Config, error) {
 	q := c.wrapRequest(&tg.InitConnectionRequest{
 		APIID:          c.appID,
 		DeviceModel:    c.device.DeviceModel,
@@ -227,22 +265,9 @@ func (c *Conn) init(ctx context.Context) error {
 			zap.Error(err), zap.Duration("duration", duration),
 		)
 	}); err != nil {
-		return errors.Wrap(err, "initConnection")
-	}
-
-	if c.setup != nil {
-		if err := c.setup(ctx, c); err != nil {
-			return errors.Wrap(err, "setup")
-		}
+		return tg.Config{}, err
 	}
-
-	c.mux.Lock()
-	c.latest = c.clock.Now()
-	c.cfg = cfg
-	c.mux.Unlock()
-
-	c.gotConfig.Signal()
-	return nil
+	return cfg, nil
 }
 
 // Ping calls ping for underlying protocol connection.
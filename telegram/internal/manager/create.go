@@ -80,6 +80,7 @@ func CreateConn(
 		opts.DC += 10000
 	}
 	opts.Handler = conn
+	opts.OnTempKeyBound = conn.reinit
 	opts.Logger = conn.log.Named("mtproto")
 	conn.proto = mtproto.New(create, opts)
 
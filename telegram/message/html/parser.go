@@ -2,12 +2,14 @@ package html
 
 import (
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/go-faster/errors"
 	"golang.org/x/net/html"
 
 	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/telegram/message/internal/entitylink"
 	"github.com/gotd/td/tg"
 )
 
@@ -36,15 +38,17 @@ func (p *htmlParser) fillAttrs() {
 }
 
 const (
-	pre       = "pre"
-	code      = "code"
-	em        = "em"
-	ins       = "ins"
-	strike    = "strike"
-	del       = "del"
-	strong    = "strong"
-	span      = "span"
-	tgSpoiler = "tg-spoiler"
+	pre        = "pre"
+	code       = "code"
+	em         = "em"
+	ins        = "ins"
+	strike     = "strike"
+	del        = "del"
+	strong     = "strong"
+	span       = "span"
+	tgSpoiler  = "tg-spoiler"
+	tgEmoji    = "tg-emoji"
+	blockquote = "blockquote"
 )
 
 func (p *htmlParser) tag(tn []byte) string {
@@ -78,6 +82,10 @@ func (p *htmlParser) tag(tn []byte) string {
 		return span
 	case tgSpoiler:
 		return tgSpoiler
+	case tgEmoji:
+		return tgEmoji
+	case blockquote:
+		return blockquote
 	default:
 		return string(tn)
 	}
@@ -108,7 +116,7 @@ func (p *htmlParser) startTag() error {
 			break
 		}
 
-		f, err := getURLFormatter(e.attr, p.opts.UserResolver)
+		f, err := entitylink.Formatter(e.attr, p.opts.UserResolver)
 		if err != nil {
 			f = nil
 		}
@@ -154,6 +162,14 @@ func (p *htmlParser) startTag() error {
 		}
 	case tgSpoiler:
 		e.format = entity.Spoiler()
+	case tgEmoji:
+		id, err := strconv.ParseInt(p.attr["emoji-id"], 10, 64)
+		if err != nil {
+			break
+		}
+		e.format = entity.CustomEmoji(id)
+	case blockquote:
+		e.format = entity.Blockquote()
 	}
 
 	p.stack.push(e)
@@ -179,7 +195,7 @@ func (p *htmlParser) endTag(checkName bool) error {
 		// TDLib tries to parse link from <a> body, so we should too.
 		if s.attr == "" {
 			msg := s.token.Text(p.builder)
-			if f, err := getURLFormatter(msg, p.opts.UserResolver); err == nil {
+			if f, err := entitylink.Formatter(msg, p.opts.UserResolver); err == nil {
 				s.format = f
 			}
 		}
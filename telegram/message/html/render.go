@@ -0,0 +1,114 @@
+package html
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gotd/td/telegram/message/internal/render"
+	"github.com/gotd/td/tg"
+)
+
+var htmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+)
+
+// openTag returns start tag of entity, if entity can be rendered.
+func openTag(e tg.MessageEntityClass) (string, bool) {
+	switch e := e.(type) {
+	case *tg.MessageEntityBold:
+		return "<b>", true
+	case *tg.MessageEntityItalic:
+		return "<i>", true
+	case *tg.MessageEntityUnderline:
+		return "<u>", true
+	case *tg.MessageEntityStrike:
+		return "<s>", true
+	case *tg.MessageEntitySpoiler:
+		return "<tg-spoiler>", true
+	case *tg.MessageEntityCode:
+		return "<code>", true
+	case *tg.MessageEntityPre:
+		if e.Language == "" {
+			return "<pre>", true
+		}
+		return `<pre><code class="language-` + htmlEscaper.Replace(e.Language) + `">`, true
+	case *tg.MessageEntityTextURL:
+		return `<a href="` + htmlEscaper.Replace(e.URL) + `">`, true
+	case *tg.MessageEntityMentionName, *tg.InputMessageEntityMentionName:
+		id, ok := render.MentionID(e)
+		if !ok {
+			return "", false
+		}
+		return `<a href="tg://user?id=` + strconv.FormatInt(id, 10) + `">`, true
+	case *tg.MessageEntityCustomEmoji:
+		return `<tg-emoji emoji-id="` + strconv.FormatInt(e.DocumentID, 10) + `">`, true
+	case *tg.MessageEntityBlockquote:
+		return "<blockquote>", true
+	default:
+		// Entities like mentions or URLs are detected by server.
+		return "", false
+	}
+}
+
+// closeTag returns end tag of entity.
+func closeTag(e tg.MessageEntityClass) string {
+	switch e := e.(type) {
+	case *tg.MessageEntityBold:
+		return "</b>"
+	case *tg.MessageEntityItalic:
+		return "</i>"
+	case *tg.MessageEntityUnderline:
+		return "</u>"
+	case *tg.MessageEntityStrike:
+		return "</s>"
+	case *tg.MessageEntitySpoiler:
+		return "</tg-spoiler>"
+	case *tg.MessageEntityCode:
+		return "</code>"
+	case *tg.MessageEntityPre:
+		if e.Language == "" {
+			return "</pre>"
+		}
+		return "</code></pre>"
+	case *tg.MessageEntityCustomEmoji:
+		return "</tg-emoji>"
+	case *tg.MessageEntityBlockquote:
+		return "</blockquote>"
+	default:
+		return "</a>"
+	}
+}
+
+// Render renders message text and entities to HTML.
+//
+// Overlapping entities are split, so result is valid HTML
+// which can be parsed back using HTML. Entities nested into code
+// are dropped. Entities which are detected by server (mentions,
+// hashtags, URLs, etc.) are rendered as plain text.
+func Render(text string, entities []tg.MessageEntityClass) (string, error) {
+	tokens, err := render.Tokenize(text, entities, render.Options{})
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	for _, t := range tokens {
+		switch t.Kind {
+		case render.Text:
+			_, _ = htmlEscaper.WriteString(&b, t.Text)
+		case render.Open:
+			if tag, ok := openTag(t.Entity); ok {
+				b.WriteString(tag)
+			}
+		case render.Close:
+			if _, ok := openTag(t.Entity); ok {
+				b.WriteString(closeTag(t.Entity))
+			}
+		}
+	}
+	return b.String(), nil
+}
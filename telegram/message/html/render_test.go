@@ -0,0 +1,143 @@
+package html
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/tg"
+)
+
+func parse(t testing.TB, s string) (string, []tg.MessageEntityClass) {
+	t.Helper()
+
+	b := entity.Builder{}
+	require.NoError(t, HTML(strings.NewReader(s), &b, Options{}))
+	return b.Raw()
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		msg      string
+		entities []tg.MessageEntityClass
+		output   string
+		// expected entities after parsing, equal to given if nil.
+		parsed []tg.MessageEntityClass
+	}{
+		{
+			msg:    `<a href="x">&</a>`,
+			output: "&lt;a href=&quot;x&quot;&gt;&amp;&lt;/a&gt;",
+		},
+		{
+			msg: "🏟 bold",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 3, Length: 4},
+			},
+			output: "🏟 <b>bold</b>",
+		},
+		{
+			msg: "bold italic",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 0, Length: 8},
+				&tg.MessageEntityItalic{Offset: 5, Length: 6},
+			},
+			output: "<b>bold <i>ita</i></b><i>lic</i>",
+			parsed: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 0, Length: 8},
+				&tg.MessageEntityItalic{Offset: 5, Length: 3},
+				&tg.MessageEntityItalic{Offset: 8, Length: 3},
+			},
+		},
+		{
+			msg: "spoiler link",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntitySpoiler{Offset: 0, Length: 12},
+				&tg.MessageEntityTextURL{Offset: 8, Length: 4, URL: "https://example.com/?a=1&b=2"},
+			},
+			output: `<tg-spoiler>spoiler <a href="https://example.com/?a=1&amp;b=2">link</a></tg-spoiler>`,
+		},
+		{
+			msg: "user",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityMentionName{Offset: 0, Length: 4, UserID: 10},
+			},
+			output: `<a href="tg://user?id=10">user</a>`,
+			parsed: []tg.MessageEntityClass{
+				&tg.InputMessageEntityMentionName{Offset: 0, Length: 4, UserID: &tg.InputUser{UserID: 10}},
+			},
+		},
+		{
+			msg: "👍!",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityCustomEmoji{Offset: 0, Length: 2, DocumentID: 10},
+			},
+			output: `<tg-emoji emoji-id="10">👍</tg-emoji>!`,
+		},
+		{
+			msg: "code: <a>",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityPre{Offset: 6, Length: 3, Language: "go"},
+				&tg.MessageEntityBold{Offset: 0, Length: 8},
+			},
+			output: `<b>code: </b><pre><code class="language-go">&lt;a&gt;</code></pre>`,
+			parsed: []tg.MessageEntityClass{
+				&tg.MessageEntityPre{Offset: 6, Length: 3, Language: "go"},
+				&tg.MessageEntityBold{Offset: 0, Length: 6},
+			},
+		},
+		{
+			msg: "text\nquote",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 5, Length: 5},
+				&tg.MessageEntityMention{Offset: 0, Length: 4},
+			},
+			output: "text\n<blockquote>quote</blockquote>",
+			parsed: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 5, Length: 5},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			output, err := Render(test.msg, test.entities)
+			a.NoError(err)
+			a.Equal(test.output, output)
+
+			msg, entities := parse(t, output)
+			a.Equal(test.msg, msg)
+			expected := test.entities
+			if test.parsed != nil {
+				expected = test.parsed
+			}
+			a.ElementsMatch(expected, entities)
+		})
+	}
+}
+
+func TestRender_RoundTrip(t *testing.T) {
+	for i, test := range tdlibHTMLTests() {
+		if test.wantErr || test.skipReason != "" {
+			continue
+		}
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			msg, entities := parse(t, test.html)
+			output, err := Render(msg, entities)
+			a.NoError(err)
+
+			// Entities nested into code are dropped, so
+			// compare result of rendering parsed output instead.
+			renderedMsg, renderedEntities := parse(t, output)
+			a.Equal(msg, renderedMsg, output)
+			rendered, err := Render(renderedMsg, renderedEntities)
+			a.NoError(err)
+			a.Equal(output, rendered)
+		})
+	}
+}
@@ -1,4 +1,5 @@
-package html
+// Package entitylink contains link entity helpers shared by message parsers.
+package entitylink
 
 import (
 	"net"
@@ -50,7 +51,11 @@ func validateHostname(u *url.URL) error {
 	return nil
 }
 
-func getURLFormatter(rawURL string, resolver entity.UserResolver) (entity.Formatter, error) {
+// Formatter returns formatter for given link.
+//
+// Links like tg://user?id=<id> are resolved to MentionName entity
+// using given resolver, other links are validated and returned as TextURL.
+func Formatter(rawURL string, resolver entity.UserResolver) (entity.Formatter, error) {
 	const defaultProtocol = "http"
 	if rawURL == "" {
 		return nil, errors.New("empty URL")
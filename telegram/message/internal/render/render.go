@@ -0,0 +1,371 @@
+// Package render contains helpers to render message entities to markup.
+package render
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+)
+
+// Kind is a Token kind.
+type Kind uint8
+
+const (
+	// Text is a plain text token.
+	Text Kind = iota
+	// Open is an entity start token.
+	Open
+	// Close is an entity end token.
+	Close
+)
+
+// Token is a part of rendered message.
+type Token struct {
+	Kind Kind
+	// Text is a text of Text token.
+	Text string
+	// Offset is a byte offset of token in message.
+	Offset int
+	// Entity of Open and Close tokens.
+	Entity tg.MessageEntityClass
+}
+
+type span struct {
+	start, end int
+	entity     tg.MessageEntityClass
+}
+
+// IsCode reports whether entity contents are rendered as code.
+func IsCode(e tg.MessageEntityClass) bool {
+	switch e.(type) {
+	case *tg.MessageEntityCode, *tg.MessageEntityPre:
+		return true
+	default:
+		return false
+	}
+}
+
+// MentionID returns user ID of mention entity.
+func MentionID(e tg.MessageEntityClass) (int64, bool) {
+	switch e := e.(type) {
+	case *tg.MessageEntityMentionName:
+		return e.UserID, true
+	case *tg.InputMessageEntityMentionName:
+		if u, ok := e.UserID.(*tg.InputUser); ok {
+			return u.UserID, true
+		}
+	}
+	return 0, false
+}
+
+func isQuote(e tg.MessageEntityClass) bool {
+	_, ok := e.(*tg.MessageEntityBlockquote)
+	return ok
+}
+
+// rank is used to order entities with the same range:
+// blockquote is outermost, code is innermost.
+func rank(e tg.MessageEntityClass) int {
+	switch {
+	case isQuote(e):
+		return 0
+	case IsCode(e):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// mergeable reports whether overlapping entities of the same type
+// can be merged into one.
+func mergeable(e tg.MessageEntityClass) bool {
+	switch e.(type) {
+	case *tg.MessageEntityBold,
+		*tg.MessageEntityItalic,
+		*tg.MessageEntityUnderline,
+		*tg.MessageEntityStrike,
+		*tg.MessageEntitySpoiler:
+		return true
+	default:
+		return false
+	}
+}
+
+// cut splits spans at given points.
+func cut(s span, points []int) []span {
+	sort.Ints(points)
+
+	var r []span
+	for _, p := range points {
+		if p <= s.start || p >= s.end {
+			continue
+		}
+		r = append(r, span{start: s.start, end: p, entity: s.entity})
+		s.start = p
+	}
+	return append(r, s)
+}
+
+// subtract removes given range from span.
+func subtract(s span, start, end int) []span {
+	if end <= s.start || start >= s.end {
+		return []span{s}
+	}
+
+	var r []span
+	if s.start < start {
+		r = append(r, span{start: s.start, end: start, entity: s.entity})
+	}
+	if end < s.end {
+		r = append(r, span{start: end, end: s.end, entity: s.entity})
+	}
+	return r
+}
+
+func overlaps(a, b span) bool {
+	return a.start < b.end && b.start < a.end
+}
+
+func contains(outer, inner span) bool {
+	return outer.start <= inner.start && inner.end <= outer.end
+}
+
+// normalize converts entities to set of spans which can be nested:
+//
+//   - overlapping entities of the same type are merged or dropped;
+//   - entities crossing blockquote boundaries are split;
+//   - entities inside code are dropped.
+//
+// nolint:gocognit
+func normalize(spans []span) []span {
+	// Merge or drop overlapping entities of the same type.
+	var uniq []span
+	for _, s := range spans {
+		added := false
+		for i, u := range uniq {
+			if u.entity.TypeID() != s.entity.TypeID() || !overlaps(u, s) {
+				continue
+			}
+			if mergeable(s.entity) {
+				uniq[i].start = min(u.start, s.start)
+				uniq[i].end = max(u.end, s.end)
+			}
+			added = true
+			break
+		}
+		if !added {
+			uniq = append(uniq, s)
+		}
+	}
+
+	var quotes, codes []span
+	for _, s := range uniq {
+		switch {
+		case isQuote(s.entity):
+			quotes = append(quotes, s)
+		case IsCode(s.entity):
+			// Code can't overlap other code.
+			overlapped := false
+			for _, c := range codes {
+				overlapped = overlapped || overlaps(c, s)
+			}
+			if !overlapped {
+				codes = append(codes, s)
+			}
+		}
+	}
+
+	var r []span
+	for _, s := range uniq {
+		if isQuote(s.entity) {
+			r = append(r, s)
+			continue
+		}
+		if IsCode(s.entity) {
+			continue
+		}
+
+		// Split at blockquote boundaries.
+		var points []int
+		for _, q := range quotes {
+			if overlaps(q, s) && !contains(q, s) {
+				points = append(points, q.start, q.end)
+			}
+		}
+		parts := cut(s, points)
+
+		// Drop parts inside code.
+		for _, c := range codes {
+			var next []span
+			for _, p := range parts {
+				if contains(p, c) {
+					next = append(next, p)
+					continue
+				}
+				next = append(next, subtract(p, c.start, c.end)...)
+			}
+			parts = next
+		}
+		r = append(r, parts...)
+	}
+
+	for _, c := range codes {
+		var points []int
+		for _, q := range quotes {
+			if overlaps(q, c) && !contains(q, c) {
+				points = append(points, q.start, q.end)
+			}
+		}
+		r = append(r, cut(c, points)...)
+	}
+	return r
+}
+
+// offsets returns mapping from UTF-16 offset to byte offset.
+func offsets(text string) []int {
+	r := make([]int, 0, len(text)+1)
+	for i, c := range text {
+		r = append(r, i)
+		if c >= 0x10000 && c <= utf8.MaxRune {
+			// Surrogate pair, point to the next character.
+			r = append(r, i+utf8.RuneLen(c))
+		}
+	}
+	return append(r, len(text))
+}
+
+// Options of Tokenize.
+type Options struct {
+	// QuoteLines denotes that markup requires blockquote to occupy whole lines,
+	// so line breaks around blockquotes are excluded from other entities.
+	QuoteLines bool
+}
+
+// quoteLines excludes line breaks around blockquotes from other entities.
+func quoteLines(text string, utf16 []int, spans []span) []span {
+	var gaps []span
+	for _, q := range spans {
+		if !isQuote(q.entity) {
+			continue
+		}
+		if q.start > 0 && text[utf16[q.start-1]] == '\n' {
+			gaps = append(gaps, span{start: q.start - 1, end: q.start})
+		}
+		if q.end < len(utf16)-1 && text[utf16[q.end]] == '\n' {
+			gaps = append(gaps, span{start: q.end, end: q.end + 1})
+		}
+	}
+	if len(gaps) == 0 {
+		return spans
+	}
+
+	var r []span
+	for _, s := range spans {
+		if isQuote(s.entity) {
+			r = append(r, s)
+			continue
+		}
+
+		parts := []span{s}
+		for _, g := range gaps {
+			var next []span
+			for _, p := range parts {
+				next = append(next, subtract(p, g.start, g.end)...)
+			}
+			parts = next
+		}
+		r = append(r, parts...)
+	}
+	return r
+}
+
+// Tokenize splits message text into tokens, so entities are properly nested.
+//
+// Overlapping entities are split into several entities, entities
+// nested into code are dropped.
+func Tokenize(text string, entities []tg.MessageEntityClass, opts Options) ([]Token, error) {
+	utf16 := offsets(text)
+	length := len(utf16) - 1
+
+	spans := make([]span, 0, len(entities))
+	for _, e := range entities {
+		start, end := e.GetOffset(), e.GetOffset()+e.GetLength()
+		if start < 0 || end > length || start > end {
+			return nil, errors.Errorf("entity %T [%d, %d) is out of range [0, %d)", e, start, end, length)
+		}
+		if start == end {
+			continue
+		}
+		spans = append(spans, span{start: start, end: end, entity: e})
+	}
+	spans = normalize(spans)
+	if opts.QuoteLines {
+		spans = quoteLines(text, utf16, spans)
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		a, b := spans[i], spans[j]
+		if a.start != b.start {
+			return a.start < b.start
+		}
+		if a.end != b.end {
+			return a.end > b.end
+		}
+		return rank(a.entity) < rank(b.entity)
+	})
+
+	points := make([]int, 0, 2*len(spans)+1)
+	for _, s := range spans {
+		points = append(points, s.start, s.end)
+	}
+	points = append(points, length)
+	sort.Ints(points)
+
+	var (
+		r     []Token
+		stack []span
+		pos   int
+		next  int
+	)
+	for _, p := range points {
+		if p > pos {
+			r = append(r, Token{
+				Kind:   Text,
+				Text:   text[utf16[pos]:utf16[p]],
+				Offset: utf16[pos],
+			})
+			pos = p
+		}
+
+		// Close ending entities, reopening entities above them.
+		for k := range stack {
+			if stack[k].end != p {
+				continue
+			}
+
+			for i := len(stack) - 1; i >= k; i-- {
+				r = append(r, Token{Kind: Close, Offset: utf16[p], Entity: stack[i].entity})
+			}
+			reopen := stack[k+1:]
+			stack = stack[:k:k]
+			for _, s := range reopen {
+				if s.end == p {
+					continue
+				}
+				stack = append(stack, s)
+				r = append(r, Token{Kind: Open, Offset: utf16[p], Entity: s.entity})
+			}
+			break
+		}
+
+		// Open starting entities.
+		for ; next < len(spans) && spans[next].start == p; next++ {
+			stack = append(stack, spans[next])
+			r = append(r, Token{Kind: Open, Offset: utf16[p], Entity: spans[next].entity})
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,40 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestTokenize(t *testing.T) {
+	a := require.New(t)
+
+	bold := &tg.MessageEntityBold{Offset: 0, Length: 3}
+	italic := &tg.MessageEntityItalic{Offset: 2, Length: 2}
+	code := &tg.MessageEntityCode{Offset: 4, Length: 1}
+	tokens, err := Tokenize("🏟abc", []tg.MessageEntityClass{bold, italic, code}, Options{})
+	a.NoError(err)
+	a.Equal([]Token{
+		{Kind: Open, Offset: 0, Entity: bold},
+		{Kind: Text, Text: "🏟", Offset: 0},
+		{Kind: Open, Offset: 4, Entity: italic},
+		{Kind: Text, Text: "a", Offset: 4},
+		{Kind: Close, Offset: 5, Entity: italic},
+		{Kind: Close, Offset: 5, Entity: bold},
+		{Kind: Open, Offset: 5, Entity: italic},
+		{Kind: Text, Text: "b", Offset: 5},
+		{Kind: Close, Offset: 6, Entity: italic},
+		{Kind: Open, Offset: 6, Entity: code},
+		{Kind: Text, Text: "c", Offset: 6},
+		{Kind: Close, Offset: 7, Entity: code},
+	}, tokens)
+}
+
+func TestTokenize_OutOfRange(t *testing.T) {
+	_, err := Tokenize("abc", []tg.MessageEntityClass{
+		&tg.MessageEntityBold{Offset: 2, Length: 2},
+	}, Options{})
+	require.Error(t, err)
+}
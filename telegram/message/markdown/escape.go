@@ -0,0 +1,18 @@
+package markdown
+
+import "strings"
+
+// Escape escapes all MarkdownV2 reserved characters in given string.
+//
+// See https://core.telegram.org/bots/api#markdownv2-style.
+func Escape(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c == '\\' || strings.IndexByte(reserved, c) >= 0 {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,28 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/message/entity"
+)
+
+func TestEscape(t *testing.T) {
+	a := require.New(t)
+	for _, s := range []string{
+		"",
+		"plain text",
+		"1 + 1 = 2.",
+		"_*[]()~`>#+-=|{}.!\\",
+		"➡️ [link](http://example.com)",
+	} {
+		b := entity.Builder{}
+		a.NoError(Markdown(strings.NewReader(Escape(s)), &b, Options{}))
+
+		msg, entities := b.Raw()
+		a.Equal(s, msg)
+		a.Empty(entities)
+	}
+}
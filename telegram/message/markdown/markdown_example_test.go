@@ -0,0 +1,59 @@
+package markdown_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/markdown"
+	"github.com/gotd/td/tg"
+)
+
+const botAPIExample = "*bold \\*text*\n" +
+	"_italic \\*text_\n" +
+	"__underline__\n" +
+	"~strikethrough~\n" +
+	"||spoiler||\n" +
+	"*bold _italic bold ~italic bold strikethrough ||italic bold strikethrough spoiler||~ __underline italic bold___ bold*\n" +
+	"[inline URL](http://www.example.com/)\n" +
+	"[inline mention of a user](tg://user?id=123456789)\n" +
+	"![👍](tg://emoji?id=5368324170671202286)\n" +
+	"`inline fixed-width code`\n" +
+	"```\n" +
+	"pre-formatted fixed-width code block\n" +
+	"```\n" +
+	"```python\n" +
+	"pre-formatted fixed-width code block written in the Python programming language\n" +
+	"```\n" +
+	">Block quotation started\n" +
+	">Block quotation continued\n" +
+	">The last line of the block quotation"
+
+func sendMarkdown(ctx context.Context) error {
+	client, err := telegram.ClientFromEnvironment(telegram.Options{})
+	if err != nil {
+		return err
+	}
+
+	// This example creates a styled message from BotAPI examples
+	// and sends to your Saved Messages folder.
+	// See https://core.telegram.org/bots/api#markdownv2-style.
+	return client.Run(ctx, func(ctx context.Context) error {
+		_, err := message.NewSender(tg.NewClient(client)).
+			Self().StyledText(ctx, markdown.String(nil, botAPIExample))
+		return err
+	})
+}
+
+func ExampleString() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := sendMarkdown(ctx); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,493 @@
+package markdown
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/telegram/message/internal/entitylink"
+)
+
+// reserved is a list of characters which must be escaped outside of code.
+//
+// See https://core.telegram.org/bots/api#markdownv2-style.
+const reserved = "_*[]()~`>#+-=|{}.!"
+
+type kind int
+
+const (
+	bold kind = iota
+	italic
+	underline
+	strike
+	spoiler
+	textURL
+	customEmoji
+	code
+	pre
+	preCode
+	blockquote
+)
+
+// String returns TDLib name of entity kind, used in errors.
+func (k kind) String() string {
+	switch k {
+	case bold:
+		return "Bold"
+	case italic:
+		return "Italic"
+	case underline:
+		return "Underline"
+	case strike:
+		return "Strikethrough"
+	case spoiler:
+		return "Spoiler"
+	case textURL:
+		return "TextUrl"
+	case customEmoji:
+		return "CustomEmoji"
+	case code:
+		return "Code"
+	case pre:
+		return "Pre"
+	case preCode:
+		return "PreCode"
+	case blockquote:
+		return "Blockquote"
+	default:
+		return "Unknown"
+	}
+}
+
+type stackElem struct {
+	kind  kind
+	token entity.Token
+	// offset is a byte offset of entity start in source text.
+	offset int
+	// lang is a language of PreCode entity.
+	lang string
+	// expandable denotes expandable blockquote.
+	expandable bool
+}
+
+type markdownParser struct {
+	text    []byte
+	builder *entity.Builder
+	stack   []stackElem
+	opts    Options
+}
+
+// at returns character at given position or zero, if position is out of range.
+func (p *markdownParser) at(i int) byte {
+	if i < len(p.text) {
+		return p.text[i]
+	}
+	return 0
+}
+
+// escaped reports whether character at given position is escaped.
+func (p *markdownParser) escaped(i int) bool {
+	next := p.at(i + 1)
+	return p.text[i] == '\\' && next > 0 && next <= 126
+}
+
+func (p *markdownParser) top() (stackElem, bool) {
+	if len(p.stack) < 1 {
+		return stackElem{}, false
+	}
+	return p.stack[len(p.stack)-1], true
+}
+
+func (p *markdownParser) push(e stackElem) {
+	e.token = p.builder.Token()
+	p.stack = append(p.stack, e)
+}
+
+func (p *markdownParser) pop() stackElem {
+	e := p.stack[len(p.stack)-1]
+	p.stack = p.stack[:len(p.stack)-1]
+	return e
+}
+
+// quoted reports whether blockquote is opened.
+func (p *markdownParser) quoted() bool {
+	return len(p.stack) > 0 && p.stack[0].kind == blockquote
+}
+
+func (p *markdownParser) unclosed() error {
+	e, _ := p.top()
+	return errors.Errorf("can't find end of %s entity at byte offset %d", e.kind, e.offset)
+}
+
+// closeQuote closes opened blockquote, all nested entities must be closed.
+func (p *markdownParser) closeQuote() error {
+	if e, _ := p.top(); e.kind != blockquote {
+		return p.unclosed()
+	}
+
+	e := p.pop()
+	if e.token.UTF16Length(p.builder) > 0 {
+		e.token.Apply(p.builder, entity.Blockquote())
+	}
+	return nil
+}
+
+// lineStart handles blockquote markers at the beginning of line.
+// Returns position of first character after marker.
+func (p *markdownParser) lineStart(i int) int {
+	switch {
+	case p.quoted():
+		// Continuation of blockquote, checked in newline.
+		if p.text[i] == '>' {
+			return i + 1
+		}
+	case len(p.stack) == 0:
+		if p.text[i] == '>' {
+			p.push(stackElem{kind: blockquote, offset: i})
+			return i + 1
+		}
+		if bytes.HasPrefix(p.text[i:], []byte("**>")) {
+			p.push(stackElem{kind: blockquote, offset: i, expandable: true})
+			return i + 3
+		}
+	}
+	return i
+}
+
+// newline closes blockquote, if next line does not continue it.
+func (p *markdownParser) newline(i int) error {
+	if !p.quoted() || p.at(i+1) == '>' {
+		return nil
+	}
+	return p.closeQuote()
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\r', '\n', '\v', 0:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *markdownParser) isEnd(e stackElem, i int) bool {
+	c := p.text[i]
+	switch e.kind {
+	case bold:
+		return c == '*'
+	case italic:
+		return c == '_' && p.at(i+1) != '_'
+	case underline:
+		return c == '_' && p.at(i+1) == '_'
+	case strike:
+		return c == '~'
+	case spoiler:
+		return c == '|' && p.at(i+1) == '|'
+	case textURL, customEmoji:
+		return c == ']'
+	case code:
+		return c == '`'
+	case pre, preCode:
+		return c == '`' && p.at(i+1) == '`' && p.at(i+2) == '`'
+	default:
+		return false
+	}
+}
+
+func reservedError(c byte) error {
+	return errors.Errorf("character %q is reserved and must be escaped with the preceding '\\'", c)
+}
+
+// startEntity opens new entity at given position.
+// Returns position of last character of entity start.
+func (p *markdownParser) startEntity(i int) (int, error) {
+	e := stackElem{offset: i}
+
+	switch c := p.text[i]; c {
+	case '_':
+		e.kind = italic
+		if p.at(i+1) == '_' {
+			e.kind = underline
+			i++
+		}
+	case '*':
+		e.kind = bold
+	case '~':
+		e.kind = strike
+	case '|':
+		if p.at(i+1) != '|' {
+			return 0, reservedError(c)
+		}
+		e.kind = spoiler
+		i++
+	case '[':
+		e.kind = textURL
+	case '!':
+		if p.at(i+1) != '[' {
+			return 0, reservedError(c)
+		}
+		e.kind = customEmoji
+		i++
+	case '`':
+		if p.at(i+1) != '`' || p.at(i+2) != '`' {
+			e.kind = code
+			break
+		}
+
+		i += 3
+		e.kind = pre
+		// Language is a first word after backticks, if it is not the only word.
+		end := i
+		for end < len(p.text) && !isSpace(p.text[end]) && p.text[end] != '`' {
+			end++
+		}
+		if i != end && end < len(p.text) && p.text[end] != '`' {
+			e.kind = preCode
+			e.lang = string(p.text[i:end])
+			i = end
+		}
+
+		// Skip one new line at the beginning of the text.
+		if c := p.at(i); c == '\n' || c == '\r' {
+			if next := p.at(i + 1); (next == '\n' || next == '\r') && c != next {
+				i += 2
+			} else {
+				i++
+			}
+		}
+		i--
+	default:
+		return 0, reservedError(c)
+	}
+
+	p.push(e)
+	return i, nil
+}
+
+// readURL reads URL until closing parenthesis.
+// Returns position of closing parenthesis.
+func (p *markdownParser) readURL(i int) (string, int, bool) {
+	var b strings.Builder
+	for i < len(p.text) && p.text[i] != ')' {
+		if p.escaped(i) {
+			b.WriteByte(p.text[i+1])
+			i += 2
+			continue
+		}
+		b.WriteByte(p.text[i])
+		i++
+	}
+	return b.String(), i, i < len(p.text)
+}
+
+func customEmojiID(rawURL string) (int64, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, errors.Wrap(err, "parse custom emoji URL")
+	}
+	if !strings.EqualFold(u.Scheme, "tg") {
+		return 0, errors.New("custom emoji URL must have scheme tg")
+	}
+	if !strings.EqualFold(u.Host, "emoji") || (u.Path != "" && u.Path != "/") {
+		return 0, errors.New(`custom emoji URL must have host "emoji"`)
+	}
+
+	rawID := u.Query().Get("id")
+	if rawID == "" {
+		return 0, errors.New("custom emoji URL must have an emoji identifier")
+	}
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id <= 0 || rawID[0] == '0' {
+		return 0, errors.New("invalid custom emoji identifier specified")
+	}
+	return id, nil
+}
+
+// endEntity closes top entity at given position.
+// Returns position of last character of entity end.
+func (p *markdownParser) endEntity(i int) (int, error) {
+	e := p.pop()
+	skip := e.token.UTF16Length(p.builder) == 0
+
+	var f entity.Formatter
+	switch e.kind {
+	case bold:
+		f = entity.Bold()
+	case italic:
+		f = entity.Italic()
+	case underline:
+		f = entity.Underline()
+		i++
+	case strike:
+		f = entity.Strike()
+	case spoiler:
+		f = entity.Spoiler()
+		i++
+	case code:
+		f = entity.Code()
+	case pre, preCode:
+		f = entity.Pre(e.lang)
+		i += 2
+	case textURL:
+		var link string
+		if p.at(i+1) == '(' {
+			begin := i + 2
+
+			var ok bool
+			link, i, ok = p.readURL(begin)
+			if !ok {
+				return 0, errors.Errorf("can't find end of a URL at byte offset %d", begin)
+			}
+		} else {
+			// Use text as a URL.
+			link = e.token.Text(p.builder)
+		}
+
+		formatter, err := entitylink.Formatter(link, p.opts.UserResolver)
+		if err != nil {
+			// Invalid links are ignored.
+			skip = true
+		}
+		f = formatter
+	case customEmoji:
+		if p.at(i+1) != '(' {
+			return 0, errors.New("custom emoji entity must contain a tg://emoji URL")
+		}
+		begin := i + 2
+
+		link, end, ok := p.readURL(begin)
+		if !ok {
+			return 0, errors.Errorf("can't find end of a custom emoji URL at byte offset %d", begin)
+		}
+		id, err := customEmojiID(link)
+		if err != nil {
+			return 0, err
+		}
+		f = entity.CustomEmoji(id)
+		i = end
+	}
+
+	if !skip {
+		e.token.Apply(p.builder, f)
+	}
+	return i, nil
+}
+
+func (p *markdownParser) isCode() bool {
+	e, ok := p.top()
+	return ok && (e.kind == code || e.kind == pre || e.kind == preCode)
+}
+
+func (p *markdownParser) isPlain(c byte) bool {
+	if c == '\\' || c == '\n' {
+		return false
+	}
+	if p.isCode() {
+		return c != '`'
+	}
+	return strings.IndexByte(reserved, c) < 0
+}
+
+func (p *markdownParser) parse() error {
+	for i := 0; i < len(p.text); i++ {
+		if i == 0 || p.text[i-1] == '\n' {
+			if i = p.lineStart(i); i >= len(p.text) {
+				break
+			}
+		}
+
+		c := p.text[i]
+		switch {
+		case p.escaped(i):
+			i++
+			_ = p.builder.WriteByte(p.text[i])
+			continue
+		case c == '\n':
+			if err := p.newline(i); err != nil {
+				return err
+			}
+			_ = p.builder.WriteByte(c)
+			continue
+		case p.isPlain(c):
+			// Write all plain text until next special character.
+			end := i + 1
+			for end < len(p.text) && p.isPlain(p.text[end]) {
+				end++
+			}
+			_, _ = p.builder.Write(p.text[i:end])
+			i = end - 1
+			continue
+		}
+
+		// Backslash which does not escape anything.
+		if c == '\\' {
+			_ = p.builder.WriteByte(c)
+			continue
+		}
+
+		e, ok := p.top()
+		if ok && e.kind == blockquote && e.expandable &&
+			c == '|' && p.at(i+1) == '|' && (p.at(i+2) == '\n' || i+2 == len(p.text)) {
+			// End of expandable blockquote.
+			if err := p.closeQuote(); err != nil {
+				return err
+			}
+			i++
+			continue
+		}
+
+		var err error
+		if ok && p.isEnd(e, i) {
+			i, err = p.endEntity(i)
+		} else {
+			i, err = p.startEntity(i)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.quoted() {
+		if err := p.closeQuote(); err != nil {
+			return err
+		}
+	}
+	if len(p.stack) > 0 {
+		return p.unclosed()
+	}
+	return nil
+}
+
+// Markdown parses given MarkdownV2 input from reader and adds parsed entities to given builder.
+//
+// Parameter userResolver is used to resolve user by ID during formatting. May be nil.
+// If userResolver is nil, formatter will create tg.InputUser using only ID.
+// Notice that it's okay for bots, but not for users.
+//
+// Expandable blockquotes are parsed as regular blockquotes.
+//
+// See https://core.telegram.org/bots/api#markdownv2-style.
+func Markdown(r io.Reader, b *entity.Builder, opts Options) error {
+	opts.setDefaults()
+
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return errors.Wrap(err, "read")
+	}
+	p := markdownParser{
+		text:    text,
+		builder: b,
+		opts:    opts,
+	}
+
+	if err := p.parse(); err != nil {
+		return errors.Wrap(err, "parse")
+	}
+	return nil
+}
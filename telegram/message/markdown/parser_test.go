@@ -0,0 +1,120 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/tg"
+)
+
+type markdownTestCase struct {
+	markdown string
+	msg      string
+	entities []tg.MessageEntityClass
+	// err is expected error message, if not empty.
+	err string
+}
+
+func runTests(t *testing.T, tests []markdownTestCase) {
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			t.Cleanup(func() {
+				if t.Failed() {
+					t.Logf("Input: %q", test.markdown)
+				}
+			})
+			a := require.New(t)
+			b := entity.Builder{}
+
+			err := Markdown(strings.NewReader(test.markdown), &b, Options{})
+			if test.err != "" {
+				a.ErrorContains(err, test.err)
+				return
+			}
+			a.NoError(err)
+
+			msg, entities := b.Raw()
+			a.Equal(test.msg, msg)
+			if len(test.entities) == 0 {
+				a.Empty(entities)
+				return
+			}
+			a.ElementsMatch(test.entities, entities)
+		})
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	t.Run("Common", func(t *testing.T) {
+		runTests(t, []markdownTestCase{
+			{markdown: "*bold \\*text*", msg: "bold *text", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 0, Length: 10},
+			}},
+			{markdown: "_italic \\*text_", msg: "italic *text", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityItalic{Offset: 0, Length: 12},
+			}},
+			{markdown: "__underline__", msg: "underline", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityUnderline{Offset: 0, Length: 9},
+			}},
+			{markdown: "~strikethrough~", msg: "strikethrough", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityStrike{Offset: 0, Length: 13},
+			}},
+			{markdown: "||spoiler||", msg: "spoiler", entities: []tg.MessageEntityClass{
+				&tg.MessageEntitySpoiler{Offset: 0, Length: 7},
+			}},
+			{
+				markdown: "*bold _italic bold ~italic bold strikethrough ||italic bold strikethrough spoiler||~ __underline italic bold___ bold*",
+				msg:      "bold italic bold italic bold strikethrough italic bold strikethrough spoiler underline italic bold bold",
+				entities: []tg.MessageEntityClass{
+					&tg.MessageEntityBold{Offset: 0, Length: 103},
+					&tg.MessageEntityItalic{Offset: 5, Length: 93},
+					&tg.MessageEntityStrike{Offset: 17, Length: 59},
+					&tg.MessageEntitySpoiler{Offset: 43, Length: 33},
+					&tg.MessageEntityUnderline{Offset: 77, Length: 21},
+				},
+			},
+			{markdown: "[inline URL](http://www.example.com/)", msg: "inline URL", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityTextURL{Offset: 0, Length: 10, URL: "http://www.example.com/"},
+			}},
+			{markdown: "[inline mention of a user](tg://user?id=123456789)", msg: "inline mention of a user", entities: []tg.MessageEntityClass{
+				&tg.InputMessageEntityMentionName{Offset: 0, Length: 24, UserID: &tg.InputUser{UserID: 123456789}},
+			}},
+			{markdown: "![👍](tg://emoji?id=5368324170671202286)", msg: "👍", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityCustomEmoji{Offset: 0, Length: 2, DocumentID: 5368324170671202286},
+			}},
+			{markdown: "`inline fixed-width code`", msg: "inline fixed-width code", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityCode{Offset: 0, Length: 23},
+			}},
+			{markdown: "```\npre-formatted fixed-width code block\n```", msg: "pre-formatted fixed-width code block\n", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityPre{Offset: 0, Length: 37},
+			}},
+			{markdown: "```python\nprint(1)\n```", msg: "print(1)\n", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityPre{Offset: 0, Length: 9, Language: "python"},
+			}},
+			{markdown: ">Block quotation started\n>Block quotation continued\nText", msg: "Block quotation started\nBlock quotation continued\nText", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 0, Length: 49},
+			}},
+			{markdown: "**>Expandable\n>quotation||\n>Another *one*", msg: "Expandable\nquotation\nAnother one", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 0, Length: 20},
+				&tg.MessageEntityBlockquote{Offset: 21, Length: 11},
+				&tg.MessageEntityBold{Offset: 29, Length: 3},
+			}},
+			{markdown: "a > b", err: "character '>' is reserved"},
+			{markdown: ">*quote\n>end*", msg: "quote\nend", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 0, Length: 9},
+				&tg.MessageEntityBold{Offset: 0, Length: 9},
+			}},
+			{markdown: ">*quote\nend*", err: "can't find end of Bold entity at byte offset 1"},
+			{markdown: "`a > b`", msg: "a > b", entities: []tg.MessageEntityClass{
+				&tg.MessageEntityCode{Offset: 0, Length: 5},
+			}},
+		})
+	})
+	t.Run("TDLib", func(t *testing.T) {
+		runTests(t, tdlibMarkdownTests())
+	})
+}
@@ -0,0 +1,232 @@
+package markdown
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gotd/td/telegram/message/internal/render"
+	"github.com/gotd/td/tg"
+)
+
+type markdownRenderer struct {
+	out   strings.Builder
+	stack []tg.MessageEntityClass
+	// skip contains entities which can't be rendered.
+	skip map[tg.MessageEntityClass]struct{}
+	// underscore denotes that last written markup ends with '_'.
+	underscore bool
+	// preStart denotes that nothing is written since pre start.
+	preStart bool
+}
+
+func (r *markdownRenderer) inCode() bool {
+	for _, e := range r.stack {
+		if render.IsCode(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *markdownRenderer) inQuote() bool {
+	for _, e := range r.stack {
+		if _, ok := e.(*tg.MessageEntityBlockquote); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// markup writes entity markup.
+func (r *markdownRenderer) markup(s string) {
+	if r.underscore && strings.HasPrefix(s, "_") {
+		// Separate italic and underline markup using empty entity,
+		// otherwise "___" is ambiguous.
+		sep := "**"
+		if len(r.stack) > 0 {
+			if _, ok := r.stack[len(r.stack)-1].(*tg.MessageEntityBold); ok {
+				sep = "~~"
+			}
+		}
+		r.out.WriteString(sep)
+	}
+	r.out.WriteString(s)
+	r.underscore = strings.HasSuffix(s, "_")
+}
+
+func (r *markdownRenderer) writeText(s string) {
+	if s == "" {
+		return
+	}
+	r.underscore = false
+
+	code := r.inCode()
+	quote := r.inQuote()
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case code && r.preStart && c == '\r':
+			// Parser skips new line at the beginning of pre.
+			r.out.WriteByte('\\')
+		case code:
+			if c == '`' || c == '\\' {
+				r.out.WriteByte('\\')
+			}
+		case c == '\\' || strings.IndexByte(reserved, c) >= 0:
+			r.out.WriteByte('\\')
+		}
+		r.preStart = false
+
+		r.out.WriteByte(c)
+		if c == '\n' && quote {
+			r.out.WriteByte('>')
+		}
+	}
+}
+
+// quoteRenderable reports whether blockquote occupies whole lines.
+func quoteRenderable(text string, start, end int) bool {
+	return (start == 0 || text[start-1] == '\n') &&
+		(end == len(text) || text[end] == '\n')
+}
+
+func (r *markdownRenderer) open(t render.Token) {
+	e := t.Entity
+	switch e := e.(type) {
+	case *tg.MessageEntityBold:
+		r.markup("*")
+	case *tg.MessageEntityItalic:
+		r.markup("_")
+	case *tg.MessageEntityUnderline:
+		r.markup("__")
+	case *tg.MessageEntityStrike:
+		r.markup("~")
+	case *tg.MessageEntitySpoiler:
+		r.markup("||")
+	case *tg.MessageEntityCode:
+		r.markup("`")
+	case *tg.MessageEntityPre:
+		lang := e.Language
+		if strings.ContainsAny(lang, " \t\r\n`") {
+			lang = ""
+		}
+		// New line is required to separate language from code.
+		r.markup("```" + lang + "\n")
+		if r.inQuote() {
+			r.out.WriteByte('>')
+		}
+		r.preStart = true
+	case *tg.MessageEntityTextURL:
+		r.markup("[")
+	case *tg.MessageEntityCustomEmoji:
+		r.markup("![")
+	case *tg.MessageEntityMentionName, *tg.InputMessageEntityMentionName:
+		if _, ok := render.MentionID(e); !ok {
+			r.skip[e] = struct{}{}
+			return
+		}
+		r.markup("[")
+	case *tg.MessageEntityBlockquote:
+		if _, ok := r.skip[e]; ok {
+			return
+		}
+		r.markup(">")
+	default:
+		// Entities like mentions or URLs are detected by server.
+		r.skip[e] = struct{}{}
+		return
+	}
+	r.stack = append(r.stack, e)
+}
+
+func escapeURL(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func (r *markdownRenderer) close(t render.Token) {
+	e := t.Entity
+	if _, ok := r.skip[e]; ok {
+		return
+	}
+	r.stack = r.stack[:len(r.stack)-1]
+
+	switch e := e.(type) {
+	case *tg.MessageEntityBold:
+		r.markup("*")
+	case *tg.MessageEntityItalic:
+		r.markup("_")
+	case *tg.MessageEntityUnderline:
+		r.markup("__")
+	case *tg.MessageEntityStrike:
+		r.markup("~")
+	case *tg.MessageEntitySpoiler:
+		r.markup("||")
+	case *tg.MessageEntityCode:
+		r.markup("`")
+	case *tg.MessageEntityPre:
+		r.markup("```")
+	case *tg.MessageEntityTextURL:
+		r.markup("](" + escapeURL(e.URL) + ")")
+	case *tg.MessageEntityCustomEmoji:
+		r.markup("](tg://emoji?id=" + strconv.FormatInt(e.DocumentID, 10) + ")")
+	case *tg.MessageEntityMentionName, *tg.InputMessageEntityMentionName:
+		id, _ := render.MentionID(e)
+		r.markup("](tg://user?id=" + strconv.FormatInt(id, 10) + ")")
+	case *tg.MessageEntityBlockquote:
+		r.underscore = false
+	}
+}
+
+// Render renders message text and entities to MarkdownV2.
+//
+// Overlapping entities are split, so result is valid MarkdownV2
+// which can be parsed back using Markdown. Entities nested into code
+// are dropped, blockquotes which do not occupy whole lines are ignored.
+// Entities which are detected by server (mentions, hashtags, URLs, etc.)
+// are rendered as plain text.
+func Render(text string, entities []tg.MessageEntityClass) (string, error) {
+	tokens, err := render.Tokenize(text, entities, render.Options{QuoteLines: true})
+	if err != nil {
+		return "", err
+	}
+
+	r := markdownRenderer{
+		skip: map[tg.MessageEntityClass]struct{}{},
+	}
+	r.out.Grow(len(text))
+
+	// Blockquotes are never split, so find their ends.
+	quotes := map[tg.MessageEntityClass]int{}
+	for _, t := range tokens {
+		if _, ok := t.Entity.(*tg.MessageEntityBlockquote); !ok {
+			continue
+		}
+		switch t.Kind {
+		case render.Open:
+			quotes[t.Entity] = t.Offset
+		case render.Close:
+			if !quoteRenderable(text, quotes[t.Entity], t.Offset) {
+				r.skip[t.Entity] = struct{}{}
+			}
+		}
+	}
+
+	for _, t := range tokens {
+		switch t.Kind {
+		case render.Text:
+			r.writeText(t.Text)
+		case render.Open:
+			r.open(t)
+		case render.Close:
+			r.close(t)
+		}
+	}
+	return r.out.String(), nil
+}
@@ -0,0 +1,195 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/message/entity"
+	"github.com/gotd/td/tg"
+)
+
+func parse(t testing.TB, s string) (string, []tg.MessageEntityClass) {
+	t.Helper()
+
+	b := entity.Builder{}
+	require.NoError(t, Markdown(strings.NewReader(s), &b, Options{}))
+	return b.Raw()
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		msg      string
+		entities []tg.MessageEntityClass
+		output   string
+		// expected entities after parsing, equal to given if nil.
+		parsed []tg.MessageEntityClass
+	}{
+		{
+			msg:    "1 + 1 = 2.",
+			output: "1 \\+ 1 \\= 2\\.",
+		},
+		{
+			msg: "🏟 bold",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 3, Length: 4},
+			},
+			output: "🏟 *bold*",
+		},
+		{
+			msg: "bold italic",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 0, Length: 8},
+				&tg.MessageEntityItalic{Offset: 5, Length: 6},
+			},
+			output: "*bold _ita_*_lic_",
+			parsed: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 0, Length: 8},
+				&tg.MessageEntityItalic{Offset: 5, Length: 3},
+				&tg.MessageEntityItalic{Offset: 8, Length: 3},
+			},
+		},
+		{
+			msg: "italic underline",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityUnderline{Offset: 0, Length: 16},
+				&tg.MessageEntityItalic{Offset: 7, Length: 9},
+			},
+			output: "__italic _underline_**__",
+		},
+		{
+			msg: "spoiler link",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntitySpoiler{Offset: 0, Length: 12},
+				&tg.MessageEntityTextURL{Offset: 8, Length: 4, URL: "https://example.com/(a)"},
+			},
+			output: "||spoiler [link](https://example.com/(a\\))||",
+		},
+		{
+			msg: "user",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityMentionName{Offset: 0, Length: 4, UserID: 10},
+			},
+			output: "[user](tg://user?id=10)",
+			parsed: []tg.MessageEntityClass{
+				&tg.InputMessageEntityMentionName{Offset: 0, Length: 4, UserID: &tg.InputUser{UserID: 10}},
+			},
+		},
+		{
+			msg: "👍!",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityCustomEmoji{Offset: 0, Length: 2, DocumentID: 10},
+			},
+			output: "![👍](tg://emoji?id=10)\\!",
+		},
+		{
+			msg: "code: `a`\\",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityPre{Offset: 6, Length: 4, Language: "go"},
+				&tg.MessageEntityBold{Offset: 0, Length: 8},
+			},
+			output: "*code: *```go\n\\`a\\`\\\\```",
+			parsed: []tg.MessageEntityClass{
+				&tg.MessageEntityPre{Offset: 6, Length: 4, Language: "go"},
+				&tg.MessageEntityBold{Offset: 0, Length: 6},
+			},
+		},
+		{
+			msg: "\nline",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityPre{Offset: 0, Length: 5},
+			},
+			output: "```\n\nline```",
+		},
+		{
+			msg: "text\nquote\nquote\ntext",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 5, Length: 11},
+				&tg.MessageEntityBold{Offset: 0, Length: 8},
+			},
+			output: "*text*\n>*quo*te\n>quote\ntext",
+			parsed: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 5, Length: 11},
+				&tg.MessageEntityBold{Offset: 0, Length: 4},
+				&tg.MessageEntityBold{Offset: 5, Length: 3},
+			},
+		},
+		{
+			msg: "quote\ntext",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 0, Length: 5},
+				&tg.MessageEntityBold{Offset: 3, Length: 7},
+			},
+			output: ">quo*te*\n*text*",
+			parsed: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 0, Length: 5},
+				&tg.MessageEntityBold{Offset: 3, Length: 2},
+				&tg.MessageEntityBold{Offset: 6, Length: 4},
+			},
+		},
+		{
+			msg: "not a quote",
+			entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBlockquote{Offset: 4, Length: 1},
+				&tg.MessageEntityMention{Offset: 0, Length: 3},
+			},
+			output: "not a quote",
+			parsed: []tg.MessageEntityClass{},
+		},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			output, err := Render(test.msg, test.entities)
+			a.NoError(err)
+			a.Equal(test.output, output)
+
+			msg, entities := parse(t, output)
+			a.Equal(test.msg, msg)
+			expected := test.entities
+			if test.parsed != nil {
+				expected = test.parsed
+			}
+			a.ElementsMatch(expected, entities)
+		})
+	}
+}
+
+func TestRender_RoundTrip(t *testing.T) {
+	inputs := []string{
+		"*bold _italic bold ~italic bold strikethrough ||italic bold strikethrough spoiler||~ __underline italic bold___ bold*",
+		"[inline URL](http://www.example.com/) and [inline mention of a user](tg://user?id=123456789)",
+		"![👍](tg://emoji?id=5368324170671202286) `inline fixed-width code`",
+		"```python\nprint(1)\n```\n>Block quotation started\n>Block quotation continued\n>The last line of the block quotation",
+		">\\~```go\n>>[```",
+	}
+	for _, test := range tdlibMarkdownTests() {
+		if test.err == "" {
+			inputs = append(inputs, test.markdown)
+		}
+	}
+
+	for i, input := range inputs {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			msg, entities := parse(t, input)
+			output, err := Render(msg, entities)
+			a.NoError(err)
+
+			renderedMsg, renderedEntities := parse(t, output)
+			a.Equal(msg, renderedMsg, output)
+			a.ElementsMatch(entities, renderedEntities, output)
+		})
+	}
+}
+
+func TestRender_OutOfRange(t *testing.T) {
+	_, err := Render("text", []tg.MessageEntityClass{
+		&tg.MessageEntityBold{Offset: 2, Length: 3},
+	})
+	require.Error(t, err)
+}
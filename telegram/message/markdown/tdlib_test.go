@@ -0,0 +1,155 @@
+package markdown
+
+import "github.com/gotd/td/tg"
+
+// tdlibMarkdownTests returns MarkdownV2 conformance tests, adapted
+// from TDLib test/message_entities.cpp.
+func tdlibMarkdownTests() []markdownTestCase {
+	entities := func(e ...tg.MessageEntityClass) []tg.MessageEntityClass {
+		return e
+	}
+	return []markdownTestCase{
+		// Errors.
+		{markdown: "🏟 🏟_abacaba", err: "can't find end of Italic entity at byte offset 9"},
+		{markdown: "🏟 🏟_abac * asd ", err: "can't find end of Bold entity at byte offset 15"},
+		{markdown: "🏟 🏟_abac * asd _", err: "can't find end of Italic entity at byte offset 21"},
+		{markdown: "🏟 🏟`", err: "can't find end of Code entity at byte offset 9"},
+		{markdown: "🏟 🏟```", err: "can't find end of Pre entity at byte offset 9"},
+		{markdown: "🏟 🏟```a", err: "can't find end of Pre entity at byte offset 9"},
+		{markdown: "🏟 🏟```a ", err: "can't find end of PreCode entity at byte offset 9"},
+		{markdown: "🏟 🏟__🏟 🏟_", err: "can't find end of Italic entity at byte offset 20"},
+		{markdown: "🏟 🏟_🏟 🏟__", err: "can't find end of Underline entity at byte offset 19"},
+		{markdown: "🏟 🏟```🏟 🏟`", err: "can't find end of Code entity at byte offset 21"},
+		{markdown: "🏟 🏟```🏟 🏟_", err: "can't find end of PreCode entity at byte offset 9"},
+		{markdown: "🏟 🏟```🏟 🏟\\`", err: "can't find end of PreCode entity at byte offset 9"},
+		{markdown: "[telegram\\.org](asd\\)", err: "can't find end of a URL at byte offset 16"},
+		{markdown: "[telegram\\.org](", err: "can't find end of a URL at byte offset 16"},
+		{markdown: "[telegram\\.org](asd", err: "can't find end of a URL at byte offset 16"},
+		{markdown: "🏟 🏟__🏟 _🏟___", err: "can't find end of Italic entity at byte offset 23"},
+		{markdown: "🏟 🏟__", err: "can't find end of Underline entity at byte offset 9"},
+		{markdown: "🏟 🏟||test\\|", err: "can't find end of Spoiler entity at byte offset 9"},
+		{markdown: "🏟 🏟!", err: "character '!' is reserved and must be escaped with the preceding '\\'"},
+		{markdown: "🏟 🏟![", err: "can't find end of CustomEmoji entity at byte offset 9"},
+		{markdown: "🏟 🏟![👍", err: "can't find end of CustomEmoji entity at byte offset 9"},
+		{markdown: "🏟 🏟![👍]", err: "custom emoji entity must contain a tg://emoji URL"},
+		{markdown: "🏟 🏟![👍](tg://emoji?id=1234", err: "can't find end of a custom emoji URL at byte offset 17"},
+		{markdown: "🏟 🏟![👍](t://emoji?id=1234)", err: "custom emoji URL must have scheme tg"},
+		{markdown: "🏟 🏟![👍](tg:emojis?id=1234)", err: `custom emoji URL must have host "emoji"`},
+		{markdown: "🏟 🏟![👍](tg://emoji#test)", err: "custom emoji URL must have an emoji identifier"},
+		{markdown: "🏟 🏟![👍](tg://emoji?test=1#&id=25)", err: "custom emoji URL must have an emoji identifier"},
+		{markdown: "🏟 🏟![👍](tg://emoji?test=1231&id=025)", err: "invalid custom emoji identifier specified"},
+		{markdown: "🏟 🏟|", err: "character '|' is reserved and must be escaped with the preceding '\\'"},
+		{markdown: "🏟 🏟.", err: "character '.' is reserved and must be escaped with the preceding '\\'"},
+
+		// Escaping.
+		{markdown: "", msg: ""},
+		{markdown: "\\\\", msg: "\\"},
+		{markdown: "\\\\\\", msg: "\\\\"},
+		{markdown: "\\\\\\\\\\_\\*\\`", msg: "\\\\_*`"},
+		{markdown: "➡️ ➡️", msg: "➡️ ➡️"},
+		{markdown: "\\\\\\a\\b\\c\\d\\e\\f\\1\\2\\3\\4\\➡️\\", msg: "\\abcdef1234\\➡️\\"},
+
+		// Entities.
+		{markdown: "🏟 🏟``", msg: "🏟 🏟"},
+		{markdown: "🏟 🏟_abac \\* asd _", msg: "🏟 🏟abac * asd ", entities: entities(
+			&tg.MessageEntityItalic{Offset: 5, Length: 11},
+		)},
+		{markdown: "🏟 \\.🏟_🏟\\. 🏟_", msg: "🏟 .🏟🏟. 🏟", entities: entities(
+			&tg.MessageEntityItalic{Offset: 6, Length: 6},
+		)},
+		{markdown: "➡️ ➡️_➡️ ➡️_", msg: "➡️ ➡️➡️ ➡️", entities: entities(
+			&tg.MessageEntityItalic{Offset: 5, Length: 5},
+		)},
+		{markdown: "➡️ ➡️_➡️ ➡️_*➡️ ➡️*", msg: "➡️ ➡️➡️ ➡️➡️ ➡️", entities: entities(
+			&tg.MessageEntityItalic{Offset: 5, Length: 5},
+			&tg.MessageEntityBold{Offset: 10, Length: 5},
+		)},
+		{markdown: "🏟 🏟_🏟 \\.🏟_", msg: "🏟 🏟🏟 .🏟", entities: entities(
+			&tg.MessageEntityItalic{Offset: 5, Length: 6},
+		)},
+		{markdown: "🏟 🏟_🏟 *🏟*_", msg: "🏟 🏟🏟 🏟", entities: entities(
+			&tg.MessageEntityItalic{Offset: 5, Length: 5},
+			&tg.MessageEntityBold{Offset: 8, Length: 2},
+		)},
+		{markdown: "🏟 🏟_🏟 __🏟___", msg: "🏟 🏟🏟 🏟", entities: entities(
+			&tg.MessageEntityItalic{Offset: 5, Length: 5},
+			&tg.MessageEntityUnderline{Offset: 8, Length: 2},
+		)},
+		{markdown: "🏟 🏟__🏟 _🏟_ __", msg: "🏟 🏟🏟 🏟 ", entities: entities(
+			&tg.MessageEntityUnderline{Offset: 5, Length: 6},
+			&tg.MessageEntityItalic{Offset: 8, Length: 2},
+		)},
+		{markdown: "🏟 🏟__🏟 _🏟_\\___", msg: "🏟 🏟🏟 🏟_", entities: entities(
+			&tg.MessageEntityUnderline{Offset: 5, Length: 6},
+			&tg.MessageEntityItalic{Offset: 8, Length: 2},
+		)},
+		{markdown: "🏟 🏟`🏟 🏟```", msg: "🏟 🏟🏟 🏟", entities: entities(
+			&tg.MessageEntityCode{Offset: 5, Length: 5},
+		)},
+		{markdown: "🏟 🏟```🏟 🏟```", msg: "🏟 🏟 🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 3, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟```🏟\n🏟```", msg: "🏟 🏟🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 2, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟```🏟\r🏟```", msg: "🏟 🏟🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 2, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟```🏟\n\r🏟```", msg: "🏟 🏟🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 2, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟```🏟\r\n🏟```", msg: "🏟 🏟🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 2, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟```🏟\n\n🏟```", msg: "🏟 🏟\n🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 3, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟```🏟\r\r🏟```", msg: "🏟 🏟\r🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 3, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟```🏟 \\\\\\`🏟```", msg: "🏟 🏟 \\`🏟", entities: entities(
+			&tg.MessageEntityPre{Offset: 5, Length: 5, Language: "🏟"},
+		)},
+		{markdown: "🏟 🏟**", msg: "🏟 🏟"},
+		{markdown: "||test||", msg: "test", entities: entities(
+			&tg.MessageEntitySpoiler{Offset: 0, Length: 4},
+		)},
+		{markdown: "🏟 🏟``````", msg: "🏟 🏟"},
+		{markdown: "🏟 🏟____", msg: "🏟 🏟"},
+		{markdown: "`_* *_`__*` `*__", msg: "_* *_ ", entities: entities(
+			&tg.MessageEntityCode{Offset: 0, Length: 5},
+			&tg.MessageEntityCode{Offset: 5, Length: 1},
+			&tg.MessageEntityBold{Offset: 5, Length: 1},
+			&tg.MessageEntityUnderline{Offset: 5, Length: 1},
+		)},
+		{markdown: "```\n```", msg: ""},
+
+		// Links.
+		{markdown: "[](telegram.org)", msg: ""},
+		{markdown: "[ ](telegram.org)", msg: " ", entities: entities(
+			&tg.MessageEntityTextURL{Offset: 0, Length: 1, URL: "http://telegram.org/"},
+		)},
+		{markdown: "[telegram\\.org]", msg: "telegram.org", entities: entities(
+			&tg.MessageEntityTextURL{Offset: 0, Length: 12, URL: "http://telegram.org/"},
+		)},
+		{markdown: "[telegram\\.org]a", msg: "telegram.orga", entities: entities(
+			&tg.MessageEntityTextURL{Offset: 0, Length: 12, URL: "http://telegram.org/"},
+		)},
+		{markdown: "[telegram\\.org](telegram.dog)", msg: "telegram.org", entities: entities(
+			&tg.MessageEntityTextURL{Offset: 0, Length: 12, URL: "http://telegram.dog/"},
+		)},
+		{markdown: "[telegram\\.org](https://telegram.dog?\\\\\\()", msg: "telegram.org", entities: entities(
+			&tg.MessageEntityTextURL{Offset: 0, Length: 12, URL: "https://telegram.dog?\\("},
+		)},
+		{markdown: "[telegram\\.org]()", msg: "telegram.org"},
+		{markdown: "[telegram\\.org](tg://user?id=123456)", msg: "telegram.org", entities: entities(
+			&tg.InputMessageEntityMentionName{Offset: 0, Length: 12, UserID: &tg.InputUser{UserID: 123456}},
+		)},
+
+		// Custom emoji.
+		{markdown: "🏟 🏟![👍](TG://EMoJI/?test=1231&id=25#id=32)a", msg: "🏟 🏟👍a", entities: entities(
+			&tg.MessageEntityCustomEmoji{Offset: 5, Length: 2, DocumentID: 25},
+		)},
+		{markdown: "🏟 🏟![](tg://emoji?id=25)a", msg: "🏟 🏟a"},
+	}
+}
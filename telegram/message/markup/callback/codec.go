@@ -0,0 +1,312 @@
+// Package callback contains typed callback data codec for inline keyboards.
+//
+// Encoded data has the following layout:
+//
+//	prefix | tag | body | signature
+//
+// Prefix identifies codec, tag is an ID of payload type, body is a payload
+// encoded using bare TL primitives and signature is an optional truncated
+// HMAC-SHA256 of preceding bytes. If data does not fit into button, body
+// is saved to Store and replaced by key, highest bit of tag is set.
+package callback
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"reflect"
+	"sync"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram/handlers"
+	"github.com/gotd/td/tg"
+)
+
+// MaxDataSize is a maximum size of callback data.
+const MaxDataSize = 64
+
+const (
+	// storedFlag is set in tag if body is saved to Store.
+	storedFlag = 0x80
+	// keySize is a size of Store key.
+	keySize = 16
+)
+
+var (
+	// ErrForeign is returned if data is not encoded by Codec
+	// or payload type is unknown.
+	ErrForeign = errors.New("data is not encoded by codec")
+	// ErrSignature is returned if signature of data is invalid.
+	ErrSignature = errors.New("invalid signature")
+	// ErrNotFound is returned if data saved to Store is not found.
+	ErrNotFound = errors.New("stored data not found")
+	// ErrTooBig is returned if encoded data exceeds MaxDataSize
+	// and Store is not set.
+	ErrTooBig = errors.New("data is too big")
+)
+
+// Options of Codec.
+type Options struct {
+	// Prefix is a first byte of encoded data.
+	//
+	// Change it to invalidate buttons with old payloads, for example,
+	// after incompatible change of payload structure.
+	//
+	// Defaults to 'c'.
+	Prefix byte
+	// Key to sign data using HMAC-SHA256. If empty, data is not signed.
+	Key []byte
+	// SignatureSize is a size of truncated signature.
+	// It is clamped to range [1, 32].
+	//
+	// Defaults to 8.
+	SignatureSize int
+	// Store is used to save data exceeding MaxDataSize.
+	// If nil, encoding of such data fails and decoding returns ErrNotFound.
+	Store Store
+}
+
+func (o *Options) setDefaults() {
+	if o.Prefix == 0 {
+		o.Prefix = 'c'
+	}
+	switch {
+	case o.SignatureSize == 0:
+		o.SignatureSize = 8
+	case o.SignatureSize < 1:
+		o.SignatureSize = 1
+	case o.SignatureSize > sha256.Size:
+		o.SignatureSize = sha256.Size
+	}
+}
+
+// Codec encodes typed payloads to callback data and back.
+type Codec struct {
+	prefix  byte
+	key     []byte
+	sigSize int
+	store   Store
+
+	ids   map[reflect.Type]uint8
+	types map[uint8]reflect.Type
+	mux   sync.RWMutex
+}
+
+// NewCodec creates new Codec.
+//
+// Page payload of Pager is registered with PageID.
+func NewCodec(opts Options) *Codec {
+	opts.setDefaults()
+	c := &Codec{
+		prefix:  opts.Prefix,
+		key:     opts.Key,
+		sigSize: opts.SignatureSize,
+		store:   opts.Store,
+		ids:     map[reflect.Type]uint8{},
+		types:   map[uint8]reflect.Type{},
+	}
+	if err := c.Register(PageID, Page{}); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func payloadType(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// Register registers payload type with given ID.
+//
+// Payload is a struct with exported fields of basic types, slices
+// and nested structs, or a type implementing bin.BareEncoder and
+// bin.BareDecoder. ID must be in range [1, 127] and must not be changed
+// while there are buttons with this payload.
+func (c *Codec) Register(id uint8, v interface{}) error {
+	t := payloadType(v)
+	if t == nil {
+		return errors.New("nil payload")
+	}
+	if id == 0 || id&storedFlag != 0 {
+		return errors.Errorf("invalid id %d", id)
+	}
+	if err := checkType(t); err != nil {
+		return errors.Wrapf(err, "check %s", t)
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if registered, ok := c.types[id]; ok {
+		return errors.Errorf("id %d is already used by %s", id, registered)
+	}
+	if _, ok := c.ids[t]; ok {
+		return errors.Errorf("%s is already registered", t)
+	}
+	c.ids[t] = id
+	c.types[id] = t
+	return nil
+}
+
+func (c *Codec) typeID(v interface{}) (uint8, bool) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	id, ok := c.ids[payloadType(v)]
+	return id, ok
+}
+
+func (c *Codec) sign(data []byte) []byte {
+	if len(c.key) == 0 {
+		return data
+	}
+	h := hmac.New(sha256.New, c.key)
+	_, _ = h.Write(data)
+	return append(data, h.Sum(nil)[:c.sigSize]...)
+}
+
+// verify checks signature and returns data without it.
+func (c *Codec) verify(data []byte) ([]byte, error) {
+	if len(c.key) == 0 {
+		return data, nil
+	}
+	if len(data) < c.sigSize {
+		return nil, ErrSignature
+	}
+	data, sig := data[:len(data)-c.sigSize], data[len(data)-c.sigSize:]
+
+	h := hmac.New(sha256.New, c.key)
+	_, _ = h.Write(data)
+	if !hmac.Equal(sig, h.Sum(nil)[:c.sigSize]) {
+		return nil, ErrSignature
+	}
+	return data, nil
+}
+
+// Encode encodes given payload to callback data.
+func (c *Codec) Encode(ctx context.Context, v interface{}) ([]byte, error) {
+	id, ok := c.typeID(v)
+	if !ok {
+		return nil, errors.Errorf("type %T is not registered", v)
+	}
+
+	b := bin.Buffer{Buf: []byte{c.prefix, id}}
+	if err := encodeValue(&b, reflect.Indirect(reflect.ValueOf(v))); err != nil {
+		return nil, errors.Wrapf(err, "encode %T", v)
+	}
+	data := c.sign(b.Buf)
+	if len(data) <= MaxDataSize {
+		return data, nil
+	}
+
+	if c.store == nil {
+		return nil, errors.Wrapf(ErrTooBig, "encode %T (%d bytes)", v, len(data))
+	}
+	body := b.Buf[2:]
+	sum := sha256.Sum256(b.Buf)
+	key := sum[:keySize]
+	if err := c.store.Set(ctx, key, body); err != nil {
+		return nil, errors.Wrap(err, "store")
+	}
+	return c.sign(append([]byte{c.prefix, id | storedFlag}, key...)), nil
+}
+
+// Button creates callback button with given payload.
+func (c *Codec) Button(ctx context.Context, text string, v interface{}) (*tg.KeyboardButtonCallback, error) {
+	data, err := c.Encode(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	return &tg.KeyboardButtonCallback{
+		Text: text,
+		Data: data,
+	}, nil
+}
+
+// header returns payload type of data, without checking signature.
+func (c *Codec) header(data []byte) (reflect.Type, bool) {
+	if len(data) < 2 || data[0] != c.prefix {
+		return nil, false
+	}
+
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	t, ok := c.types[data[1]&^storedFlag]
+	return t, ok
+}
+
+// Decode decodes callback data to pointer to registered payload type.
+func (c *Codec) Decode(ctx context.Context, data []byte) (interface{}, error) {
+	t, ok := c.header(data)
+	if !ok {
+		return nil, ErrForeign
+	}
+	data, err := c.verify(data)
+	if err != nil {
+		return nil, err
+	}
+
+	body := data[2:]
+	if data[1]&storedFlag != 0 {
+		// Data comes from client, so flag can be set even if Store is not.
+		if c.store == nil {
+			return nil, ErrNotFound
+		}
+		stored, found, err := c.store.Get(ctx, body)
+		if err != nil {
+			return nil, errors.Wrap(err, "get stored data")
+		}
+		if !found {
+			return nil, ErrNotFound
+		}
+		body = stored
+	}
+
+	v := reflect.New(t)
+	b := bin.Buffer{Buf: body}
+	if err := decodeValue(&b, v.Elem()); err != nil {
+		return nil, errors.Wrapf(err, "decode %s", t)
+	}
+	if b.Len() != 0 {
+		return nil, errors.Errorf("decode %s: %d trailing bytes", t, b.Len())
+	}
+	return v.Interface(), nil
+}
+
+// DecodeTo decodes callback data to given pointer to payload.
+//
+// Returns ErrForeign if data contains payload of another type.
+func (c *Codec) DecodeTo(ctx context.Context, data []byte, v interface{}) error {
+	if !c.Is(data, v) {
+		return ErrForeign
+	}
+	r, err := c.Decode(ctx, data)
+	if err != nil {
+		return err
+	}
+	reflect.ValueOf(v).Elem().Set(reflect.ValueOf(r).Elem())
+	return nil
+}
+
+// Is reports whether data contains payload of the same type as given one.
+//
+// Signature is not checked.
+func (c *Codec) Is(data []byte, v interface{}) bool {
+	t, ok := c.header(data)
+	return ok && t == payloadType(v)
+}
+
+// Filter returns handlers.Filter which matches callback queries with
+// payload of the same type as given one.
+func (c *Codec) Filter(v interface{}) handlers.Filter {
+	return func(u *handlers.Update) bool {
+		cb, ok := u.Callback()
+		return ok && c.Is(cb.Data, v)
+	}
+}
@@ -0,0 +1,213 @@
+package callback
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram/handlers"
+	"github.com/gotd/td/tg"
+)
+
+type action struct {
+	Name   string
+	ID     int64
+	Small  int8
+	Flag   bool
+	Tags   []string
+	Nested struct {
+		Score float64
+		Raw   []byte
+	}
+	hidden int
+}
+
+type vote struct {
+	Up bool
+}
+
+// bare payload with custom encoding.
+type bare struct {
+	Value uint8
+}
+
+func (b *bare) EncodeBare(buf *bin.Buffer) error {
+	buf.Put([]byte{b.Value})
+	return nil
+}
+
+func (b *bare) DecodeBare(buf *bin.Buffer) error {
+	if buf.Len() < 1 {
+		return io.ErrUnexpectedEOF
+	}
+	b.Value = buf.Buf[0]
+	buf.Skip(1)
+	return nil
+}
+
+func testCodec(t *testing.T, opts Options) *Codec {
+	c := NewCodec(opts)
+	require.NoError(t, c.Register(1, action{}))
+	require.NoError(t, c.Register(2, &vote{}))
+	require.NoError(t, c.Register(3, bare{}))
+	return c
+}
+
+func TestCodec(t *testing.T) {
+	ctx := context.Background()
+	a := action{Name: "ban", ID: 1 << 40, Small: -5, Flag: true, Tags: []string{"a", "b"}}
+	a.Nested.Score = 1.5
+	a.Nested.Raw = []byte{1, 2}
+
+	for _, opts := range []Options{
+		{},
+		{Key: []byte("secret")},
+		{Prefix: 'x', Key: []byte("secret"), SignatureSize: 4},
+	} {
+		c := testCodec(t, opts)
+		for _, payload := range []interface{}{
+			&a,
+			&vote{Up: true},
+			&bare{Value: 10},
+			&Page{List: "users", Page: 3},
+		} {
+			data, err := c.Encode(ctx, payload)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(data), MaxDataSize)
+			require.True(t, c.Is(data, payload))
+
+			v, err := c.Decode(ctx, data)
+			require.NoError(t, err)
+			require.Equal(t, payload, v)
+		}
+	}
+}
+
+func TestCodec_Errors(t *testing.T) {
+	ctx := context.Background()
+	c := testCodec(t, Options{Key: []byte("secret")})
+
+	_, err := c.Encode(ctx, struct{}{})
+	require.Error(t, err)
+
+	data, err := c.Encode(ctx, vote{Up: true})
+	require.NoError(t, err)
+	require.False(t, c.Is(data, action{}))
+	require.ErrorIs(t, c.DecodeTo(ctx, data, &action{}), ErrForeign)
+
+	var v vote
+	require.NoError(t, c.DecodeTo(ctx, data, &v))
+	require.True(t, v.Up)
+
+	// Tampered data.
+	data[2] ^= 0xff
+	_, err = c.Decode(ctx, data)
+	require.ErrorIs(t, err, ErrSignature)
+
+	// Other key.
+	data, err = testCodec(t, Options{Key: []byte("other")}).Encode(ctx, vote{})
+	require.NoError(t, err)
+	_, err = c.Decode(ctx, data)
+	require.ErrorIs(t, err, ErrSignature)
+
+	for _, data := range [][]byte{nil, []byte("c"), []byte("raw data"), {'c', 100}} {
+		_, err = c.Decode(ctx, data)
+		require.ErrorIs(t, err, ErrForeign)
+	}
+
+	// Trailing bytes.
+	data, err = NewCodec(Options{}).Encode(ctx, &Page{List: "users", Page: 3})
+	require.NoError(t, err)
+	_, err = NewCodec(Options{}).Decode(ctx, append(data, 0))
+	require.Error(t, err)
+}
+
+func TestCodec_SignatureSize(t *testing.T) {
+	ctx := context.Background()
+	for size, expected := range map[int]int{
+		-1: 1,
+		0:  8,
+		4:  4,
+		64: 32,
+	} {
+		c := testCodec(t, Options{Key: []byte("secret"), SignatureSize: size})
+		data, err := c.Encode(ctx, &vote{Up: true})
+		require.NoError(t, err)
+		// Header, TL bool and signature.
+		require.Len(t, data, 2+4+expected)
+
+		v, err := c.Decode(ctx, data)
+		require.NoError(t, err)
+		require.Equal(t, &vote{Up: true}, v)
+	}
+}
+
+func TestCodec_Register(t *testing.T) {
+	c := NewCodec(Options{})
+	require.NoError(t, c.Register(1, vote{}))
+	require.Error(t, c.Register(1, action{}))
+	require.Error(t, c.Register(2, vote{}))
+	require.Error(t, c.Register(0, action{}))
+	require.Error(t, c.Register(128, action{}))
+	require.Error(t, c.Register(PageID, action{}))
+	require.Error(t, c.Register(3, struct{ C chan int }{}))
+	require.Error(t, c.Register(3, nil))
+}
+
+func TestCodec_Store(t *testing.T) {
+	ctx := context.Background()
+	big := action{Name: strings.Repeat("a", 100)}
+
+	_, err := testCodec(t, Options{}).Encode(ctx, big)
+	require.ErrorIs(t, err, ErrTooBig)
+
+	store := &InmemoryStore{}
+	c := testCodec(t, Options{Key: []byte("secret"), Store: store})
+	data, err := c.Encode(ctx, big)
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(data), MaxDataSize)
+	require.True(t, c.Is(data, action{}))
+
+	var v action
+	require.NoError(t, c.DecodeTo(ctx, data, &v))
+	require.Equal(t, big, v)
+
+	_, err = testCodec(t, Options{Key: []byte("secret"), Store: &InmemoryStore{}}).Decode(ctx, data)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Stored flag without Store.
+	_, err = testCodec(t, Options{}).Decode(ctx, []byte{'c', PageID | storedFlag, 1, 2, 3})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCodec_Filter(t *testing.T) {
+	ctx := context.Background()
+	c := testCodec(t, Options{})
+	r := handlers.NewRouter(tg.NewClient(nil), handlers.Options{})
+
+	var got []interface{}
+	r.OnCallback(func(ctx context.Context, u *handlers.Update) error {
+		v, err := c.Decode(ctx, u.Data())
+		got = append(got, v)
+		return err
+	}, c.Filter(vote{}))
+
+	for _, payload := range []interface{}{vote{Up: true}, action{}} {
+		data, err := c.Encode(ctx, payload)
+		require.NoError(t, err)
+		require.NoError(t, r.Handle(ctx, &tg.Updates{
+			Updates: []tg.UpdateClass{
+				&tg.UpdateBotCallbackQuery{
+					UserID: 1,
+					Peer:   &tg.PeerUser{UserID: 1},
+					Data:   data,
+				},
+			},
+		}))
+	}
+	require.Equal(t, []interface{}{&vote{Up: true}}, got)
+}
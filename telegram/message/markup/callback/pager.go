@@ -0,0 +1,153 @@
+package callback
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gotd/td/tg"
+)
+
+// PageID is an ID of Page payload.
+const PageID uint8 = 127
+
+// Page is a payload of Pager buttons.
+type Page struct {
+	// List identifies paginated list.
+	List string
+	// Page is a zero-based number of page.
+	Page int
+}
+
+// Pager builds inline keyboards with pagination over list of items.
+type Pager struct {
+	// Codec to encode Page payloads.
+	Codec *Codec
+	// List identifies paginated list.
+	List string
+	// Size is a count of items on page.
+	//
+	// Defaults to 10.
+	Size int
+	// Columns is a count of item buttons in row.
+	//
+	// Defaults to 1.
+	Columns int
+	// Prev is a text of button to previous page.
+	//
+	// Defaults to "«".
+	Prev string
+	// Next is a text of button to next page.
+	//
+	// Defaults to "»".
+	Next string
+}
+
+func (p *Pager) setDefaults() {
+	if p.Size <= 0 {
+		p.Size = 10
+	}
+	if p.Columns <= 0 {
+		p.Columns = 1
+	}
+	if p.Prev == "" {
+		p.Prev = "«"
+	}
+	if p.Next == "" {
+		p.Next = "»"
+	}
+}
+
+// Pages returns count of pages for given count of items.
+func (p Pager) Pages(total int) int {
+	p.setDefaults()
+	if total <= 0 {
+		return 1
+	}
+	return (total + p.Size - 1) / p.Size
+}
+
+// Items returns range of item indexes [from, to) of given page.
+// Page out of range is clamped.
+func (p Pager) Items(page, total int) (from, to int) {
+	p.setDefaults()
+	page = p.clamp(page, total)
+	from = page * p.Size
+	to = from + p.Size
+	if to > total {
+		to = total
+	}
+	if from > to {
+		from = to
+	}
+	return from, to
+}
+
+func (p Pager) clamp(page, total int) int {
+	if pages := p.Pages(total); page >= pages {
+		page = pages - 1
+	}
+	if page < 0 {
+		page = 0
+	}
+	return page
+}
+
+// Keyboard builds inline keyboard of given page.
+//
+// Button of i-th item is created by item callback. Navigation row contains
+// buttons to previous and next pages and current page indicator, it is
+// omitted if there is only one page.
+func (p Pager) Keyboard(
+	ctx context.Context,
+	page, total int,
+	item func(i int) (tg.KeyboardButtonClass, error),
+) (tg.ReplyMarkupClass, error) {
+	p.setDefaults()
+	page = p.clamp(page, total)
+
+	var (
+		rows     []tg.KeyboardButtonRow
+		from, to = p.Items(page, total)
+	)
+	for i := from; i < to; i++ {
+		b, err := item(i)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 || len(rows[len(rows)-1].Buttons) >= p.Columns {
+			rows = append(rows, tg.KeyboardButtonRow{})
+		}
+		last := &rows[len(rows)-1]
+		last.Buttons = append(last.Buttons, b)
+	}
+
+	if pages := p.Pages(total); pages > 1 {
+		var nav tg.KeyboardButtonRow
+		add := func(text string, to int) error {
+			b, err := p.Codec.Button(ctx, text, Page{List: p.List, Page: to})
+			if err != nil {
+				return err
+			}
+			nav.Buttons = append(nav.Buttons, b)
+			return nil
+		}
+
+		if page > 0 {
+			if err := add(p.Prev, page-1); err != nil {
+				return nil, err
+			}
+		}
+		indicator := strconv.Itoa(page+1) + "/" + strconv.Itoa(pages)
+		if err := add(indicator, page); err != nil {
+			return nil, err
+		}
+		if page < pages-1 {
+			if err := add(p.Next, page+1); err != nil {
+				return nil, err
+			}
+		}
+		rows = append(rows, nav)
+	}
+
+	return &tg.ReplyInlineMarkup{Rows: rows}, nil
+}
@@ -0,0 +1,66 @@
+package callback
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/telegram/message/markup"
+	"github.com/gotd/td/tg"
+)
+
+func TestPager(t *testing.T) {
+	ctx := context.Background()
+	c := NewCodec(Options{})
+	p := Pager{
+		Codec:   c,
+		List:    "items",
+		Size:    3,
+		Columns: 2,
+	}
+	item := func(i int) (tg.KeyboardButtonClass, error) {
+		return markup.Callback(strconv.Itoa(i), nil), nil
+	}
+	texts := func(m tg.ReplyMarkupClass) (r [][]string) {
+		for _, row := range m.(*tg.ReplyInlineMarkup).Rows {
+			var texts []string
+			for _, b := range row.Buttons {
+				texts = append(texts, b.GetText())
+			}
+			r = append(r, texts)
+		}
+		return r
+	}
+
+	require.Equal(t, 3, p.Pages(7))
+	require.Equal(t, 1, p.Pages(0))
+
+	for _, test := range []struct {
+		page     int
+		expected [][]string
+	}{
+		{0, [][]string{{"0", "1"}, {"2"}, {"1/3", "»"}}},
+		{1, [][]string{{"3", "4"}, {"5"}, {"«", "2/3", "»"}}},
+		{2, [][]string{{"6"}, {"«", "3/3"}}},
+		{10, [][]string{{"6"}, {"«", "3/3"}}},
+	} {
+		m, err := p.Keyboard(ctx, test.page, 7, item)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, texts(m))
+	}
+
+	// Navigation buttons contain Page payload.
+	m, err := p.Keyboard(ctx, 1, 7, item)
+	require.NoError(t, err)
+	next := m.(*tg.ReplyInlineMarkup).Rows[2].Buttons[2].(*tg.KeyboardButtonCallback)
+	var page Page
+	require.NoError(t, c.DecodeTo(ctx, next.Data, &page))
+	require.Equal(t, Page{List: "items", Page: 2}, page)
+
+	// Single page has no navigation.
+	m, err = p.Keyboard(ctx, 0, 2, item)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"0", "1"}}, texts(m))
+}
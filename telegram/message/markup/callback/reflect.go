@@ -0,0 +1,212 @@
+package callback
+
+import (
+	"reflect"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+)
+
+var (
+	bareEncoderType = reflect.TypeOf((*bin.BareEncoder)(nil)).Elem()
+	bareDecoderType = reflect.TypeOf((*bin.BareDecoder)(nil)).Elem()
+)
+
+// isBare reports whether type implements bin.BareEncoder and bin.BareDecoder.
+func isBare(t reflect.Type) bool {
+	p := reflect.PointerTo(t)
+	return p.Implements(bareEncoderType) && p.Implements(bareDecoderType)
+}
+
+// checkType checks that values of given type can be encoded.
+func checkType(t reflect.Type) error {
+	if isBare(t) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return nil
+	case reflect.Slice:
+		if err := checkType(t.Elem()); err != nil {
+			return errors.Wrapf(err, "slice of %s", t.Elem())
+		}
+		return nil
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if err := checkType(f.Type); err != nil {
+				return errors.Wrapf(err, "field %s", f.Name)
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported type %s", t)
+	}
+}
+
+// encodeValue encodes value using TL primitives.
+//
+// Values are encoded bare, in order of declaration of struct fields.
+func encodeValue(b *bin.Buffer, v reflect.Value) error {
+	if isBare(v.Type()) {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		return p.Interface().(bin.BareEncoder).EncodeBare(b)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b.PutBool(v.Bool())
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		b.PutInt32(int32(v.Int()))
+	case reflect.Int, reflect.Int64:
+		b.PutLong(v.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		b.PutUint32(uint32(v.Uint()))
+	case reflect.Uint, reflect.Uint64:
+		b.PutUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		b.PutDouble(v.Float())
+	case reflect.String:
+		b.PutString(v.String())
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b.PutBytes(v.Bytes())
+			return nil
+		}
+		b.PutInt(v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if err := encodeValue(b, v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if err := encodeValue(b, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// decodeValue decodes value encoded by encodeValue.
+//
+// nolint:gocognit,gocyclo
+func decodeValue(b *bin.Buffer, v reflect.Value) error {
+	if isBare(v.Type()) {
+		return v.Addr().Interface().(bin.BareDecoder).DecodeBare(b)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		r, err := b.Bool()
+		if err != nil {
+			return err
+		}
+		v.SetBool(r)
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		r, err := b.Int32()
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(int64(r)) {
+			return errors.Errorf("value %d overflows %s", r, v.Type())
+		}
+		v.SetInt(int64(r))
+	case reflect.Int, reflect.Int64:
+		r, err := b.Long()
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(r) {
+			return errors.Errorf("value %d overflows %s", r, v.Type())
+		}
+		v.SetInt(r)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		r, err := b.Uint32()
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(uint64(r)) {
+			return errors.Errorf("value %d overflows %s", r, v.Type())
+		}
+		v.SetUint(uint64(r))
+	case reflect.Uint, reflect.Uint64:
+		r, err := b.Uint64()
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(r) {
+			return errors.Errorf("value %d overflows %s", r, v.Type())
+		}
+		v.SetUint(r)
+	case reflect.Float32, reflect.Float64:
+		r, err := b.Double()
+		if err != nil {
+			return err
+		}
+		v.SetFloat(r)
+	case reflect.String:
+		r, err := b.String()
+		if err != nil {
+			return err
+		}
+		v.SetString(r)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			r, err := b.Bytes()
+			if err != nil {
+				return err
+			}
+			v.SetBytes(r)
+			return nil
+		}
+
+		n, err := b.Int()
+		if err != nil {
+			return err
+		}
+		// Prevent huge allocations, element takes at least one byte.
+		if n < 0 || n > b.Len() {
+			return errors.Errorf("invalid length %d", n)
+		}
+		if n == 0 {
+			return nil
+		}
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := decodeValue(b, s.Index(i)); err != nil {
+				return errors.Wrapf(err, "element %d", i)
+			}
+		}
+		v.Set(s)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if err := decodeValue(b, v.Field(i)); err != nil {
+				return errors.Wrapf(err, "field %s", f.Name)
+			}
+		}
+	default:
+		return errors.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package callback
+
+import (
+	"context"
+	"sync"
+)
+
+// Store stores callback data which does not fit into button.
+type Store interface {
+	Set(ctx context.Context, key, value []byte) error
+	Get(ctx context.Context, key []byte) (value []byte, found bool, err error)
+}
+
+var _ Store = (*InmemoryStore)(nil)
+
+// InmemoryStore is basic in-memory Store implementation.
+//
+// Stored data is never evicted and lost on restart, so buttons
+// with stored data become invalid.
+type InmemoryStore struct {
+	data map[string][]byte
+	mux  sync.Mutex
+}
+
+// Set implements Store.
+func (s *InmemoryStore) Set(ctx context.Context, key, value []byte) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.data == nil {
+		s.data = map[string][]byte{}
+	}
+	s.data[string(key)] = append([]byte(nil), value...)
+	return nil
+}
+
+// Get implements Store.
+func (s *InmemoryStore) Get(ctx context.Context, key []byte) ([]byte, bool, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, ok := s.data[string(key)]
+	return v, ok, nil
+}
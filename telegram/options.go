@@ -85,6 +85,16 @@ type Options struct {
 	// If == 0, default value will be used.
 	CompressThreshold int
 
+	// EnablePFS enables Perfect Forward Secrecy mode: all connections
+	// encrypt traffic using temporary keys bound to the permanent key.
+	//
+	// See https://core.telegram.org/api/pfs.
+	EnablePFS bool
+	// TempKeyTTL is lifetime of temporary keys in PFS mode.
+	//
+	// If not provided, mtproto.Options default will be used.
+	TempKeyTTL time.Duration
+
 	// Device is device config.
 	// Will be sent with session creation request.
 	Device DeviceConfig
@@ -94,6 +104,9 @@ type Options struct {
 
 	// OpenTelemetry.
 	TracerProvider trace.TracerProvider
+	// Hooks are called on connection events, like reconnect or key
+	// exchange. See oteltg.Metrics.
+	Hooks Hooks
 
 	// OnTransfer is called during authorization transfer.
 	// See [AuthTransferHandler] for details.
@@ -147,6 +160,9 @@ func (opt *Options) setDefaults() {
 	if opt.OnTransfer == nil {
 		opt.OnTransfer = noopOnTransfer
 	}
+	if opt.Hooks == nil {
+		opt.Hooks = nopHooks{}
+	}
 }
 
 func defaultBackoff(c clock.Clock) func() backoff.BackOff {
@@ -0,0 +1,556 @@
+package peers
+
+import (
+	"context"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/internal/atomicfile"
+	"github.com/gotd/td/tg"
+)
+
+// fileStorageMagic is header of FileStorage file.
+const fileStorageMagic = 0x73726570 // "pers"
+
+// fileStorageVersion is current version of FileStorage format.
+const fileStorageVersion = 1
+
+// objectKind is kind of cached object.
+type objectKind int
+
+const (
+	kindUser objectKind = iota + 1
+	kindUserFull
+	kindChat
+	kindChatFull
+	kindChannel
+	kindChannelFull
+)
+
+// full reports whether kind is *Full object, which is subject to TTL.
+func (k objectKind) full() bool {
+	switch k {
+	case kindUserFull, kindChatFull, kindChannelFull:
+		return true
+	default:
+		return false
+	}
+}
+
+type objectKey struct {
+	Kind objectKind
+	ID   int64
+}
+
+// cachedObject is encoded object with save time.
+type cachedObject struct {
+	Saved time.Time
+	Data  []byte
+}
+
+// FileStorageOptions is options of FileStorage.
+type FileStorageOptions struct {
+	// FullTTL is lifetime of cached *Full objects (tg.UserFull, tg.ChatFull,
+	// tg.ChannelFull). Expired objects are not returned and evicted
+	// on flush.
+	//
+	// Defaults to 1 hour. Negative value disables expiration.
+	FullTTL time.Duration
+	// MaxObjects is maximum number of cached objects. When limit is
+	// exceeded, least recently saved objects are evicted on flush.
+	//
+	// Access hashes and phones are never evicted.
+	// Defaults to 10000. Negative value disables limit.
+	MaxObjects int
+	// FlushInterval is a delay between change and its write to the file.
+	// Changes made during this delay are written at once.
+	//
+	// Defaults to 1 second.
+	FlushInterval time.Duration
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+}
+
+func (o *FileStorageOptions) setDefaults() {
+	if o.FullTTL == 0 {
+		o.FullTTL = time.Hour
+	}
+	if o.MaxObjects == 0 {
+		o.MaxObjects = 10000
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = time.Second
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+}
+
+var (
+	_ Storage = (*FileStorage)(nil)
+	_ Cache   = (*FileStorage)(nil)
+)
+
+// FileStorage is Storage and Cache implementation which persists data
+// in a single file, so restarted Manager is able to resolve peers
+// without additional requests.
+//
+// Cached objects are stored using TL binary encoding.
+// Changes are applied in memory and written to the file in background
+// after FlushInterval, so saving of every received entity does not result
+// in separate write. Use Flush to write changes immediately and Close to
+// write pending changes before exit.
+//
+// File is replaced atomically: data is written to temporary file, synced
+// and renamed over the old one.
+type FileStorage struct {
+	path          string
+	fullTTL       time.Duration
+	maxObjects    int
+	flushInterval time.Duration
+	clock         clock.Clock
+
+	phones       map[string]Key
+	data         map[Key]Value
+	contactsHash int64
+	objects      map[objectKey]cachedObject
+	dirty        bool  // data is changed after last flush
+	timer        bool  // flush is scheduled
+	err          error // error of background flush
+	mux          sync.Mutex
+
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	// flushMux serializes writes, so older data never overwrites newer.
+	flushMux sync.Mutex
+}
+
+// NewFileStorage opens storage at given path, creating new one if file
+// does not exist.
+func NewFileStorage(path string, opts FileStorageOptions) (*FileStorage, error) {
+	opts.setDefaults()
+	s := &FileStorage{
+		path:          path,
+		fullTTL:       opts.FullTTL,
+		maxObjects:    opts.MaxObjects,
+		flushInterval: opts.FlushInterval,
+		clock:         opts.Clock,
+		phones:        map[string]Key{},
+		data:          map[Key]Value{},
+		objects:       map[objectKey]cachedObject{},
+		closed:        make(chan struct{}),
+	}
+
+	raw, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return s, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "read")
+	}
+
+	if err := s.decode(&bin.Buffer{Buf: raw}); err != nil {
+		return nil, errors.Wrapf(err, "decode %q", path)
+	}
+	return s, nil
+}
+
+func (s *FileStorage) encode(b *bin.Buffer) {
+	b.PutUint32(fileStorageMagic)
+	b.PutInt(fileStorageVersion)
+	b.PutLong(s.contactsHash)
+
+	b.PutVectorHeader(len(s.data))
+	for k, v := range s.data {
+		b.PutString(k.Prefix)
+		b.PutLong(k.ID)
+		b.PutLong(v.AccessHash)
+	}
+
+	b.PutVectorHeader(len(s.phones))
+	for phone, k := range s.phones {
+		b.PutString(phone)
+		b.PutString(k.Prefix)
+		b.PutLong(k.ID)
+	}
+
+	b.PutVectorHeader(len(s.objects))
+	for k, o := range s.objects {
+		b.PutInt(int(k.Kind))
+		b.PutLong(k.ID)
+		b.PutLong(o.Saved.UnixNano())
+		b.PutBytes(o.Data)
+	}
+}
+
+func (s *FileStorage) decode(b *bin.Buffer) error {
+	magic, err := b.Uint32()
+	if err != nil {
+		return errors.Wrap(err, "read magic")
+	}
+	if magic != fileStorageMagic {
+		return errors.Errorf("unexpected magic %#x", magic)
+	}
+	version, err := b.Int()
+	if err != nil {
+		return errors.Wrap(err, "read version")
+	}
+	if version != fileStorageVersion {
+		return errors.Errorf("unsupported version %d", version)
+	}
+	if s.contactsHash, err = b.Long(); err != nil {
+		return errors.Wrap(err, "read contacts hash")
+	}
+
+	n, err := b.VectorHeader()
+	if err != nil {
+		return errors.Wrap(err, "read access hashes")
+	}
+	for i := 0; i < n; i++ {
+		var (
+			k Key
+			v Value
+		)
+		if k.Prefix, err = b.String(); err != nil {
+			return errors.Wrapf(err, "read access hash %d", i)
+		}
+		if k.ID, err = b.Long(); err != nil {
+			return errors.Wrapf(err, "read access hash %d", i)
+		}
+		if v.AccessHash, err = b.Long(); err != nil {
+			return errors.Wrapf(err, "read access hash %d", i)
+		}
+		s.data[k] = v
+	}
+
+	n, err = b.VectorHeader()
+	if err != nil {
+		return errors.Wrap(err, "read phones")
+	}
+	for i := 0; i < n; i++ {
+		var k Key
+		phone, err := b.String()
+		if err != nil {
+			return errors.Wrapf(err, "read phone %d", i)
+		}
+		if k.Prefix, err = b.String(); err != nil {
+			return errors.Wrapf(err, "read phone %d", i)
+		}
+		if k.ID, err = b.Long(); err != nil {
+			return errors.Wrapf(err, "read phone %d", i)
+		}
+		s.phones[phone] = k
+	}
+
+	n, err = b.VectorHeader()
+	if err != nil {
+		return errors.Wrap(err, "read objects")
+	}
+	for i := 0; i < n; i++ {
+		kind, err := b.Int()
+		if err != nil {
+			return errors.Wrapf(err, "read object %d", i)
+		}
+		id, err := b.Long()
+		if err != nil {
+			return errors.Wrapf(err, "read object %d", i)
+		}
+		saved, err := b.Long()
+		if err != nil {
+			return errors.Wrapf(err, "read object %d", i)
+		}
+		data, err := b.Bytes()
+		if err != nil {
+			return errors.Wrapf(err, "read object %d", i)
+		}
+		s.objects[objectKey{Kind: objectKind(kind), ID: id}] = cachedObject{
+			Saved: time.Unix(0, saved),
+			Data:  data,
+		}
+	}
+
+	return nil
+}
+
+// expired reports whether object is expired.
+func (s *FileStorage) expired(k objectKey, o cachedObject, now time.Time) bool {
+	return k.Kind.full() && s.fullTTL > 0 && now.Sub(o.Saved) > s.fullTTL
+}
+
+// evictLocked removes expired objects and enforces objects limit.
+//
+// Called on flush only, so saving does not scan all objects.
+func (s *FileStorage) evictLocked(now time.Time) {
+	for k, o := range s.objects {
+		if s.expired(k, o, now) {
+			delete(s.objects, k)
+		}
+	}
+
+	if s.maxObjects < 0 || len(s.objects) <= s.maxObjects {
+		return
+	}
+	keys := make([]objectKey, 0, len(s.objects))
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return s.objects[keys[i]].Saved.Before(s.objects[keys[j]].Saved)
+	})
+	for _, k := range keys[:len(keys)-s.maxObjects] {
+		delete(s.objects, k)
+	}
+}
+
+// update applies f and schedules flush.
+//
+// Error of previous background flush is returned, if any.
+func (s *FileStorage) update(f func()) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	f()
+	s.dirty = true
+
+	if !s.timer {
+		s.timer = true
+		timer := s.clock.Timer(s.flushInterval)
+		go func() {
+			select {
+			case <-timer.C():
+				_ = s.flush(true)
+			case <-s.closed:
+				clock.StopTimer(timer)
+			}
+		}()
+	}
+
+	err := s.err
+	s.err = nil
+	return err
+}
+
+// flush writes data if it was changed.
+//
+// Background flush stores error to return it from next update.
+func (s *FileStorage) flush(background bool) error {
+	s.flushMux.Lock()
+	defer s.flushMux.Unlock()
+
+	s.mux.Lock()
+	if background {
+		s.timer = false
+	}
+	if !s.dirty {
+		s.mux.Unlock()
+		return nil
+	}
+	s.evictLocked(s.clock.Now())
+	var b bin.Buffer
+	s.encode(&b)
+	s.dirty = false
+	s.mux.Unlock()
+
+	if err := atomicfile.WriteFile(s.path, b.Buf); err != nil {
+		err = errors.Wrap(err, "write")
+
+		// Retry on next flush.
+		s.mux.Lock()
+		s.dirty = true
+		if background {
+			s.err = err
+		}
+		s.mux.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Flush writes pending changes to the file.
+func (s *FileStorage) Flush() error {
+	return s.flush(false)
+}
+
+// Close writes pending changes to the file.
+//
+// Storage should not be changed after Close.
+func (s *FileStorage) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	return s.Flush()
+}
+
+type cacheObject interface {
+	bin.Object
+	GetID() int64
+}
+
+func saveObjects[T cacheObject](s *FileStorage, kind objectKind, objects []T) error {
+	if len(objects) == 0 {
+		return nil
+	}
+
+	type encoded struct {
+		id   int64
+		data []byte
+	}
+	r := make([]encoded, 0, len(objects))
+	for _, o := range objects {
+		var b bin.Buffer
+		if err := o.Encode(&b); err != nil {
+			return errors.Wrapf(err, "encode %d", o.GetID())
+		}
+		r = append(r, encoded{id: o.GetID(), data: b.Buf})
+	}
+
+	return s.update(func() {
+		now := s.clock.Now()
+		for _, e := range r {
+			s.objects[objectKey{Kind: kind, ID: e.id}] = cachedObject{
+				Saved: now,
+				Data:  e.data,
+			}
+		}
+	})
+}
+
+func findObject[T any, PT interface {
+	*T
+	bin.Decoder
+}](s *FileStorage, kind objectKind, id int64) (*T, bool, error) {
+	s.mux.Lock()
+	k := objectKey{Kind: kind, ID: id}
+	o, ok := s.objects[k]
+	if ok && s.expired(k, o, s.clock.Now()) {
+		ok = false
+	}
+	s.mux.Unlock()
+	if !ok {
+		return nil, false, nil
+	}
+
+	v := new(T)
+	if err := PT(v).Decode(&bin.Buffer{Buf: o.Data}); err != nil {
+		return nil, false, errors.Wrapf(err, "decode %d", id)
+	}
+	return v, true, nil
+}
+
+// Save implements Storage.
+func (s *FileStorage) Save(ctx context.Context, key Key, value Value) error {
+	return s.update(func() {
+		s.data[key] = value
+	})
+}
+
+// Find implements Storage.
+func (s *FileStorage) Find(ctx context.Context, key Key) (value Value, found bool, _ error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	value, found = s.data[key]
+	return value, found, nil
+}
+
+// SavePhone implements Storage.
+func (s *FileStorage) SavePhone(ctx context.Context, phone string, key Key) error {
+	return s.update(func() {
+		s.phones[phone] = key
+	})
+}
+
+// FindPhone implements Storage.
+func (s *FileStorage) FindPhone(ctx context.Context, phone string) (key Key, value Value, found bool, err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	key, found = s.phones[phone]
+	if !found {
+		return Key{}, Value{}, false, nil
+	}
+	value, found = s.data[key]
+	return key, value, found, nil
+}
+
+// GetContactsHash implements Storage.
+func (s *FileStorage) GetContactsHash(ctx context.Context) (int64, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.contactsHash, nil
+}
+
+// SaveContactsHash implements Storage.
+func (s *FileStorage) SaveContactsHash(ctx context.Context, hash int64) error {
+	return s.update(func() {
+		s.contactsHash = hash
+	})
+}
+
+// SaveUsers implements Cache.
+func (s *FileStorage) SaveUsers(ctx context.Context, users ...*tg.User) error {
+	return saveObjects(s, kindUser, users)
+}
+
+// SaveUserFulls implements Cache.
+func (s *FileStorage) SaveUserFulls(ctx context.Context, users ...*tg.UserFull) error {
+	return saveObjects(s, kindUserFull, users)
+}
+
+// FindUser implements Cache.
+func (s *FileStorage) FindUser(ctx context.Context, id int64) (*tg.User, bool, error) {
+	return findObject[tg.User](s, kindUser, id)
+}
+
+// FindUserFull implements Cache.
+func (s *FileStorage) FindUserFull(ctx context.Context, id int64) (*tg.UserFull, bool, error) {
+	return findObject[tg.UserFull](s, kindUserFull, id)
+}
+
+// SaveChats implements Cache.
+func (s *FileStorage) SaveChats(ctx context.Context, chats ...*tg.Chat) error {
+	return saveObjects(s, kindChat, chats)
+}
+
+// SaveChatFulls implements Cache.
+func (s *FileStorage) SaveChatFulls(ctx context.Context, chats ...*tg.ChatFull) error {
+	return saveObjects(s, kindChatFull, chats)
+}
+
+// FindChat implements Cache.
+func (s *FileStorage) FindChat(ctx context.Context, id int64) (*tg.Chat, bool, error) {
+	return findObject[tg.Chat](s, kindChat, id)
+}
+
+// FindChatFull implements Cache.
+func (s *FileStorage) FindChatFull(ctx context.Context, id int64) (*tg.ChatFull, bool, error) {
+	return findObject[tg.ChatFull](s, kindChatFull, id)
+}
+
+// SaveChannels implements Cache.
+func (s *FileStorage) SaveChannels(ctx context.Context, channels ...*tg.Channel) error {
+	return saveObjects(s, kindChannel, channels)
+}
+
+// SaveChannelFulls implements Cache.
+func (s *FileStorage) SaveChannelFulls(ctx context.Context, channels ...*tg.ChannelFull) error {
+	return saveObjects(s, kindChannelFull, channels)
+}
+
+// FindChannel implements Cache.
+func (s *FileStorage) FindChannel(ctx context.Context, id int64) (*tg.Channel, bool, error) {
+	return findObject[tg.Channel](s, kindChannel, id)
+}
+
+// FindChannelFull implements Cache.
+func (s *FileStorage) FindChannelFull(ctx context.Context, id int64) (*tg.ChannelFull, bool, error) {
+	return findObject[tg.ChannelFull](s, kindChannelFull, id)
+}
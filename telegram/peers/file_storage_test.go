@@ -0,0 +1,252 @@
+package peers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func testFileStorageOptions() FileStorageOptions {
+	// Background flush never happens.
+	return FileStorageOptions{
+		Clock: neo.NewTime(time.Unix(1000, 0)),
+	}
+}
+
+func TestFileStorage(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "peers.bin")
+	k := Key{Prefix: usersPrefix, ID: 10}
+	v := Value{AccessHash: 100}
+
+	s, err := NewFileStorage(path, testFileStorageOptions())
+	a.NoError(err)
+
+	_, found, err := s.Find(ctx, k)
+	a.NoError(err)
+	a.False(found)
+
+	a.NoError(s.Save(ctx, k, v))
+	a.NoError(s.SavePhone(ctx, "phone", k))
+	a.NoError(s.SaveContactsHash(ctx, 5))
+	a.NoError(s.SaveUsers(ctx, &tg.User{ID: 10, AccessHash: 100, Username: "user"}))
+	a.NoError(s.SaveChannels(ctx, &tg.Channel{ID: 20, Title: "channel", Photo: &tg.ChatPhotoEmpty{}}))
+	a.NoError(s.SaveChatFulls(ctx, &tg.ChatFull{
+		ID:             30,
+		About:          "about",
+		Participants:   &tg.ChatParticipantsForbidden{ChatID: 30},
+		NotifySettings: tg.PeerNotifySettings{},
+	}))
+
+	// Changes are written on close.
+	_, err = os.Stat(path)
+	a.True(os.IsNotExist(err))
+	a.NoError(s.Close())
+
+	// Reopen storage.
+	s, err = NewFileStorage(path, testFileStorageOptions())
+	a.NoError(err)
+
+	v2, found, err := s.Find(ctx, k)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(v, v2)
+
+	k2, v2, found, err := s.FindPhone(ctx, "phone")
+	a.NoError(err)
+	a.True(found)
+	a.Equal(k, k2)
+	a.Equal(v, v2)
+
+	hash, err := s.GetContactsHash(ctx)
+	a.NoError(err)
+	a.Equal(int64(5), hash)
+
+	u, found, err := s.FindUser(ctx, 10)
+	a.NoError(err)
+	a.True(found)
+	a.Equal("user", u.Username)
+	a.Equal(int64(100), u.AccessHash)
+
+	ch, found, err := s.FindChannel(ctx, 20)
+	a.NoError(err)
+	a.True(found)
+	a.Equal("channel", ch.Title)
+
+	full, found, err := s.FindChatFull(ctx, 30)
+	a.NoError(err)
+	a.True(found)
+	a.Equal("about", full.About)
+
+	_, found, err = s.FindChat(ctx, 30)
+	a.NoError(err)
+	a.False(found)
+
+	// No temporary files left.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	a.NoError(err)
+	a.Len(entries, 1)
+}
+
+func TestFileStorageTTL(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "peers.bin")
+	clock := neo.NewTime(time.Unix(1000, 0))
+
+	s, err := NewFileStorage(path, FileStorageOptions{
+		FullTTL: time.Minute,
+		// Background flush never happens.
+		FlushInterval: time.Hour,
+		Clock:         clock,
+	})
+	a.NoError(err)
+	defer func() {
+		a.NoError(s.Close())
+	}()
+
+	a.NoError(s.SaveUsers(ctx, &tg.User{ID: 10}))
+	a.NoError(s.SaveUserFulls(ctx, &tg.UserFull{ID: 10, About: "about"}))
+
+	_, found, err := s.FindUserFull(ctx, 10)
+	a.NoError(err)
+	a.True(found)
+
+	clock.Travel(time.Minute + time.Second)
+
+	_, found, err = s.FindUserFull(ctx, 10)
+	a.NoError(err)
+	a.False(found)
+
+	// Non-full objects do not expire.
+	_, found, err = s.FindUser(ctx, 10)
+	a.NoError(err)
+	a.True(found)
+
+	// Expired objects are evicted on flush.
+	a.NoError(s.SaveChats(ctx, &tg.Chat{ID: 20, Photo: &tg.ChatPhotoEmpty{}}))
+	a.Len(s.objects, 3)
+	a.NoError(s.Flush())
+	a.Len(s.objects, 2)
+}
+
+func TestFileStorageMaxObjects(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	clock := neo.NewTime(time.Unix(1000, 0))
+
+	s, err := NewFileStorage(filepath.Join(t.TempDir(), "peers.bin"), FileStorageOptions{
+		MaxObjects: 2,
+		Clock:      clock,
+	})
+	a.NoError(err)
+	defer func() {
+		a.NoError(s.Close())
+	}()
+
+	for i := int64(1); i <= 3; i++ {
+		a.NoError(s.SaveUsers(ctx, &tg.User{ID: i}))
+		a.NoError(s.Save(ctx, Key{Prefix: usersPrefix, ID: i}, Value{AccessHash: i}))
+		clock.Travel(time.Second)
+	}
+	a.NoError(s.Flush())
+
+	// Oldest object is evicted.
+	_, found, err := s.FindUser(ctx, 1)
+	a.NoError(err)
+	a.False(found)
+	for i := int64(2); i <= 3; i++ {
+		_, found, err := s.FindUser(ctx, i)
+		a.NoError(err)
+		a.True(found)
+	}
+
+	// Access hashes are never evicted.
+	for i := int64(1); i <= 3; i++ {
+		v, found, err := s.Find(ctx, Key{Prefix: usersPrefix, ID: i})
+		a.NoError(err)
+		a.True(found)
+		a.Equal(i, v.AccessHash)
+	}
+}
+
+func TestFileStorageFlushInterval(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "peers.bin")
+	clock := neo.NewTime(time.Unix(1000, 0))
+
+	s, err := NewFileStorage(path, FileStorageOptions{
+		FlushInterval: time.Second,
+		Clock:         clock,
+	})
+	a.NoError(err)
+	defer func() {
+		a.NoError(s.Close())
+	}()
+
+	for i := int64(1); i <= 10; i++ {
+		a.NoError(s.Save(ctx, Key{Prefix: usersPrefix, ID: i}, Value{AccessHash: i}))
+	}
+	_, err = os.Stat(path)
+	a.True(os.IsNotExist(err))
+
+	clock.Travel(time.Second)
+	a.Eventually(func() bool {
+		r, err := NewFileStorage(path, testFileStorageOptions())
+		if err != nil {
+			return false
+		}
+		_, found, _ := r.Find(ctx, Key{Prefix: usersPrefix, ID: 10})
+		return found
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestFileStorageFailedWrite(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "dir")
+	path := filepath.Join(dir, "peers.bin")
+	a.NoError(os.Mkdir(dir, 0o700))
+
+	s, err := NewFileStorage(path, testFileStorageOptions())
+	a.NoError(err)
+	a.NoError(s.SaveContactsHash(ctx, 1))
+	a.NoError(s.Flush())
+
+	// Make writes fail.
+	a.NoError(os.RemoveAll(dir))
+	a.NoError(s.SaveContactsHash(ctx, 2))
+	a.Error(s.Flush())
+
+	// Change is kept and written by next flush.
+	hash, err := s.GetContactsHash(ctx)
+	a.NoError(err)
+	a.Equal(int64(2), hash)
+
+	a.NoError(os.Mkdir(dir, 0o700))
+	a.NoError(s.Close())
+
+	s, err = NewFileStorage(path, testFileStorageOptions())
+	a.NoError(err)
+	hash, err = s.GetContactsHash(ctx)
+	a.NoError(err)
+	a.Equal(int64(2), hash)
+}
+
+func TestFileStorageCorrupted(t *testing.T) {
+	a := require.New(t)
+	path := filepath.Join(t.TempDir(), "peers.bin")
+	a.NoError(os.WriteFile(path, []byte("corrupted"), 0o600))
+
+	_, err := NewFileStorage(path, FileStorageOptions{})
+	a.Error(err)
+}
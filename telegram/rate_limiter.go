@@ -0,0 +1,302 @@
+package telegram
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/tg"
+)
+
+// Rate is rate limit of Events per Period.
+//
+// Zero Rate means no limit.
+type Rate struct {
+	Events int
+	Period time.Duration
+}
+
+// unlimited reports whether rate does not limit anything.
+func (r Rate) unlimited() bool {
+	return r.Events <= 0 || r.Period <= 0
+}
+
+// RateLimitRule is rate limits of request.
+type RateLimitRule struct {
+	// Bucket is name of rule limits shared by several methods.
+	// If empty, limits are not shared with other methods.
+	Bucket string
+	// Global limits all requests of rule.
+	Global Rate
+	// User limits requests to the same user.
+	User Rate
+	// Chat limits requests to the same chat or channel.
+	Chat Rate
+}
+
+// RateLimiterOptions is options of RateLimiter.
+type RateLimiterOptions struct {
+	// Rules are rate limit rules by TL request type id.
+	Rules map[uint32]RateLimitRule
+	// Default is rule for requests without rule in Rules.
+	// Zero value means no limit.
+	Default RateLimitRule
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+}
+
+func (o *RateLimiterOptions) setDefaults() {
+	if o.Rules == nil {
+		o.Rules = map[uint32]RateLimitRule{}
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+}
+
+// BotRateLimiterOptions returns RateLimiterOptions with limits of bot
+// messages: 30 messages per second overall, 1 message per second to the
+// same user and 20 messages per minute to the same group.
+//
+// Returned options can be modified to override limits.
+//
+// See https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this.
+func BotRateLimiterOptions() RateLimiterOptions {
+	rule := RateLimitRule{
+		Bucket: "messages",
+		Global: Rate{Events: 30, Period: time.Second},
+		User:   Rate{Events: 1, Period: time.Second},
+		Chat:   Rate{Events: 20, Period: time.Minute},
+	}
+	return RateLimiterOptions{
+		Rules: map[uint32]RateLimitRule{
+			tg.MessagesSendMessageRequestTypeID:     rule,
+			tg.MessagesSendMediaRequestTypeID:       rule,
+			tg.MessagesSendMultiMediaRequestTypeID:  rule,
+			tg.MessagesForwardMessagesRequestTypeID: rule,
+		},
+	}
+}
+
+// rateBucketKey is key of token bucket.
+type rateBucketKey struct {
+	method uint32
+	bucket string
+	peer   floodPeer
+}
+
+// rateBucket is token bucket.
+//
+// Tokens can be negative, which means that reservations are waiting
+// for refill.
+type rateBucket struct {
+	rate   Rate
+	tokens float64
+	last   time.Time
+}
+
+func newRateBucket(rate Rate, now time.Time) *rateBucket {
+	return &rateBucket{
+		rate:   rate,
+		tokens: float64(rate.Events),
+		last:   now,
+	}
+}
+
+func (b *rateBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += float64(elapsed) * float64(b.rate.Events) / float64(b.rate.Period)
+		b.last = now
+	}
+	if capacity := float64(b.rate.Events); b.tokens > capacity {
+		b.tokens = capacity
+	}
+}
+
+// full reports whether bucket is full, so it can be dropped.
+func (b *rateBucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= float64(b.rate.Events)
+}
+
+// reserve takes token and returns duration to wait before it is
+// available.
+func (b *rateBucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens * float64(b.rate.Period) / float64(b.rate.Events))
+}
+
+// cancel returns reserved token.
+func (b *rateBucket) cancel(now time.Time) {
+	b.tokens++
+	b.refill(now)
+}
+
+// rateLimiterSweep is number of buckets which triggers removal of
+// unused buckets.
+const rateLimiterSweep = 1024
+
+var _ Middleware = (*RateLimiter)(nil)
+
+// RateLimiter is Middleware which limits request rate using token buckets
+// by TL request type id and by target peer.
+//
+// Requests exceeding limits are delayed until tokens are available.
+type RateLimiter struct {
+	rules map[uint32]RateLimitRule
+	def   RateLimitRule
+	clock clock.Clock
+
+	buckets   map[rateBucketKey]*rateBucket
+	sweepSize int
+	mux       sync.Mutex
+
+	waiting atomic.Int64
+}
+
+// NewRateLimiter creates new RateLimiter.
+func NewRateLimiter(opts RateLimiterOptions) *RateLimiter {
+	opts.setDefaults()
+	rules := make(map[uint32]RateLimitRule, len(opts.Rules))
+	for k, v := range opts.Rules {
+		rules[k] = v
+	}
+	return &RateLimiter{
+		rules:     rules,
+		def:       opts.Default,
+		clock:     opts.Clock,
+		buckets:   map[rateBucketKey]*rateBucket{},
+		sweepSize: rateLimiterSweep,
+	}
+}
+
+// Waiting returns number of requests waiting for rate limit, i.e.
+// queue depth.
+func (r *RateLimiter) Waiting() int {
+	return int(r.waiting.Load())
+}
+
+type rateLimit struct {
+	key  rateBucketKey
+	rate Rate
+}
+
+// limits returns limits of given request.
+func (r *RateLimiter) limits(input bin.Encoder) []rateLimit {
+	var typeID uint32
+	if t, ok := input.(interface{ TypeID() uint32 }); ok {
+		typeID = t.TypeID()
+	}
+	rule, ok := r.rules[typeID]
+	if !ok {
+		rule = r.def
+	}
+
+	base := rateBucketKey{bucket: rule.Bucket}
+	if rule.Bucket == "" {
+		base.method = typeID
+	}
+
+	var limits []rateLimit
+	if !rule.Global.unlimited() {
+		limits = append(limits, rateLimit{key: base, rate: rule.Global})
+	}
+	if peer, ok := requestPeer(input); ok {
+		rate := rule.Chat
+		if peer.kind == 'u' {
+			rate = rule.User
+		}
+		if !rate.unlimited() {
+			key := base
+			key.peer = peer
+			limits = append(limits, rateLimit{key: key, rate: rate})
+		}
+	}
+	return limits
+}
+
+// sweepLocked removes full buckets if there are too many of them.
+func (r *RateLimiter) sweepLocked(now time.Time) {
+	if len(r.buckets) < r.sweepSize {
+		return
+	}
+	for k, b := range r.buckets {
+		if b.full(now) {
+			delete(r.buckets, k)
+		}
+	}
+	// Prevent sweeping on every request if most buckets are in use.
+	r.sweepSize = 2 * len(r.buckets)
+	if r.sweepSize < rateLimiterSweep {
+		r.sweepSize = rateLimiterSweep
+	}
+}
+
+// reserve takes tokens of given limits and returns duration to wait.
+func (r *RateLimiter) reserve(limits []rateLimit) time.Duration {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	now := r.clock.Now()
+	r.sweepLocked(now)
+
+	var wait time.Duration
+	for _, l := range limits {
+		b, ok := r.buckets[l.key]
+		if !ok || b.rate != l.rate {
+			b = newRateBucket(l.rate, now)
+			r.buckets[l.key] = b
+		}
+		if d := b.reserve(now); d > wait {
+			wait = d
+		}
+	}
+	return wait
+}
+
+// cancel returns tokens of given limits.
+func (r *RateLimiter) cancel(limits []rateLimit) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	now := r.clock.Now()
+	for _, l := range limits {
+		if b, ok := r.buckets[l.key]; ok {
+			b.cancel(now)
+		}
+	}
+}
+
+// Handle implements Middleware.
+func (r *RateLimiter) Handle(next tg.Invoker) InvokeFunc {
+	return func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+		limits := r.limits(input)
+		if len(limits) == 0 {
+			return next.Invoke(ctx, input, output)
+		}
+
+		if wait := r.reserve(limits); wait > 0 {
+			r.waiting.Inc()
+			timer := r.clock.Timer(wait)
+			select {
+			case <-timer.C():
+				r.waiting.Dec()
+			case <-ctx.Done():
+				clock.StopTimer(timer)
+				r.waiting.Dec()
+				r.cancel(limits)
+				return ctx.Err()
+			}
+		}
+
+		return next.Invoke(ctx, input, output)
+	}
+}
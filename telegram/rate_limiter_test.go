@@ -0,0 +1,125 @@
+package telegram_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	nop := telegram.InvokeFunc(func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+		return nil
+	})
+	send := func(peer tg.InputPeerClass) bin.Encoder {
+		return &tg.MessagesSendMessageRequest{Peer: peer}
+	}
+
+	t.Run("Global", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		limiter := telegram.NewRateLimiter(telegram.RateLimiterOptions{
+			Rules: map[uint32]telegram.RateLimitRule{
+				tg.HelpGetConfigRequestTypeID: {
+					Global: telegram.Rate{Events: 2, Period: time.Second},
+				},
+			},
+			Clock: clock,
+		})
+		invoker := limiter.Handle(nop)
+
+		// Burst.
+		a.NoError(invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil))
+		a.NoError(invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil))
+		// Other methods are not limited.
+		a.NoError(invoker.Invoke(ctx, &tg.HelpGetNearestDCRequest{}, nil))
+
+		observe := clock.Observe()
+		done := make(chan error, 1)
+		go func() {
+			done <- invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil)
+		}()
+		<-observe
+		a.Equal(1, limiter.Waiting())
+		clock.Travel(500 * time.Millisecond)
+
+		a.NoError(<-done)
+		a.Zero(limiter.Waiting())
+	})
+	t.Run("Bot", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		opts := telegram.BotRateLimiterOptions()
+		opts.Clock = clock
+		invoker := telegram.NewRateLimiter(opts).Handle(nop)
+
+		// Different users are limited separately.
+		a.NoError(invoker.Invoke(ctx, send(&tg.InputPeerUser{UserID: 1}), nil))
+		a.NoError(invoker.Invoke(ctx, send(&tg.InputPeerUser{UserID: 2}), nil))
+
+		// Limit is shared between message sending methods.
+		observe := clock.Observe()
+		done := make(chan error, 1)
+		go func() {
+			done <- invoker.Invoke(ctx, &tg.MessagesSendMediaRequest{
+				Peer: &tg.InputPeerUser{UserID: 1},
+			}, nil)
+		}()
+		<-observe
+		clock.Travel(time.Second)
+		a.NoError(<-done)
+
+		// Group allows 20 messages per minute.
+		for i := 0; i < 20; i++ {
+			a.NoError(invoker.Invoke(ctx, send(&tg.InputPeerChat{ChatID: 10}), nil))
+			clock.Travel(100 * time.Millisecond)
+		}
+		observe = clock.Observe()
+		go func() {
+			done <- invoker.Invoke(ctx, send(&tg.InputPeerChat{ChatID: 10}), nil)
+		}()
+		<-observe
+		clock.Travel(time.Second)
+		select {
+		case <-done:
+			t.Fatal("unexpected send")
+		default:
+		}
+		clock.Travel(2 * time.Second)
+		a.NoError(<-done)
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		a := require.New(t)
+		clock := neo.NewTime(time.Unix(1000, 0))
+		limiter := telegram.NewRateLimiter(telegram.RateLimiterOptions{
+			Default: telegram.RateLimitRule{
+				Global: telegram.Rate{Events: 1, Period: time.Second},
+			},
+			Clock: clock,
+		})
+		invoker := limiter.Handle(nop)
+		a.NoError(invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil))
+
+		ctx, cancel := context.WithCancel(ctx)
+		observe := clock.Observe()
+		done := make(chan error, 1)
+		go func() {
+			done <- invoker.Invoke(ctx, &tg.HelpGetConfigRequest{}, nil)
+		}()
+		<-observe
+		cancel()
+		a.ErrorIs(<-done, context.Canceled)
+		a.Zero(limiter.Waiting())
+
+		// Reserved token is returned.
+		clock.Travel(time.Second)
+		a.NoError(invoker.Invoke(context.Background(), &tg.HelpGetConfigRequest{}, nil))
+	})
+}
@@ -0,0 +1,162 @@
+package secretchat
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg"
+)
+
+// State is secret chat state.
+type State int
+
+const (
+	// StateWaiting means that chat is requested by current user and
+	// is waiting for acceptance by other side.
+	StateWaiting State = iota
+	// StateRequested means that chat is requested by other side and
+	// is waiting for acceptance by current user.
+	StateRequested
+	// StateReady means that key is established and chat can be used.
+	StateReady
+	// StateDiscarded means that chat was discarded by one of sides.
+	StateDiscarded
+)
+
+// String implements fmt.Stringer.
+func (s State) String() string {
+	switch s {
+	case StateWaiting:
+		return "waiting"
+	case StateRequested:
+		return "requested"
+	case StateReady:
+		return "ready"
+	case StateDiscarded:
+		return "discarded"
+	default:
+		return "unknown"
+	}
+}
+
+// Exchange is state of key re-negotiation.
+//
+// See https://core.telegram.org/api/end-to-end/pfs.
+type Exchange struct {
+	// ID of exchange, zero if there is no active exchange.
+	ID int64
+	// Initiator denotes whether current user started exchange.
+	Initiator bool
+	// A is private DH exponent of initiator.
+	A []byte
+	// Key is a new key, computed by accepting side.
+	Key crypto.AuthKey
+}
+
+// Chat is secret chat state.
+type Chat struct {
+	// ID of secret chat.
+	ID int
+	// AccessHash of secret chat.
+	AccessHash int64
+	// Originator denotes whether current user created the chat.
+	Originator bool
+	// AdminID is ID of chat creator.
+	AdminID int64
+	// ParticipantID is ID of invited user.
+	ParticipantID int64
+	// State of chat.
+	State State
+	// Layer is negotiated secret chat layer.
+	Layer int
+
+	// A is private DH exponent of originator, used until chat is accepted.
+	A []byte
+	// GA is g_a of originator, used until chat is accepted.
+	GA []byte
+
+	// Key is current encryption key.
+	Key crypto.AuthKey
+	// PrevKey is previous encryption key, kept to decrypt messages sent
+	// before re-keying.
+	PrevKey crypto.AuthKey
+	// KeyUsed is count of messages sent and received using current key.
+	KeyUsed int
+	// KeyCreated is time of current key creation.
+	KeyCreated time.Time
+	// Exchange is current key re-negotiation state.
+	Exchange Exchange
+
+	// InSeq is count of received messages.
+	InSeq int
+	// OutSeq is count of sent messages.
+	OutSeq int
+}
+
+// Input returns input peer of chat.
+func (c Chat) Input() tg.InputEncryptedChat {
+	return tg.InputEncryptedChat{
+		ChatID:     c.ID,
+		AccessHash: c.AccessHash,
+	}
+}
+
+// PeerID returns ID of other participant.
+func (c Chat) PeerID() int64 {
+	if c.Originator {
+		return c.ParticipantID
+	}
+	return c.AdminID
+}
+
+// side returns encryption side of current user.
+//
+// Messages from chat originator are encrypted with x = 0, so
+// originator is considered a "client" side.
+func (c Chat) side() crypto.Side {
+	if c.Originator {
+		return crypto.Client
+	}
+	return crypto.Server
+}
+
+// outParity returns parity of out_seq_no of current user.
+func (c Chat) outParity() int {
+	if c.Originator {
+		return 1
+	}
+	return 0
+}
+
+// inParity returns parity of out_seq_no of other side.
+func (c Chat) inParity() int {
+	return 1 - c.outParity()
+}
+
+// seqNo returns in_seq_no and out_seq_no for next message.
+func (c Chat) seqNo() (in, out int) {
+	return 2*c.InSeq + c.inParity(), 2*c.OutSeq + c.outParity()
+}
+
+// keyByFingerprint finds key by fingerprint.
+func (c Chat) keyByFingerprint(fingerprint int64) (crypto.AuthKey, bool) {
+	for _, k := range []crypto.AuthKey{
+		c.Key,
+		c.PrevKey,
+		c.Exchange.Key,
+	} {
+		if k.Value.Zero() {
+			continue
+		}
+		if keyFingerprint(k) == fingerprint {
+			return k, true
+		}
+	}
+	return crypto.AuthKey{}, false
+}
+
+// keyFingerprint returns key_fingerprint, the 64 lower-order bits of SHA1(key).
+func keyFingerprint(k crypto.AuthKey) int64 {
+	return int64(binary.LittleEndian.Uint64(k.ID[:]))
+}
@@ -0,0 +1,107 @@
+package secretchat
+
+import (
+	"crypto/aes"
+	"io"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/ige"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/crypto"
+)
+
+const (
+	minPadding = 12
+	maxPadding = 1024
+)
+
+// encrypt encrypts serialized DecryptedMessageLayer using MTProto 2.0
+// end-to-end encryption rules.
+//
+// See https://core.telegram.org/api/end-to-end#sending-encrypted-messages.
+func encrypt(rand io.Reader, k crypto.AuthKey, side crypto.Side, data []byte) ([]byte, error) {
+	plaintext := bin.Buffer{Buf: make([]byte, 0, 4+len(data)+minPadding+16)}
+	plaintext.PutInt32(int32(len(data)))
+	plaintext.Put(data)
+
+	padding := minPadding + (16-(len(plaintext.Buf)+minPadding)%16)%16
+	offset := len(plaintext.Buf)
+	plaintext.Expand(padding)
+	if _, err := io.ReadFull(rand, plaintext.Buf[offset:]); err != nil {
+		return nil, errors.Wrap(err, "read padding")
+	}
+
+	msgKey := crypto.MessageKey(k.Value, plaintext.Buf, side)
+	key, iv := crypto.Keys(k.Value, msgKey, side)
+	aesBlock, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "create cipher")
+	}
+
+	encrypted := make([]byte, len(plaintext.Buf))
+	ige.EncryptBlocks(aesBlock, iv[:], encrypted, plaintext.Buf)
+
+	out := bin.Buffer{Buf: make([]byte, 0, 8+16+len(encrypted))}
+	out.PutLong(keyFingerprint(k))
+	out.PutInt128(msgKey)
+	out.Put(encrypted)
+	return out.Buf, nil
+}
+
+// decodeFingerprint returns key_fingerprint of encrypted message.
+func decodeFingerprint(data []byte) (int64, error) {
+	b := bin.Buffer{Buf: data}
+	return b.Long()
+}
+
+// decrypt decrypts message encrypted by encrypt.
+func decrypt(k crypto.AuthKey, side crypto.Side, data []byte) ([]byte, error) {
+	b := bin.Buffer{Buf: data}
+	fingerprint, err := b.Long()
+	if err != nil {
+		return nil, errors.Wrap(err, "decode key fingerprint")
+	}
+	if fingerprint != keyFingerprint(k) {
+		return nil, errors.Errorf("unexpected key fingerprint %d", fingerprint)
+	}
+	msgKey, err := b.Int128()
+	if err != nil {
+		return nil, errors.Wrap(err, "decode msg_key")
+	}
+	encrypted := b.Buf
+	if len(encrypted) == 0 || len(encrypted)%16 != 0 {
+		return nil, errors.New("invalid encrypted data padding")
+	}
+
+	key, iv := crypto.Keys(k.Value, msgKey, side)
+	aesBlock, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "create cipher")
+	}
+	plaintext := make([]byte, len(encrypted))
+	ige.DecryptBlocks(aesBlock, iv[:], plaintext, encrypted)
+
+	if crypto.MessageKey(k.Value, plaintext, side) != msgKey {
+		return nil, errors.New("msg_key is invalid")
+	}
+
+	p := bin.Buffer{Buf: plaintext}
+	n, err := p.Int32()
+	if err != nil {
+		return nil, errors.Wrap(err, "decode length")
+	}
+	paddingLen := len(p.Buf) - int(n)
+	switch {
+	case n < 0:
+		return nil, errors.Errorf("message length is invalid: %d less than zero", n)
+	case n%4 != 0:
+		return nil, errors.Errorf("message length is invalid: %d is not divisible by 4", n)
+	case paddingLen < minPadding:
+		return nil, errors.Errorf("padding %d of message is too small", paddingLen)
+	case paddingLen > maxPadding:
+		return nil, errors.Errorf("padding %d of message is too big", paddingLen)
+	}
+
+	return p.Buf[:n], nil
+}
@@ -0,0 +1,58 @@
+package secretchat
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/crypto"
+)
+
+func testKey(t *testing.T) crypto.AuthKey {
+	var k crypto.Key
+	_, err := rand.Read(k[:])
+	require.NoError(t, err)
+	return k.WithID()
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	a := require.New(t)
+	key := testKey(t)
+
+	for _, size := range []int{0, 4, 16, 28, 1024} {
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		a.NoError(err)
+
+		encrypted, err := encrypt(rand.Reader, key, crypto.Client, data)
+		a.NoError(err)
+
+		fingerprint, err := decodeFingerprint(encrypted)
+		a.NoError(err)
+		a.Equal(keyFingerprint(key), fingerprint)
+
+		decrypted, err := decrypt(key, crypto.Client, encrypted)
+		a.NoError(err)
+		a.Equal(data, decrypted)
+
+		// Other side uses other part of the key.
+		_, err = decrypt(key, crypto.Server, encrypted)
+		a.Error(err)
+		// Another key.
+		_, err = decrypt(testKey(t), crypto.Client, encrypted)
+		a.Error(err)
+	}
+}
+
+func TestDecryptTampered(t *testing.T) {
+	a := require.New(t)
+	key := testKey(t)
+
+	encrypted, err := encrypt(rand.Reader, key, crypto.Server, []byte("abcdabcd"))
+	a.NoError(err)
+
+	encrypted[len(encrypted)-1] ^= 1
+	_, err = decrypt(key, crypto.Server, encrypted)
+	a.Error(err)
+}
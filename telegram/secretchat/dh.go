@@ -0,0 +1,128 @@
+package secretchat
+
+import (
+	"context"
+	"io"
+	"math/big"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg"
+)
+
+type dhConfig struct {
+	G       int
+	P       *big.Int
+	Version int
+}
+
+// getDHConfig requests DH parameters and server-side random.
+//
+// Parameters are cached and checked only once per version.
+func (m *Manager) getDHConfig(ctx context.Context) (dhConfig, []byte, error) {
+	m.dhMux.Lock()
+	cfg := m.dh
+	m.dhMux.Unlock()
+
+	r, err := m.api.MessagesGetDhConfig(ctx, &tg.MessagesGetDhConfigRequest{
+		Version:      cfg.Version,
+		RandomLength: 256,
+	})
+	if err != nil {
+		return dhConfig{}, nil, errors.Wrap(err, "get dh config")
+	}
+
+	switch r := r.(type) {
+	case *tg.MessagesDhConfigNotModified:
+		if cfg.P == nil {
+			return dhConfig{}, nil, errors.New("unexpected dh config not modified")
+		}
+		return cfg, r.Random, nil
+	case *tg.MessagesDhConfig:
+		p := big.NewInt(0).SetBytes(r.P)
+		// Primality check is expensive, so skip it if parameters are unchanged.
+		if cfg.P == nil || cfg.G != r.G || cfg.P.Cmp(p) != 0 {
+			if err := crypto.CheckDH(r.G, p); err != nil {
+				return dhConfig{}, nil, errors.Wrap(err, "check dh config")
+			}
+		}
+
+		cfg = dhConfig{
+			G:       r.G,
+			P:       p,
+			Version: r.Version,
+		}
+		m.dhMux.Lock()
+		m.dh = cfg
+		m.dhMux.Unlock()
+		return cfg, r.Random, nil
+	default:
+		return dhConfig{}, nil, errors.Errorf("unexpected type %T", r)
+	}
+}
+
+// checkGA checks that g_a (or g_b) is in allowed range.
+func checkGA(gA, p *big.Int) error {
+	one := big.NewInt(1)
+	if !crypto.InRange(gA, one, big.NewInt(0).Sub(p, one)) {
+		return errors.New("g_a must be 1 < g_a < dh_prime - 1")
+	}
+
+	safetyRangeMin := big.NewInt(0).Exp(big.NewInt(2), big.NewInt(crypto.RSAKeyBits-64), nil)
+	safetyRangeMax := big.NewInt(0).Sub(p, safetyRangeMin)
+	if !crypto.InRange(gA, safetyRangeMin, safetyRangeMax) {
+		return errors.New("g_a must be 2^{2048-64} < g_a < dh_prime - 2^{2048-64}")
+	}
+
+	return nil
+}
+
+// generateDH generates private exponent a and public value g_a.
+func (m *Manager) generateDH(ctx context.Context) (a, gA []byte, cfg dhConfig, _ error) {
+	cfg, serverRandom, err := m.getDHConfig(ctx)
+	if err != nil {
+		return nil, nil, dhConfig{}, err
+	}
+
+	g := big.NewInt(int64(cfg.G))
+	for {
+		a = make([]byte, 256)
+		if _, err := io.ReadFull(m.rand, a); err != nil {
+			return nil, nil, dhConfig{}, errors.Wrap(err, "read random")
+		}
+		// Client is expected to mix server-provided random with own random.
+		for i := range a {
+			if i < len(serverRandom) {
+				a[i] ^= serverRandom[i]
+			}
+		}
+
+		gABig := big.NewInt(0).Exp(g, big.NewInt(0).SetBytes(a), cfg.P)
+		if checkGA(gABig, cfg.P) != nil {
+			continue
+		}
+
+		gA = make([]byte, 256)
+		if !crypto.FillBytes(gABig, gA) {
+			continue
+		}
+		return a, gA, cfg, nil
+	}
+}
+
+// computeKey computes shared key (g_b)^a mod dh_prime.
+func computeKey(cfg dhConfig, a, gB []byte) (crypto.AuthKey, error) {
+	gBBig := big.NewInt(0).SetBytes(gB)
+	if err := checkGA(gBBig, cfg.P); err != nil {
+		return crypto.AuthKey{}, errors.Wrap(err, "check g_b")
+	}
+
+	var key crypto.Key
+	shared := big.NewInt(0).Exp(gBBig, big.NewInt(0).SetBytes(a), cfg.P)
+	if !crypto.FillBytes(shared, key[:]) {
+		return crypto.AuthKey{}, errors.New("shared key is too big")
+	}
+
+	return key.WithID(), nil
+}
@@ -0,0 +1,6 @@
+// Package secretchat implements client side of end-to-end encrypted secret chats.
+//
+// See https://core.telegram.org/api/end-to-end.
+//
+// NB: this package is completely experimental and still WIP.
+package secretchat
@@ -0,0 +1,267 @@
+package secretchat
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tg/e2e"
+)
+
+// OnEncryption handles secret chat state updates.
+func (m *Manager) OnEncryption(ctx context.Context, e tg.Entities, update *tg.UpdateEncryption) error {
+	var ev events
+	if err := m.handleEncryption(ctx, update.Chat, &ev); err != nil {
+		return errors.Wrap(err, "handle encryption")
+	}
+	return m.emit(ctx, ev)
+}
+
+func (m *Manager) handleEncryption(ctx context.Context, c tg.EncryptedChatClass, ev *events) error {
+	s := m.lock(c.GetID())
+	defer s.mux.Unlock()
+
+	switch c := c.(type) {
+	case *tg.EncryptedChatRequested:
+		chat := Chat{
+			ID:            c.ID,
+			AccessHash:    c.AccessHash,
+			AdminID:       c.AdminID,
+			ParticipantID: c.ParticipantID,
+			State:         StateRequested,
+			Layer:         defaultLayer,
+			GA:            c.GA,
+		}
+		if err := m.storage.SaveChat(ctx, chat); err != nil {
+			return errors.Wrap(err, "save chat")
+		}
+		ev.chats = append(ev.chats, chat)
+	case *tg.EncryptedChat:
+		chat, ok, err := m.storage.FindChat(ctx, c.ID)
+		if err != nil {
+			return errors.Wrap(err, "find chat")
+		}
+		if !ok || chat.State != StateWaiting {
+			return nil
+		}
+
+		cfg, _, err := m.getDHConfig(ctx)
+		if err != nil {
+			return err
+		}
+		key, err := computeKey(cfg, chat.A, c.GAOrB)
+		if err != nil {
+			return errors.Wrap(err, "compute key")
+		}
+		if keyFingerprint(key) != c.KeyFingerprint {
+			return errors.Errorf("key fingerprint mismatch for chat %d", c.ID)
+		}
+
+		chat.AccessHash = c.AccessHash
+		chat.State = StateReady
+		chat.A = nil
+		chat.GA = nil
+		chat.Key = key
+		chat.KeyCreated = m.clock.Now()
+		if err := m.storage.SaveChat(ctx, chat); err != nil {
+			return errors.Wrap(err, "save chat")
+		}
+		if err := m.notifyLayer(ctx, &chat); err != nil {
+			return err
+		}
+		ev.chats = append(ev.chats, chat)
+	case *tg.EncryptedChatDiscarded:
+		chat, ok, err := m.storage.FindChat(ctx, c.ID)
+		if err != nil {
+			return errors.Wrap(err, "find chat")
+		}
+		if !ok || chat.State == StateDiscarded {
+			return nil
+		}
+
+		chat.State = StateDiscarded
+		if err := m.storage.SaveChat(ctx, chat); err != nil {
+			return errors.Wrap(err, "save chat")
+		}
+		ev.chats = append(ev.chats, chat)
+	}
+
+	return nil
+}
+
+// OnNewEncryptedMessage handles new secret chat messages.
+func (m *Manager) OnNewEncryptedMessage(ctx context.Context, e tg.Entities, update *tg.UpdateNewEncryptedMessage) error {
+	var (
+		ev   events
+		data []byte
+		file tg.EncryptedFileClass
+	)
+	switch msg := update.Message.(type) {
+	case *tg.EncryptedMessage:
+		data = msg.Bytes
+		file = msg.File
+	case *tg.EncryptedMessageService:
+		data = msg.Bytes
+	default:
+		return errors.Errorf("unexpected type %T", msg)
+	}
+
+	if err := m.handleMessage(ctx, update.Message.GetChatID(), incoming{
+		Date: update.Message.GetDate(),
+		File: file,
+	}, data, &ev); err != nil {
+		return errors.Wrap(err, "handle message")
+	}
+	if update.Qts != 0 {
+		if _, err := m.api.MessagesReceivedQueue(ctx, update.Qts); err != nil {
+			m.logger.Warn("Failed to acknowledge qts", zap.Error(err))
+		}
+	}
+
+	return m.emit(ctx, ev)
+}
+
+func (m *Manager) handleMessage(ctx context.Context, id int, in incoming, data []byte, ev *events) error {
+	s := m.lock(id)
+	defer s.mux.Unlock()
+
+	chat, err := m.findChat(ctx, id)
+	if err != nil {
+		return err
+	}
+	if chat.State != StateReady {
+		m.logger.Warn("Got message for chat which is not ready",
+			zap.Int("chat_id", id),
+			zap.Stringer("state", chat.State),
+		)
+		return nil
+	}
+
+	fingerprint, err := decodeFingerprint(data)
+	if err != nil {
+		return errors.Wrap(err, "decode fingerprint")
+	}
+	key, ok := chat.keyByFingerprint(fingerprint)
+	if !ok {
+		m.logger.Warn("Got message with unknown key",
+			zap.Int("chat_id", id),
+			zap.Int64("key_fingerprint", fingerprint),
+		)
+		return nil
+	}
+
+	plaintext, err := decrypt(key, chat.side().DecryptSide(), data)
+	if err != nil {
+		return errors.Wrap(err, "decrypt")
+	}
+
+	layer := new(e2e.DecryptedMessageLayer)
+	if err := layer.Decode(&bin.Buffer{Buf: plaintext}); err != nil {
+		return errors.Wrap(err, "decode layer")
+	}
+	in.Layer = layer
+
+	return m.receive(ctx, s, &chat, in, ev)
+}
+
+// receive checks sequence numbers of message and processes it and all
+// pending messages after it.
+//
+// See https://core.telegram.org/api/end-to-end/seq_no.
+func (m *Manager) receive(ctx context.Context, s *chatState, chat *Chat, in incoming, ev *events) error {
+	if sent := (in.Layer.InSeqNo - chat.outParity()) / 2; sent > chat.OutSeq {
+		return errors.Errorf("invalid in_seq_no %d: only %d messages sent", in.Layer.InSeqNo, chat.OutSeq)
+	}
+
+	expected := 2*chat.InSeq + chat.inParity()
+	switch seq := in.Layer.OutSeqNo; {
+	case seq < expected:
+		m.logger.Debug("Ignore duplicate message",
+			zap.Int("chat_id", chat.ID),
+			zap.Int("out_seq_no", seq),
+		)
+		return nil
+	case seq > expected:
+		if (seq-expected)%2 != 0 {
+			return errors.Errorf("invalid out_seq_no %d", seq)
+		}
+		s.pending[seq] = in
+
+		if seq-2 <= s.resendTo {
+			return nil
+		}
+		from := expected
+		if s.resendTo >= from {
+			from = s.resendTo + 2
+		}
+		s.resendTo = seq - 2
+		if err := m.sendService(ctx, chat, &e2e.DecryptedMessageActionResend{
+			StartSeqNo: from,
+			EndSeqNo:   seq - 2,
+		}); err != nil {
+			return errors.Wrap(err, "request resend")
+		}
+		return nil
+	}
+
+	for {
+		chat.InSeq++
+		chat.KeyUsed++
+		if err := m.process(ctx, chat, in, ev); err != nil {
+			return err
+		}
+
+		next, ok := s.pending[2*chat.InSeq+chat.inParity()]
+		if !ok {
+			break
+		}
+		delete(s.pending, next.Layer.OutSeqNo)
+		in = next
+	}
+
+	if err := m.storage.SaveChat(ctx, *chat); err != nil {
+		return errors.Wrap(err, "save chat")
+	}
+	return m.maybeRekey(ctx, chat)
+}
+
+// process handles single message in order.
+func (m *Manager) process(ctx context.Context, chat *Chat, in incoming, ev *events) error {
+	if l := min(in.Layer.Layer, m.layer); l > chat.Layer {
+		chat.Layer = l
+	}
+
+	if msg, ok := in.Layer.Message.(*e2e.DecryptedMessageService); ok {
+		switch action := msg.Action.(type) {
+		case *e2e.DecryptedMessageActionNotifyLayer:
+			if l := min(action.Layer, m.layer); l > chat.Layer {
+				chat.Layer = l
+			}
+			return nil
+		case *e2e.DecryptedMessageActionResend:
+			return m.resend(ctx, *chat, action.StartSeqNo, action.EndSeqNo)
+		case *e2e.DecryptedMessageActionRequestKey:
+			return m.onRequestKey(ctx, chat, action)
+		case *e2e.DecryptedMessageActionAcceptKey:
+			return m.onAcceptKey(ctx, chat, action)
+		case *e2e.DecryptedMessageActionCommitKey:
+			return m.onCommitKey(ctx, chat, action)
+		case *e2e.DecryptedMessageActionAbortKey:
+			m.onAbortKey(chat, action)
+			return nil
+		case *e2e.DecryptedMessageActionNoop:
+			return nil
+		}
+	}
+
+	ev.messages = append(ev.messages, Message{
+		Chat:    *chat,
+		Date:    in.Date,
+		Message: in.Layer.Message,
+		File:    in.File,
+	})
+	return nil
+}
@@ -0,0 +1,290 @@
+package secretchat
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tg/e2e"
+)
+
+const (
+	// Layer is the latest supported secret chat layer.
+	Layer = 144
+	// defaultLayer is a layer used before other side notifies its layer.
+	defaultLayer = 46
+)
+
+// ErrChatNotFound is returned when chat is not found in storage.
+var ErrChatNotFound = errors.New("secret chat not found")
+
+// incoming is received message waiting for processing.
+type incoming struct {
+	Layer *e2e.DecryptedMessageLayer
+	Date  int
+	File  tg.EncryptedFileClass
+}
+
+// chatState is in-memory state of chat.
+type chatState struct {
+	mux sync.Mutex
+	// pending stores messages received out of order by out_seq_no.
+	pending map[int]incoming
+	// resendTo is max out_seq_no requested to resend.
+	resendTo int
+}
+
+// events are callbacks to call after chat is unlocked.
+type events struct {
+	chats    []Chat
+	messages []Message
+}
+
+// Manager is secret chats manager.
+//
+// NB: this package is completely experimental and still WIP.
+type Manager struct {
+	api       *tg.Client
+	storage   Storage
+	onMessage func(ctx context.Context, msg Message) error
+	onChat    func(ctx context.Context, chat Chat) error
+
+	layer         int
+	rekeyMessages int
+	rekeyInterval time.Duration
+
+	dh    dhConfig
+	dhMux sync.Mutex // guards dh
+
+	chats    map[int]*chatState
+	chatsMux sync.Mutex // guards chats
+
+	rand   io.Reader
+	clock  clock.Clock
+	logger *zap.Logger
+}
+
+// NewManager creates new Manager.
+func NewManager(api *tg.Client, opts Options) *Manager {
+	opts.setDefaults()
+	return &Manager{
+		api:           api,
+		storage:       opts.Storage,
+		onMessage:     opts.OnMessage,
+		onChat:        opts.OnChat,
+		layer:         opts.Layer,
+		rekeyMessages: opts.RekeyMessages,
+		rekeyInterval: opts.RekeyInterval,
+		chats:         map[int]*chatState{},
+		rand:          opts.Random,
+		clock:         opts.Clock,
+		logger:        opts.Logger,
+	}
+}
+
+// Register sets update handlers to given dispatcher.
+func (m *Manager) Register(d interface {
+	OnEncryption(tg.EncryptionHandler)
+	OnNewEncryptedMessage(tg.NewEncryptedMessageHandler)
+},
+) {
+	d.OnEncryption(m.OnEncryption)
+	d.OnNewEncryptedMessage(m.OnNewEncryptedMessage)
+}
+
+// lock locks chat and returns its in-memory state.
+func (m *Manager) lock(id int) *chatState {
+	m.chatsMux.Lock()
+	s, ok := m.chats[id]
+	if !ok {
+		s = &chatState{
+			pending: map[int]incoming{},
+		}
+		m.chats[id] = s
+	}
+	m.chatsMux.Unlock()
+
+	s.mux.Lock()
+	return s
+}
+
+func (m *Manager) emit(ctx context.Context, ev events) error {
+	for _, chat := range ev.chats {
+		if err := m.onChat(ctx, chat); err != nil {
+			return errors.Wrap(err, "handle chat")
+		}
+	}
+	for _, msg := range ev.messages {
+		if err := m.onMessage(ctx, msg); err != nil {
+			return errors.Wrap(err, "handle message")
+		}
+	}
+	return nil
+}
+
+func (m *Manager) findChat(ctx context.Context, id int) (Chat, error) {
+	chat, ok, err := m.storage.FindChat(ctx, id)
+	if err != nil {
+		return Chat{}, errors.Wrap(err, "find chat")
+	}
+	if !ok {
+		return Chat{}, errors.Wrapf(ErrChatNotFound, "find %d", id)
+	}
+	return chat, nil
+}
+
+// Chat returns chat state.
+func (m *Manager) Chat(ctx context.Context, id int) (Chat, error) {
+	s := m.lock(id)
+	defer s.mux.Unlock()
+
+	return m.findChat(ctx, id)
+}
+
+func (m *Manager) randInt64() (int64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(m.rand, buf[:]); err != nil {
+		return 0, errors.Wrap(err, "read random")
+	}
+	return int64(binary.LittleEndian.Uint64(buf[:])), nil
+}
+
+// Request requests new secret chat with given user.
+//
+// Returned chat is in StateWaiting until other side accepts it.
+func (m *Manager) Request(ctx context.Context, user tg.InputUserClass) (Chat, error) {
+	a, gA, _, err := m.generateDH(ctx)
+	if err != nil {
+		return Chat{}, errors.Wrap(err, "generate dh")
+	}
+
+	randomID, err := m.randInt64()
+	if err != nil {
+		return Chat{}, err
+	}
+
+	r, err := m.api.MessagesRequestEncryption(ctx, &tg.MessagesRequestEncryptionRequest{
+		UserID:   user,
+		RandomID: int(int32(randomID)),
+		GA:       gA,
+	})
+	if err != nil {
+		return Chat{}, errors.Wrap(err, "request encryption")
+	}
+
+	waiting, ok := r.(*tg.EncryptedChatWaiting)
+	if !ok {
+		return Chat{}, errors.Errorf("unexpected type %T", r)
+	}
+
+	chat := Chat{
+		ID:            waiting.ID,
+		AccessHash:    waiting.AccessHash,
+		Originator:    true,
+		AdminID:       waiting.AdminID,
+		ParticipantID: waiting.ParticipantID,
+		State:         StateWaiting,
+		Layer:         defaultLayer,
+		A:             a,
+		GA:            gA,
+	}
+
+	s := m.lock(chat.ID)
+	defer s.mux.Unlock()
+
+	if err := m.storage.SaveChat(ctx, chat); err != nil {
+		return Chat{}, errors.Wrap(err, "save chat")
+	}
+	return chat, nil
+}
+
+// Accept accepts secret chat requested by other side.
+func (m *Manager) Accept(ctx context.Context, id int) (Chat, error) {
+	chat, err := m.accept(ctx, id)
+	if err != nil {
+		return Chat{}, err
+	}
+
+	if err := m.emit(ctx, events{chats: []Chat{chat}}); err != nil {
+		return Chat{}, err
+	}
+	return chat, nil
+}
+
+func (m *Manager) accept(ctx context.Context, id int) (Chat, error) {
+	s := m.lock(id)
+	defer s.mux.Unlock()
+
+	chat, err := m.findChat(ctx, id)
+	if err != nil {
+		return Chat{}, err
+	}
+	if chat.State != StateRequested {
+		return Chat{}, errors.Errorf("unexpected chat state %s", chat.State)
+	}
+
+	b, gB, cfg, err := m.generateDH(ctx)
+	if err != nil {
+		return Chat{}, errors.Wrap(err, "generate dh")
+	}
+	key, err := computeKey(cfg, b, chat.GA)
+	if err != nil {
+		return Chat{}, errors.Wrap(err, "compute key")
+	}
+
+	r, err := m.api.MessagesAcceptEncryption(ctx, &tg.MessagesAcceptEncryptionRequest{
+		Peer:           chat.Input(),
+		GB:             gB,
+		KeyFingerprint: keyFingerprint(key),
+	})
+	if err != nil {
+		return Chat{}, errors.Wrap(err, "accept encryption")
+	}
+	if _, ok := r.(*tg.EncryptedChat); !ok {
+		return Chat{}, errors.Errorf("unexpected type %T", r)
+	}
+
+	chat.State = StateReady
+	chat.GA = nil
+	chat.Key = key
+	chat.KeyCreated = m.clock.Now()
+	if err := m.storage.SaveChat(ctx, chat); err != nil {
+		return Chat{}, errors.Wrap(err, "save chat")
+	}
+
+	if err := m.notifyLayer(ctx, &chat); err != nil {
+		return Chat{}, err
+	}
+	return chat, nil
+}
+
+// Discard discards secret chat.
+func (m *Manager) Discard(ctx context.Context, id int, deleteHistory bool) error {
+	s := m.lock(id)
+	defer s.mux.Unlock()
+
+	chat, err := m.findChat(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := m.api.MessagesDiscardEncryption(ctx, &tg.MessagesDiscardEncryptionRequest{
+		DeleteHistory: deleteHistory,
+		ChatID:        id,
+	}); err != nil {
+		return errors.Wrap(err, "discard encryption")
+	}
+
+	chat.State = StateDiscarded
+	if err := m.storage.SaveChat(ctx, chat); err != nil {
+		return errors.Wrap(err, "save chat")
+	}
+	return nil
+}
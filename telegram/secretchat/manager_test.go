@@ -0,0 +1,323 @@
+package secretchat
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tg/e2e"
+)
+
+// From Telegram docs example.
+//
+// See https://core.telegram.org/mtproto/auth_key#presenting-proof-of-work-server-authentication.
+var testDHPrime = func() []byte {
+	data, err := hex.DecodeString("C71CAEB9C6B1C9048E6C522F70F13F73980D40238E3E21C14934D037563D930F" +
+		"48198A0AA7C14058229493D22530F4DBFA336F6E0AC925139543AED44CCE7C37" +
+		"20FD51F69458705AC68CD4FE6B6B13ABDC9746512969328454F18FAF8C595F64" +
+		"2477FE96BB2A941D5BCD1D4AC8CC49880708FA9B378E3C4F3A9060BEE67CF9A4" +
+		"A4A695811051907E162753B56B0F6B410DBA74D8A84B2A14B3144E0EF1284754" +
+		"FD17ED950D5965B4B9DD46582DB1178D169C6BC465B0D6FF9CA3928FEF5B9AE4" +
+		"E418FC15E83EBEA0F87FA9FF5EED70050DED2849F47BF959D956850CE929851F" +
+		"0D8115F635B105EE2E4E15D04B2454BF6F4FADF034B10403119CD8E3B92FCC5B")
+	if err != nil {
+		panic(err)
+	}
+	return data
+}()
+
+type testChat struct {
+	admin       int64
+	participant int64
+}
+
+// testServer is a simple secret chats server.
+type testServer struct {
+	t       *testing.T
+	chats   map[int]testChat
+	updates map[int64]chan tg.UpdateClass
+	// drop reports whether sent message should be dropped.
+	drop func(from int64, req *tg.MessagesSendEncryptedRequest) bool
+	mux  sync.Mutex
+}
+
+func newTestServer(t *testing.T) *testServer {
+	return &testServer{
+		t:       t,
+		chats:   map[int]testChat{},
+		updates: map[int64]chan tg.UpdateClass{},
+	}
+}
+
+type testInvoker struct {
+	s      *testServer
+	userID int64
+}
+
+func (i testInvoker) Invoke(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+	r, err := i.s.handle(i.userID, input)
+	if err != nil {
+		return err
+	}
+
+	var b bin.Buffer
+	if err := r.Encode(&b); err != nil {
+		return err
+	}
+	return output.Decode(&b)
+}
+
+func (s *testServer) other(chatID int, userID int64) int64 {
+	c := s.chats[chatID]
+	if c.admin == userID {
+		return c.participant
+	}
+	return c.admin
+}
+
+func (s *testServer) push(ctx context.Context, userID int64, u tg.UpdateClass) {
+	s.updates[userID] <- u
+}
+
+func (s *testServer) newMessage(chatID int, randomID int64, data []byte) tg.UpdateClass {
+	return &tg.UpdateNewEncryptedMessage{
+		Message: &tg.EncryptedMessage{
+			RandomID: randomID,
+			ChatID:   chatID,
+			Date:     int(time.Now().Unix()),
+			Bytes:    data,
+			File:     &tg.EncryptedFileEmpty{},
+		},
+	}
+}
+
+func (s *testServer) handle(from int64, input bin.Encoder) (bin.Encoder, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ctx := context.Background()
+
+	switch req := input.(type) {
+	case *tg.MessagesGetDhConfigRequest:
+		if req.Version == 1 {
+			return &tg.MessagesDhConfigNotModified{
+				Random: make([]byte, req.RandomLength),
+			}, nil
+		}
+		return &tg.MessagesDhConfig{
+			G:       3,
+			P:       testDHPrime,
+			Version: 1,
+			Random:  make([]byte, req.RandomLength),
+		}, nil
+	case *tg.MessagesRequestEncryptionRequest:
+		id := len(s.chats) + 1
+		target := req.UserID.(*tg.InputUser).UserID
+		s.chats[id] = testChat{admin: from, participant: target}
+
+		s.push(ctx, target, &tg.UpdateEncryption{
+			Chat: &tg.EncryptedChatRequested{
+				ID:            id,
+				AccessHash:    int64(id),
+				AdminID:       from,
+				ParticipantID: target,
+				GA:            req.GA,
+			},
+		})
+		return &tg.EncryptedChatWaiting{
+			ID:            id,
+			AccessHash:    int64(id),
+			AdminID:       from,
+			ParticipantID: target,
+		}, nil
+	case *tg.MessagesAcceptEncryptionRequest:
+		c := s.chats[req.Peer.ChatID]
+		chat := &tg.EncryptedChat{
+			ID:             req.Peer.ChatID,
+			AccessHash:     req.Peer.AccessHash,
+			AdminID:        c.admin,
+			ParticipantID:  c.participant,
+			GAOrB:          req.GB,
+			KeyFingerprint: req.KeyFingerprint,
+		}
+		s.push(ctx, c.admin, &tg.UpdateEncryption{Chat: chat})
+		return chat, nil
+	case *tg.MessagesSendEncryptedRequest:
+		if s.drop == nil || !s.drop(from, req) {
+			s.push(ctx, s.other(req.Peer.ChatID, from), s.newMessage(req.Peer.ChatID, req.RandomID, req.Data))
+		}
+		return &tg.MessagesSentEncryptedMessage{}, nil
+	case *tg.MessagesSendEncryptedServiceRequest:
+		s.push(ctx, s.other(req.Peer.ChatID, from), s.newMessage(req.Peer.ChatID, req.RandomID, req.Data))
+		return &tg.MessagesSentEncryptedMessage{}, nil
+	case *tg.MessagesReceivedQueueRequest:
+		return &tg.LongVector{}, nil
+	default:
+		return nil, errors.Errorf("unexpected request %T", req)
+	}
+}
+
+// client creates new Manager for given user and starts update loop.
+func (s *testServer) client(ctx context.Context, userID int64, opts Options) *Manager {
+	updates := make(chan tg.UpdateClass, 100)
+	s.mux.Lock()
+	s.updates[userID] = updates
+	s.mux.Unlock()
+
+	opts.Logger = zaptest.NewLogger(s.t).Named(string(rune('0' + userID)))
+	m := NewManager(tg.NewClient(testInvoker{s: s, userID: userID}), opts)
+	d := tg.NewUpdateDispatcher()
+	m.Register(d)
+
+	done := make(chan struct{})
+	s.t.Cleanup(func() { <-done })
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case u := <-updates:
+				if err := d.Handle(ctx, &tg.Updates{Updates: []tg.UpdateClass{u}}); err != nil {
+					s.t.Error(err)
+				}
+			}
+		}
+	}()
+	return m
+}
+
+type testPair struct {
+	a, b         *Manager
+	aMsgs, bMsgs chan Message
+	chat         Chat
+}
+
+func setupPair(ctx context.Context, t *testing.T, s *testServer, rekeyMessages int) testPair {
+	p := testPair{
+		aMsgs: make(chan Message, 100),
+		bMsgs: make(chan Message, 100),
+	}
+	aReady := make(chan Chat, 1)
+
+	p.a = s.client(ctx, 1, Options{
+		RekeyMessages: rekeyMessages,
+		OnMessage: func(ctx context.Context, msg Message) error {
+			p.aMsgs <- msg
+			return nil
+		},
+		OnChat: func(ctx context.Context, chat Chat) error {
+			if chat.State == StateReady {
+				aReady <- chat
+			}
+			return nil
+		},
+	})
+	var b *Manager
+	b = s.client(ctx, 2, Options{
+		RekeyMessages: rekeyMessages,
+		OnMessage: func(ctx context.Context, msg Message) error {
+			p.bMsgs <- msg
+			return nil
+		},
+		OnChat: func(ctx context.Context, chat Chat) error {
+			if chat.State != StateRequested {
+				return nil
+			}
+			_, err := b.Accept(ctx, chat.ID)
+			return err
+		},
+	})
+	p.b = b
+
+	chat, err := p.a.Request(ctx, &tg.InputUser{UserID: 2})
+	require.NoError(t, err)
+	require.Equal(t, StateWaiting, chat.State)
+
+	select {
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	case p.chat = <-aReady:
+	}
+	return p
+}
+
+func receiveText(ctx context.Context, t *testing.T, ch <-chan Message) string {
+	select {
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+		return ""
+	case msg := <-ch:
+		m, ok := msg.Message.(*e2e.DecryptedMessage)
+		require.Truef(t, ok, "unexpected type %T", msg.Message)
+		return m.Message
+	}
+}
+
+func TestManager(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	a := require.New(t)
+
+	s := newTestServer(t)
+	p := setupPair(ctx, t, s, 5)
+	initialKey := p.chat.Key
+
+	bChat, err := p.b.Chat(ctx, p.chat.ID)
+	a.NoError(err)
+	a.Equal(initialKey, bChat.Key)
+	a.False(bChat.Originator)
+	a.Equal(int64(1), bChat.PeerID())
+
+	for i := 0; i < 10; i++ {
+		a.NoError(p.a.SendText(ctx, p.chat.ID, "ping"))
+		a.Equal("ping", receiveText(ctx, t, p.bMsgs))
+		a.NoError(p.b.SendText(ctx, p.chat.ID, "pong"))
+		a.Equal("pong", receiveText(ctx, t, p.aMsgs))
+	}
+
+	a.Eventually(func() bool {
+		aChat, err := p.a.Chat(ctx, p.chat.ID)
+		a.NoError(err)
+		bChat, err := p.b.Chat(ctx, p.chat.ID)
+		a.NoError(err)
+		return aChat.Exchange.ID == 0 && bChat.Exchange.ID == 0 &&
+			aChat.Key == bChat.Key && aChat.Key != initialKey
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Ensure that chat is still usable after re-keying.
+	a.NoError(p.b.SendText(ctx, p.chat.ID, "after"))
+	a.Equal("after", receiveText(ctx, t, p.aMsgs))
+	cancel()
+}
+
+func TestManagerResend(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	a := require.New(t)
+
+	s := newTestServer(t)
+	dropped := false
+	s.drop = func(from int64, req *tg.MessagesSendEncryptedRequest) bool {
+		if from != 1 || dropped {
+			return false
+		}
+		dropped = true
+		return true
+	}
+	p := setupPair(ctx, t, s, 100)
+
+	a.NoError(p.a.SendText(ctx, p.chat.ID, "first"))
+	a.NoError(p.a.SendText(ctx, p.chat.ID, "second"))
+	a.Equal("first", receiveText(ctx, t, p.bMsgs))
+	a.Equal("second", receiveText(ctx, t, p.bMsgs))
+	a.True(dropped)
+	cancel()
+}
@@ -0,0 +1,89 @@
+package secretchat
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// InmemoryStorage is basic in-memory Storage implementation.
+type InmemoryStorage struct {
+	chats    map[int]Chat
+	outgoing map[int][]Outgoing
+	mux      sync.Mutex // guards chats and outgoing
+}
+
+var _ Storage = (*InmemoryStorage)(nil)
+
+func (s *InmemoryStorage) initLocked() {
+	if s.chats == nil {
+		s.chats = map[int]Chat{}
+	}
+	if s.outgoing == nil {
+		s.outgoing = map[int][]Outgoing{}
+	}
+}
+
+// SaveChat implements Storage.
+func (s *InmemoryStorage) SaveChat(ctx context.Context, chat Chat) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.initLocked()
+
+	s.chats[chat.ID] = chat
+	return nil
+}
+
+// FindChat implements Storage.
+func (s *InmemoryStorage) FindChat(ctx context.Context, id int) (Chat, bool, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	chat, ok := s.chats[id]
+	return chat, ok, nil
+}
+
+// DeleteChat implements Storage.
+func (s *InmemoryStorage) DeleteChat(ctx context.Context, id int) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.chats, id)
+	delete(s.outgoing, id)
+	return nil
+}
+
+// SaveOutgoing implements Storage.
+func (s *InmemoryStorage) SaveOutgoing(ctx context.Context, chatID int, msg Outgoing) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.initLocked()
+
+	msgs := s.outgoing[chatID]
+	idx := sort.Search(len(msgs), func(i int) bool {
+		return msgs[i].OutSeqNo >= msg.OutSeqNo
+	})
+	if idx < len(msgs) && msgs[idx].OutSeqNo == msg.OutSeqNo {
+		msgs[idx] = msg
+		return nil
+	}
+	msgs = append(msgs, Outgoing{})
+	copy(msgs[idx+1:], msgs[idx:])
+	msgs[idx] = msg
+	s.outgoing[chatID] = msgs
+	return nil
+}
+
+// FindOutgoing implements Storage.
+func (s *InmemoryStorage) FindOutgoing(ctx context.Context, chatID int, from, to int) ([]Outgoing, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var r []Outgoing
+	for _, msg := range s.outgoing[chatID] {
+		if msg.OutSeqNo >= from && msg.OutSeqNo <= to {
+			r = append(r, msg)
+		}
+	}
+	return r, nil
+}
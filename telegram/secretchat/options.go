@@ -0,0 +1,89 @@
+package secretchat
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tg/e2e"
+)
+
+// Message is incoming secret chat message.
+type Message struct {
+	// Chat is state of chat.
+	Chat Chat
+	// Date of message.
+	Date int
+	// Message is decrypted message.
+	Message e2e.DecryptedMessageClass
+	// File is attached encrypted file, if any.
+	File tg.EncryptedFileClass
+}
+
+// Options is options of Manager.
+type Options struct {
+	// Storage stores chats state.
+	//
+	// Defaults to InmemoryStorage.
+	Storage Storage
+	// OnMessage is called on every incoming non-technical message.
+	OnMessage func(ctx context.Context, msg Message) error
+	// OnChat is called when chat state changes.
+	//
+	// Chat in StateRequested can be accepted using Manager.Accept.
+	OnChat func(ctx context.Context, chat Chat) error
+
+	// Layer is maximum supported layer.
+	//
+	// Defaults to Layer.
+	Layer int
+	// RekeyMessages is count of messages after which key re-negotiation
+	// is initiated.
+	//
+	// Defaults to 100.
+	RekeyMessages int
+	// RekeyInterval is key age after which key re-negotiation
+	// is initiated.
+	//
+	// Defaults to one week.
+	RekeyInterval time.Duration
+
+	Random io.Reader
+	Clock  clock.Clock
+	Logger *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.Storage == nil {
+		o.Storage = &InmemoryStorage{}
+	}
+	if o.OnMessage == nil {
+		o.OnMessage = func(ctx context.Context, msg Message) error { return nil }
+	}
+	if o.OnChat == nil {
+		o.OnChat = func(ctx context.Context, chat Chat) error { return nil }
+	}
+	if o.Layer == 0 {
+		o.Layer = Layer
+	}
+	if o.RekeyMessages == 0 {
+		o.RekeyMessages = 100
+	}
+	if o.RekeyInterval == 0 {
+		o.RekeyInterval = 7 * 24 * time.Hour
+	}
+	if o.Random == nil {
+		o.Random = crypto.DefaultRand()
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
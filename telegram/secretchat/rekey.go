@@ -0,0 +1,171 @@
+package secretchat
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg/e2e"
+)
+
+// Re-keying is described here:
+// * https://core.telegram.org/api/end-to-end/pfs
+
+// Rekey initiates key re-negotiation.
+func (m *Manager) Rekey(ctx context.Context, id int) error {
+	s := m.lock(id)
+	defer s.mux.Unlock()
+
+	chat, err := m.findChat(ctx, id)
+	if err != nil {
+		return err
+	}
+	if chat.State != StateReady {
+		return errors.Errorf("unexpected chat state %s", chat.State)
+	}
+	if chat.Exchange.ID != 0 {
+		return errors.New("key exchange already in progress")
+	}
+
+	return m.requestKey(ctx, &chat)
+}
+
+// maybeRekey initiates key re-negotiation if current key is used for too
+// many messages or is too old.
+func (m *Manager) maybeRekey(ctx context.Context, chat *Chat) error {
+	if chat.State != StateReady || chat.Exchange.ID != 0 {
+		return nil
+	}
+	if chat.KeyUsed < m.rekeyMessages && m.clock.Now().Sub(chat.KeyCreated) < m.rekeyInterval {
+		return nil
+	}
+
+	return m.requestKey(ctx, chat)
+}
+
+func (m *Manager) requestKey(ctx context.Context, chat *Chat) error {
+	a, gA, _, err := m.generateDH(ctx)
+	if err != nil {
+		return errors.Wrap(err, "generate dh")
+	}
+	exchangeID, err := m.randInt64()
+	if err != nil {
+		return err
+	}
+
+	chat.Exchange = Exchange{
+		ID:        exchangeID,
+		Initiator: true,
+		A:         a,
+	}
+	if err := m.sendService(ctx, chat, &e2e.DecryptedMessageActionRequestKey{
+		ExchangeID: exchangeID,
+		GA:         gA,
+	}); err != nil {
+		return errors.Wrap(err, "request key")
+	}
+	return nil
+}
+
+func (m *Manager) onRequestKey(ctx context.Context, chat *Chat, action *e2e.DecryptedMessageActionRequestKey) error {
+	if ex := chat.Exchange; ex.ID != 0 {
+		// If both sides requested re-keying at the same time, exchange with
+		// bigger ID wins.
+		if ex.Initiator && ex.ID > action.ExchangeID {
+			return nil
+		}
+		m.logger.Debug("Drop key exchange",
+			zap.Int("chat_id", chat.ID),
+			zap.Int64("exchange_id", ex.ID),
+		)
+	}
+
+	b, gB, cfg, err := m.generateDH(ctx)
+	if err != nil {
+		return errors.Wrap(err, "generate dh")
+	}
+	key, err := computeKey(cfg, b, action.GA)
+	if err != nil {
+		return m.abortKey(ctx, chat, action.ExchangeID)
+	}
+
+	chat.Exchange = Exchange{
+		ID:  action.ExchangeID,
+		Key: key,
+	}
+	if err := m.sendService(ctx, chat, &e2e.DecryptedMessageActionAcceptKey{
+		ExchangeID:     action.ExchangeID,
+		GB:             gB,
+		KeyFingerprint: keyFingerprint(key),
+	}); err != nil {
+		return errors.Wrap(err, "accept key")
+	}
+	return nil
+}
+
+func (m *Manager) onAcceptKey(ctx context.Context, chat *Chat, action *e2e.DecryptedMessageActionAcceptKey) error {
+	ex := chat.Exchange
+	if ex.ID != action.ExchangeID || !ex.Initiator {
+		return nil
+	}
+
+	cfg, _, err := m.getDHConfig(ctx)
+	if err != nil {
+		return err
+	}
+	key, err := computeKey(cfg, ex.A, action.GB)
+	if err != nil || keyFingerprint(key) != action.KeyFingerprint {
+		return m.abortKey(ctx, chat, ex.ID)
+	}
+
+	if err := m.sendService(ctx, chat, &e2e.DecryptedMessageActionCommitKey{
+		ExchangeID:     ex.ID,
+		KeyFingerprint: action.KeyFingerprint,
+	}); err != nil {
+		return errors.Wrap(err, "commit key")
+	}
+	m.switchKey(chat, key)
+	return nil
+}
+
+func (m *Manager) onCommitKey(ctx context.Context, chat *Chat, action *e2e.DecryptedMessageActionCommitKey) error {
+	ex := chat.Exchange
+	if ex.ID != action.ExchangeID || ex.Initiator {
+		return nil
+	}
+	if keyFingerprint(ex.Key) != action.KeyFingerprint {
+		return m.abortKey(ctx, chat, ex.ID)
+	}
+
+	m.switchKey(chat, ex.Key)
+	if err := m.sendService(ctx, chat, &e2e.DecryptedMessageActionNoop{}); err != nil {
+		return errors.Wrap(err, "send noop")
+	}
+	return nil
+}
+
+func (m *Manager) onAbortKey(chat *Chat, action *e2e.DecryptedMessageActionAbortKey) {
+	if chat.Exchange.ID == action.ExchangeID {
+		chat.Exchange = Exchange{}
+	}
+}
+
+func (m *Manager) abortKey(ctx context.Context, chat *Chat, exchangeID int64) error {
+	chat.Exchange = Exchange{}
+	if err := m.sendService(ctx, chat, &e2e.DecryptedMessageActionAbortKey{
+		ExchangeID: exchangeID,
+	}); err != nil {
+		return errors.Wrap(err, "abort key")
+	}
+	return nil
+}
+
+func (m *Manager) switchKey(chat *Chat, key crypto.AuthKey) {
+	chat.PrevKey = chat.Key
+	chat.Key = key
+	chat.KeyUsed = 0
+	chat.KeyCreated = m.clock.Now()
+	chat.Exchange = Exchange{}
+}
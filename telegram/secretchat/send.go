@@ -0,0 +1,175 @@
+package secretchat
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tg/e2e"
+)
+
+// Send sends message to secret chat.
+//
+// If RandomID of message is zero, it will be generated.
+func (m *Manager) Send(ctx context.Context, id int, msg e2e.DecryptedMessageClass) error {
+	s := m.lock(id)
+	defer s.mux.Unlock()
+
+	chat, err := m.findChat(ctx, id)
+	if err != nil {
+		return err
+	}
+	if chat.State != StateReady {
+		return errors.Errorf("unexpected chat state %s", chat.State)
+	}
+
+	if err := m.setRandomID(msg); err != nil {
+		return err
+	}
+	if err := m.sendLocked(ctx, &chat, msg); err != nil {
+		return err
+	}
+	return m.maybeRekey(ctx, &chat)
+}
+
+// SendText sends text message to secret chat.
+func (m *Manager) SendText(ctx context.Context, id int, text string) error {
+	return m.Send(ctx, id, &e2e.DecryptedMessage{
+		Message: text,
+	})
+}
+
+func (m *Manager) setRandomID(msg e2e.DecryptedMessageClass) error {
+	if msg.GetRandomID() != 0 {
+		return nil
+	}
+
+	randomID, err := m.randInt64()
+	if err != nil {
+		return err
+	}
+	switch msg := msg.(type) {
+	case *e2e.DecryptedMessage:
+		msg.RandomID = randomID
+	case *e2e.DecryptedMessageService:
+		msg.RandomID = randomID
+	case *e2e.DecryptedMessage46:
+		msg.RandomID = randomID
+	case *e2e.DecryptedMessage23:
+		msg.RandomID = randomID
+	case *e2e.DecryptedMessage8:
+		msg.RandomID = randomID
+	case *e2e.DecryptedMessageService8:
+		msg.RandomID = randomID
+	}
+	return nil
+}
+
+// sendService sends service message with given action.
+func (m *Manager) sendService(ctx context.Context, chat *Chat, action e2e.DecryptedMessageActionClass) error {
+	msg := &e2e.DecryptedMessageService{
+		Action: action,
+	}
+	if err := m.setRandomID(msg); err != nil {
+		return err
+	}
+	return m.sendLocked(ctx, chat, msg)
+}
+
+// notifyLayer sends current layer to other side.
+func (m *Manager) notifyLayer(ctx context.Context, chat *Chat) error {
+	if err := m.sendService(ctx, chat, &e2e.DecryptedMessageActionNotifyLayer{
+		Layer: m.layer,
+	}); err != nil {
+		return errors.Wrap(err, "notify layer")
+	}
+	return nil
+}
+
+// sendLocked wraps message into layer, saves it and sends to the chat.
+//
+// Chat must be locked.
+func (m *Manager) sendLocked(ctx context.Context, chat *Chat, msg e2e.DecryptedMessageClass) error {
+	randomBytes := make([]byte, 16)
+	if _, err := io.ReadFull(m.rand, randomBytes); err != nil {
+		return errors.Wrap(err, "read random")
+	}
+
+	in, out := chat.seqNo()
+	layer := e2e.DecryptedMessageLayer{
+		RandomBytes: randomBytes,
+		Layer:       chat.Layer,
+		InSeqNo:     in,
+		OutSeqNo:    out,
+		Message:     msg,
+	}
+	var b bin.Buffer
+	if err := layer.Encode(&b); err != nil {
+		return errors.Wrap(err, "encode layer")
+	}
+
+	_, service := msg.(*e2e.DecryptedMessageService)
+	o := Outgoing{
+		OutSeqNo: out,
+		RandomID: msg.GetRandomID(),
+		Service:  service,
+		Layer:    b.Buf,
+	}
+	if err := m.storage.SaveOutgoing(ctx, chat.ID, o); err != nil {
+		return errors.Wrap(err, "save outgoing")
+	}
+
+	chat.OutSeq++
+	chat.KeyUsed++
+	if err := m.storage.SaveChat(ctx, *chat); err != nil {
+		return errors.Wrap(err, "save chat")
+	}
+
+	return m.sendOutgoing(ctx, *chat, o)
+}
+
+// sendOutgoing encrypts and sends stored message.
+func (m *Manager) sendOutgoing(ctx context.Context, chat Chat, o Outgoing) error {
+	data, err := encrypt(m.rand, chat.Key, chat.side(), o.Layer)
+	if err != nil {
+		return errors.Wrap(err, "encrypt")
+	}
+
+	if o.Service {
+		if _, err := m.api.MessagesSendEncryptedService(ctx, &tg.MessagesSendEncryptedServiceRequest{
+			Peer:     chat.Input(),
+			RandomID: o.RandomID,
+			Data:     data,
+		}); err != nil {
+			return errors.Wrap(err, "send encrypted service")
+		}
+		return nil
+	}
+
+	if _, err := m.api.MessagesSendEncrypted(ctx, &tg.MessagesSendEncryptedRequest{
+		Peer:     chat.Input(),
+		RandomID: o.RandomID,
+		Data:     data,
+	}); err != nil {
+		return errors.Wrap(err, "send encrypted")
+	}
+	return nil
+}
+
+// resend sends again messages with from <= out_seq_no <= to.
+func (m *Manager) resend(ctx context.Context, chat Chat, from, to int) error {
+	msgs, err := m.storage.FindOutgoing(ctx, chat.ID, from, to)
+	if err != nil {
+		return errors.Wrap(err, "find outgoing")
+	}
+
+	for _, o := range msgs {
+		if err := m.sendOutgoing(ctx, chat, o); err != nil {
+			return errors.Wrapf(err, "resend %d", o.OutSeqNo)
+		}
+	}
+	return nil
+}
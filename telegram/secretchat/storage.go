@@ -0,0 +1,27 @@
+package secretchat
+
+import "context"
+
+// Outgoing is sent message, stored to handle resend requests.
+type Outgoing struct {
+	// OutSeqNo is out_seq_no of message.
+	OutSeqNo int
+	// RandomID of message.
+	RandomID int64
+	// Service denotes whether message is a service message.
+	Service bool
+	// Layer is serialized DecryptedMessageLayer.
+	Layer []byte
+}
+
+// Storage is secret chat storage.
+type Storage interface {
+	SaveChat(ctx context.Context, chat Chat) error
+	FindChat(ctx context.Context, id int) (chat Chat, found bool, _ error)
+	DeleteChat(ctx context.Context, id int) error
+
+	// SaveOutgoing saves sent message.
+	SaveOutgoing(ctx context.Context, chatID int, msg Outgoing) error
+	// FindOutgoing finds sent messages with from <= out_seq_no <= to, ordered by out_seq_no.
+	FindOutgoing(ctx context.Context, chatID int, from, to int) ([]Outgoing, error)
+}
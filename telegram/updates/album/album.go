@@ -0,0 +1,377 @@
+// Package album contains update handler which aggregates albums.
+//
+// Handler delays album messages, so it must be placed after
+// updates.Manager, e.g. as updates.Config.Handler. Otherwise, pts-bearing
+// messages are passed to the manager out of order and state machine
+// detects false gaps.
+package album
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/constant"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+)
+
+// Album is a group of messages with the same GroupedID.
+type Album struct {
+	// GroupedID of album messages.
+	GroupedID int64
+	// Peer where album was sent.
+	Peer tg.PeerClass
+	// Messages of album, sorted by ID.
+	Messages []*tg.Message
+	// Updates which contain album messages, in the same order as Messages.
+	Updates []tg.UpdateClass
+	// Users and Chats mentioned in updates.
+	Users []tg.UserClass
+	Chats []tg.ChatClass
+}
+
+// Options of Handler.
+type Options struct {
+	// Timeout is a period of time without new album messages,
+	// after which album is flushed.
+	//
+	// Defaults to 500ms.
+	Timeout time.Duration
+	// MaxCount is a count of messages after which album is flushed
+	// immediately.
+	//
+	// Defaults to 10, maximum count of media in album.
+	MaxCount int
+	// OnAlbum is called for each album. If nil, album is passed to
+	// next handler as a single tg.Updates.
+	OnAlbum func(ctx context.Context, a Album) error
+	// Clock to use.
+	Clock clock.Clock
+	// Logger to use.
+	Logger *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.Timeout == 0 {
+		o.Timeout = 500 * time.Millisecond
+	}
+	if o.MaxCount == 0 {
+		o.MaxCount = 10
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
+
+type groupKey struct {
+	peer      constant.TDLibPeerID
+	groupedID int64
+}
+
+func peerID(p tg.PeerClass) (r constant.TDLibPeerID) {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		r.User(p.UserID)
+	case *tg.PeerChat:
+		r.Chat(p.ChatID)
+	case *tg.PeerChannel:
+		r.Channel(p.ChannelID)
+	}
+	return r
+}
+
+type entityKey struct {
+	typeID uint32
+	id     int64
+}
+
+type group struct {
+	album    Album
+	seen     map[entityKey]struct{}
+	deadline time.Time
+}
+
+func (g *group) add(u tg.UpdateClass, msg *tg.Message, users []tg.UserClass, chats []tg.ChatClass) {
+	g.album.Updates = append(g.album.Updates, u)
+	g.album.Messages = append(g.album.Messages, msg)
+	for _, user := range users {
+		k := entityKey{typeID: user.TypeID(), id: user.GetID()}
+		if _, ok := g.seen[k]; ok {
+			continue
+		}
+		g.seen[k] = struct{}{}
+		g.album.Users = append(g.album.Users, user)
+	}
+	for _, chat := range chats {
+		k := entityKey{typeID: chat.TypeID(), id: chat.GetID()}
+		if _, ok := g.seen[k]; ok {
+			continue
+		}
+		g.seen[k] = struct{}{}
+		g.album.Chats = append(g.album.Chats, chat)
+	}
+}
+
+// Handler aggregates album messages from new message updates.
+//
+// Other updates are passed to next handler as is. Note that album is
+// passed after timeout, so updates received later may be handled before it.
+type Handler struct {
+	next     telegram.UpdateHandler
+	timeout  time.Duration
+	maxCount int
+	onAlbum  func(ctx context.Context, a Album) error
+	clock    clock.Clock
+	log      *zap.Logger
+
+	groups map[groupKey]*group
+	mux    sync.Mutex
+	// wake notifies Run about new group.
+	wake chan struct{}
+}
+
+var _ telegram.UpdateHandler = (*Handler)(nil)
+
+// New creates new Handler.
+//
+// Run must be called to flush albums after timeout.
+func New(next telegram.UpdateHandler, opts Options) *Handler {
+	opts.setDefaults()
+	return &Handler{
+		next:     next,
+		timeout:  opts.Timeout,
+		maxCount: opts.MaxCount,
+		onAlbum:  opts.OnAlbum,
+		clock:    opts.Clock,
+		log:      opts.Logger,
+		groups:   map[groupKey]*group{},
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// albumMessage returns album message from update, if any.
+func albumMessage(u tg.UpdateClass) (*tg.Message, bool) {
+	var m tg.MessageClass
+	switch u := u.(type) {
+	case *tg.UpdateNewMessage:
+		m = u.Message
+	case *tg.UpdateNewChannelMessage:
+		m = u.Message
+	default:
+		return nil, false
+	}
+
+	msg, ok := m.(*tg.Message)
+	if !ok || msg.GroupedID == 0 {
+		return nil, false
+	}
+	return msg, true
+}
+
+// Handle implements telegram.UpdateHandler.
+func (h *Handler) Handle(ctx context.Context, u tg.UpdatesClass) error {
+	var (
+		updates []tg.UpdateClass
+		users   []tg.UserClass
+		chats   []tg.ChatClass
+		// rebuild creates update to pass with remaining updates.
+		rebuild func(rest []tg.UpdateClass) tg.UpdatesClass
+	)
+	switch u := u.(type) {
+	case *tg.Updates:
+		updates, users, chats = u.Updates, u.Users, u.Chats
+		rebuild = func(rest []tg.UpdateClass) tg.UpdatesClass {
+			r := *u
+			r.Updates = rest
+			return &r
+		}
+	case *tg.UpdatesCombined:
+		updates, users, chats = u.Updates, u.Users, u.Chats
+		rebuild = func(rest []tg.UpdateClass) tg.UpdatesClass {
+			r := *u
+			r.Updates = rest
+			return &r
+		}
+	case *tg.UpdateShort:
+		updates = []tg.UpdateClass{u.Update}
+		rebuild = func(rest []tg.UpdateClass) tg.UpdatesClass {
+			return u
+		}
+	default:
+		return h.next.Handle(ctx, u)
+	}
+
+	var (
+		rest  []tg.UpdateClass
+		ready []Album
+	)
+	h.mux.Lock()
+	for _, upd := range updates {
+		msg, ok := albumMessage(upd)
+		if !ok {
+			rest = append(rest, upd)
+			continue
+		}
+		if a, ok := h.add(upd, msg, users, chats); ok {
+			ready = append(ready, a)
+		}
+	}
+	h.mux.Unlock()
+
+	if len(rest) == len(updates) {
+		return h.next.Handle(ctx, u)
+	}
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+
+	var err error
+	if len(rest) > 0 {
+		err = h.next.Handle(ctx, rebuild(rest))
+	}
+	for _, a := range ready {
+		if flushErr := h.flush(ctx, a); flushErr != nil && err == nil {
+			err = flushErr
+		}
+	}
+	return err
+}
+
+// add adds message to album and returns album if it is full.
+func (h *Handler) add(u tg.UpdateClass, msg *tg.Message, users []tg.UserClass, chats []tg.ChatClass) (Album, bool) {
+	key := groupKey{peer: peerID(msg.PeerID), groupedID: msg.GroupedID}
+	found, ok := h.groups[key]
+	if !ok {
+		found = &group{
+			album: Album{
+				GroupedID: msg.GroupedID,
+				Peer:      msg.PeerID,
+			},
+			seen: map[entityKey]struct{}{},
+		}
+		h.groups[key] = found
+	}
+
+	found.add(u, msg, users, chats)
+	found.deadline = h.clock.Now().Add(h.timeout)
+	if len(found.album.Messages) < h.maxCount {
+		return Album{}, false
+	}
+	delete(h.groups, key)
+	return found.album, true
+}
+
+func (h *Handler) flush(ctx context.Context, a Album) error {
+	sort.Sort(byID(a))
+	if h.onAlbum != nil {
+		return h.onAlbum(ctx, a)
+	}
+
+	date := 0
+	for _, m := range a.Messages {
+		if m.Date > date {
+			date = m.Date
+		}
+	}
+	return h.next.Handle(ctx, &tg.Updates{
+		Updates: a.Updates,
+		Users:   a.Users,
+		Chats:   a.Chats,
+		Date:    date,
+	})
+}
+
+// expired removes and returns albums with deadline before given time.
+// Also returns nearest deadline of remaining albums, if any.
+func (h *Handler) expired(now time.Time, all bool) (r []Album, next time.Time) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	for k, g := range h.groups {
+		if all || !g.deadline.After(now) {
+			sort.Sort(byID(g.album))
+			r = append(r, g.album)
+			delete(h.groups, k)
+			continue
+		}
+		if next.IsZero() || g.deadline.Before(next) {
+			next = g.deadline
+		}
+	}
+	// Flush albums in order of first message.
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Messages[0].ID < r[j].Messages[0].ID
+	})
+	return r, next
+}
+
+func (h *Handler) flushExpired(ctx context.Context, all bool) time.Time {
+	albums, next := h.expired(h.clock.Now(), all)
+	for _, a := range albums {
+		if err := h.flush(ctx, a); err != nil {
+			h.log.Error("Handle album",
+				zap.Int64("grouped_id", a.GroupedID),
+				zap.Error(err),
+			)
+		}
+	}
+	return next
+}
+
+// Flush flushes all pending albums.
+func (h *Handler) Flush(ctx context.Context) {
+	h.flushExpired(ctx, true)
+}
+
+// Run flushes albums after timeout until context is done.
+// Pending albums are flushed before return.
+func (h *Handler) Run(ctx context.Context) error {
+	defer h.Flush(context.WithoutCancel(ctx))
+
+	for {
+		next := h.flushExpired(ctx, false)
+
+		var (
+			timer   clock.Timer
+			timeout <-chan time.Time
+		)
+		if !next.IsZero() {
+			timer = h.clock.Timer(next.Sub(h.clock.Now()))
+			timeout = timer.C()
+		}
+
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return ctx.Err()
+		case <-h.wake:
+		case <-timeout:
+		}
+		if timer != nil {
+			clock.StopTimer(timer)
+		}
+	}
+}
+
+// byID sorts album messages by ID.
+type byID Album
+
+func (a byID) Len() int { return len(a.Messages) }
+
+func (a byID) Less(i, j int) bool { return a.Messages[i].ID < a.Messages[j].ID }
+
+func (a byID) Swap(i, j int) {
+	a.Messages[i], a.Messages[j] = a.Messages[j], a.Messages[i]
+	a.Updates[i], a.Updates[j] = a.Updates[j], a.Updates[i]
+}
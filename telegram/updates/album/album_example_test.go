@@ -0,0 +1,60 @@
+package album_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/updates"
+	"github.com/gotd/td/telegram/updates/album"
+	"github.com/gotd/td/tg"
+)
+
+func ExampleHandler() {
+	dispatcher := tg.NewUpdateDispatcher()
+	albums := album.New(dispatcher, album.Options{
+		OnAlbum: func(ctx context.Context, a album.Album) error {
+			fmt.Println("Album of", len(a.Messages), "messages")
+			return nil
+		},
+	})
+	// Album handler is placed after updates manager, so manager receives
+	// updates in the same order as server sent them.
+	gaps := updates.New(updates.Config{
+		Handler: albums,
+	})
+
+	client, err := telegram.ClientFromEnvironment(telegram.Options{
+		UpdateHandler: gaps,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := client.Run(ctx, func(ctx context.Context) error {
+		self, err := client.Self(ctx)
+		if err != nil {
+			return err
+		}
+
+		g := tdsync.NewCancellableGroup(ctx)
+		g.Go(albums.Run)
+		g.Go(func(ctx context.Context) error {
+			return gaps.Run(ctx, client.API(), self.ID, updates.AuthOptions{
+				IsBot: self.Bot,
+			})
+		})
+		if err := g.Wait(); err != nil {
+			return errors.Wrap(err, "run")
+		}
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+}
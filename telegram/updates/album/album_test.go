@@ -0,0 +1,162 @@
+package album
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gotd/neo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+)
+
+type recorder struct {
+	updates []tg.UpdatesClass
+	mux     sync.Mutex
+}
+
+func (r *recorder) Handle(ctx context.Context, u tg.UpdatesClass) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.updates = append(r.updates, u)
+	return nil
+}
+
+func (r *recorder) get() []tg.UpdatesClass {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return append([]tg.UpdatesClass(nil), r.updates...)
+}
+
+var _ telegram.UpdateHandler = (*recorder)(nil)
+
+func newMessage(id int, peer tg.PeerClass, groupedID int64) *tg.UpdateNewMessage {
+	return &tg.UpdateNewMessage{
+		Message: &tg.Message{
+			ID:        id,
+			PeerID:    peer,
+			GroupedID: groupedID,
+			Date:      id,
+		},
+	}
+}
+
+func albumIDs(a Album) (r []int) {
+	for _, m := range a.Messages {
+		r = append(r, m.ID)
+	}
+	return r
+}
+
+func TestHandler(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clk := neo.NewTime(time.Unix(0, 0))
+	next := &recorder{}
+	albums := make(chan Album, 10)
+	h := New(next, Options{
+		Timeout: time.Second,
+		Clock:   clk,
+		Logger:  zaptest.NewLogger(t),
+		OnAlbum: func(ctx context.Context, a Album) error {
+			albums <- a
+			return nil
+		},
+	})
+	done := make(chan error, 1)
+	go func() { done <- h.Run(ctx) }()
+
+	user := &tg.PeerUser{UserID: 1}
+	plain := &tg.Updates{Updates: []tg.UpdateClass{newMessage(1, user, 0)}}
+	a.NoError(h.Handle(ctx, plain))
+	a.Equal([]tg.UpdatesClass{plain}, next.get())
+
+	observe := clk.Observe()
+	a.NoError(h.Handle(ctx, &tg.Updates{
+		Updates: []tg.UpdateClass{
+			newMessage(3, user, 10),
+			newMessage(4, user, 0),
+		},
+		Users: []tg.UserClass{&tg.User{ID: 1}},
+	}))
+	a.NoError(h.Handle(ctx, &tg.Updates{
+		Updates: []tg.UpdateClass{
+			newMessage(2, user, 10),
+			// Same grouped ID in other chat.
+			newMessage(5, &tg.PeerChat{ChatID: 1}, 10),
+		},
+		Users: []tg.UserClass{&tg.User{ID: 1}},
+	}))
+	<-observe
+
+	// Non-album update is passed immediately.
+	passed := next.get()
+	a.Len(passed, 2)
+	a.Equal([]tg.UpdateClass{newMessage(4, user, 0)}, passed[1].(*tg.Updates).Updates)
+
+	clk.Travel(time.Second)
+	first, second := <-albums, <-albums
+	a.Equal([]int{2, 3}, albumIDs(first))
+	a.Equal(user, first.Peer)
+	a.Equal(int64(10), first.GroupedID)
+	a.Len(first.Users, 1)
+	a.Len(first.Updates, 2)
+	a.Equal([]int{5}, albumIDs(second))
+
+	cancel()
+	a.ErrorIs(<-done, context.Canceled)
+}
+
+func TestHandler_MaxCount(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	next := &recorder{}
+	h := New(next, Options{
+		MaxCount: 2,
+		Clock:    neo.NewTime(time.Unix(0, 0)),
+	})
+
+	user := &tg.PeerUser{UserID: 1}
+	a.NoError(h.Handle(ctx, &tg.UpdateShort{Update: newMessage(2, user, 10)}))
+	a.Empty(next.get())
+	a.NoError(h.Handle(ctx, &tg.UpdateShort{Update: newMessage(1, user, 10)}))
+	a.NoError(h.Handle(ctx, &tg.UpdateShort{Update: newMessage(3, user, 10)}))
+
+	passed := next.get()
+	a.Len(passed, 1)
+	a.Equal(&tg.Updates{
+		Updates: []tg.UpdateClass{
+			newMessage(1, user, 10),
+			newMessage(2, user, 10),
+		},
+		Date: 2,
+	}, passed[0])
+
+	// Pending album is flushed explicitly.
+	h.Flush(ctx)
+	passed = next.get()
+	a.Len(passed, 2)
+	a.Equal([]tg.UpdateClass{newMessage(3, user, 10)}, passed[1].(*tg.Updates).Updates)
+}
+
+func TestHandler_RunFlush(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	next := &recorder{}
+	h := New(next, Options{
+		Clock: neo.NewTime(time.Unix(0, 0)),
+	})
+	a.NoError(h.Handle(ctx, &tg.UpdateShort{Update: newMessage(1, &tg.PeerUser{UserID: 1}, 10)}))
+
+	cancel()
+	a.ErrorIs(h.Run(ctx), context.Canceled)
+	a.Len(next.get(), 1)
+}
@@ -26,10 +26,10 @@ type Config struct {
 	// recover channel gap (optional).
 	OnChannelTooLong func(channelID int64)
 	// State storage.
-	// In-mem used if not provided.
+	// In-mem used if not provided, use FileStorage to persist state.
 	Storage StateStorage
 	// Channel access hash storage.
-	// In-mem used if not provided.
+	// In-mem used if not provided, use FileStorage to persist hashes.
 	AccessHasher ChannelAccessHasher
 	// Logger (optional).
 	Logger *zap.Logger
@@ -0,0 +1,335 @@
+package updates
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/internal/atomicfile"
+)
+
+// fileStorageVersion is current version of FileStorage format.
+const fileStorageVersion = 1
+
+// fileStorageMigrations upgrade data of given version to the next version.
+//
+// When format changes, increment fileStorageVersion and add migration
+// from previous version here.
+var fileStorageMigrations = map[int]func(data []byte) ([]byte, error){}
+
+type fileUserState struct {
+	Pts      int           `json:"pts"`
+	Qts      int           `json:"qts"`
+	Date     int           `json:"date"`
+	Seq      int           `json:"seq"`
+	Channels map[int64]int `json:"channels"`
+}
+
+type fileData struct {
+	Version      int                       `json:"version"`
+	States       map[int64]*fileUserState  `json:"states"`
+	AccessHashes map[int64]map[int64]int64 `json:"access_hashes"`
+}
+
+func (d fileData) clone() fileData {
+	r := fileData{
+		Version:      d.Version,
+		States:       make(map[int64]*fileUserState, len(d.States)),
+		AccessHashes: make(map[int64]map[int64]int64, len(d.AccessHashes)),
+	}
+	for userID, state := range d.States {
+		s := *state
+		s.Channels = make(map[int64]int, len(state.Channels))
+		for channelID, pts := range state.Channels {
+			s.Channels[channelID] = pts
+		}
+		r.States[userID] = &s
+	}
+	for userID, hashes := range d.AccessHashes {
+		h := make(map[int64]int64, len(hashes))
+		for channelID, hash := range hashes {
+			h[channelID] = hash
+		}
+		r.AccessHashes[userID] = h
+	}
+	return r
+}
+
+var (
+	_ StateStorage        = (*FileStorage)(nil)
+	_ ChannelAccessHasher = (*FileStorage)(nil)
+)
+
+// FileStorage is StateStorage and ChannelAccessHasher which persists data
+// in a single file.
+//
+// Every change is written atomically: data is written to temporary file,
+// synced and renamed over the old one, so file is never left in partial
+// state after crash. Change is applied only if it was persisted.
+type FileStorage struct {
+	path string
+	data fileData
+	mux  sync.Mutex
+}
+
+// NewFileStorage opens storage at given path, creating new one if file
+// does not exist.
+func NewFileStorage(path string) (*FileStorage, error) {
+	s := &FileStorage{
+		path: path,
+		data: fileData{
+			Version:      fileStorageVersion,
+			States:       map[int64]*fileUserState{},
+			AccessHashes: map[int64]map[int64]int64{},
+		},
+	}
+
+	raw, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return s, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "read")
+	}
+
+	data, err := decodeFileData(raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decode %q", path)
+	}
+	s.data = data
+
+	return s, nil
+}
+
+func decodeFileData(raw []byte) (fileData, error) {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return fileData{}, err
+	}
+
+	version := header.Version
+	if version > fileStorageVersion {
+		return fileData{}, errors.Errorf("unsupported version %d", version)
+	}
+	for ; version < fileStorageVersion; version++ {
+		migrate, ok := fileStorageMigrations[version]
+		if !ok {
+			return fileData{}, errors.Errorf("no migration from version %d", version)
+		}
+
+		migrated, err := migrate(raw)
+		if err != nil {
+			return fileData{}, errors.Wrapf(err, "migrate from version %d", version)
+		}
+		raw = migrated
+	}
+
+	var data fileData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fileData{}, err
+	}
+	data.Version = fileStorageVersion
+	if data.States == nil {
+		data.States = map[int64]*fileUserState{}
+	}
+	if data.AccessHashes == nil {
+		data.AccessHashes = map[int64]map[int64]int64{}
+	}
+	for _, state := range data.States {
+		if state.Channels == nil {
+			state.Channels = map[int64]int{}
+		}
+	}
+
+	return data, nil
+}
+
+// update applies f to copy of data and persists it.
+//
+// Data is replaced only if f succeeded and data was written.
+func (s *FileStorage) update(f func(data *fileData) error) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	data := s.data.clone()
+	if err := f(&data); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "encode")
+	}
+	if err := atomicfile.WriteFile(s.path, raw); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	s.data = data
+	return nil
+}
+
+// updateState applies f to state of given user.
+func (s *FileStorage) updateState(userID int64, f func(state *fileUserState)) error {
+	return s.update(func(data *fileData) error {
+		state, ok := data.States[userID]
+		if !ok {
+			return errors.New("internalState not found")
+		}
+
+		f(state)
+		return nil
+	})
+}
+
+// GetState implements StateStorage.
+func (s *FileStorage) GetState(ctx context.Context, userID int64) (state State, found bool, err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, found := s.data.States[userID]
+	if !found {
+		return State{}, false, nil
+	}
+
+	return State{
+		Pts:  v.Pts,
+		Qts:  v.Qts,
+		Date: v.Date,
+		Seq:  v.Seq,
+	}, true, nil
+}
+
+// SetState implements StateStorage.
+func (s *FileStorage) SetState(ctx context.Context, userID int64, state State) error {
+	return s.update(func(data *fileData) error {
+		data.States[userID] = &fileUserState{
+			Pts:      state.Pts,
+			Qts:      state.Qts,
+			Date:     state.Date,
+			Seq:      state.Seq,
+			Channels: map[int64]int{},
+		}
+		return nil
+	})
+}
+
+// SetPts implements StateStorage.
+func (s *FileStorage) SetPts(ctx context.Context, userID int64, pts int) error {
+	return s.updateState(userID, func(state *fileUserState) {
+		state.Pts = pts
+	})
+}
+
+// SetQts implements StateStorage.
+func (s *FileStorage) SetQts(ctx context.Context, userID int64, qts int) error {
+	return s.updateState(userID, func(state *fileUserState) {
+		state.Qts = qts
+	})
+}
+
+// SetDate implements StateStorage.
+func (s *FileStorage) SetDate(ctx context.Context, userID int64, date int) error {
+	return s.updateState(userID, func(state *fileUserState) {
+		state.Date = date
+	})
+}
+
+// SetSeq implements StateStorage.
+func (s *FileStorage) SetSeq(ctx context.Context, userID int64, seq int) error {
+	return s.updateState(userID, func(state *fileUserState) {
+		state.Seq = seq
+	})
+}
+
+// SetDateSeq implements StateStorage.
+//
+// Date and seq are written in single transaction.
+func (s *FileStorage) SetDateSeq(ctx context.Context, userID int64, date, seq int) error {
+	return s.updateState(userID, func(state *fileUserState) {
+		state.Date = date
+		state.Seq = seq
+	})
+}
+
+// GetChannelPts implements StateStorage.
+func (s *FileStorage) GetChannelPts(ctx context.Context, userID, channelID int64) (pts int, found bool, err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	state, ok := s.data.States[userID]
+	if !ok {
+		return 0, false, nil
+	}
+
+	pts, found = state.Channels[channelID]
+	return pts, found, nil
+}
+
+// SetChannelPts implements StateStorage.
+func (s *FileStorage) SetChannelPts(ctx context.Context, userID, channelID int64, pts int) error {
+	return s.update(func(data *fileData) error {
+		state, ok := data.States[userID]
+		if !ok {
+			return errors.New("user internalState does not exist")
+		}
+
+		state.Channels[channelID] = pts
+		return nil
+	})
+}
+
+// ForEachChannels implements StateStorage.
+func (s *FileStorage) ForEachChannels(ctx context.Context, userID int64, f func(ctx context.Context, channelID int64, pts int) error) error {
+	s.mux.Lock()
+	state, ok := s.data.States[userID]
+	if !ok {
+		s.mux.Unlock()
+		return errors.New("channels map does not exist")
+	}
+	// Copy channels to call f without lock, so it is able to modify storage.
+	channels := make(map[int64]int, len(state.Channels))
+	for id, pts := range state.Channels {
+		channels[id] = pts
+	}
+	s.mux.Unlock()
+
+	for id, pts := range channels {
+		if err := f(ctx, id, pts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetChannelAccessHash implements ChannelAccessHasher.
+func (s *FileStorage) GetChannelAccessHash(ctx context.Context, userID, channelID int64) (accessHash int64, found bool, err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	hashes, ok := s.data.AccessHashes[userID]
+	if !ok {
+		return 0, false, nil
+	}
+
+	accessHash, found = hashes[channelID]
+	return accessHash, found, nil
+}
+
+// SetChannelAccessHash implements ChannelAccessHasher.
+func (s *FileStorage) SetChannelAccessHash(ctx context.Context, userID, channelID, accessHash int64) error {
+	return s.update(func(data *fileData) error {
+		hashes, ok := data.AccessHashes[userID]
+		if !ok {
+			hashes = map[int64]int64{}
+			data.AccessHashes[userID] = hashes
+		}
+
+		hashes[channelID] = accessHash
+		return nil
+	})
+}
@@ -0,0 +1,108 @@
+package updates
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStorage(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	s, err := NewFileStorage(path)
+	a.NoError(err)
+
+	_, found, err := s.GetState(ctx, 1)
+	a.NoError(err)
+	a.False(found)
+	a.Error(s.SetPts(ctx, 1, 10), "state must exist")
+	a.Error(s.SetChannelPts(ctx, 1, 2, 10), "state must exist")
+
+	a.NoError(s.SetState(ctx, 1, State{Pts: 1, Qts: 2, Date: 3, Seq: 4}))
+	a.NoError(s.SetPts(ctx, 1, 10))
+	a.NoError(s.SetQts(ctx, 1, 20))
+	a.NoError(s.SetDateSeq(ctx, 1, 30, 40))
+	a.NoError(s.SetChannelPts(ctx, 1, 100, 5))
+	a.NoError(s.SetChannelAccessHash(ctx, 1, 100, 500))
+
+	// Reopen storage.
+	s, err = NewFileStorage(path)
+	a.NoError(err)
+
+	state, found, err := s.GetState(ctx, 1)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(State{Pts: 10, Qts: 20, Date: 30, Seq: 40}, state)
+
+	pts, found, err := s.GetChannelPts(ctx, 1, 100)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(5, pts)
+
+	channels := map[int64]int{}
+	a.NoError(s.ForEachChannels(ctx, 1, func(ctx context.Context, channelID int64, pts int) error {
+		channels[channelID] = pts
+		return nil
+	}))
+	a.Equal(map[int64]int{100: 5}, channels)
+
+	hash, found, err := s.GetChannelAccessHash(ctx, 1, 100)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(int64(500), hash)
+
+	// SetState resets channels.
+	a.NoError(s.SetState(ctx, 1, State{}))
+	_, found, err = s.GetChannelPts(ctx, 1, 100)
+	a.NoError(err)
+	a.False(found)
+
+	// No temporary files left.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	a.NoError(err)
+	a.Len(entries, 1)
+}
+
+func TestFileStorageFailedWrite(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "dir")
+	a.NoError(os.Mkdir(dir, 0o700))
+
+	s, err := NewFileStorage(filepath.Join(dir, "state.json"))
+	a.NoError(err)
+	a.NoError(s.SetState(ctx, 1, State{Pts: 1}))
+
+	// Make writes fail.
+	a.NoError(os.RemoveAll(dir))
+	a.Error(s.SetPts(ctx, 1, 2))
+
+	// Change must not be applied.
+	state, found, err := s.GetState(ctx, 1)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(1, state.Pts)
+}
+
+func TestFileStorageVersion(t *testing.T) {
+	a := require.New(t)
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	a.NoError(os.WriteFile(path, []byte(`{"version":100}`), 0o600))
+	_, err := NewFileStorage(path)
+	a.Error(err)
+
+	a.NoError(os.WriteFile(path, []byte(`{"version":0}`), 0o600))
+	_, err = NewFileStorage(path)
+	a.Error(err, "no migration from version 0")
+
+	a.NoError(os.WriteFile(path, []byte(`{"version":1,"states":{"1":{"pts":10}}}`), 0o600))
+	s, err := NewFileStorage(path)
+	a.NoError(err)
+	a.NoError(s.SetChannelPts(context.Background(), 1, 2, 3))
+}
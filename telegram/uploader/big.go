@@ -2,6 +2,7 @@ package uploader
 
 import (
 	"context"
+	"hash/crc32"
 	"io"
 
 	"github.com/go-faster/errors"
@@ -16,6 +17,7 @@ import (
 type part struct {
 	id     int
 	buf    *bin.Buffer
+	sum    uint32
 	upload *Upload
 }
 
@@ -76,8 +78,26 @@ func (u *Uploader) bigLoop(ctx context.Context, threads int, upload *Upload) err
 			nextPart := part{
 				id:     int(upload.sentParts.Load()),
 				buf:    buf,
+				sum:    crc32.ChecksumIEEE(buf.Buf),
 				upload: upload,
 			}
+
+			// Skip part if it was already uploaded by previous attempt.
+			if sum, ok := upload.resumed[nextPart.id]; ok && sum == nextPart.sum {
+				u.pool.Put(buf)
+				upload.sentParts.Inc()
+
+				state := upload.confirmResumed(nextPart.id, n, nextPart.sum)
+				if err := u.callback(ctx, state); err != nil {
+					return errors.Wrap(err, "progress callback")
+				}
+				if last {
+					close(toSend)
+					return nil
+				}
+				continue
+			}
+
 			select {
 			case toSend <- nextPart:
 				upload.sentParts.Inc()
@@ -109,7 +129,7 @@ func (u *Uploader) bigLoop(ctx context.Context, threads int, upload *Upload) err
 						return errors.Wrap(err, "upload part")
 					}
 
-					if err := u.callback(ctx, upload.confirm(part.id, n)); err != nil {
+					if err := u.callback(ctx, upload.confirm(part.id, n, part.sum)); err != nil {
 						return errors.Wrap(err, "progress callback")
 					}
 				}
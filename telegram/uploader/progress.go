@@ -17,6 +17,10 @@ type ProgressState struct {
 	// Total is a total size of uploading file.
 	// May be equal to -1, in case when Upload created without size (stream upload).
 	Total int64
+	// Resumed denotes that part was uploaded by previous upload attempt
+	// (see ResumeUpload) and was not sent again, because its
+	// checksum matches the saved one.
+	Resumed bool
 }
 
 // Progress is interface of upload process tracker.
@@ -0,0 +1,73 @@
+package uploader
+
+import (
+	"io"
+)
+
+// UploadState is a serializable state of upload, which can
+// be used to resume interrupted big file upload.
+//
+// Telegram stores uploaded parts only for a limited time,
+// so state should be used shortly after interruption.
+type UploadState struct {
+	// ID of upload.
+	ID int64 `json:"id"`
+	// Name of uploading file.
+	Name string `json:"name"`
+	// PartSize is a part size of Uploader.
+	PartSize int `json:"part_size"`
+	// Total is a total size of uploading file.
+	Total int64 `json:"total"`
+	// Parts contains CRC32 (IEEE) checksums of confirmed
+	// parts of big file by part ID.
+	Parts map[int]uint32 `json:"parts,omitempty"`
+}
+
+// State returns current state of upload.
+//
+// State is safe to call concurrently with upload,
+// e.g. from Progress callback or after upload failure.
+func (u *Upload) State() UploadState {
+	u.confirmedMux.Lock()
+	defer u.confirmedMux.Unlock()
+
+	parts := make(map[int]uint32, len(u.resumed)+len(u.confirmedSums))
+	// Keep parts of previous attempt which are not reached yet.
+	for id, sum := range u.resumed {
+		parts[id] = sum
+	}
+	for id, sum := range u.confirmedSums {
+		parts[id] = sum
+	}
+
+	return UploadState{
+		ID:       u.id,
+		Name:     u.name,
+		PartSize: u.partSize,
+		Total:    u.totalBytes,
+		Parts:    parts,
+	}
+}
+
+// ResumeUpload creates new Upload to continue upload using given state
+// and reader. Reader should read the same file from the beginning.
+//
+// Already uploaded parts are read and compared with saved checksums,
+// matching parts are not sent again and are reported to Progress
+// with ProgressState.Resumed set. Small files are uploaded again
+// from the beginning.
+func ResumeUpload(state UploadState, from io.Reader) *Upload {
+	upload := NewUpload(state.Name, from, state.Total)
+	if state.ID == 0 {
+		// Nothing was uploaded.
+		return upload
+	}
+
+	upload.id = state.ID
+	upload.partSize = state.PartSize
+	upload.resumed = make(map[int]uint32, len(state.Parts))
+	for id, sum := range state.Parts {
+		upload.resumed[id] = sum
+	}
+	return upload
+}
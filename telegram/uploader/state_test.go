@@ -0,0 +1,116 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+// partsClient records uploaded big file parts and fails on given one.
+type partsClient struct {
+	failOn int
+	parts  []int
+	mux    sync.Mutex
+}
+
+func (c *partsClient) UploadSaveFilePart(ctx context.Context, request *tg.UploadSaveFilePartRequest) (bool, error) {
+	return true, nil
+}
+
+func (c *partsClient) UploadSaveBigFilePart(ctx context.Context, request *tg.UploadSaveBigFilePartRequest) (bool, error) {
+	if request.FilePart == c.failOn {
+		return false, testErr
+	}
+
+	c.mux.Lock()
+	c.parts = append(c.parts, request.FilePart)
+	c.mux.Unlock()
+	return true, nil
+}
+
+type resumedCounter struct {
+	resumed  int
+	uploaded int64
+	mux      sync.Mutex
+}
+
+func (c *resumedCounter) Chunk(ctx context.Context, state ProgressState) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if state.Resumed {
+		c.resumed++
+	}
+	c.uploaded = state.Uploaded
+	return nil
+}
+
+func TestResumeUpload(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	const partSize = MaximumPartSize
+	data := make([]byte, bigFileLimit+partSize/2)
+	_, err := io.ReadFull(rand.Reader, data)
+	a.NoError(err)
+	totalParts := computeParts(partSize, len(data))
+
+	// First attempt fails on the 10th part.
+	client := &partsClient{failOn: 10}
+	upload := NewUpload("file.bin", bytes.NewReader(data), int64(len(data)))
+	_, err = NewUploader(client).WithPartSize(partSize).Upload(ctx, upload)
+	a.Error(err)
+
+	raw, err := json.Marshal(upload.State())
+	a.NoError(err)
+	var state UploadState
+	a.NoError(json.Unmarshal(raw, &state))
+	a.Len(state.Parts, 10)
+
+	// Change third part, so it should be sent again.
+	data[3*partSize] ^= 0xff
+
+	progress := &resumedCounter{}
+	client = &partsClient{failOn: -1}
+	f, err := NewUploader(client).
+		WithPartSize(partSize).
+		WithProgress(progress).
+		Upload(ctx, ResumeUpload(state, bytes.NewReader(data)))
+	a.NoError(err)
+	a.Equal(&tg.InputFileBig{
+		ID:    state.ID,
+		Parts: totalParts,
+		Name:  "file.bin",
+	}, f)
+
+	expected := []int{3}
+	for i := 10; i < totalParts; i++ {
+		expected = append(expected, i)
+	}
+	sort.Ints(client.parts)
+	a.Equal(expected, client.parts)
+	a.Equal(9, progress.resumed)
+	a.Equal(int64(len(data)), progress.uploaded)
+}
+
+func TestResumeUpload_PartSize(t *testing.T) {
+	a := require.New(t)
+
+	upload := ResumeUpload(UploadState{
+		ID:       10,
+		Name:     "file.bin",
+		PartSize: 1024,
+		Total:    bigFileLimit + 1,
+	}, bytes.NewReader(nil))
+	_, err := NewUploader(&partsClient{failOn: -1}).Upload(context.Background(), upload)
+	a.Error(err)
+}
@@ -31,7 +31,12 @@ type Upload struct {
 	confirmedParts int
 	// Confirmed uploaded bytes.
 	confirmedBytes int64
-	confirmedMux   sync.Mutex
+	// Checksums of confirmed parts of big file.
+	confirmedSums map[int]uint32
+	confirmedMux  sync.Mutex
+
+	// Checksums of parts confirmed by previous upload attempt.
+	resumed map[int]uint32 // immutable
 
 	// Total parts.
 	totalParts int
@@ -56,13 +61,24 @@ func (u *Upload) confirmSmall(bytes int) ProgressState {
 	return u.confirmLocked(u.confirmedParts, bytes)
 }
 
-func (u *Upload) confirm(part, bytes int) ProgressState {
+func (u *Upload) confirm(part, bytes int, sum uint32) ProgressState {
 	u.confirmedMux.Lock()
 	defer u.confirmedMux.Unlock()
 
+	if u.confirmedSums == nil {
+		u.confirmedSums = map[int]uint32{}
+	}
+	u.confirmedSums[part] = sum
 	return u.confirmLocked(part, bytes)
 }
 
+// confirmResumed marks part as verified part from previous upload attempt.
+func (u *Upload) confirmResumed(part, bytes int, sum uint32) ProgressState {
+	s := u.confirm(part, bytes, sum)
+	s.Resumed = true
+	return s
+}
+
 func (u *Upload) confirmLocked(part, bytes int) ProgressState {
 	u.confirmedBytes += int64(bytes)
 
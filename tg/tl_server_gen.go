@@ -31,11 +31,20 @@ var (
 	_ = tdjson.Encoder{}
 )
 
+// ServerDispatcher dispatches RPC requests to typed handlers.
+//
+// Handlers get decoded request and return result, which is packed into
+// encoder of method result type. Errors are returned as-is, so
+// *tgerr.Error can be sent as RPC error by server.
 type ServerDispatcher struct {
 	fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 	handlers map[uint32]func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)
 }
 
+// NewServerDispatcher creates new ServerDispatcher.
+//
+// Fallback is called for methods without handler. If fallback is nil,
+// INPUT_METHOD_INVALID error is returned.
 func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error)) *ServerDispatcher {
 	return &ServerDispatcher{
 		fallback: fallback,
@@ -43,6 +52,7 @@ func NewServerDispatcher(fallback func(ctx context.Context, b *bin.Buffer) (bin.
 	}
 }
 
+// Handle decodes request from given buffer and calls handler of method.
 func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 	id, err := b.PeekID()
 	if err != nil {
@@ -51,12 +61,28 @@ func (s *ServerDispatcher) Handle(ctx context.Context, b *bin.Buffer) (bin.Encod
 
 	f, ok := s.handlers[id]
 	if !ok {
+		if s.fallback == nil {
+			return nil, tgerr.New(400, "INPUT_METHOD_INVALID")
+		}
 		return s.fallback(ctx, b)
 	}
 
 	return f(ctx, b)
 }
 
+// TypeIDs returns sorted type IDs of methods with handler.
+func (s *ServerDispatcher) TypeIDs() []uint32 {
+	r := make([]uint32, 0, len(s.handlers))
+	for id := range s.handlers {
+		r = append(r, id)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// OnAuthSendCode sets handler of auth.sendCode#a677244f method.
+//
+// See https://core.telegram.org/method/auth.sendCode for reference.
 func (s *ServerDispatcher) OnAuthSendCode(f func(ctx context.Context, request *AuthSendCodeRequest) (AuthSentCodeClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthSendCodeRequest
@@ -74,6 +100,9 @@ func (s *ServerDispatcher) OnAuthSendCode(f func(ctx context.Context, request *A
 	s.handlers[AuthSendCodeRequestTypeID] = handler
 }
 
+// OnAuthSignUp sets handler of auth.signUp#aac7b717 method.
+//
+// See https://core.telegram.org/method/auth.signUp for reference.
 func (s *ServerDispatcher) OnAuthSignUp(f func(ctx context.Context, request *AuthSignUpRequest) (AuthAuthorizationClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthSignUpRequest
@@ -91,6 +120,9 @@ func (s *ServerDispatcher) OnAuthSignUp(f func(ctx context.Context, request *Aut
 	s.handlers[AuthSignUpRequestTypeID] = handler
 }
 
+// OnAuthSignIn sets handler of auth.signIn#8d52a951 method.
+//
+// See https://core.telegram.org/method/auth.signIn for reference.
 func (s *ServerDispatcher) OnAuthSignIn(f func(ctx context.Context, request *AuthSignInRequest) (AuthAuthorizationClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthSignInRequest
@@ -108,6 +140,9 @@ func (s *ServerDispatcher) OnAuthSignIn(f func(ctx context.Context, request *Aut
 	s.handlers[AuthSignInRequestTypeID] = handler
 }
 
+// OnAuthLogOut sets handler of auth.logOut#3e72ba19 method.
+//
+// See https://core.telegram.org/method/auth.logOut for reference.
 func (s *ServerDispatcher) OnAuthLogOut(f func(ctx context.Context) (*AuthLoggedOut, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthLogOutRequest
@@ -125,6 +160,9 @@ func (s *ServerDispatcher) OnAuthLogOut(f func(ctx context.Context) (*AuthLogged
 	s.handlers[AuthLogOutRequestTypeID] = handler
 }
 
+// OnAuthResetAuthorizations sets handler of auth.resetAuthorizations#9fab0d1a method.
+//
+// See https://core.telegram.org/method/auth.resetAuthorizations for reference.
 func (s *ServerDispatcher) OnAuthResetAuthorizations(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthResetAuthorizationsRequest
@@ -146,6 +184,9 @@ func (s *ServerDispatcher) OnAuthResetAuthorizations(f func(ctx context.Context)
 	s.handlers[AuthResetAuthorizationsRequestTypeID] = handler
 }
 
+// OnAuthExportAuthorization sets handler of auth.exportAuthorization#e5bfffcd method.
+//
+// See https://core.telegram.org/method/auth.exportAuthorization for reference.
 func (s *ServerDispatcher) OnAuthExportAuthorization(f func(ctx context.Context, dcid int) (*AuthExportedAuthorization, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthExportAuthorizationRequest
@@ -163,6 +204,9 @@ func (s *ServerDispatcher) OnAuthExportAuthorization(f func(ctx context.Context,
 	s.handlers[AuthExportAuthorizationRequestTypeID] = handler
 }
 
+// OnAuthImportAuthorization sets handler of auth.importAuthorization#a57a7dad method.
+//
+// See https://core.telegram.org/method/auth.importAuthorization for reference.
 func (s *ServerDispatcher) OnAuthImportAuthorization(f func(ctx context.Context, request *AuthImportAuthorizationRequest) (AuthAuthorizationClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthImportAuthorizationRequest
@@ -180,6 +224,9 @@ func (s *ServerDispatcher) OnAuthImportAuthorization(f func(ctx context.Context,
 	s.handlers[AuthImportAuthorizationRequestTypeID] = handler
 }
 
+// OnAuthBindTempAuthKey sets handler of auth.bindTempAuthKey#cdd42a05 method.
+//
+// See https://core.telegram.org/method/auth.bindTempAuthKey for reference.
 func (s *ServerDispatcher) OnAuthBindTempAuthKey(f func(ctx context.Context, request *AuthBindTempAuthKeyRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthBindTempAuthKeyRequest
@@ -201,6 +248,9 @@ func (s *ServerDispatcher) OnAuthBindTempAuthKey(f func(ctx context.Context, req
 	s.handlers[AuthBindTempAuthKeyRequestTypeID] = handler
 }
 
+// OnAuthImportBotAuthorization sets handler of auth.importBotAuthorization#67a3ff2c method.
+//
+// See https://core.telegram.org/method/auth.importBotAuthorization for reference.
 func (s *ServerDispatcher) OnAuthImportBotAuthorization(f func(ctx context.Context, request *AuthImportBotAuthorizationRequest) (AuthAuthorizationClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthImportBotAuthorizationRequest
@@ -218,6 +268,9 @@ func (s *ServerDispatcher) OnAuthImportBotAuthorization(f func(ctx context.Conte
 	s.handlers[AuthImportBotAuthorizationRequestTypeID] = handler
 }
 
+// OnAuthCheckPassword sets handler of auth.checkPassword#d18b4d16 method.
+//
+// See https://core.telegram.org/method/auth.checkPassword for reference.
 func (s *ServerDispatcher) OnAuthCheckPassword(f func(ctx context.Context, password InputCheckPasswordSRPClass) (AuthAuthorizationClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthCheckPasswordRequest
@@ -235,6 +288,9 @@ func (s *ServerDispatcher) OnAuthCheckPassword(f func(ctx context.Context, passw
 	s.handlers[AuthCheckPasswordRequestTypeID] = handler
 }
 
+// OnAuthRequestPasswordRecovery sets handler of auth.requestPasswordRecovery#d897bc66 method.
+//
+// See https://core.telegram.org/method/auth.requestPasswordRecovery for reference.
 func (s *ServerDispatcher) OnAuthRequestPasswordRecovery(f func(ctx context.Context) (*AuthPasswordRecovery, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthRequestPasswordRecoveryRequest
@@ -252,6 +308,9 @@ func (s *ServerDispatcher) OnAuthRequestPasswordRecovery(f func(ctx context.Cont
 	s.handlers[AuthRequestPasswordRecoveryRequestTypeID] = handler
 }
 
+// OnAuthRecoverPassword sets handler of auth.recoverPassword#37096c70 method.
+//
+// See https://core.telegram.org/method/auth.recoverPassword for reference.
 func (s *ServerDispatcher) OnAuthRecoverPassword(f func(ctx context.Context, request *AuthRecoverPasswordRequest) (AuthAuthorizationClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthRecoverPasswordRequest
@@ -269,6 +328,9 @@ func (s *ServerDispatcher) OnAuthRecoverPassword(f func(ctx context.Context, req
 	s.handlers[AuthRecoverPasswordRequestTypeID] = handler
 }
 
+// OnAuthResendCode sets handler of auth.resendCode#3ef1a9bf method.
+//
+// See https://core.telegram.org/method/auth.resendCode for reference.
 func (s *ServerDispatcher) OnAuthResendCode(f func(ctx context.Context, request *AuthResendCodeRequest) (AuthSentCodeClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthResendCodeRequest
@@ -286,6 +348,9 @@ func (s *ServerDispatcher) OnAuthResendCode(f func(ctx context.Context, request
 	s.handlers[AuthResendCodeRequestTypeID] = handler
 }
 
+// OnAuthCancelCode sets handler of auth.cancelCode#1f040578 method.
+//
+// See https://core.telegram.org/method/auth.cancelCode for reference.
 func (s *ServerDispatcher) OnAuthCancelCode(f func(ctx context.Context, request *AuthCancelCodeRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthCancelCodeRequest
@@ -307,6 +372,9 @@ func (s *ServerDispatcher) OnAuthCancelCode(f func(ctx context.Context, request
 	s.handlers[AuthCancelCodeRequestTypeID] = handler
 }
 
+// OnAuthDropTempAuthKeys sets handler of auth.dropTempAuthKeys#8e48a188 method.
+//
+// See https://core.telegram.org/method/auth.dropTempAuthKeys for reference.
 func (s *ServerDispatcher) OnAuthDropTempAuthKeys(f func(ctx context.Context, exceptauthkeys []int64) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthDropTempAuthKeysRequest
@@ -328,6 +396,9 @@ func (s *ServerDispatcher) OnAuthDropTempAuthKeys(f func(ctx context.Context, ex
 	s.handlers[AuthDropTempAuthKeysRequestTypeID] = handler
 }
 
+// OnAuthExportLoginToken sets handler of auth.exportLoginToken#b7e085fe method.
+//
+// See https://core.telegram.org/method/auth.exportLoginToken for reference.
 func (s *ServerDispatcher) OnAuthExportLoginToken(f func(ctx context.Context, request *AuthExportLoginTokenRequest) (AuthLoginTokenClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthExportLoginTokenRequest
@@ -345,6 +416,9 @@ func (s *ServerDispatcher) OnAuthExportLoginToken(f func(ctx context.Context, re
 	s.handlers[AuthExportLoginTokenRequestTypeID] = handler
 }
 
+// OnAuthImportLoginToken sets handler of auth.importLoginToken#95ac5ce4 method.
+//
+// See https://core.telegram.org/method/auth.importLoginToken for reference.
 func (s *ServerDispatcher) OnAuthImportLoginToken(f func(ctx context.Context, token []byte) (AuthLoginTokenClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthImportLoginTokenRequest
@@ -362,6 +436,9 @@ func (s *ServerDispatcher) OnAuthImportLoginToken(f func(ctx context.Context, to
 	s.handlers[AuthImportLoginTokenRequestTypeID] = handler
 }
 
+// OnAuthAcceptLoginToken sets handler of auth.acceptLoginToken#e894ad4d method.
+//
+// See https://core.telegram.org/method/auth.acceptLoginToken for reference.
 func (s *ServerDispatcher) OnAuthAcceptLoginToken(f func(ctx context.Context, token []byte) (*Authorization, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthAcceptLoginTokenRequest
@@ -379,6 +456,9 @@ func (s *ServerDispatcher) OnAuthAcceptLoginToken(f func(ctx context.Context, to
 	s.handlers[AuthAcceptLoginTokenRequestTypeID] = handler
 }
 
+// OnAuthCheckRecoveryPassword sets handler of auth.checkRecoveryPassword#d36bf79 method.
+//
+// See https://core.telegram.org/method/auth.checkRecoveryPassword for reference.
 func (s *ServerDispatcher) OnAuthCheckRecoveryPassword(f func(ctx context.Context, code string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthCheckRecoveryPasswordRequest
@@ -400,6 +480,9 @@ func (s *ServerDispatcher) OnAuthCheckRecoveryPassword(f func(ctx context.Contex
 	s.handlers[AuthCheckRecoveryPasswordRequestTypeID] = handler
 }
 
+// OnAuthImportWebTokenAuthorization sets handler of auth.importWebTokenAuthorization#2db873a9 method.
+//
+// See https://core.telegram.org/method/auth.importWebTokenAuthorization for reference.
 func (s *ServerDispatcher) OnAuthImportWebTokenAuthorization(f func(ctx context.Context, request *AuthImportWebTokenAuthorizationRequest) (AuthAuthorizationClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthImportWebTokenAuthorizationRequest
@@ -417,6 +500,9 @@ func (s *ServerDispatcher) OnAuthImportWebTokenAuthorization(f func(ctx context.
 	s.handlers[AuthImportWebTokenAuthorizationRequestTypeID] = handler
 }
 
+// OnAuthRequestFirebaseSMS sets handler of auth.requestFirebaseSms#89464b50 method.
+//
+// See https://core.telegram.org/method/auth.requestFirebaseSms for reference.
 func (s *ServerDispatcher) OnAuthRequestFirebaseSMS(f func(ctx context.Context, request *AuthRequestFirebaseSMSRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthRequestFirebaseSMSRequest
@@ -438,6 +524,9 @@ func (s *ServerDispatcher) OnAuthRequestFirebaseSMS(f func(ctx context.Context,
 	s.handlers[AuthRequestFirebaseSMSRequestTypeID] = handler
 }
 
+// OnAuthResetLoginEmail sets handler of auth.resetLoginEmail#7e960193 method.
+//
+// See https://core.telegram.org/method/auth.resetLoginEmail for reference.
 func (s *ServerDispatcher) OnAuthResetLoginEmail(f func(ctx context.Context, request *AuthResetLoginEmailRequest) (AuthSentCodeClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AuthResetLoginEmailRequest
@@ -455,6 +544,9 @@ func (s *ServerDispatcher) OnAuthResetLoginEmail(f func(ctx context.Context, req
 	s.handlers[AuthResetLoginEmailRequestTypeID] = handler
 }
 
+// OnAccountRegisterDevice sets handler of account.registerDevice#ec86017a method.
+//
+// See https://core.telegram.org/method/account.registerDevice for reference.
 func (s *ServerDispatcher) OnAccountRegisterDevice(f func(ctx context.Context, request *AccountRegisterDeviceRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountRegisterDeviceRequest
@@ -476,6 +568,9 @@ func (s *ServerDispatcher) OnAccountRegisterDevice(f func(ctx context.Context, r
 	s.handlers[AccountRegisterDeviceRequestTypeID] = handler
 }
 
+// OnAccountUnregisterDevice sets handler of account.unregisterDevice#6a0d3206 method.
+//
+// See https://core.telegram.org/method/account.unregisterDevice for reference.
 func (s *ServerDispatcher) OnAccountUnregisterDevice(f func(ctx context.Context, request *AccountUnregisterDeviceRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUnregisterDeviceRequest
@@ -497,6 +592,9 @@ func (s *ServerDispatcher) OnAccountUnregisterDevice(f func(ctx context.Context,
 	s.handlers[AccountUnregisterDeviceRequestTypeID] = handler
 }
 
+// OnAccountUpdateNotifySettings sets handler of account.updateNotifySettings#84be5b93 method.
+//
+// See https://core.telegram.org/method/account.updateNotifySettings for reference.
 func (s *ServerDispatcher) OnAccountUpdateNotifySettings(f func(ctx context.Context, request *AccountUpdateNotifySettingsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateNotifySettingsRequest
@@ -518,6 +616,9 @@ func (s *ServerDispatcher) OnAccountUpdateNotifySettings(f func(ctx context.Cont
 	s.handlers[AccountUpdateNotifySettingsRequestTypeID] = handler
 }
 
+// OnAccountGetNotifySettings sets handler of account.getNotifySettings#12b3ad31 method.
+//
+// See https://core.telegram.org/method/account.getNotifySettings for reference.
 func (s *ServerDispatcher) OnAccountGetNotifySettings(f func(ctx context.Context, peer InputNotifyPeerClass) (*PeerNotifySettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetNotifySettingsRequest
@@ -535,6 +636,9 @@ func (s *ServerDispatcher) OnAccountGetNotifySettings(f func(ctx context.Context
 	s.handlers[AccountGetNotifySettingsRequestTypeID] = handler
 }
 
+// OnAccountResetNotifySettings sets handler of account.resetNotifySettings#db7e1747 method.
+//
+// See https://core.telegram.org/method/account.resetNotifySettings for reference.
 func (s *ServerDispatcher) OnAccountResetNotifySettings(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountResetNotifySettingsRequest
@@ -556,6 +660,9 @@ func (s *ServerDispatcher) OnAccountResetNotifySettings(f func(ctx context.Conte
 	s.handlers[AccountResetNotifySettingsRequestTypeID] = handler
 }
 
+// OnAccountUpdateProfile sets handler of account.updateProfile#78515775 method.
+//
+// See https://core.telegram.org/method/account.updateProfile for reference.
 func (s *ServerDispatcher) OnAccountUpdateProfile(f func(ctx context.Context, request *AccountUpdateProfileRequest) (UserClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateProfileRequest
@@ -573,6 +680,9 @@ func (s *ServerDispatcher) OnAccountUpdateProfile(f func(ctx context.Context, re
 	s.handlers[AccountUpdateProfileRequestTypeID] = handler
 }
 
+// OnAccountUpdateStatus sets handler of account.updateStatus#6628562c method.
+//
+// See https://core.telegram.org/method/account.updateStatus for reference.
 func (s *ServerDispatcher) OnAccountUpdateStatus(f func(ctx context.Context, offline bool) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateStatusRequest
@@ -594,6 +704,9 @@ func (s *ServerDispatcher) OnAccountUpdateStatus(f func(ctx context.Context, off
 	s.handlers[AccountUpdateStatusRequestTypeID] = handler
 }
 
+// OnAccountGetWallPapers sets handler of account.getWallPapers#7967d36 method.
+//
+// See https://core.telegram.org/method/account.getWallPapers for reference.
 func (s *ServerDispatcher) OnAccountGetWallPapers(f func(ctx context.Context, hash int64) (AccountWallPapersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetWallPapersRequest
@@ -611,6 +724,9 @@ func (s *ServerDispatcher) OnAccountGetWallPapers(f func(ctx context.Context, ha
 	s.handlers[AccountGetWallPapersRequestTypeID] = handler
 }
 
+// OnAccountReportPeer sets handler of account.reportPeer#c5ba3d86 method.
+//
+// See https://core.telegram.org/method/account.reportPeer for reference.
 func (s *ServerDispatcher) OnAccountReportPeer(f func(ctx context.Context, request *AccountReportPeerRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountReportPeerRequest
@@ -632,6 +748,9 @@ func (s *ServerDispatcher) OnAccountReportPeer(f func(ctx context.Context, reque
 	s.handlers[AccountReportPeerRequestTypeID] = handler
 }
 
+// OnAccountCheckUsername sets handler of account.checkUsername#2714d86c method.
+//
+// See https://core.telegram.org/method/account.checkUsername for reference.
 func (s *ServerDispatcher) OnAccountCheckUsername(f func(ctx context.Context, username string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountCheckUsernameRequest
@@ -653,6 +772,9 @@ func (s *ServerDispatcher) OnAccountCheckUsername(f func(ctx context.Context, us
 	s.handlers[AccountCheckUsernameRequestTypeID] = handler
 }
 
+// OnAccountUpdateUsername sets handler of account.updateUsername#3e0bdd7c method.
+//
+// See https://core.telegram.org/method/account.updateUsername for reference.
 func (s *ServerDispatcher) OnAccountUpdateUsername(f func(ctx context.Context, username string) (UserClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateUsernameRequest
@@ -670,6 +792,9 @@ func (s *ServerDispatcher) OnAccountUpdateUsername(f func(ctx context.Context, u
 	s.handlers[AccountUpdateUsernameRequestTypeID] = handler
 }
 
+// OnAccountGetPrivacy sets handler of account.getPrivacy#dadbc950 method.
+//
+// See https://core.telegram.org/method/account.getPrivacy for reference.
 func (s *ServerDispatcher) OnAccountGetPrivacy(f func(ctx context.Context, key InputPrivacyKeyClass) (*AccountPrivacyRules, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetPrivacyRequest
@@ -687,6 +812,9 @@ func (s *ServerDispatcher) OnAccountGetPrivacy(f func(ctx context.Context, key I
 	s.handlers[AccountGetPrivacyRequestTypeID] = handler
 }
 
+// OnAccountSetPrivacy sets handler of account.setPrivacy#c9f81ce8 method.
+//
+// See https://core.telegram.org/method/account.setPrivacy for reference.
 func (s *ServerDispatcher) OnAccountSetPrivacy(f func(ctx context.Context, request *AccountSetPrivacyRequest) (*AccountPrivacyRules, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSetPrivacyRequest
@@ -704,6 +832,9 @@ func (s *ServerDispatcher) OnAccountSetPrivacy(f func(ctx context.Context, reque
 	s.handlers[AccountSetPrivacyRequestTypeID] = handler
 }
 
+// OnAccountDeleteAccount sets handler of account.deleteAccount#a2c0cf74 method.
+//
+// See https://core.telegram.org/method/account.deleteAccount for reference.
 func (s *ServerDispatcher) OnAccountDeleteAccount(f func(ctx context.Context, request *AccountDeleteAccountRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountDeleteAccountRequest
@@ -725,6 +856,9 @@ func (s *ServerDispatcher) OnAccountDeleteAccount(f func(ctx context.Context, re
 	s.handlers[AccountDeleteAccountRequestTypeID] = handler
 }
 
+// OnAccountGetAccountTTL sets handler of account.getAccountTTL#8fc711d method.
+//
+// See https://core.telegram.org/method/account.getAccountTTL for reference.
 func (s *ServerDispatcher) OnAccountGetAccountTTL(f func(ctx context.Context) (*AccountDaysTTL, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetAccountTTLRequest
@@ -742,6 +876,9 @@ func (s *ServerDispatcher) OnAccountGetAccountTTL(f func(ctx context.Context) (*
 	s.handlers[AccountGetAccountTTLRequestTypeID] = handler
 }
 
+// OnAccountSetAccountTTL sets handler of account.setAccountTTL#2442485e method.
+//
+// See https://core.telegram.org/method/account.setAccountTTL for reference.
 func (s *ServerDispatcher) OnAccountSetAccountTTL(f func(ctx context.Context, ttl AccountDaysTTL) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSetAccountTTLRequest
@@ -763,6 +900,9 @@ func (s *ServerDispatcher) OnAccountSetAccountTTL(f func(ctx context.Context, tt
 	s.handlers[AccountSetAccountTTLRequestTypeID] = handler
 }
 
+// OnAccountSendChangePhoneCode sets handler of account.sendChangePhoneCode#82574ae5 method.
+//
+// See https://core.telegram.org/method/account.sendChangePhoneCode for reference.
 func (s *ServerDispatcher) OnAccountSendChangePhoneCode(f func(ctx context.Context, request *AccountSendChangePhoneCodeRequest) (AuthSentCodeClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSendChangePhoneCodeRequest
@@ -780,6 +920,9 @@ func (s *ServerDispatcher) OnAccountSendChangePhoneCode(f func(ctx context.Conte
 	s.handlers[AccountSendChangePhoneCodeRequestTypeID] = handler
 }
 
+// OnAccountChangePhone sets handler of account.changePhone#70c32edb method.
+//
+// See https://core.telegram.org/method/account.changePhone for reference.
 func (s *ServerDispatcher) OnAccountChangePhone(f func(ctx context.Context, request *AccountChangePhoneRequest) (UserClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountChangePhoneRequest
@@ -797,6 +940,9 @@ func (s *ServerDispatcher) OnAccountChangePhone(f func(ctx context.Context, requ
 	s.handlers[AccountChangePhoneRequestTypeID] = handler
 }
 
+// OnAccountUpdateDeviceLocked sets handler of account.updateDeviceLocked#38df3532 method.
+//
+// See https://core.telegram.org/method/account.updateDeviceLocked for reference.
 func (s *ServerDispatcher) OnAccountUpdateDeviceLocked(f func(ctx context.Context, period int) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateDeviceLockedRequest
@@ -818,6 +964,9 @@ func (s *ServerDispatcher) OnAccountUpdateDeviceLocked(f func(ctx context.Contex
 	s.handlers[AccountUpdateDeviceLockedRequestTypeID] = handler
 }
 
+// OnAccountGetAuthorizations sets handler of account.getAuthorizations#e320c158 method.
+//
+// See https://core.telegram.org/method/account.getAuthorizations for reference.
 func (s *ServerDispatcher) OnAccountGetAuthorizations(f func(ctx context.Context) (*AccountAuthorizations, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetAuthorizationsRequest
@@ -835,6 +984,9 @@ func (s *ServerDispatcher) OnAccountGetAuthorizations(f func(ctx context.Context
 	s.handlers[AccountGetAuthorizationsRequestTypeID] = handler
 }
 
+// OnAccountResetAuthorization sets handler of account.resetAuthorization#df77f3bc method.
+//
+// See https://core.telegram.org/method/account.resetAuthorization for reference.
 func (s *ServerDispatcher) OnAccountResetAuthorization(f func(ctx context.Context, hash int64) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountResetAuthorizationRequest
@@ -856,6 +1008,9 @@ func (s *ServerDispatcher) OnAccountResetAuthorization(f func(ctx context.Contex
 	s.handlers[AccountResetAuthorizationRequestTypeID] = handler
 }
 
+// OnAccountGetPassword sets handler of account.getPassword#548a30f5 method.
+//
+// See https://core.telegram.org/method/account.getPassword for reference.
 func (s *ServerDispatcher) OnAccountGetPassword(f func(ctx context.Context) (*AccountPassword, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetPasswordRequest
@@ -873,6 +1028,9 @@ func (s *ServerDispatcher) OnAccountGetPassword(f func(ctx context.Context) (*Ac
 	s.handlers[AccountGetPasswordRequestTypeID] = handler
 }
 
+// OnAccountGetPasswordSettings sets handler of account.getPasswordSettings#9cd4eaf9 method.
+//
+// See https://core.telegram.org/method/account.getPasswordSettings for reference.
 func (s *ServerDispatcher) OnAccountGetPasswordSettings(f func(ctx context.Context, password InputCheckPasswordSRPClass) (*AccountPasswordSettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetPasswordSettingsRequest
@@ -890,6 +1048,9 @@ func (s *ServerDispatcher) OnAccountGetPasswordSettings(f func(ctx context.Conte
 	s.handlers[AccountGetPasswordSettingsRequestTypeID] = handler
 }
 
+// OnAccountUpdatePasswordSettings sets handler of account.updatePasswordSettings#a59b102f method.
+//
+// See https://core.telegram.org/method/account.updatePasswordSettings for reference.
 func (s *ServerDispatcher) OnAccountUpdatePasswordSettings(f func(ctx context.Context, request *AccountUpdatePasswordSettingsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdatePasswordSettingsRequest
@@ -911,6 +1072,9 @@ func (s *ServerDispatcher) OnAccountUpdatePasswordSettings(f func(ctx context.Co
 	s.handlers[AccountUpdatePasswordSettingsRequestTypeID] = handler
 }
 
+// OnAccountSendConfirmPhoneCode sets handler of account.sendConfirmPhoneCode#1b3faa88 method.
+//
+// See https://core.telegram.org/method/account.sendConfirmPhoneCode for reference.
 func (s *ServerDispatcher) OnAccountSendConfirmPhoneCode(f func(ctx context.Context, request *AccountSendConfirmPhoneCodeRequest) (AuthSentCodeClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSendConfirmPhoneCodeRequest
@@ -928,6 +1092,9 @@ func (s *ServerDispatcher) OnAccountSendConfirmPhoneCode(f func(ctx context.Cont
 	s.handlers[AccountSendConfirmPhoneCodeRequestTypeID] = handler
 }
 
+// OnAccountConfirmPhone sets handler of account.confirmPhone#5f2178c3 method.
+//
+// See https://core.telegram.org/method/account.confirmPhone for reference.
 func (s *ServerDispatcher) OnAccountConfirmPhone(f func(ctx context.Context, request *AccountConfirmPhoneRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountConfirmPhoneRequest
@@ -949,6 +1116,9 @@ func (s *ServerDispatcher) OnAccountConfirmPhone(f func(ctx context.Context, req
 	s.handlers[AccountConfirmPhoneRequestTypeID] = handler
 }
 
+// OnAccountGetTmpPassword sets handler of account.getTmpPassword#449e0b51 method.
+//
+// See https://core.telegram.org/method/account.getTmpPassword for reference.
 func (s *ServerDispatcher) OnAccountGetTmpPassword(f func(ctx context.Context, request *AccountGetTmpPasswordRequest) (*AccountTmpPassword, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetTmpPasswordRequest
@@ -966,6 +1136,9 @@ func (s *ServerDispatcher) OnAccountGetTmpPassword(f func(ctx context.Context, r
 	s.handlers[AccountGetTmpPasswordRequestTypeID] = handler
 }
 
+// OnAccountGetWebAuthorizations sets handler of account.getWebAuthorizations#182e6d6f method.
+//
+// See https://core.telegram.org/method/account.getWebAuthorizations for reference.
 func (s *ServerDispatcher) OnAccountGetWebAuthorizations(f func(ctx context.Context) (*AccountWebAuthorizations, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetWebAuthorizationsRequest
@@ -983,6 +1156,9 @@ func (s *ServerDispatcher) OnAccountGetWebAuthorizations(f func(ctx context.Cont
 	s.handlers[AccountGetWebAuthorizationsRequestTypeID] = handler
 }
 
+// OnAccountResetWebAuthorization sets handler of account.resetWebAuthorization#2d01b9ef method.
+//
+// See https://core.telegram.org/method/account.resetWebAuthorization for reference.
 func (s *ServerDispatcher) OnAccountResetWebAuthorization(f func(ctx context.Context, hash int64) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountResetWebAuthorizationRequest
@@ -1004,6 +1180,9 @@ func (s *ServerDispatcher) OnAccountResetWebAuthorization(f func(ctx context.Con
 	s.handlers[AccountResetWebAuthorizationRequestTypeID] = handler
 }
 
+// OnAccountResetWebAuthorizations sets handler of account.resetWebAuthorizations#682d2594 method.
+//
+// See https://core.telegram.org/method/account.resetWebAuthorizations for reference.
 func (s *ServerDispatcher) OnAccountResetWebAuthorizations(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountResetWebAuthorizationsRequest
@@ -1025,6 +1204,9 @@ func (s *ServerDispatcher) OnAccountResetWebAuthorizations(f func(ctx context.Co
 	s.handlers[AccountResetWebAuthorizationsRequestTypeID] = handler
 }
 
+// OnAccountGetAllSecureValues sets handler of account.getAllSecureValues#b288bc7d method.
+//
+// See https://core.telegram.org/method/account.getAllSecureValues for reference.
 func (s *ServerDispatcher) OnAccountGetAllSecureValues(f func(ctx context.Context) ([]SecureValue, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetAllSecureValuesRequest
@@ -1042,6 +1224,9 @@ func (s *ServerDispatcher) OnAccountGetAllSecureValues(f func(ctx context.Contex
 	s.handlers[AccountGetAllSecureValuesRequestTypeID] = handler
 }
 
+// OnAccountGetSecureValue sets handler of account.getSecureValue#73665bc2 method.
+//
+// See https://core.telegram.org/method/account.getSecureValue for reference.
 func (s *ServerDispatcher) OnAccountGetSecureValue(f func(ctx context.Context, types []SecureValueTypeClass) ([]SecureValue, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetSecureValueRequest
@@ -1059,6 +1244,9 @@ func (s *ServerDispatcher) OnAccountGetSecureValue(f func(ctx context.Context, t
 	s.handlers[AccountGetSecureValueRequestTypeID] = handler
 }
 
+// OnAccountSaveSecureValue sets handler of account.saveSecureValue#899fe31d method.
+//
+// See https://core.telegram.org/method/account.saveSecureValue for reference.
 func (s *ServerDispatcher) OnAccountSaveSecureValue(f func(ctx context.Context, request *AccountSaveSecureValueRequest) (*SecureValue, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSaveSecureValueRequest
@@ -1076,6 +1264,9 @@ func (s *ServerDispatcher) OnAccountSaveSecureValue(f func(ctx context.Context,
 	s.handlers[AccountSaveSecureValueRequestTypeID] = handler
 }
 
+// OnAccountDeleteSecureValue sets handler of account.deleteSecureValue#b880bc4b method.
+//
+// See https://core.telegram.org/method/account.deleteSecureValue for reference.
 func (s *ServerDispatcher) OnAccountDeleteSecureValue(f func(ctx context.Context, types []SecureValueTypeClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountDeleteSecureValueRequest
@@ -1097,6 +1288,9 @@ func (s *ServerDispatcher) OnAccountDeleteSecureValue(f func(ctx context.Context
 	s.handlers[AccountDeleteSecureValueRequestTypeID] = handler
 }
 
+// OnAccountGetAuthorizationForm sets handler of account.getAuthorizationForm#a929597a method.
+//
+// See https://core.telegram.org/method/account.getAuthorizationForm for reference.
 func (s *ServerDispatcher) OnAccountGetAuthorizationForm(f func(ctx context.Context, request *AccountGetAuthorizationFormRequest) (*AccountAuthorizationForm, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetAuthorizationFormRequest
@@ -1114,6 +1308,9 @@ func (s *ServerDispatcher) OnAccountGetAuthorizationForm(f func(ctx context.Cont
 	s.handlers[AccountGetAuthorizationFormRequestTypeID] = handler
 }
 
+// OnAccountAcceptAuthorization sets handler of account.acceptAuthorization#f3ed4c73 method.
+//
+// See https://core.telegram.org/method/account.acceptAuthorization for reference.
 func (s *ServerDispatcher) OnAccountAcceptAuthorization(f func(ctx context.Context, request *AccountAcceptAuthorizationRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountAcceptAuthorizationRequest
@@ -1135,6 +1332,9 @@ func (s *ServerDispatcher) OnAccountAcceptAuthorization(f func(ctx context.Conte
 	s.handlers[AccountAcceptAuthorizationRequestTypeID] = handler
 }
 
+// OnAccountSendVerifyPhoneCode sets handler of account.sendVerifyPhoneCode#a5a356f9 method.
+//
+// See https://core.telegram.org/method/account.sendVerifyPhoneCode for reference.
 func (s *ServerDispatcher) OnAccountSendVerifyPhoneCode(f func(ctx context.Context, request *AccountSendVerifyPhoneCodeRequest) (AuthSentCodeClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSendVerifyPhoneCodeRequest
@@ -1152,6 +1352,9 @@ func (s *ServerDispatcher) OnAccountSendVerifyPhoneCode(f func(ctx context.Conte
 	s.handlers[AccountSendVerifyPhoneCodeRequestTypeID] = handler
 }
 
+// OnAccountVerifyPhone sets handler of account.verifyPhone#4dd3a7f6 method.
+//
+// See https://core.telegram.org/method/account.verifyPhone for reference.
 func (s *ServerDispatcher) OnAccountVerifyPhone(f func(ctx context.Context, request *AccountVerifyPhoneRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountVerifyPhoneRequest
@@ -1173,6 +1376,9 @@ func (s *ServerDispatcher) OnAccountVerifyPhone(f func(ctx context.Context, requ
 	s.handlers[AccountVerifyPhoneRequestTypeID] = handler
 }
 
+// OnAccountSendVerifyEmailCode sets handler of account.sendVerifyEmailCode#98e037bb method.
+//
+// See https://core.telegram.org/method/account.sendVerifyEmailCode for reference.
 func (s *ServerDispatcher) OnAccountSendVerifyEmailCode(f func(ctx context.Context, request *AccountSendVerifyEmailCodeRequest) (*AccountSentEmailCode, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSendVerifyEmailCodeRequest
@@ -1190,6 +1396,9 @@ func (s *ServerDispatcher) OnAccountSendVerifyEmailCode(f func(ctx context.Conte
 	s.handlers[AccountSendVerifyEmailCodeRequestTypeID] = handler
 }
 
+// OnAccountVerifyEmail sets handler of account.verifyEmail#32da4cf method.
+//
+// See https://core.telegram.org/method/account.verifyEmail for reference.
 func (s *ServerDispatcher) OnAccountVerifyEmail(f func(ctx context.Context, request *AccountVerifyEmailRequest) (AccountEmailVerifiedClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountVerifyEmailRequest
@@ -1207,6 +1416,9 @@ func (s *ServerDispatcher) OnAccountVerifyEmail(f func(ctx context.Context, requ
 	s.handlers[AccountVerifyEmailRequestTypeID] = handler
 }
 
+// OnAccountInitTakeoutSession sets handler of account.initTakeoutSession#8ef3eab0 method.
+//
+// See https://core.telegram.org/method/account.initTakeoutSession for reference.
 func (s *ServerDispatcher) OnAccountInitTakeoutSession(f func(ctx context.Context, request *AccountInitTakeoutSessionRequest) (*AccountTakeout, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountInitTakeoutSessionRequest
@@ -1224,6 +1436,9 @@ func (s *ServerDispatcher) OnAccountInitTakeoutSession(f func(ctx context.Contex
 	s.handlers[AccountInitTakeoutSessionRequestTypeID] = handler
 }
 
+// OnAccountFinishTakeoutSession sets handler of account.finishTakeoutSession#1d2652ee method.
+//
+// See https://core.telegram.org/method/account.finishTakeoutSession for reference.
 func (s *ServerDispatcher) OnAccountFinishTakeoutSession(f func(ctx context.Context, request *AccountFinishTakeoutSessionRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountFinishTakeoutSessionRequest
@@ -1245,6 +1460,9 @@ func (s *ServerDispatcher) OnAccountFinishTakeoutSession(f func(ctx context.Cont
 	s.handlers[AccountFinishTakeoutSessionRequestTypeID] = handler
 }
 
+// OnAccountConfirmPasswordEmail sets handler of account.confirmPasswordEmail#8fdf1920 method.
+//
+// See https://core.telegram.org/method/account.confirmPasswordEmail for reference.
 func (s *ServerDispatcher) OnAccountConfirmPasswordEmail(f func(ctx context.Context, code string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountConfirmPasswordEmailRequest
@@ -1266,6 +1484,9 @@ func (s *ServerDispatcher) OnAccountConfirmPasswordEmail(f func(ctx context.Cont
 	s.handlers[AccountConfirmPasswordEmailRequestTypeID] = handler
 }
 
+// OnAccountResendPasswordEmail sets handler of account.resendPasswordEmail#7a7f2a15 method.
+//
+// See https://core.telegram.org/method/account.resendPasswordEmail for reference.
 func (s *ServerDispatcher) OnAccountResendPasswordEmail(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountResendPasswordEmailRequest
@@ -1287,6 +1508,9 @@ func (s *ServerDispatcher) OnAccountResendPasswordEmail(f func(ctx context.Conte
 	s.handlers[AccountResendPasswordEmailRequestTypeID] = handler
 }
 
+// OnAccountCancelPasswordEmail sets handler of account.cancelPasswordEmail#c1cbd5b6 method.
+//
+// See https://core.telegram.org/method/account.cancelPasswordEmail for reference.
 func (s *ServerDispatcher) OnAccountCancelPasswordEmail(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountCancelPasswordEmailRequest
@@ -1308,6 +1532,9 @@ func (s *ServerDispatcher) OnAccountCancelPasswordEmail(f func(ctx context.Conte
 	s.handlers[AccountCancelPasswordEmailRequestTypeID] = handler
 }
 
+// OnAccountGetContactSignUpNotification sets handler of account.getContactSignUpNotification#9f07c728 method.
+//
+// See https://core.telegram.org/method/account.getContactSignUpNotification for reference.
 func (s *ServerDispatcher) OnAccountGetContactSignUpNotification(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetContactSignUpNotificationRequest
@@ -1329,6 +1556,9 @@ func (s *ServerDispatcher) OnAccountGetContactSignUpNotification(f func(ctx cont
 	s.handlers[AccountGetContactSignUpNotificationRequestTypeID] = handler
 }
 
+// OnAccountSetContactSignUpNotification sets handler of account.setContactSignUpNotification#cff43f61 method.
+//
+// See https://core.telegram.org/method/account.setContactSignUpNotification for reference.
 func (s *ServerDispatcher) OnAccountSetContactSignUpNotification(f func(ctx context.Context, silent bool) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSetContactSignUpNotificationRequest
@@ -1350,6 +1580,9 @@ func (s *ServerDispatcher) OnAccountSetContactSignUpNotification(f func(ctx cont
 	s.handlers[AccountSetContactSignUpNotificationRequestTypeID] = handler
 }
 
+// OnAccountGetNotifyExceptions sets handler of account.getNotifyExceptions#53577479 method.
+//
+// See https://core.telegram.org/method/account.getNotifyExceptions for reference.
 func (s *ServerDispatcher) OnAccountGetNotifyExceptions(f func(ctx context.Context, request *AccountGetNotifyExceptionsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetNotifyExceptionsRequest
@@ -1367,6 +1600,9 @@ func (s *ServerDispatcher) OnAccountGetNotifyExceptions(f func(ctx context.Conte
 	s.handlers[AccountGetNotifyExceptionsRequestTypeID] = handler
 }
 
+// OnAccountGetWallPaper sets handler of account.getWallPaper#fc8ddbea method.
+//
+// See https://core.telegram.org/method/account.getWallPaper for reference.
 func (s *ServerDispatcher) OnAccountGetWallPaper(f func(ctx context.Context, wallpaper InputWallPaperClass) (WallPaperClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetWallPaperRequest
@@ -1384,6 +1620,9 @@ func (s *ServerDispatcher) OnAccountGetWallPaper(f func(ctx context.Context, wal
 	s.handlers[AccountGetWallPaperRequestTypeID] = handler
 }
 
+// OnAccountUploadWallPaper sets handler of account.uploadWallPaper#e39a8f03 method.
+//
+// See https://core.telegram.org/method/account.uploadWallPaper for reference.
 func (s *ServerDispatcher) OnAccountUploadWallPaper(f func(ctx context.Context, request *AccountUploadWallPaperRequest) (WallPaperClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUploadWallPaperRequest
@@ -1401,6 +1640,9 @@ func (s *ServerDispatcher) OnAccountUploadWallPaper(f func(ctx context.Context,
 	s.handlers[AccountUploadWallPaperRequestTypeID] = handler
 }
 
+// OnAccountSaveWallPaper sets handler of account.saveWallPaper#6c5a5b37 method.
+//
+// See https://core.telegram.org/method/account.saveWallPaper for reference.
 func (s *ServerDispatcher) OnAccountSaveWallPaper(f func(ctx context.Context, request *AccountSaveWallPaperRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSaveWallPaperRequest
@@ -1422,6 +1664,9 @@ func (s *ServerDispatcher) OnAccountSaveWallPaper(f func(ctx context.Context, re
 	s.handlers[AccountSaveWallPaperRequestTypeID] = handler
 }
 
+// OnAccountInstallWallPaper sets handler of account.installWallPaper#feed5769 method.
+//
+// See https://core.telegram.org/method/account.installWallPaper for reference.
 func (s *ServerDispatcher) OnAccountInstallWallPaper(f func(ctx context.Context, request *AccountInstallWallPaperRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountInstallWallPaperRequest
@@ -1443,6 +1688,9 @@ func (s *ServerDispatcher) OnAccountInstallWallPaper(f func(ctx context.Context,
 	s.handlers[AccountInstallWallPaperRequestTypeID] = handler
 }
 
+// OnAccountResetWallPapers sets handler of account.resetWallPapers#bb3b9804 method.
+//
+// See https://core.telegram.org/method/account.resetWallPapers for reference.
 func (s *ServerDispatcher) OnAccountResetWallPapers(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountResetWallPapersRequest
@@ -1464,6 +1712,9 @@ func (s *ServerDispatcher) OnAccountResetWallPapers(f func(ctx context.Context)
 	s.handlers[AccountResetWallPapersRequestTypeID] = handler
 }
 
+// OnAccountGetAutoDownloadSettings sets handler of account.getAutoDownloadSettings#56da0b3f method.
+//
+// See https://core.telegram.org/method/account.getAutoDownloadSettings for reference.
 func (s *ServerDispatcher) OnAccountGetAutoDownloadSettings(f func(ctx context.Context) (*AccountAutoDownloadSettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetAutoDownloadSettingsRequest
@@ -1481,6 +1732,9 @@ func (s *ServerDispatcher) OnAccountGetAutoDownloadSettings(f func(ctx context.C
 	s.handlers[AccountGetAutoDownloadSettingsRequestTypeID] = handler
 }
 
+// OnAccountSaveAutoDownloadSettings sets handler of account.saveAutoDownloadSettings#76f36233 method.
+//
+// See https://core.telegram.org/method/account.saveAutoDownloadSettings for reference.
 func (s *ServerDispatcher) OnAccountSaveAutoDownloadSettings(f func(ctx context.Context, request *AccountSaveAutoDownloadSettingsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSaveAutoDownloadSettingsRequest
@@ -1502,6 +1756,9 @@ func (s *ServerDispatcher) OnAccountSaveAutoDownloadSettings(f func(ctx context.
 	s.handlers[AccountSaveAutoDownloadSettingsRequestTypeID] = handler
 }
 
+// OnAccountUploadTheme sets handler of account.uploadTheme#1c3db333 method.
+//
+// See https://core.telegram.org/method/account.uploadTheme for reference.
 func (s *ServerDispatcher) OnAccountUploadTheme(f func(ctx context.Context, request *AccountUploadThemeRequest) (DocumentClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUploadThemeRequest
@@ -1519,6 +1776,9 @@ func (s *ServerDispatcher) OnAccountUploadTheme(f func(ctx context.Context, requ
 	s.handlers[AccountUploadThemeRequestTypeID] = handler
 }
 
+// OnAccountCreateTheme sets handler of account.createTheme#652e4400 method.
+//
+// See https://core.telegram.org/method/account.createTheme for reference.
 func (s *ServerDispatcher) OnAccountCreateTheme(f func(ctx context.Context, request *AccountCreateThemeRequest) (*Theme, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountCreateThemeRequest
@@ -1536,6 +1796,9 @@ func (s *ServerDispatcher) OnAccountCreateTheme(f func(ctx context.Context, requ
 	s.handlers[AccountCreateThemeRequestTypeID] = handler
 }
 
+// OnAccountUpdateTheme sets handler of account.updateTheme#2bf40ccc method.
+//
+// See https://core.telegram.org/method/account.updateTheme for reference.
 func (s *ServerDispatcher) OnAccountUpdateTheme(f func(ctx context.Context, request *AccountUpdateThemeRequest) (*Theme, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateThemeRequest
@@ -1553,6 +1816,9 @@ func (s *ServerDispatcher) OnAccountUpdateTheme(f func(ctx context.Context, requ
 	s.handlers[AccountUpdateThemeRequestTypeID] = handler
 }
 
+// OnAccountSaveTheme sets handler of account.saveTheme#f257106c method.
+//
+// See https://core.telegram.org/method/account.saveTheme for reference.
 func (s *ServerDispatcher) OnAccountSaveTheme(f func(ctx context.Context, request *AccountSaveThemeRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSaveThemeRequest
@@ -1574,6 +1840,9 @@ func (s *ServerDispatcher) OnAccountSaveTheme(f func(ctx context.Context, reques
 	s.handlers[AccountSaveThemeRequestTypeID] = handler
 }
 
+// OnAccountInstallTheme sets handler of account.installTheme#c727bb3b method.
+//
+// See https://core.telegram.org/method/account.installTheme for reference.
 func (s *ServerDispatcher) OnAccountInstallTheme(f func(ctx context.Context, request *AccountInstallThemeRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountInstallThemeRequest
@@ -1595,6 +1864,9 @@ func (s *ServerDispatcher) OnAccountInstallTheme(f func(ctx context.Context, req
 	s.handlers[AccountInstallThemeRequestTypeID] = handler
 }
 
+// OnAccountGetTheme sets handler of account.getTheme#3a5869ec method.
+//
+// See https://core.telegram.org/method/account.getTheme for reference.
 func (s *ServerDispatcher) OnAccountGetTheme(f func(ctx context.Context, request *AccountGetThemeRequest) (*Theme, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetThemeRequest
@@ -1612,6 +1884,9 @@ func (s *ServerDispatcher) OnAccountGetTheme(f func(ctx context.Context, request
 	s.handlers[AccountGetThemeRequestTypeID] = handler
 }
 
+// OnAccountGetThemes sets handler of account.getThemes#7206e458 method.
+//
+// See https://core.telegram.org/method/account.getThemes for reference.
 func (s *ServerDispatcher) OnAccountGetThemes(f func(ctx context.Context, request *AccountGetThemesRequest) (AccountThemesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetThemesRequest
@@ -1629,6 +1904,9 @@ func (s *ServerDispatcher) OnAccountGetThemes(f func(ctx context.Context, reques
 	s.handlers[AccountGetThemesRequestTypeID] = handler
 }
 
+// OnAccountSetContentSettings sets handler of account.setContentSettings#b574b16b method.
+//
+// See https://core.telegram.org/method/account.setContentSettings for reference.
 func (s *ServerDispatcher) OnAccountSetContentSettings(f func(ctx context.Context, request *AccountSetContentSettingsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSetContentSettingsRequest
@@ -1650,6 +1928,9 @@ func (s *ServerDispatcher) OnAccountSetContentSettings(f func(ctx context.Contex
 	s.handlers[AccountSetContentSettingsRequestTypeID] = handler
 }
 
+// OnAccountGetContentSettings sets handler of account.getContentSettings#8b9b4dae method.
+//
+// See https://core.telegram.org/method/account.getContentSettings for reference.
 func (s *ServerDispatcher) OnAccountGetContentSettings(f func(ctx context.Context) (*AccountContentSettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetContentSettingsRequest
@@ -1667,6 +1948,9 @@ func (s *ServerDispatcher) OnAccountGetContentSettings(f func(ctx context.Contex
 	s.handlers[AccountGetContentSettingsRequestTypeID] = handler
 }
 
+// OnAccountGetMultiWallPapers sets handler of account.getMultiWallPapers#65ad71dc method.
+//
+// See https://core.telegram.org/method/account.getMultiWallPapers for reference.
 func (s *ServerDispatcher) OnAccountGetMultiWallPapers(f func(ctx context.Context, wallpapers []InputWallPaperClass) ([]WallPaperClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetMultiWallPapersRequest
@@ -1684,6 +1968,9 @@ func (s *ServerDispatcher) OnAccountGetMultiWallPapers(f func(ctx context.Contex
 	s.handlers[AccountGetMultiWallPapersRequestTypeID] = handler
 }
 
+// OnAccountGetGlobalPrivacySettings sets handler of account.getGlobalPrivacySettings#eb2b4cf6 method.
+//
+// See https://core.telegram.org/method/account.getGlobalPrivacySettings for reference.
 func (s *ServerDispatcher) OnAccountGetGlobalPrivacySettings(f func(ctx context.Context) (*GlobalPrivacySettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetGlobalPrivacySettingsRequest
@@ -1701,6 +1988,9 @@ func (s *ServerDispatcher) OnAccountGetGlobalPrivacySettings(f func(ctx context.
 	s.handlers[AccountGetGlobalPrivacySettingsRequestTypeID] = handler
 }
 
+// OnAccountSetGlobalPrivacySettings sets handler of account.setGlobalPrivacySettings#1edaaac2 method.
+//
+// See https://core.telegram.org/method/account.setGlobalPrivacySettings for reference.
 func (s *ServerDispatcher) OnAccountSetGlobalPrivacySettings(f func(ctx context.Context, settings GlobalPrivacySettings) (*GlobalPrivacySettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSetGlobalPrivacySettingsRequest
@@ -1718,6 +2008,9 @@ func (s *ServerDispatcher) OnAccountSetGlobalPrivacySettings(f func(ctx context.
 	s.handlers[AccountSetGlobalPrivacySettingsRequestTypeID] = handler
 }
 
+// OnAccountReportProfilePhoto sets handler of account.reportProfilePhoto#fa8cc6f5 method.
+//
+// See https://core.telegram.org/method/account.reportProfilePhoto for reference.
 func (s *ServerDispatcher) OnAccountReportProfilePhoto(f func(ctx context.Context, request *AccountReportProfilePhotoRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountReportProfilePhotoRequest
@@ -1739,6 +2032,9 @@ func (s *ServerDispatcher) OnAccountReportProfilePhoto(f func(ctx context.Contex
 	s.handlers[AccountReportProfilePhotoRequestTypeID] = handler
 }
 
+// OnAccountResetPassword sets handler of account.resetPassword#9308ce1b method.
+//
+// See https://core.telegram.org/method/account.resetPassword for reference.
 func (s *ServerDispatcher) OnAccountResetPassword(f func(ctx context.Context) (AccountResetPasswordResultClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountResetPasswordRequest
@@ -1756,6 +2052,9 @@ func (s *ServerDispatcher) OnAccountResetPassword(f func(ctx context.Context) (A
 	s.handlers[AccountResetPasswordRequestTypeID] = handler
 }
 
+// OnAccountDeclinePasswordReset sets handler of account.declinePasswordReset#4c9409f6 method.
+//
+// See https://core.telegram.org/method/account.declinePasswordReset for reference.
 func (s *ServerDispatcher) OnAccountDeclinePasswordReset(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountDeclinePasswordResetRequest
@@ -1777,6 +2076,9 @@ func (s *ServerDispatcher) OnAccountDeclinePasswordReset(f func(ctx context.Cont
 	s.handlers[AccountDeclinePasswordResetRequestTypeID] = handler
 }
 
+// OnAccountGetChatThemes sets handler of account.getChatThemes#d638de89 method.
+//
+// See https://core.telegram.org/method/account.getChatThemes for reference.
 func (s *ServerDispatcher) OnAccountGetChatThemes(f func(ctx context.Context, hash int64) (AccountThemesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetChatThemesRequest
@@ -1794,6 +2096,9 @@ func (s *ServerDispatcher) OnAccountGetChatThemes(f func(ctx context.Context, ha
 	s.handlers[AccountGetChatThemesRequestTypeID] = handler
 }
 
+// OnAccountSetAuthorizationTTL sets handler of account.setAuthorizationTTL#bf899aa0 method.
+//
+// See https://core.telegram.org/method/account.setAuthorizationTTL for reference.
 func (s *ServerDispatcher) OnAccountSetAuthorizationTTL(f func(ctx context.Context, authorizationttldays int) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSetAuthorizationTTLRequest
@@ -1815,6 +2120,9 @@ func (s *ServerDispatcher) OnAccountSetAuthorizationTTL(f func(ctx context.Conte
 	s.handlers[AccountSetAuthorizationTTLRequestTypeID] = handler
 }
 
+// OnAccountChangeAuthorizationSettings sets handler of account.changeAuthorizationSettings#40f48462 method.
+//
+// See https://core.telegram.org/method/account.changeAuthorizationSettings for reference.
 func (s *ServerDispatcher) OnAccountChangeAuthorizationSettings(f func(ctx context.Context, request *AccountChangeAuthorizationSettingsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountChangeAuthorizationSettingsRequest
@@ -1836,6 +2144,9 @@ func (s *ServerDispatcher) OnAccountChangeAuthorizationSettings(f func(ctx conte
 	s.handlers[AccountChangeAuthorizationSettingsRequestTypeID] = handler
 }
 
+// OnAccountGetSavedRingtones sets handler of account.getSavedRingtones#e1902288 method.
+//
+// See https://core.telegram.org/method/account.getSavedRingtones for reference.
 func (s *ServerDispatcher) OnAccountGetSavedRingtones(f func(ctx context.Context, hash int64) (AccountSavedRingtonesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetSavedRingtonesRequest
@@ -1853,6 +2164,9 @@ func (s *ServerDispatcher) OnAccountGetSavedRingtones(f func(ctx context.Context
 	s.handlers[AccountGetSavedRingtonesRequestTypeID] = handler
 }
 
+// OnAccountSaveRingtone sets handler of account.saveRingtone#3dea5b03 method.
+//
+// See https://core.telegram.org/method/account.saveRingtone for reference.
 func (s *ServerDispatcher) OnAccountSaveRingtone(f func(ctx context.Context, request *AccountSaveRingtoneRequest) (AccountSavedRingtoneClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSaveRingtoneRequest
@@ -1870,6 +2184,9 @@ func (s *ServerDispatcher) OnAccountSaveRingtone(f func(ctx context.Context, req
 	s.handlers[AccountSaveRingtoneRequestTypeID] = handler
 }
 
+// OnAccountUploadRingtone sets handler of account.uploadRingtone#831a83a2 method.
+//
+// See https://core.telegram.org/method/account.uploadRingtone for reference.
 func (s *ServerDispatcher) OnAccountUploadRingtone(f func(ctx context.Context, request *AccountUploadRingtoneRequest) (DocumentClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUploadRingtoneRequest
@@ -1887,6 +2204,9 @@ func (s *ServerDispatcher) OnAccountUploadRingtone(f func(ctx context.Context, r
 	s.handlers[AccountUploadRingtoneRequestTypeID] = handler
 }
 
+// OnAccountUpdateEmojiStatus sets handler of account.updateEmojiStatus#fbd3de6b method.
+//
+// See https://core.telegram.org/method/account.updateEmojiStatus for reference.
 func (s *ServerDispatcher) OnAccountUpdateEmojiStatus(f func(ctx context.Context, emojistatus EmojiStatusClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateEmojiStatusRequest
@@ -1908,6 +2228,9 @@ func (s *ServerDispatcher) OnAccountUpdateEmojiStatus(f func(ctx context.Context
 	s.handlers[AccountUpdateEmojiStatusRequestTypeID] = handler
 }
 
+// OnAccountGetDefaultEmojiStatuses sets handler of account.getDefaultEmojiStatuses#d6753386 method.
+//
+// See https://core.telegram.org/method/account.getDefaultEmojiStatuses for reference.
 func (s *ServerDispatcher) OnAccountGetDefaultEmojiStatuses(f func(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetDefaultEmojiStatusesRequest
@@ -1925,6 +2248,9 @@ func (s *ServerDispatcher) OnAccountGetDefaultEmojiStatuses(f func(ctx context.C
 	s.handlers[AccountGetDefaultEmojiStatusesRequestTypeID] = handler
 }
 
+// OnAccountGetRecentEmojiStatuses sets handler of account.getRecentEmojiStatuses#f578105 method.
+//
+// See https://core.telegram.org/method/account.getRecentEmojiStatuses for reference.
 func (s *ServerDispatcher) OnAccountGetRecentEmojiStatuses(f func(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetRecentEmojiStatusesRequest
@@ -1942,6 +2268,9 @@ func (s *ServerDispatcher) OnAccountGetRecentEmojiStatuses(f func(ctx context.Co
 	s.handlers[AccountGetRecentEmojiStatusesRequestTypeID] = handler
 }
 
+// OnAccountClearRecentEmojiStatuses sets handler of account.clearRecentEmojiStatuses#18201aae method.
+//
+// See https://core.telegram.org/method/account.clearRecentEmojiStatuses for reference.
 func (s *ServerDispatcher) OnAccountClearRecentEmojiStatuses(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountClearRecentEmojiStatusesRequest
@@ -1963,6 +2292,9 @@ func (s *ServerDispatcher) OnAccountClearRecentEmojiStatuses(f func(ctx context.
 	s.handlers[AccountClearRecentEmojiStatusesRequestTypeID] = handler
 }
 
+// OnAccountReorderUsernames sets handler of account.reorderUsernames#ef500eab method.
+//
+// See https://core.telegram.org/method/account.reorderUsernames for reference.
 func (s *ServerDispatcher) OnAccountReorderUsernames(f func(ctx context.Context, order []string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountReorderUsernamesRequest
@@ -1984,6 +2316,9 @@ func (s *ServerDispatcher) OnAccountReorderUsernames(f func(ctx context.Context,
 	s.handlers[AccountReorderUsernamesRequestTypeID] = handler
 }
 
+// OnAccountToggleUsername sets handler of account.toggleUsername#58d6b376 method.
+//
+// See https://core.telegram.org/method/account.toggleUsername for reference.
 func (s *ServerDispatcher) OnAccountToggleUsername(f func(ctx context.Context, request *AccountToggleUsernameRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountToggleUsernameRequest
@@ -2005,6 +2340,9 @@ func (s *ServerDispatcher) OnAccountToggleUsername(f func(ctx context.Context, r
 	s.handlers[AccountToggleUsernameRequestTypeID] = handler
 }
 
+// OnAccountGetDefaultProfilePhotoEmojis sets handler of account.getDefaultProfilePhotoEmojis#e2750328 method.
+//
+// See https://core.telegram.org/method/account.getDefaultProfilePhotoEmojis for reference.
 func (s *ServerDispatcher) OnAccountGetDefaultProfilePhotoEmojis(f func(ctx context.Context, hash int64) (EmojiListClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetDefaultProfilePhotoEmojisRequest
@@ -2022,6 +2360,9 @@ func (s *ServerDispatcher) OnAccountGetDefaultProfilePhotoEmojis(f func(ctx cont
 	s.handlers[AccountGetDefaultProfilePhotoEmojisRequestTypeID] = handler
 }
 
+// OnAccountGetDefaultGroupPhotoEmojis sets handler of account.getDefaultGroupPhotoEmojis#915860ae method.
+//
+// See https://core.telegram.org/method/account.getDefaultGroupPhotoEmojis for reference.
 func (s *ServerDispatcher) OnAccountGetDefaultGroupPhotoEmojis(f func(ctx context.Context, hash int64) (EmojiListClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetDefaultGroupPhotoEmojisRequest
@@ -2039,6 +2380,9 @@ func (s *ServerDispatcher) OnAccountGetDefaultGroupPhotoEmojis(f func(ctx contex
 	s.handlers[AccountGetDefaultGroupPhotoEmojisRequestTypeID] = handler
 }
 
+// OnAccountGetAutoSaveSettings sets handler of account.getAutoSaveSettings#adcbbcda method.
+//
+// See https://core.telegram.org/method/account.getAutoSaveSettings for reference.
 func (s *ServerDispatcher) OnAccountGetAutoSaveSettings(f func(ctx context.Context) (*AccountAutoSaveSettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetAutoSaveSettingsRequest
@@ -2056,6 +2400,9 @@ func (s *ServerDispatcher) OnAccountGetAutoSaveSettings(f func(ctx context.Conte
 	s.handlers[AccountGetAutoSaveSettingsRequestTypeID] = handler
 }
 
+// OnAccountSaveAutoSaveSettings sets handler of account.saveAutoSaveSettings#d69b8361 method.
+//
+// See https://core.telegram.org/method/account.saveAutoSaveSettings for reference.
 func (s *ServerDispatcher) OnAccountSaveAutoSaveSettings(f func(ctx context.Context, request *AccountSaveAutoSaveSettingsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountSaveAutoSaveSettingsRequest
@@ -2077,6 +2424,9 @@ func (s *ServerDispatcher) OnAccountSaveAutoSaveSettings(f func(ctx context.Cont
 	s.handlers[AccountSaveAutoSaveSettingsRequestTypeID] = handler
 }
 
+// OnAccountDeleteAutoSaveExceptions sets handler of account.deleteAutoSaveExceptions#53bc0020 method.
+//
+// See https://core.telegram.org/method/account.deleteAutoSaveExceptions for reference.
 func (s *ServerDispatcher) OnAccountDeleteAutoSaveExceptions(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountDeleteAutoSaveExceptionsRequest
@@ -2098,6 +2448,9 @@ func (s *ServerDispatcher) OnAccountDeleteAutoSaveExceptions(f func(ctx context.
 	s.handlers[AccountDeleteAutoSaveExceptionsRequestTypeID] = handler
 }
 
+// OnAccountInvalidateSignInCodes sets handler of account.invalidateSignInCodes#ca8ae8ba method.
+//
+// See https://core.telegram.org/method/account.invalidateSignInCodes for reference.
 func (s *ServerDispatcher) OnAccountInvalidateSignInCodes(f func(ctx context.Context, codes []string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountInvalidateSignInCodesRequest
@@ -2119,6 +2472,9 @@ func (s *ServerDispatcher) OnAccountInvalidateSignInCodes(f func(ctx context.Con
 	s.handlers[AccountInvalidateSignInCodesRequestTypeID] = handler
 }
 
+// OnAccountUpdateColor sets handler of account.updateColor#7cefa15d method.
+//
+// See https://core.telegram.org/method/account.updateColor for reference.
 func (s *ServerDispatcher) OnAccountUpdateColor(f func(ctx context.Context, request *AccountUpdateColorRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateColorRequest
@@ -2140,6 +2496,9 @@ func (s *ServerDispatcher) OnAccountUpdateColor(f func(ctx context.Context, requ
 	s.handlers[AccountUpdateColorRequestTypeID] = handler
 }
 
+// OnAccountGetDefaultBackgroundEmojis sets handler of account.getDefaultBackgroundEmojis#a60ab9ce method.
+//
+// See https://core.telegram.org/method/account.getDefaultBackgroundEmojis for reference.
 func (s *ServerDispatcher) OnAccountGetDefaultBackgroundEmojis(f func(ctx context.Context, hash int64) (EmojiListClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetDefaultBackgroundEmojisRequest
@@ -2157,6 +2516,9 @@ func (s *ServerDispatcher) OnAccountGetDefaultBackgroundEmojis(f func(ctx contex
 	s.handlers[AccountGetDefaultBackgroundEmojisRequestTypeID] = handler
 }
 
+// OnAccountGetChannelDefaultEmojiStatuses sets handler of account.getChannelDefaultEmojiStatuses#7727a7d5 method.
+//
+// See https://core.telegram.org/method/account.getChannelDefaultEmojiStatuses for reference.
 func (s *ServerDispatcher) OnAccountGetChannelDefaultEmojiStatuses(f func(ctx context.Context, hash int64) (AccountEmojiStatusesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetChannelDefaultEmojiStatusesRequest
@@ -2174,6 +2536,9 @@ func (s *ServerDispatcher) OnAccountGetChannelDefaultEmojiStatuses(f func(ctx co
 	s.handlers[AccountGetChannelDefaultEmojiStatusesRequestTypeID] = handler
 }
 
+// OnAccountGetChannelRestrictedStatusEmojis sets handler of account.getChannelRestrictedStatusEmojis#35a9e0d5 method.
+//
+// See https://core.telegram.org/method/account.getChannelRestrictedStatusEmojis for reference.
 func (s *ServerDispatcher) OnAccountGetChannelRestrictedStatusEmojis(f func(ctx context.Context, hash int64) (EmojiListClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetChannelRestrictedStatusEmojisRequest
@@ -2191,6 +2556,9 @@ func (s *ServerDispatcher) OnAccountGetChannelRestrictedStatusEmojis(f func(ctx
 	s.handlers[AccountGetChannelRestrictedStatusEmojisRequestTypeID] = handler
 }
 
+// OnAccountUpdateBusinessWorkHours sets handler of account.updateBusinessWorkHours#4b00e066 method.
+//
+// See https://core.telegram.org/method/account.updateBusinessWorkHours for reference.
 func (s *ServerDispatcher) OnAccountUpdateBusinessWorkHours(f func(ctx context.Context, request *AccountUpdateBusinessWorkHoursRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateBusinessWorkHoursRequest
@@ -2212,6 +2580,9 @@ func (s *ServerDispatcher) OnAccountUpdateBusinessWorkHours(f func(ctx context.C
 	s.handlers[AccountUpdateBusinessWorkHoursRequestTypeID] = handler
 }
 
+// OnAccountUpdateBusinessLocation sets handler of account.updateBusinessLocation#9e6b131a method.
+//
+// See https://core.telegram.org/method/account.updateBusinessLocation for reference.
 func (s *ServerDispatcher) OnAccountUpdateBusinessLocation(f func(ctx context.Context, request *AccountUpdateBusinessLocationRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateBusinessLocationRequest
@@ -2233,6 +2604,9 @@ func (s *ServerDispatcher) OnAccountUpdateBusinessLocation(f func(ctx context.Co
 	s.handlers[AccountUpdateBusinessLocationRequestTypeID] = handler
 }
 
+// OnAccountUpdateBusinessGreetingMessage sets handler of account.updateBusinessGreetingMessage#66cdafc4 method.
+//
+// See https://core.telegram.org/method/account.updateBusinessGreetingMessage for reference.
 func (s *ServerDispatcher) OnAccountUpdateBusinessGreetingMessage(f func(ctx context.Context, request *AccountUpdateBusinessGreetingMessageRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateBusinessGreetingMessageRequest
@@ -2254,6 +2628,9 @@ func (s *ServerDispatcher) OnAccountUpdateBusinessGreetingMessage(f func(ctx con
 	s.handlers[AccountUpdateBusinessGreetingMessageRequestTypeID] = handler
 }
 
+// OnAccountUpdateBusinessAwayMessage sets handler of account.updateBusinessAwayMessage#a26a7fa5 method.
+//
+// See https://core.telegram.org/method/account.updateBusinessAwayMessage for reference.
 func (s *ServerDispatcher) OnAccountUpdateBusinessAwayMessage(f func(ctx context.Context, request *AccountUpdateBusinessAwayMessageRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateBusinessAwayMessageRequest
@@ -2275,6 +2652,9 @@ func (s *ServerDispatcher) OnAccountUpdateBusinessAwayMessage(f func(ctx context
 	s.handlers[AccountUpdateBusinessAwayMessageRequestTypeID] = handler
 }
 
+// OnAccountUpdateConnectedBot sets handler of account.updateConnectedBot#9c2d527d method.
+//
+// See https://core.telegram.org/method/account.updateConnectedBot for reference.
 func (s *ServerDispatcher) OnAccountUpdateConnectedBot(f func(ctx context.Context, request *AccountUpdateConnectedBotRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountUpdateConnectedBotRequest
@@ -2292,6 +2672,9 @@ func (s *ServerDispatcher) OnAccountUpdateConnectedBot(f func(ctx context.Contex
 	s.handlers[AccountUpdateConnectedBotRequestTypeID] = handler
 }
 
+// OnAccountGetConnectedBots sets handler of account.getConnectedBots#4ea4c80f method.
+//
+// See https://core.telegram.org/method/account.getConnectedBots for reference.
 func (s *ServerDispatcher) OnAccountGetConnectedBots(f func(ctx context.Context) (*AccountConnectedBots, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request AccountGetConnectedBotsRequest
@@ -2309,6 +2692,9 @@ func (s *ServerDispatcher) OnAccountGetConnectedBots(f func(ctx context.Context)
 	s.handlers[AccountGetConnectedBotsRequestTypeID] = handler
 }
 
+// OnUsersGetUsers sets handler of users.getUsers#d91a548 method.
+//
+// See https://core.telegram.org/method/users.getUsers for reference.
 func (s *ServerDispatcher) OnUsersGetUsers(f func(ctx context.Context, id []InputUserClass) ([]UserClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UsersGetUsersRequest
@@ -2326,6 +2712,9 @@ func (s *ServerDispatcher) OnUsersGetUsers(f func(ctx context.Context, id []Inpu
 	s.handlers[UsersGetUsersRequestTypeID] = handler
 }
 
+// OnUsersGetFullUser sets handler of users.getFullUser#b60f5918 method.
+//
+// See https://core.telegram.org/method/users.getFullUser for reference.
 func (s *ServerDispatcher) OnUsersGetFullUser(f func(ctx context.Context, id InputUserClass) (*UsersUserFull, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UsersGetFullUserRequest
@@ -2343,6 +2732,9 @@ func (s *ServerDispatcher) OnUsersGetFullUser(f func(ctx context.Context, id Inp
 	s.handlers[UsersGetFullUserRequestTypeID] = handler
 }
 
+// OnUsersSetSecureValueErrors sets handler of users.setSecureValueErrors#90c894b5 method.
+//
+// See https://core.telegram.org/method/users.setSecureValueErrors for reference.
 func (s *ServerDispatcher) OnUsersSetSecureValueErrors(f func(ctx context.Context, request *UsersSetSecureValueErrorsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UsersSetSecureValueErrorsRequest
@@ -2364,6 +2756,9 @@ func (s *ServerDispatcher) OnUsersSetSecureValueErrors(f func(ctx context.Contex
 	s.handlers[UsersSetSecureValueErrorsRequestTypeID] = handler
 }
 
+// OnUsersGetIsPremiumRequiredToContact sets handler of users.getIsPremiumRequiredToContact#a622aa10 method.
+//
+// See https://core.telegram.org/method/users.getIsPremiumRequiredToContact for reference.
 func (s *ServerDispatcher) OnUsersGetIsPremiumRequiredToContact(f func(ctx context.Context, id []InputUserClass) ([]bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UsersGetIsPremiumRequiredToContactRequest
@@ -2381,6 +2776,9 @@ func (s *ServerDispatcher) OnUsersGetIsPremiumRequiredToContact(f func(ctx conte
 	s.handlers[UsersGetIsPremiumRequiredToContactRequestTypeID] = handler
 }
 
+// OnContactsGetContactIDs sets handler of contacts.getContactIDs#7adc669d method.
+//
+// See https://core.telegram.org/method/contacts.getContactIDs for reference.
 func (s *ServerDispatcher) OnContactsGetContactIDs(f func(ctx context.Context, hash int64) ([]int, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsGetContactIDsRequest
@@ -2398,6 +2796,9 @@ func (s *ServerDispatcher) OnContactsGetContactIDs(f func(ctx context.Context, h
 	s.handlers[ContactsGetContactIDsRequestTypeID] = handler
 }
 
+// OnContactsGetStatuses sets handler of contacts.getStatuses#c4a353ee method.
+//
+// See https://core.telegram.org/method/contacts.getStatuses for reference.
 func (s *ServerDispatcher) OnContactsGetStatuses(f func(ctx context.Context) ([]ContactStatus, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsGetStatusesRequest
@@ -2415,6 +2816,9 @@ func (s *ServerDispatcher) OnContactsGetStatuses(f func(ctx context.Context) ([]
 	s.handlers[ContactsGetStatusesRequestTypeID] = handler
 }
 
+// OnContactsGetContacts sets handler of contacts.getContacts#5dd69e12 method.
+//
+// See https://core.telegram.org/method/contacts.getContacts for reference.
 func (s *ServerDispatcher) OnContactsGetContacts(f func(ctx context.Context, hash int64) (ContactsContactsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsGetContactsRequest
@@ -2432,6 +2836,9 @@ func (s *ServerDispatcher) OnContactsGetContacts(f func(ctx context.Context, has
 	s.handlers[ContactsGetContactsRequestTypeID] = handler
 }
 
+// OnContactsImportContacts sets handler of contacts.importContacts#2c800be5 method.
+//
+// See https://core.telegram.org/method/contacts.importContacts for reference.
 func (s *ServerDispatcher) OnContactsImportContacts(f func(ctx context.Context, contacts []InputPhoneContact) (*ContactsImportedContacts, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsImportContactsRequest
@@ -2449,6 +2856,9 @@ func (s *ServerDispatcher) OnContactsImportContacts(f func(ctx context.Context,
 	s.handlers[ContactsImportContactsRequestTypeID] = handler
 }
 
+// OnContactsDeleteContacts sets handler of contacts.deleteContacts#96a0e00 method.
+//
+// See https://core.telegram.org/method/contacts.deleteContacts for reference.
 func (s *ServerDispatcher) OnContactsDeleteContacts(f func(ctx context.Context, id []InputUserClass) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsDeleteContactsRequest
@@ -2466,6 +2876,9 @@ func (s *ServerDispatcher) OnContactsDeleteContacts(f func(ctx context.Context,
 	s.handlers[ContactsDeleteContactsRequestTypeID] = handler
 }
 
+// OnContactsDeleteByPhones sets handler of contacts.deleteByPhones#1013fd9e method.
+//
+// See https://core.telegram.org/method/contacts.deleteByPhones for reference.
 func (s *ServerDispatcher) OnContactsDeleteByPhones(f func(ctx context.Context, phones []string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsDeleteByPhonesRequest
@@ -2487,6 +2900,9 @@ func (s *ServerDispatcher) OnContactsDeleteByPhones(f func(ctx context.Context,
 	s.handlers[ContactsDeleteByPhonesRequestTypeID] = handler
 }
 
+// OnContactsBlock sets handler of contacts.block#2e2e8734 method.
+//
+// See https://core.telegram.org/method/contacts.block for reference.
 func (s *ServerDispatcher) OnContactsBlock(f func(ctx context.Context, request *ContactsBlockRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsBlockRequest
@@ -2508,6 +2924,9 @@ func (s *ServerDispatcher) OnContactsBlock(f func(ctx context.Context, request *
 	s.handlers[ContactsBlockRequestTypeID] = handler
 }
 
+// OnContactsUnblock sets handler of contacts.unblock#b550d328 method.
+//
+// See https://core.telegram.org/method/contacts.unblock for reference.
 func (s *ServerDispatcher) OnContactsUnblock(f func(ctx context.Context, request *ContactsUnblockRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsUnblockRequest
@@ -2529,6 +2948,9 @@ func (s *ServerDispatcher) OnContactsUnblock(f func(ctx context.Context, request
 	s.handlers[ContactsUnblockRequestTypeID] = handler
 }
 
+// OnContactsGetBlocked sets handler of contacts.getBlocked#9a868f80 method.
+//
+// See https://core.telegram.org/method/contacts.getBlocked for reference.
 func (s *ServerDispatcher) OnContactsGetBlocked(f func(ctx context.Context, request *ContactsGetBlockedRequest) (ContactsBlockedClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsGetBlockedRequest
@@ -2546,6 +2968,9 @@ func (s *ServerDispatcher) OnContactsGetBlocked(f func(ctx context.Context, requ
 	s.handlers[ContactsGetBlockedRequestTypeID] = handler
 }
 
+// OnContactsSearch sets handler of contacts.search#11f812d8 method.
+//
+// See https://core.telegram.org/method/contacts.search for reference.
 func (s *ServerDispatcher) OnContactsSearch(f func(ctx context.Context, request *ContactsSearchRequest) (*ContactsFound, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsSearchRequest
@@ -2563,6 +2988,9 @@ func (s *ServerDispatcher) OnContactsSearch(f func(ctx context.Context, request
 	s.handlers[ContactsSearchRequestTypeID] = handler
 }
 
+// OnContactsResolveUsername sets handler of contacts.resolveUsername#f93ccba3 method.
+//
+// See https://core.telegram.org/method/contacts.resolveUsername for reference.
 func (s *ServerDispatcher) OnContactsResolveUsername(f func(ctx context.Context, username string) (*ContactsResolvedPeer, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsResolveUsernameRequest
@@ -2580,6 +3008,9 @@ func (s *ServerDispatcher) OnContactsResolveUsername(f func(ctx context.Context,
 	s.handlers[ContactsResolveUsernameRequestTypeID] = handler
 }
 
+// OnContactsGetTopPeers sets handler of contacts.getTopPeers#973478b6 method.
+//
+// See https://core.telegram.org/method/contacts.getTopPeers for reference.
 func (s *ServerDispatcher) OnContactsGetTopPeers(f func(ctx context.Context, request *ContactsGetTopPeersRequest) (ContactsTopPeersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsGetTopPeersRequest
@@ -2597,6 +3028,9 @@ func (s *ServerDispatcher) OnContactsGetTopPeers(f func(ctx context.Context, req
 	s.handlers[ContactsGetTopPeersRequestTypeID] = handler
 }
 
+// OnContactsResetTopPeerRating sets handler of contacts.resetTopPeerRating#1ae373ac method.
+//
+// See https://core.telegram.org/method/contacts.resetTopPeerRating for reference.
 func (s *ServerDispatcher) OnContactsResetTopPeerRating(f func(ctx context.Context, request *ContactsResetTopPeerRatingRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsResetTopPeerRatingRequest
@@ -2618,6 +3052,9 @@ func (s *ServerDispatcher) OnContactsResetTopPeerRating(f func(ctx context.Conte
 	s.handlers[ContactsResetTopPeerRatingRequestTypeID] = handler
 }
 
+// OnContactsResetSaved sets handler of contacts.resetSaved#879537f1 method.
+//
+// See https://core.telegram.org/method/contacts.resetSaved for reference.
 func (s *ServerDispatcher) OnContactsResetSaved(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsResetSavedRequest
@@ -2639,6 +3076,9 @@ func (s *ServerDispatcher) OnContactsResetSaved(f func(ctx context.Context) (boo
 	s.handlers[ContactsResetSavedRequestTypeID] = handler
 }
 
+// OnContactsGetSaved sets handler of contacts.getSaved#82f1e39f method.
+//
+// See https://core.telegram.org/method/contacts.getSaved for reference.
 func (s *ServerDispatcher) OnContactsGetSaved(f func(ctx context.Context) ([]SavedPhoneContact, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsGetSavedRequest
@@ -2656,6 +3096,9 @@ func (s *ServerDispatcher) OnContactsGetSaved(f func(ctx context.Context) ([]Sav
 	s.handlers[ContactsGetSavedRequestTypeID] = handler
 }
 
+// OnContactsToggleTopPeers sets handler of contacts.toggleTopPeers#8514bdda method.
+//
+// See https://core.telegram.org/method/contacts.toggleTopPeers for reference.
 func (s *ServerDispatcher) OnContactsToggleTopPeers(f func(ctx context.Context, enabled bool) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsToggleTopPeersRequest
@@ -2677,6 +3120,9 @@ func (s *ServerDispatcher) OnContactsToggleTopPeers(f func(ctx context.Context,
 	s.handlers[ContactsToggleTopPeersRequestTypeID] = handler
 }
 
+// OnContactsAddContact sets handler of contacts.addContact#e8f463d0 method.
+//
+// See https://core.telegram.org/method/contacts.addContact for reference.
 func (s *ServerDispatcher) OnContactsAddContact(f func(ctx context.Context, request *ContactsAddContactRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsAddContactRequest
@@ -2694,6 +3140,9 @@ func (s *ServerDispatcher) OnContactsAddContact(f func(ctx context.Context, requ
 	s.handlers[ContactsAddContactRequestTypeID] = handler
 }
 
+// OnContactsAcceptContact sets handler of contacts.acceptContact#f831a20f method.
+//
+// See https://core.telegram.org/method/contacts.acceptContact for reference.
 func (s *ServerDispatcher) OnContactsAcceptContact(f func(ctx context.Context, id InputUserClass) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsAcceptContactRequest
@@ -2711,6 +3160,9 @@ func (s *ServerDispatcher) OnContactsAcceptContact(f func(ctx context.Context, i
 	s.handlers[ContactsAcceptContactRequestTypeID] = handler
 }
 
+// OnContactsGetLocated sets handler of contacts.getLocated#d348bc44 method.
+//
+// See https://core.telegram.org/method/contacts.getLocated for reference.
 func (s *ServerDispatcher) OnContactsGetLocated(f func(ctx context.Context, request *ContactsGetLocatedRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsGetLocatedRequest
@@ -2728,6 +3180,9 @@ func (s *ServerDispatcher) OnContactsGetLocated(f func(ctx context.Context, requ
 	s.handlers[ContactsGetLocatedRequestTypeID] = handler
 }
 
+// OnContactsBlockFromReplies sets handler of contacts.blockFromReplies#29a8962c method.
+//
+// See https://core.telegram.org/method/contacts.blockFromReplies for reference.
 func (s *ServerDispatcher) OnContactsBlockFromReplies(f func(ctx context.Context, request *ContactsBlockFromRepliesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsBlockFromRepliesRequest
@@ -2745,6 +3200,9 @@ func (s *ServerDispatcher) OnContactsBlockFromReplies(f func(ctx context.Context
 	s.handlers[ContactsBlockFromRepliesRequestTypeID] = handler
 }
 
+// OnContactsResolvePhone sets handler of contacts.resolvePhone#8af94344 method.
+//
+// See https://core.telegram.org/method/contacts.resolvePhone for reference.
 func (s *ServerDispatcher) OnContactsResolvePhone(f func(ctx context.Context, phone string) (*ContactsResolvedPeer, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsResolvePhoneRequest
@@ -2762,6 +3220,9 @@ func (s *ServerDispatcher) OnContactsResolvePhone(f func(ctx context.Context, ph
 	s.handlers[ContactsResolvePhoneRequestTypeID] = handler
 }
 
+// OnContactsExportContactToken sets handler of contacts.exportContactToken#f8654027 method.
+//
+// See https://core.telegram.org/method/contacts.exportContactToken for reference.
 func (s *ServerDispatcher) OnContactsExportContactToken(f func(ctx context.Context) (*ExportedContactToken, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsExportContactTokenRequest
@@ -2779,6 +3240,9 @@ func (s *ServerDispatcher) OnContactsExportContactToken(f func(ctx context.Conte
 	s.handlers[ContactsExportContactTokenRequestTypeID] = handler
 }
 
+// OnContactsImportContactToken sets handler of contacts.importContactToken#13005788 method.
+//
+// See https://core.telegram.org/method/contacts.importContactToken for reference.
 func (s *ServerDispatcher) OnContactsImportContactToken(f func(ctx context.Context, token string) (UserClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsImportContactTokenRequest
@@ -2796,6 +3260,9 @@ func (s *ServerDispatcher) OnContactsImportContactToken(f func(ctx context.Conte
 	s.handlers[ContactsImportContactTokenRequestTypeID] = handler
 }
 
+// OnContactsEditCloseFriends sets handler of contacts.editCloseFriends#ba6705f0 method.
+//
+// See https://core.telegram.org/method/contacts.editCloseFriends for reference.
 func (s *ServerDispatcher) OnContactsEditCloseFriends(f func(ctx context.Context, id []int64) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsEditCloseFriendsRequest
@@ -2817,6 +3284,9 @@ func (s *ServerDispatcher) OnContactsEditCloseFriends(f func(ctx context.Context
 	s.handlers[ContactsEditCloseFriendsRequestTypeID] = handler
 }
 
+// OnContactsSetBlocked sets handler of contacts.setBlocked#94c65c76 method.
+//
+// See https://core.telegram.org/method/contacts.setBlocked for reference.
 func (s *ServerDispatcher) OnContactsSetBlocked(f func(ctx context.Context, request *ContactsSetBlockedRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ContactsSetBlockedRequest
@@ -2838,6 +3308,9 @@ func (s *ServerDispatcher) OnContactsSetBlocked(f func(ctx context.Context, requ
 	s.handlers[ContactsSetBlockedRequestTypeID] = handler
 }
 
+// OnMessagesGetMessages sets handler of messages.getMessages#63c66506 method.
+//
+// See https://core.telegram.org/method/messages.getMessages for reference.
 func (s *ServerDispatcher) OnMessagesGetMessages(f func(ctx context.Context, id []InputMessageClass) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetMessagesRequest
@@ -2855,6 +3328,9 @@ func (s *ServerDispatcher) OnMessagesGetMessages(f func(ctx context.Context, id
 	s.handlers[MessagesGetMessagesRequestTypeID] = handler
 }
 
+// OnMessagesGetDialogs sets handler of messages.getDialogs#a0f4cb4f method.
+//
+// See https://core.telegram.org/method/messages.getDialogs for reference.
 func (s *ServerDispatcher) OnMessagesGetDialogs(f func(ctx context.Context, request *MessagesGetDialogsRequest) (MessagesDialogsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDialogsRequest
@@ -2872,6 +3348,9 @@ func (s *ServerDispatcher) OnMessagesGetDialogs(f func(ctx context.Context, requ
 	s.handlers[MessagesGetDialogsRequestTypeID] = handler
 }
 
+// OnMessagesGetHistory sets handler of messages.getHistory#4423e6c5 method.
+//
+// See https://core.telegram.org/method/messages.getHistory for reference.
 func (s *ServerDispatcher) OnMessagesGetHistory(f func(ctx context.Context, request *MessagesGetHistoryRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetHistoryRequest
@@ -2889,6 +3368,9 @@ func (s *ServerDispatcher) OnMessagesGetHistory(f func(ctx context.Context, requ
 	s.handlers[MessagesGetHistoryRequestTypeID] = handler
 }
 
+// OnMessagesSearch sets handler of messages.search#29ee847a method.
+//
+// See https://core.telegram.org/method/messages.search for reference.
 func (s *ServerDispatcher) OnMessagesSearch(f func(ctx context.Context, request *MessagesSearchRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSearchRequest
@@ -2906,6 +3388,9 @@ func (s *ServerDispatcher) OnMessagesSearch(f func(ctx context.Context, request
 	s.handlers[MessagesSearchRequestTypeID] = handler
 }
 
+// OnMessagesReadHistory sets handler of messages.readHistory#e306d3a method.
+//
+// See https://core.telegram.org/method/messages.readHistory for reference.
 func (s *ServerDispatcher) OnMessagesReadHistory(f func(ctx context.Context, request *MessagesReadHistoryRequest) (*MessagesAffectedMessages, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReadHistoryRequest
@@ -2923,6 +3408,9 @@ func (s *ServerDispatcher) OnMessagesReadHistory(f func(ctx context.Context, req
 	s.handlers[MessagesReadHistoryRequestTypeID] = handler
 }
 
+// OnMessagesDeleteHistory sets handler of messages.deleteHistory#b08f922a method.
+//
+// See https://core.telegram.org/method/messages.deleteHistory for reference.
 func (s *ServerDispatcher) OnMessagesDeleteHistory(f func(ctx context.Context, request *MessagesDeleteHistoryRequest) (*MessagesAffectedHistory, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteHistoryRequest
@@ -2940,6 +3428,9 @@ func (s *ServerDispatcher) OnMessagesDeleteHistory(f func(ctx context.Context, r
 	s.handlers[MessagesDeleteHistoryRequestTypeID] = handler
 }
 
+// OnMessagesDeleteMessages sets handler of messages.deleteMessages#e58e95d2 method.
+//
+// See https://core.telegram.org/method/messages.deleteMessages for reference.
 func (s *ServerDispatcher) OnMessagesDeleteMessages(f func(ctx context.Context, request *MessagesDeleteMessagesRequest) (*MessagesAffectedMessages, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteMessagesRequest
@@ -2957,6 +3448,9 @@ func (s *ServerDispatcher) OnMessagesDeleteMessages(f func(ctx context.Context,
 	s.handlers[MessagesDeleteMessagesRequestTypeID] = handler
 }
 
+// OnMessagesReceivedMessages sets handler of messages.receivedMessages#5a954c0 method.
+//
+// See https://core.telegram.org/method/messages.receivedMessages for reference.
 func (s *ServerDispatcher) OnMessagesReceivedMessages(f func(ctx context.Context, maxid int) ([]ReceivedNotifyMessage, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReceivedMessagesRequest
@@ -2974,6 +3468,9 @@ func (s *ServerDispatcher) OnMessagesReceivedMessages(f func(ctx context.Context
 	s.handlers[MessagesReceivedMessagesRequestTypeID] = handler
 }
 
+// OnMessagesSetTyping sets handler of messages.setTyping#58943ee2 method.
+//
+// See https://core.telegram.org/method/messages.setTyping for reference.
 func (s *ServerDispatcher) OnMessagesSetTyping(f func(ctx context.Context, request *MessagesSetTypingRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetTypingRequest
@@ -2995,6 +3492,9 @@ func (s *ServerDispatcher) OnMessagesSetTyping(f func(ctx context.Context, reque
 	s.handlers[MessagesSetTypingRequestTypeID] = handler
 }
 
+// OnMessagesSendMessage sets handler of messages.sendMessage#dff8042c method.
+//
+// See https://core.telegram.org/method/messages.sendMessage for reference.
 func (s *ServerDispatcher) OnMessagesSendMessage(f func(ctx context.Context, request *MessagesSendMessageRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendMessageRequest
@@ -3012,6 +3512,9 @@ func (s *ServerDispatcher) OnMessagesSendMessage(f func(ctx context.Context, req
 	s.handlers[MessagesSendMessageRequestTypeID] = handler
 }
 
+// OnMessagesSendMedia sets handler of messages.sendMedia#7bd66041 method.
+//
+// See https://core.telegram.org/method/messages.sendMedia for reference.
 func (s *ServerDispatcher) OnMessagesSendMedia(f func(ctx context.Context, request *MessagesSendMediaRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendMediaRequest
@@ -3029,6 +3532,9 @@ func (s *ServerDispatcher) OnMessagesSendMedia(f func(ctx context.Context, reque
 	s.handlers[MessagesSendMediaRequestTypeID] = handler
 }
 
+// OnMessagesForwardMessages sets handler of messages.forwardMessages#d5039208 method.
+//
+// See https://core.telegram.org/method/messages.forwardMessages for reference.
 func (s *ServerDispatcher) OnMessagesForwardMessages(f func(ctx context.Context, request *MessagesForwardMessagesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesForwardMessagesRequest
@@ -3046,6 +3552,9 @@ func (s *ServerDispatcher) OnMessagesForwardMessages(f func(ctx context.Context,
 	s.handlers[MessagesForwardMessagesRequestTypeID] = handler
 }
 
+// OnMessagesReportSpam sets handler of messages.reportSpam#cf1592db method.
+//
+// See https://core.telegram.org/method/messages.reportSpam for reference.
 func (s *ServerDispatcher) OnMessagesReportSpam(f func(ctx context.Context, peer InputPeerClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReportSpamRequest
@@ -3067,6 +3576,9 @@ func (s *ServerDispatcher) OnMessagesReportSpam(f func(ctx context.Context, peer
 	s.handlers[MessagesReportSpamRequestTypeID] = handler
 }
 
+// OnMessagesGetPeerSettings sets handler of messages.getPeerSettings#efd9a6a2 method.
+//
+// See https://core.telegram.org/method/messages.getPeerSettings for reference.
 func (s *ServerDispatcher) OnMessagesGetPeerSettings(f func(ctx context.Context, peer InputPeerClass) (*MessagesPeerSettings, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetPeerSettingsRequest
@@ -3084,6 +3596,9 @@ func (s *ServerDispatcher) OnMessagesGetPeerSettings(f func(ctx context.Context,
 	s.handlers[MessagesGetPeerSettingsRequestTypeID] = handler
 }
 
+// OnMessagesReport sets handler of messages.report#8953ab4e method.
+//
+// See https://core.telegram.org/method/messages.report for reference.
 func (s *ServerDispatcher) OnMessagesReport(f func(ctx context.Context, request *MessagesReportRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReportRequest
@@ -3105,6 +3620,9 @@ func (s *ServerDispatcher) OnMessagesReport(f func(ctx context.Context, request
 	s.handlers[MessagesReportRequestTypeID] = handler
 }
 
+// OnMessagesGetChats sets handler of messages.getChats#49e9528f method.
+//
+// See https://core.telegram.org/method/messages.getChats for reference.
 func (s *ServerDispatcher) OnMessagesGetChats(f func(ctx context.Context, id []int64) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetChatsRequest
@@ -3122,6 +3640,9 @@ func (s *ServerDispatcher) OnMessagesGetChats(f func(ctx context.Context, id []i
 	s.handlers[MessagesGetChatsRequestTypeID] = handler
 }
 
+// OnMessagesGetFullChat sets handler of messages.getFullChat#aeb00b34 method.
+//
+// See https://core.telegram.org/method/messages.getFullChat for reference.
 func (s *ServerDispatcher) OnMessagesGetFullChat(f func(ctx context.Context, chatid int64) (*MessagesChatFull, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetFullChatRequest
@@ -3139,6 +3660,9 @@ func (s *ServerDispatcher) OnMessagesGetFullChat(f func(ctx context.Context, cha
 	s.handlers[MessagesGetFullChatRequestTypeID] = handler
 }
 
+// OnMessagesEditChatTitle sets handler of messages.editChatTitle#73783ffd method.
+//
+// See https://core.telegram.org/method/messages.editChatTitle for reference.
 func (s *ServerDispatcher) OnMessagesEditChatTitle(f func(ctx context.Context, request *MessagesEditChatTitleRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditChatTitleRequest
@@ -3156,6 +3680,9 @@ func (s *ServerDispatcher) OnMessagesEditChatTitle(f func(ctx context.Context, r
 	s.handlers[MessagesEditChatTitleRequestTypeID] = handler
 }
 
+// OnMessagesEditChatPhoto sets handler of messages.editChatPhoto#35ddd674 method.
+//
+// See https://core.telegram.org/method/messages.editChatPhoto for reference.
 func (s *ServerDispatcher) OnMessagesEditChatPhoto(f func(ctx context.Context, request *MessagesEditChatPhotoRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditChatPhotoRequest
@@ -3173,6 +3700,9 @@ func (s *ServerDispatcher) OnMessagesEditChatPhoto(f func(ctx context.Context, r
 	s.handlers[MessagesEditChatPhotoRequestTypeID] = handler
 }
 
+// OnMessagesAddChatUser sets handler of messages.addChatUser#f24753e3 method.
+//
+// See https://core.telegram.org/method/messages.addChatUser for reference.
 func (s *ServerDispatcher) OnMessagesAddChatUser(f func(ctx context.Context, request *MessagesAddChatUserRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesAddChatUserRequest
@@ -3190,6 +3720,9 @@ func (s *ServerDispatcher) OnMessagesAddChatUser(f func(ctx context.Context, req
 	s.handlers[MessagesAddChatUserRequestTypeID] = handler
 }
 
+// OnMessagesDeleteChatUser sets handler of messages.deleteChatUser#a2185cab method.
+//
+// See https://core.telegram.org/method/messages.deleteChatUser for reference.
 func (s *ServerDispatcher) OnMessagesDeleteChatUser(f func(ctx context.Context, request *MessagesDeleteChatUserRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteChatUserRequest
@@ -3207,6 +3740,9 @@ func (s *ServerDispatcher) OnMessagesDeleteChatUser(f func(ctx context.Context,
 	s.handlers[MessagesDeleteChatUserRequestTypeID] = handler
 }
 
+// OnMessagesCreateChat sets handler of messages.createChat#34a818 method.
+//
+// See https://core.telegram.org/method/messages.createChat for reference.
 func (s *ServerDispatcher) OnMessagesCreateChat(f func(ctx context.Context, request *MessagesCreateChatRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesCreateChatRequest
@@ -3224,6 +3760,9 @@ func (s *ServerDispatcher) OnMessagesCreateChat(f func(ctx context.Context, requ
 	s.handlers[MessagesCreateChatRequestTypeID] = handler
 }
 
+// OnMessagesGetDhConfig sets handler of messages.getDhConfig#26cf8950 method.
+//
+// See https://core.telegram.org/method/messages.getDhConfig for reference.
 func (s *ServerDispatcher) OnMessagesGetDhConfig(f func(ctx context.Context, request *MessagesGetDhConfigRequest) (MessagesDhConfigClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDhConfigRequest
@@ -3241,6 +3780,9 @@ func (s *ServerDispatcher) OnMessagesGetDhConfig(f func(ctx context.Context, req
 	s.handlers[MessagesGetDhConfigRequestTypeID] = handler
 }
 
+// OnMessagesRequestEncryption sets handler of messages.requestEncryption#f64daf43 method.
+//
+// See https://core.telegram.org/method/messages.requestEncryption for reference.
 func (s *ServerDispatcher) OnMessagesRequestEncryption(f func(ctx context.Context, request *MessagesRequestEncryptionRequest) (EncryptedChatClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesRequestEncryptionRequest
@@ -3258,6 +3800,9 @@ func (s *ServerDispatcher) OnMessagesRequestEncryption(f func(ctx context.Contex
 	s.handlers[MessagesRequestEncryptionRequestTypeID] = handler
 }
 
+// OnMessagesAcceptEncryption sets handler of messages.acceptEncryption#3dbc0415 method.
+//
+// See https://core.telegram.org/method/messages.acceptEncryption for reference.
 func (s *ServerDispatcher) OnMessagesAcceptEncryption(f func(ctx context.Context, request *MessagesAcceptEncryptionRequest) (EncryptedChatClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesAcceptEncryptionRequest
@@ -3275,6 +3820,9 @@ func (s *ServerDispatcher) OnMessagesAcceptEncryption(f func(ctx context.Context
 	s.handlers[MessagesAcceptEncryptionRequestTypeID] = handler
 }
 
+// OnMessagesDiscardEncryption sets handler of messages.discardEncryption#f393aea0 method.
+//
+// See https://core.telegram.org/method/messages.discardEncryption for reference.
 func (s *ServerDispatcher) OnMessagesDiscardEncryption(f func(ctx context.Context, request *MessagesDiscardEncryptionRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDiscardEncryptionRequest
@@ -3296,6 +3844,9 @@ func (s *ServerDispatcher) OnMessagesDiscardEncryption(f func(ctx context.Contex
 	s.handlers[MessagesDiscardEncryptionRequestTypeID] = handler
 }
 
+// OnMessagesSetEncryptedTyping sets handler of messages.setEncryptedTyping#791451ed method.
+//
+// See https://core.telegram.org/method/messages.setEncryptedTyping for reference.
 func (s *ServerDispatcher) OnMessagesSetEncryptedTyping(f func(ctx context.Context, request *MessagesSetEncryptedTypingRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetEncryptedTypingRequest
@@ -3317,6 +3868,9 @@ func (s *ServerDispatcher) OnMessagesSetEncryptedTyping(f func(ctx context.Conte
 	s.handlers[MessagesSetEncryptedTypingRequestTypeID] = handler
 }
 
+// OnMessagesReadEncryptedHistory sets handler of messages.readEncryptedHistory#7f4b690a method.
+//
+// See https://core.telegram.org/method/messages.readEncryptedHistory for reference.
 func (s *ServerDispatcher) OnMessagesReadEncryptedHistory(f func(ctx context.Context, request *MessagesReadEncryptedHistoryRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReadEncryptedHistoryRequest
@@ -3338,6 +3892,9 @@ func (s *ServerDispatcher) OnMessagesReadEncryptedHistory(f func(ctx context.Con
 	s.handlers[MessagesReadEncryptedHistoryRequestTypeID] = handler
 }
 
+// OnMessagesSendEncrypted sets handler of messages.sendEncrypted#44fa7a15 method.
+//
+// See https://core.telegram.org/method/messages.sendEncrypted for reference.
 func (s *ServerDispatcher) OnMessagesSendEncrypted(f func(ctx context.Context, request *MessagesSendEncryptedRequest) (MessagesSentEncryptedMessageClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendEncryptedRequest
@@ -3355,6 +3912,9 @@ func (s *ServerDispatcher) OnMessagesSendEncrypted(f func(ctx context.Context, r
 	s.handlers[MessagesSendEncryptedRequestTypeID] = handler
 }
 
+// OnMessagesSendEncryptedFile sets handler of messages.sendEncryptedFile#5559481d method.
+//
+// See https://core.telegram.org/method/messages.sendEncryptedFile for reference.
 func (s *ServerDispatcher) OnMessagesSendEncryptedFile(f func(ctx context.Context, request *MessagesSendEncryptedFileRequest) (MessagesSentEncryptedMessageClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendEncryptedFileRequest
@@ -3372,6 +3932,9 @@ func (s *ServerDispatcher) OnMessagesSendEncryptedFile(f func(ctx context.Contex
 	s.handlers[MessagesSendEncryptedFileRequestTypeID] = handler
 }
 
+// OnMessagesSendEncryptedService sets handler of messages.sendEncryptedService#32d439a4 method.
+//
+// See https://core.telegram.org/method/messages.sendEncryptedService for reference.
 func (s *ServerDispatcher) OnMessagesSendEncryptedService(f func(ctx context.Context, request *MessagesSendEncryptedServiceRequest) (MessagesSentEncryptedMessageClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendEncryptedServiceRequest
@@ -3389,6 +3952,9 @@ func (s *ServerDispatcher) OnMessagesSendEncryptedService(f func(ctx context.Con
 	s.handlers[MessagesSendEncryptedServiceRequestTypeID] = handler
 }
 
+// OnMessagesReceivedQueue sets handler of messages.receivedQueue#55a5bb66 method.
+//
+// See https://core.telegram.org/method/messages.receivedQueue for reference.
 func (s *ServerDispatcher) OnMessagesReceivedQueue(f func(ctx context.Context, maxqts int) ([]int64, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReceivedQueueRequest
@@ -3406,6 +3972,9 @@ func (s *ServerDispatcher) OnMessagesReceivedQueue(f func(ctx context.Context, m
 	s.handlers[MessagesReceivedQueueRequestTypeID] = handler
 }
 
+// OnMessagesReportEncryptedSpam sets handler of messages.reportEncryptedSpam#4b0c8c0f method.
+//
+// See https://core.telegram.org/method/messages.reportEncryptedSpam for reference.
 func (s *ServerDispatcher) OnMessagesReportEncryptedSpam(f func(ctx context.Context, peer InputEncryptedChat) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReportEncryptedSpamRequest
@@ -3427,6 +3996,9 @@ func (s *ServerDispatcher) OnMessagesReportEncryptedSpam(f func(ctx context.Cont
 	s.handlers[MessagesReportEncryptedSpamRequestTypeID] = handler
 }
 
+// OnMessagesReadMessageContents sets handler of messages.readMessageContents#36a73f77 method.
+//
+// See https://core.telegram.org/method/messages.readMessageContents for reference.
 func (s *ServerDispatcher) OnMessagesReadMessageContents(f func(ctx context.Context, id []int) (*MessagesAffectedMessages, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReadMessageContentsRequest
@@ -3444,6 +4016,9 @@ func (s *ServerDispatcher) OnMessagesReadMessageContents(f func(ctx context.Cont
 	s.handlers[MessagesReadMessageContentsRequestTypeID] = handler
 }
 
+// OnMessagesGetStickers sets handler of messages.getStickers#d5a5d3a1 method.
+//
+// See https://core.telegram.org/method/messages.getStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetStickers(f func(ctx context.Context, request *MessagesGetStickersRequest) (MessagesStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetStickersRequest
@@ -3461,6 +4036,9 @@ func (s *ServerDispatcher) OnMessagesGetStickers(f func(ctx context.Context, req
 	s.handlers[MessagesGetStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetAllStickers sets handler of messages.getAllStickers#b8a0a1a8 method.
+//
+// See https://core.telegram.org/method/messages.getAllStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetAllStickers(f func(ctx context.Context, hash int64) (MessagesAllStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetAllStickersRequest
@@ -3478,6 +4056,9 @@ func (s *ServerDispatcher) OnMessagesGetAllStickers(f func(ctx context.Context,
 	s.handlers[MessagesGetAllStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetWebPagePreview sets handler of messages.getWebPagePreview#8b68b0cc method.
+//
+// See https://core.telegram.org/method/messages.getWebPagePreview for reference.
 func (s *ServerDispatcher) OnMessagesGetWebPagePreview(f func(ctx context.Context, request *MessagesGetWebPagePreviewRequest) (MessageMediaClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetWebPagePreviewRequest
@@ -3495,6 +4076,9 @@ func (s *ServerDispatcher) OnMessagesGetWebPagePreview(f func(ctx context.Contex
 	s.handlers[MessagesGetWebPagePreviewRequestTypeID] = handler
 }
 
+// OnMessagesExportChatInvite sets handler of messages.exportChatInvite#a02ce5d5 method.
+//
+// See https://core.telegram.org/method/messages.exportChatInvite for reference.
 func (s *ServerDispatcher) OnMessagesExportChatInvite(f func(ctx context.Context, request *MessagesExportChatInviteRequest) (ExportedChatInviteClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesExportChatInviteRequest
@@ -3512,6 +4096,9 @@ func (s *ServerDispatcher) OnMessagesExportChatInvite(f func(ctx context.Context
 	s.handlers[MessagesExportChatInviteRequestTypeID] = handler
 }
 
+// OnMessagesCheckChatInvite sets handler of messages.checkChatInvite#3eadb1bb method.
+//
+// See https://core.telegram.org/method/messages.checkChatInvite for reference.
 func (s *ServerDispatcher) OnMessagesCheckChatInvite(f func(ctx context.Context, hash string) (ChatInviteClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesCheckChatInviteRequest
@@ -3529,6 +4116,9 @@ func (s *ServerDispatcher) OnMessagesCheckChatInvite(f func(ctx context.Context,
 	s.handlers[MessagesCheckChatInviteRequestTypeID] = handler
 }
 
+// OnMessagesImportChatInvite sets handler of messages.importChatInvite#6c50051c method.
+//
+// See https://core.telegram.org/method/messages.importChatInvite for reference.
 func (s *ServerDispatcher) OnMessagesImportChatInvite(f func(ctx context.Context, hash string) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesImportChatInviteRequest
@@ -3546,6 +4136,9 @@ func (s *ServerDispatcher) OnMessagesImportChatInvite(f func(ctx context.Context
 	s.handlers[MessagesImportChatInviteRequestTypeID] = handler
 }
 
+// OnMessagesGetStickerSet sets handler of messages.getStickerSet#c8a0ec74 method.
+//
+// See https://core.telegram.org/method/messages.getStickerSet for reference.
 func (s *ServerDispatcher) OnMessagesGetStickerSet(f func(ctx context.Context, request *MessagesGetStickerSetRequest) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetStickerSetRequest
@@ -3563,6 +4156,9 @@ func (s *ServerDispatcher) OnMessagesGetStickerSet(f func(ctx context.Context, r
 	s.handlers[MessagesGetStickerSetRequestTypeID] = handler
 }
 
+// OnMessagesInstallStickerSet sets handler of messages.installStickerSet#c78fe460 method.
+//
+// See https://core.telegram.org/method/messages.installStickerSet for reference.
 func (s *ServerDispatcher) OnMessagesInstallStickerSet(f func(ctx context.Context, request *MessagesInstallStickerSetRequest) (MessagesStickerSetInstallResultClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesInstallStickerSetRequest
@@ -3580,6 +4176,9 @@ func (s *ServerDispatcher) OnMessagesInstallStickerSet(f func(ctx context.Contex
 	s.handlers[MessagesInstallStickerSetRequestTypeID] = handler
 }
 
+// OnMessagesUninstallStickerSet sets handler of messages.uninstallStickerSet#f96e55de method.
+//
+// See https://core.telegram.org/method/messages.uninstallStickerSet for reference.
 func (s *ServerDispatcher) OnMessagesUninstallStickerSet(f func(ctx context.Context, stickerset InputStickerSetClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUninstallStickerSetRequest
@@ -3601,6 +4200,9 @@ func (s *ServerDispatcher) OnMessagesUninstallStickerSet(f func(ctx context.Cont
 	s.handlers[MessagesUninstallStickerSetRequestTypeID] = handler
 }
 
+// OnMessagesStartBot sets handler of messages.startBot#e6df7378 method.
+//
+// See https://core.telegram.org/method/messages.startBot for reference.
 func (s *ServerDispatcher) OnMessagesStartBot(f func(ctx context.Context, request *MessagesStartBotRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesStartBotRequest
@@ -3618,6 +4220,9 @@ func (s *ServerDispatcher) OnMessagesStartBot(f func(ctx context.Context, reques
 	s.handlers[MessagesStartBotRequestTypeID] = handler
 }
 
+// OnMessagesGetMessagesViews sets handler of messages.getMessagesViews#5784d3e1 method.
+//
+// See https://core.telegram.org/method/messages.getMessagesViews for reference.
 func (s *ServerDispatcher) OnMessagesGetMessagesViews(f func(ctx context.Context, request *MessagesGetMessagesViewsRequest) (*MessagesMessageViews, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetMessagesViewsRequest
@@ -3635,6 +4240,9 @@ func (s *ServerDispatcher) OnMessagesGetMessagesViews(f func(ctx context.Context
 	s.handlers[MessagesGetMessagesViewsRequestTypeID] = handler
 }
 
+// OnMessagesEditChatAdmin sets handler of messages.editChatAdmin#a85bd1c2 method.
+//
+// See https://core.telegram.org/method/messages.editChatAdmin for reference.
 func (s *ServerDispatcher) OnMessagesEditChatAdmin(f func(ctx context.Context, request *MessagesEditChatAdminRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditChatAdminRequest
@@ -3656,6 +4264,9 @@ func (s *ServerDispatcher) OnMessagesEditChatAdmin(f func(ctx context.Context, r
 	s.handlers[MessagesEditChatAdminRequestTypeID] = handler
 }
 
+// OnMessagesMigrateChat sets handler of messages.migrateChat#a2875319 method.
+//
+// See https://core.telegram.org/method/messages.migrateChat for reference.
 func (s *ServerDispatcher) OnMessagesMigrateChat(f func(ctx context.Context, chatid int64) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesMigrateChatRequest
@@ -3673,6 +4284,9 @@ func (s *ServerDispatcher) OnMessagesMigrateChat(f func(ctx context.Context, cha
 	s.handlers[MessagesMigrateChatRequestTypeID] = handler
 }
 
+// OnMessagesSearchGlobal sets handler of messages.searchGlobal#4bc6589a method.
+//
+// See https://core.telegram.org/method/messages.searchGlobal for reference.
 func (s *ServerDispatcher) OnMessagesSearchGlobal(f func(ctx context.Context, request *MessagesSearchGlobalRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSearchGlobalRequest
@@ -3690,6 +4304,9 @@ func (s *ServerDispatcher) OnMessagesSearchGlobal(f func(ctx context.Context, re
 	s.handlers[MessagesSearchGlobalRequestTypeID] = handler
 }
 
+// OnMessagesReorderStickerSets sets handler of messages.reorderStickerSets#78337739 method.
+//
+// See https://core.telegram.org/method/messages.reorderStickerSets for reference.
 func (s *ServerDispatcher) OnMessagesReorderStickerSets(f func(ctx context.Context, request *MessagesReorderStickerSetsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReorderStickerSetsRequest
@@ -3711,6 +4328,9 @@ func (s *ServerDispatcher) OnMessagesReorderStickerSets(f func(ctx context.Conte
 	s.handlers[MessagesReorderStickerSetsRequestTypeID] = handler
 }
 
+// OnMessagesGetDocumentByHash sets handler of messages.getDocumentByHash#b1f2061f method.
+//
+// See https://core.telegram.org/method/messages.getDocumentByHash for reference.
 func (s *ServerDispatcher) OnMessagesGetDocumentByHash(f func(ctx context.Context, request *MessagesGetDocumentByHashRequest) (DocumentClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDocumentByHashRequest
@@ -3728,6 +4348,9 @@ func (s *ServerDispatcher) OnMessagesGetDocumentByHash(f func(ctx context.Contex
 	s.handlers[MessagesGetDocumentByHashRequestTypeID] = handler
 }
 
+// OnMessagesGetSavedGifs sets handler of messages.getSavedGifs#5cf09635 method.
+//
+// See https://core.telegram.org/method/messages.getSavedGifs for reference.
 func (s *ServerDispatcher) OnMessagesGetSavedGifs(f func(ctx context.Context, hash int64) (MessagesSavedGifsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSavedGifsRequest
@@ -3745,6 +4368,9 @@ func (s *ServerDispatcher) OnMessagesGetSavedGifs(f func(ctx context.Context, ha
 	s.handlers[MessagesGetSavedGifsRequestTypeID] = handler
 }
 
+// OnMessagesSaveGif sets handler of messages.saveGif#327a30cb method.
+//
+// See https://core.telegram.org/method/messages.saveGif for reference.
 func (s *ServerDispatcher) OnMessagesSaveGif(f func(ctx context.Context, request *MessagesSaveGifRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSaveGifRequest
@@ -3766,6 +4392,9 @@ func (s *ServerDispatcher) OnMessagesSaveGif(f func(ctx context.Context, request
 	s.handlers[MessagesSaveGifRequestTypeID] = handler
 }
 
+// OnMessagesGetInlineBotResults sets handler of messages.getInlineBotResults#514e999d method.
+//
+// See https://core.telegram.org/method/messages.getInlineBotResults for reference.
 func (s *ServerDispatcher) OnMessagesGetInlineBotResults(f func(ctx context.Context, request *MessagesGetInlineBotResultsRequest) (*MessagesBotResults, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetInlineBotResultsRequest
@@ -3783,6 +4412,9 @@ func (s *ServerDispatcher) OnMessagesGetInlineBotResults(f func(ctx context.Cont
 	s.handlers[MessagesGetInlineBotResultsRequestTypeID] = handler
 }
 
+// OnMessagesSetInlineBotResults sets handler of messages.setInlineBotResults#bb12a419 method.
+//
+// See https://core.telegram.org/method/messages.setInlineBotResults for reference.
 func (s *ServerDispatcher) OnMessagesSetInlineBotResults(f func(ctx context.Context, request *MessagesSetInlineBotResultsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetInlineBotResultsRequest
@@ -3804,6 +4436,9 @@ func (s *ServerDispatcher) OnMessagesSetInlineBotResults(f func(ctx context.Cont
 	s.handlers[MessagesSetInlineBotResultsRequestTypeID] = handler
 }
 
+// OnMessagesSendInlineBotResult sets handler of messages.sendInlineBotResult#3ebee86a method.
+//
+// See https://core.telegram.org/method/messages.sendInlineBotResult for reference.
 func (s *ServerDispatcher) OnMessagesSendInlineBotResult(f func(ctx context.Context, request *MessagesSendInlineBotResultRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendInlineBotResultRequest
@@ -3821,6 +4456,9 @@ func (s *ServerDispatcher) OnMessagesSendInlineBotResult(f func(ctx context.Cont
 	s.handlers[MessagesSendInlineBotResultRequestTypeID] = handler
 }
 
+// OnMessagesGetMessageEditData sets handler of messages.getMessageEditData#fda68d36 method.
+//
+// See https://core.telegram.org/method/messages.getMessageEditData for reference.
 func (s *ServerDispatcher) OnMessagesGetMessageEditData(f func(ctx context.Context, request *MessagesGetMessageEditDataRequest) (*MessagesMessageEditData, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetMessageEditDataRequest
@@ -3838,6 +4476,9 @@ func (s *ServerDispatcher) OnMessagesGetMessageEditData(f func(ctx context.Conte
 	s.handlers[MessagesGetMessageEditDataRequestTypeID] = handler
 }
 
+// OnMessagesEditMessage sets handler of messages.editMessage#dfd14005 method.
+//
+// See https://core.telegram.org/method/messages.editMessage for reference.
 func (s *ServerDispatcher) OnMessagesEditMessage(f func(ctx context.Context, request *MessagesEditMessageRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditMessageRequest
@@ -3855,6 +4496,9 @@ func (s *ServerDispatcher) OnMessagesEditMessage(f func(ctx context.Context, req
 	s.handlers[MessagesEditMessageRequestTypeID] = handler
 }
 
+// OnMessagesEditInlineBotMessage sets handler of messages.editInlineBotMessage#83557dba method.
+//
+// See https://core.telegram.org/method/messages.editInlineBotMessage for reference.
 func (s *ServerDispatcher) OnMessagesEditInlineBotMessage(f func(ctx context.Context, request *MessagesEditInlineBotMessageRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditInlineBotMessageRequest
@@ -3876,6 +4520,9 @@ func (s *ServerDispatcher) OnMessagesEditInlineBotMessage(f func(ctx context.Con
 	s.handlers[MessagesEditInlineBotMessageRequestTypeID] = handler
 }
 
+// OnMessagesGetBotCallbackAnswer sets handler of messages.getBotCallbackAnswer#9342ca07 method.
+//
+// See https://core.telegram.org/method/messages.getBotCallbackAnswer for reference.
 func (s *ServerDispatcher) OnMessagesGetBotCallbackAnswer(f func(ctx context.Context, request *MessagesGetBotCallbackAnswerRequest) (*MessagesBotCallbackAnswer, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetBotCallbackAnswerRequest
@@ -3893,6 +4540,9 @@ func (s *ServerDispatcher) OnMessagesGetBotCallbackAnswer(f func(ctx context.Con
 	s.handlers[MessagesGetBotCallbackAnswerRequestTypeID] = handler
 }
 
+// OnMessagesSetBotCallbackAnswer sets handler of messages.setBotCallbackAnswer#d58f130a method.
+//
+// See https://core.telegram.org/method/messages.setBotCallbackAnswer for reference.
 func (s *ServerDispatcher) OnMessagesSetBotCallbackAnswer(f func(ctx context.Context, request *MessagesSetBotCallbackAnswerRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetBotCallbackAnswerRequest
@@ -3914,6 +4564,9 @@ func (s *ServerDispatcher) OnMessagesSetBotCallbackAnswer(f func(ctx context.Con
 	s.handlers[MessagesSetBotCallbackAnswerRequestTypeID] = handler
 }
 
+// OnMessagesGetPeerDialogs sets handler of messages.getPeerDialogs#e470bcfd method.
+//
+// See https://core.telegram.org/method/messages.getPeerDialogs for reference.
 func (s *ServerDispatcher) OnMessagesGetPeerDialogs(f func(ctx context.Context, peers []InputDialogPeerClass) (*MessagesPeerDialogs, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetPeerDialogsRequest
@@ -3931,6 +4584,9 @@ func (s *ServerDispatcher) OnMessagesGetPeerDialogs(f func(ctx context.Context,
 	s.handlers[MessagesGetPeerDialogsRequestTypeID] = handler
 }
 
+// OnMessagesSaveDraft sets handler of messages.saveDraft#7ff3b806 method.
+//
+// See https://core.telegram.org/method/messages.saveDraft for reference.
 func (s *ServerDispatcher) OnMessagesSaveDraft(f func(ctx context.Context, request *MessagesSaveDraftRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSaveDraftRequest
@@ -3952,6 +4608,9 @@ func (s *ServerDispatcher) OnMessagesSaveDraft(f func(ctx context.Context, reque
 	s.handlers[MessagesSaveDraftRequestTypeID] = handler
 }
 
+// OnMessagesGetAllDrafts sets handler of messages.getAllDrafts#6a3f8d65 method.
+//
+// See https://core.telegram.org/method/messages.getAllDrafts for reference.
 func (s *ServerDispatcher) OnMessagesGetAllDrafts(f func(ctx context.Context) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetAllDraftsRequest
@@ -3969,6 +4628,9 @@ func (s *ServerDispatcher) OnMessagesGetAllDrafts(f func(ctx context.Context) (U
 	s.handlers[MessagesGetAllDraftsRequestTypeID] = handler
 }
 
+// OnMessagesGetFeaturedStickers sets handler of messages.getFeaturedStickers#64780b14 method.
+//
+// See https://core.telegram.org/method/messages.getFeaturedStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetFeaturedStickers(f func(ctx context.Context, hash int64) (MessagesFeaturedStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetFeaturedStickersRequest
@@ -3986,6 +4648,9 @@ func (s *ServerDispatcher) OnMessagesGetFeaturedStickers(f func(ctx context.Cont
 	s.handlers[MessagesGetFeaturedStickersRequestTypeID] = handler
 }
 
+// OnMessagesReadFeaturedStickers sets handler of messages.readFeaturedStickers#5b118126 method.
+//
+// See https://core.telegram.org/method/messages.readFeaturedStickers for reference.
 func (s *ServerDispatcher) OnMessagesReadFeaturedStickers(f func(ctx context.Context, id []int64) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReadFeaturedStickersRequest
@@ -4007,6 +4672,9 @@ func (s *ServerDispatcher) OnMessagesReadFeaturedStickers(f func(ctx context.Con
 	s.handlers[MessagesReadFeaturedStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetRecentStickers sets handler of messages.getRecentStickers#9da9403b method.
+//
+// See https://core.telegram.org/method/messages.getRecentStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetRecentStickers(f func(ctx context.Context, request *MessagesGetRecentStickersRequest) (MessagesRecentStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetRecentStickersRequest
@@ -4024,6 +4692,9 @@ func (s *ServerDispatcher) OnMessagesGetRecentStickers(f func(ctx context.Contex
 	s.handlers[MessagesGetRecentStickersRequestTypeID] = handler
 }
 
+// OnMessagesSaveRecentSticker sets handler of messages.saveRecentSticker#392718f8 method.
+//
+// See https://core.telegram.org/method/messages.saveRecentSticker for reference.
 func (s *ServerDispatcher) OnMessagesSaveRecentSticker(f func(ctx context.Context, request *MessagesSaveRecentStickerRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSaveRecentStickerRequest
@@ -4045,6 +4716,9 @@ func (s *ServerDispatcher) OnMessagesSaveRecentSticker(f func(ctx context.Contex
 	s.handlers[MessagesSaveRecentStickerRequestTypeID] = handler
 }
 
+// OnMessagesClearRecentStickers sets handler of messages.clearRecentStickers#8999602d method.
+//
+// See https://core.telegram.org/method/messages.clearRecentStickers for reference.
 func (s *ServerDispatcher) OnMessagesClearRecentStickers(f func(ctx context.Context, request *MessagesClearRecentStickersRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesClearRecentStickersRequest
@@ -4066,6 +4740,9 @@ func (s *ServerDispatcher) OnMessagesClearRecentStickers(f func(ctx context.Cont
 	s.handlers[MessagesClearRecentStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetArchivedStickers sets handler of messages.getArchivedStickers#57f17692 method.
+//
+// See https://core.telegram.org/method/messages.getArchivedStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetArchivedStickers(f func(ctx context.Context, request *MessagesGetArchivedStickersRequest) (*MessagesArchivedStickers, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetArchivedStickersRequest
@@ -4083,6 +4760,9 @@ func (s *ServerDispatcher) OnMessagesGetArchivedStickers(f func(ctx context.Cont
 	s.handlers[MessagesGetArchivedStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetMaskStickers sets handler of messages.getMaskStickers#640f82b8 method.
+//
+// See https://core.telegram.org/method/messages.getMaskStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetMaskStickers(f func(ctx context.Context, hash int64) (MessagesAllStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetMaskStickersRequest
@@ -4100,6 +4780,9 @@ func (s *ServerDispatcher) OnMessagesGetMaskStickers(f func(ctx context.Context,
 	s.handlers[MessagesGetMaskStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetAttachedStickers sets handler of messages.getAttachedStickers#cc5b67cc method.
+//
+// See https://core.telegram.org/method/messages.getAttachedStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetAttachedStickers(f func(ctx context.Context, media InputStickeredMediaClass) ([]StickerSetCoveredClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetAttachedStickersRequest
@@ -4117,6 +4800,9 @@ func (s *ServerDispatcher) OnMessagesGetAttachedStickers(f func(ctx context.Cont
 	s.handlers[MessagesGetAttachedStickersRequestTypeID] = handler
 }
 
+// OnMessagesSetGameScore sets handler of messages.setGameScore#8ef8ecc0 method.
+//
+// See https://core.telegram.org/method/messages.setGameScore for reference.
 func (s *ServerDispatcher) OnMessagesSetGameScore(f func(ctx context.Context, request *MessagesSetGameScoreRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetGameScoreRequest
@@ -4134,6 +4820,9 @@ func (s *ServerDispatcher) OnMessagesSetGameScore(f func(ctx context.Context, re
 	s.handlers[MessagesSetGameScoreRequestTypeID] = handler
 }
 
+// OnMessagesSetInlineGameScore sets handler of messages.setInlineGameScore#15ad9f64 method.
+//
+// See https://core.telegram.org/method/messages.setInlineGameScore for reference.
 func (s *ServerDispatcher) OnMessagesSetInlineGameScore(f func(ctx context.Context, request *MessagesSetInlineGameScoreRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetInlineGameScoreRequest
@@ -4155,6 +4844,9 @@ func (s *ServerDispatcher) OnMessagesSetInlineGameScore(f func(ctx context.Conte
 	s.handlers[MessagesSetInlineGameScoreRequestTypeID] = handler
 }
 
+// OnMessagesGetGameHighScores sets handler of messages.getGameHighScores#e822649d method.
+//
+// See https://core.telegram.org/method/messages.getGameHighScores for reference.
 func (s *ServerDispatcher) OnMessagesGetGameHighScores(f func(ctx context.Context, request *MessagesGetGameHighScoresRequest) (*MessagesHighScores, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetGameHighScoresRequest
@@ -4172,6 +4864,9 @@ func (s *ServerDispatcher) OnMessagesGetGameHighScores(f func(ctx context.Contex
 	s.handlers[MessagesGetGameHighScoresRequestTypeID] = handler
 }
 
+// OnMessagesGetInlineGameHighScores sets handler of messages.getInlineGameHighScores#f635e1b method.
+//
+// See https://core.telegram.org/method/messages.getInlineGameHighScores for reference.
 func (s *ServerDispatcher) OnMessagesGetInlineGameHighScores(f func(ctx context.Context, request *MessagesGetInlineGameHighScoresRequest) (*MessagesHighScores, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetInlineGameHighScoresRequest
@@ -4189,6 +4884,9 @@ func (s *ServerDispatcher) OnMessagesGetInlineGameHighScores(f func(ctx context.
 	s.handlers[MessagesGetInlineGameHighScoresRequestTypeID] = handler
 }
 
+// OnMessagesGetCommonChats sets handler of messages.getCommonChats#e40ca104 method.
+//
+// See https://core.telegram.org/method/messages.getCommonChats for reference.
 func (s *ServerDispatcher) OnMessagesGetCommonChats(f func(ctx context.Context, request *MessagesGetCommonChatsRequest) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetCommonChatsRequest
@@ -4206,6 +4904,9 @@ func (s *ServerDispatcher) OnMessagesGetCommonChats(f func(ctx context.Context,
 	s.handlers[MessagesGetCommonChatsRequestTypeID] = handler
 }
 
+// OnMessagesGetWebPage sets handler of messages.getWebPage#8d9692a3 method.
+//
+// See https://core.telegram.org/method/messages.getWebPage for reference.
 func (s *ServerDispatcher) OnMessagesGetWebPage(f func(ctx context.Context, request *MessagesGetWebPageRequest) (*MessagesWebPage, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetWebPageRequest
@@ -4223,6 +4924,9 @@ func (s *ServerDispatcher) OnMessagesGetWebPage(f func(ctx context.Context, requ
 	s.handlers[MessagesGetWebPageRequestTypeID] = handler
 }
 
+// OnMessagesToggleDialogPin sets handler of messages.toggleDialogPin#a731e257 method.
+//
+// See https://core.telegram.org/method/messages.toggleDialogPin for reference.
 func (s *ServerDispatcher) OnMessagesToggleDialogPin(f func(ctx context.Context, request *MessagesToggleDialogPinRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesToggleDialogPinRequest
@@ -4244,6 +4948,9 @@ func (s *ServerDispatcher) OnMessagesToggleDialogPin(f func(ctx context.Context,
 	s.handlers[MessagesToggleDialogPinRequestTypeID] = handler
 }
 
+// OnMessagesReorderPinnedDialogs sets handler of messages.reorderPinnedDialogs#3b1adf37 method.
+//
+// See https://core.telegram.org/method/messages.reorderPinnedDialogs for reference.
 func (s *ServerDispatcher) OnMessagesReorderPinnedDialogs(f func(ctx context.Context, request *MessagesReorderPinnedDialogsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReorderPinnedDialogsRequest
@@ -4265,6 +4972,9 @@ func (s *ServerDispatcher) OnMessagesReorderPinnedDialogs(f func(ctx context.Con
 	s.handlers[MessagesReorderPinnedDialogsRequestTypeID] = handler
 }
 
+// OnMessagesGetPinnedDialogs sets handler of messages.getPinnedDialogs#d6b94df2 method.
+//
+// See https://core.telegram.org/method/messages.getPinnedDialogs for reference.
 func (s *ServerDispatcher) OnMessagesGetPinnedDialogs(f func(ctx context.Context, folderid int) (*MessagesPeerDialogs, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetPinnedDialogsRequest
@@ -4282,6 +4992,9 @@ func (s *ServerDispatcher) OnMessagesGetPinnedDialogs(f func(ctx context.Context
 	s.handlers[MessagesGetPinnedDialogsRequestTypeID] = handler
 }
 
+// OnMessagesSetBotShippingResults sets handler of messages.setBotShippingResults#e5f672fa method.
+//
+// See https://core.telegram.org/method/messages.setBotShippingResults for reference.
 func (s *ServerDispatcher) OnMessagesSetBotShippingResults(f func(ctx context.Context, request *MessagesSetBotShippingResultsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetBotShippingResultsRequest
@@ -4303,6 +5016,9 @@ func (s *ServerDispatcher) OnMessagesSetBotShippingResults(f func(ctx context.Co
 	s.handlers[MessagesSetBotShippingResultsRequestTypeID] = handler
 }
 
+// OnMessagesSetBotPrecheckoutResults sets handler of messages.setBotPrecheckoutResults#9c2dd95 method.
+//
+// See https://core.telegram.org/method/messages.setBotPrecheckoutResults for reference.
 func (s *ServerDispatcher) OnMessagesSetBotPrecheckoutResults(f func(ctx context.Context, request *MessagesSetBotPrecheckoutResultsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetBotPrecheckoutResultsRequest
@@ -4324,6 +5040,9 @@ func (s *ServerDispatcher) OnMessagesSetBotPrecheckoutResults(f func(ctx context
 	s.handlers[MessagesSetBotPrecheckoutResultsRequestTypeID] = handler
 }
 
+// OnMessagesUploadMedia sets handler of messages.uploadMedia#519bc2b1 method.
+//
+// See https://core.telegram.org/method/messages.uploadMedia for reference.
 func (s *ServerDispatcher) OnMessagesUploadMedia(f func(ctx context.Context, request *MessagesUploadMediaRequest) (MessageMediaClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUploadMediaRequest
@@ -4341,6 +5060,9 @@ func (s *ServerDispatcher) OnMessagesUploadMedia(f func(ctx context.Context, req
 	s.handlers[MessagesUploadMediaRequestTypeID] = handler
 }
 
+// OnMessagesSendScreenshotNotification sets handler of messages.sendScreenshotNotification#a1405817 method.
+//
+// See https://core.telegram.org/method/messages.sendScreenshotNotification for reference.
 func (s *ServerDispatcher) OnMessagesSendScreenshotNotification(f func(ctx context.Context, request *MessagesSendScreenshotNotificationRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendScreenshotNotificationRequest
@@ -4358,6 +5080,9 @@ func (s *ServerDispatcher) OnMessagesSendScreenshotNotification(f func(ctx conte
 	s.handlers[MessagesSendScreenshotNotificationRequestTypeID] = handler
 }
 
+// OnMessagesGetFavedStickers sets handler of messages.getFavedStickers#4f1aaa9 method.
+//
+// See https://core.telegram.org/method/messages.getFavedStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetFavedStickers(f func(ctx context.Context, hash int64) (MessagesFavedStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetFavedStickersRequest
@@ -4375,6 +5100,9 @@ func (s *ServerDispatcher) OnMessagesGetFavedStickers(f func(ctx context.Context
 	s.handlers[MessagesGetFavedStickersRequestTypeID] = handler
 }
 
+// OnMessagesFaveSticker sets handler of messages.faveSticker#b9ffc55b method.
+//
+// See https://core.telegram.org/method/messages.faveSticker for reference.
 func (s *ServerDispatcher) OnMessagesFaveSticker(f func(ctx context.Context, request *MessagesFaveStickerRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesFaveStickerRequest
@@ -4396,6 +5124,9 @@ func (s *ServerDispatcher) OnMessagesFaveSticker(f func(ctx context.Context, req
 	s.handlers[MessagesFaveStickerRequestTypeID] = handler
 }
 
+// OnMessagesGetUnreadMentions sets handler of messages.getUnreadMentions#f107e790 method.
+//
+// See https://core.telegram.org/method/messages.getUnreadMentions for reference.
 func (s *ServerDispatcher) OnMessagesGetUnreadMentions(f func(ctx context.Context, request *MessagesGetUnreadMentionsRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetUnreadMentionsRequest
@@ -4413,6 +5144,9 @@ func (s *ServerDispatcher) OnMessagesGetUnreadMentions(f func(ctx context.Contex
 	s.handlers[MessagesGetUnreadMentionsRequestTypeID] = handler
 }
 
+// OnMessagesReadMentions sets handler of messages.readMentions#36e5bf4d method.
+//
+// See https://core.telegram.org/method/messages.readMentions for reference.
 func (s *ServerDispatcher) OnMessagesReadMentions(f func(ctx context.Context, request *MessagesReadMentionsRequest) (*MessagesAffectedHistory, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReadMentionsRequest
@@ -4430,6 +5164,9 @@ func (s *ServerDispatcher) OnMessagesReadMentions(f func(ctx context.Context, re
 	s.handlers[MessagesReadMentionsRequestTypeID] = handler
 }
 
+// OnMessagesGetRecentLocations sets handler of messages.getRecentLocations#702a40e0 method.
+//
+// See https://core.telegram.org/method/messages.getRecentLocations for reference.
 func (s *ServerDispatcher) OnMessagesGetRecentLocations(f func(ctx context.Context, request *MessagesGetRecentLocationsRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetRecentLocationsRequest
@@ -4447,6 +5184,9 @@ func (s *ServerDispatcher) OnMessagesGetRecentLocations(f func(ctx context.Conte
 	s.handlers[MessagesGetRecentLocationsRequestTypeID] = handler
 }
 
+// OnMessagesSendMultiMedia sets handler of messages.sendMultiMedia#c964709 method.
+//
+// See https://core.telegram.org/method/messages.sendMultiMedia for reference.
 func (s *ServerDispatcher) OnMessagesSendMultiMedia(f func(ctx context.Context, request *MessagesSendMultiMediaRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendMultiMediaRequest
@@ -4464,6 +5204,9 @@ func (s *ServerDispatcher) OnMessagesSendMultiMedia(f func(ctx context.Context,
 	s.handlers[MessagesSendMultiMediaRequestTypeID] = handler
 }
 
+// OnMessagesUploadEncryptedFile sets handler of messages.uploadEncryptedFile#5057c497 method.
+//
+// See https://core.telegram.org/method/messages.uploadEncryptedFile for reference.
 func (s *ServerDispatcher) OnMessagesUploadEncryptedFile(f func(ctx context.Context, request *MessagesUploadEncryptedFileRequest) (EncryptedFileClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUploadEncryptedFileRequest
@@ -4481,6 +5224,9 @@ func (s *ServerDispatcher) OnMessagesUploadEncryptedFile(f func(ctx context.Cont
 	s.handlers[MessagesUploadEncryptedFileRequestTypeID] = handler
 }
 
+// OnMessagesSearchStickerSets sets handler of messages.searchStickerSets#35705b8a method.
+//
+// See https://core.telegram.org/method/messages.searchStickerSets for reference.
 func (s *ServerDispatcher) OnMessagesSearchStickerSets(f func(ctx context.Context, request *MessagesSearchStickerSetsRequest) (MessagesFoundStickerSetsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSearchStickerSetsRequest
@@ -4498,6 +5244,9 @@ func (s *ServerDispatcher) OnMessagesSearchStickerSets(f func(ctx context.Contex
 	s.handlers[MessagesSearchStickerSetsRequestTypeID] = handler
 }
 
+// OnMessagesGetSplitRanges sets handler of messages.getSplitRanges#1cff7e08 method.
+//
+// See https://core.telegram.org/method/messages.getSplitRanges for reference.
 func (s *ServerDispatcher) OnMessagesGetSplitRanges(f func(ctx context.Context) ([]MessageRange, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSplitRangesRequest
@@ -4515,6 +5264,9 @@ func (s *ServerDispatcher) OnMessagesGetSplitRanges(f func(ctx context.Context)
 	s.handlers[MessagesGetSplitRangesRequestTypeID] = handler
 }
 
+// OnMessagesMarkDialogUnread sets handler of messages.markDialogUnread#c286d98f method.
+//
+// See https://core.telegram.org/method/messages.markDialogUnread for reference.
 func (s *ServerDispatcher) OnMessagesMarkDialogUnread(f func(ctx context.Context, request *MessagesMarkDialogUnreadRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesMarkDialogUnreadRequest
@@ -4536,6 +5288,9 @@ func (s *ServerDispatcher) OnMessagesMarkDialogUnread(f func(ctx context.Context
 	s.handlers[MessagesMarkDialogUnreadRequestTypeID] = handler
 }
 
+// OnMessagesGetDialogUnreadMarks sets handler of messages.getDialogUnreadMarks#22e24e22 method.
+//
+// See https://core.telegram.org/method/messages.getDialogUnreadMarks for reference.
 func (s *ServerDispatcher) OnMessagesGetDialogUnreadMarks(f func(ctx context.Context) ([]DialogPeerClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDialogUnreadMarksRequest
@@ -4553,6 +5308,9 @@ func (s *ServerDispatcher) OnMessagesGetDialogUnreadMarks(f func(ctx context.Con
 	s.handlers[MessagesGetDialogUnreadMarksRequestTypeID] = handler
 }
 
+// OnMessagesClearAllDrafts sets handler of messages.clearAllDrafts#7e58ee9c method.
+//
+// See https://core.telegram.org/method/messages.clearAllDrafts for reference.
 func (s *ServerDispatcher) OnMessagesClearAllDrafts(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesClearAllDraftsRequest
@@ -4574,6 +5332,9 @@ func (s *ServerDispatcher) OnMessagesClearAllDrafts(f func(ctx context.Context)
 	s.handlers[MessagesClearAllDraftsRequestTypeID] = handler
 }
 
+// OnMessagesUpdatePinnedMessage sets handler of messages.updatePinnedMessage#d2aaf7ec method.
+//
+// See https://core.telegram.org/method/messages.updatePinnedMessage for reference.
 func (s *ServerDispatcher) OnMessagesUpdatePinnedMessage(f func(ctx context.Context, request *MessagesUpdatePinnedMessageRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUpdatePinnedMessageRequest
@@ -4591,6 +5352,9 @@ func (s *ServerDispatcher) OnMessagesUpdatePinnedMessage(f func(ctx context.Cont
 	s.handlers[MessagesUpdatePinnedMessageRequestTypeID] = handler
 }
 
+// OnMessagesSendVote sets handler of messages.sendVote#10ea6184 method.
+//
+// See https://core.telegram.org/method/messages.sendVote for reference.
 func (s *ServerDispatcher) OnMessagesSendVote(f func(ctx context.Context, request *MessagesSendVoteRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendVoteRequest
@@ -4608,6 +5372,9 @@ func (s *ServerDispatcher) OnMessagesSendVote(f func(ctx context.Context, reques
 	s.handlers[MessagesSendVoteRequestTypeID] = handler
 }
 
+// OnMessagesGetPollResults sets handler of messages.getPollResults#73bb643b method.
+//
+// See https://core.telegram.org/method/messages.getPollResults for reference.
 func (s *ServerDispatcher) OnMessagesGetPollResults(f func(ctx context.Context, request *MessagesGetPollResultsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetPollResultsRequest
@@ -4625,6 +5392,9 @@ func (s *ServerDispatcher) OnMessagesGetPollResults(f func(ctx context.Context,
 	s.handlers[MessagesGetPollResultsRequestTypeID] = handler
 }
 
+// OnMessagesGetOnlines sets handler of messages.getOnlines#6e2be050 method.
+//
+// See https://core.telegram.org/method/messages.getOnlines for reference.
 func (s *ServerDispatcher) OnMessagesGetOnlines(f func(ctx context.Context, peer InputPeerClass) (*ChatOnlines, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetOnlinesRequest
@@ -4642,6 +5412,9 @@ func (s *ServerDispatcher) OnMessagesGetOnlines(f func(ctx context.Context, peer
 	s.handlers[MessagesGetOnlinesRequestTypeID] = handler
 }
 
+// OnMessagesEditChatAbout sets handler of messages.editChatAbout#def60797 method.
+//
+// See https://core.telegram.org/method/messages.editChatAbout for reference.
 func (s *ServerDispatcher) OnMessagesEditChatAbout(f func(ctx context.Context, request *MessagesEditChatAboutRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditChatAboutRequest
@@ -4663,6 +5436,9 @@ func (s *ServerDispatcher) OnMessagesEditChatAbout(f func(ctx context.Context, r
 	s.handlers[MessagesEditChatAboutRequestTypeID] = handler
 }
 
+// OnMessagesEditChatDefaultBannedRights sets handler of messages.editChatDefaultBannedRights#a5866b41 method.
+//
+// See https://core.telegram.org/method/messages.editChatDefaultBannedRights for reference.
 func (s *ServerDispatcher) OnMessagesEditChatDefaultBannedRights(f func(ctx context.Context, request *MessagesEditChatDefaultBannedRightsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditChatDefaultBannedRightsRequest
@@ -4680,6 +5456,9 @@ func (s *ServerDispatcher) OnMessagesEditChatDefaultBannedRights(f func(ctx cont
 	s.handlers[MessagesEditChatDefaultBannedRightsRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiKeywords sets handler of messages.getEmojiKeywords#35a0e062 method.
+//
+// See https://core.telegram.org/method/messages.getEmojiKeywords for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiKeywords(f func(ctx context.Context, langcode string) (*EmojiKeywordsDifference, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiKeywordsRequest
@@ -4697,6 +5476,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiKeywords(f func(ctx context.Context
 	s.handlers[MessagesGetEmojiKeywordsRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiKeywordsDifference sets handler of messages.getEmojiKeywordsDifference#1508b6af method.
+//
+// See https://core.telegram.org/method/messages.getEmojiKeywordsDifference for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiKeywordsDifference(f func(ctx context.Context, request *MessagesGetEmojiKeywordsDifferenceRequest) (*EmojiKeywordsDifference, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiKeywordsDifferenceRequest
@@ -4714,6 +5496,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiKeywordsDifference(f func(ctx conte
 	s.handlers[MessagesGetEmojiKeywordsDifferenceRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiKeywordsLanguages sets handler of messages.getEmojiKeywordsLanguages#4e9963b2 method.
+//
+// See https://core.telegram.org/method/messages.getEmojiKeywordsLanguages for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiKeywordsLanguages(f func(ctx context.Context, langcodes []string) ([]EmojiLanguage, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiKeywordsLanguagesRequest
@@ -4731,6 +5516,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiKeywordsLanguages(f func(ctx contex
 	s.handlers[MessagesGetEmojiKeywordsLanguagesRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiURL sets handler of messages.getEmojiURL#d5b10c26 method.
+//
+// See https://core.telegram.org/method/messages.getEmojiURL for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiURL(f func(ctx context.Context, langcode string) (*EmojiURL, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiURLRequest
@@ -4748,6 +5536,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiURL(f func(ctx context.Context, lan
 	s.handlers[MessagesGetEmojiURLRequestTypeID] = handler
 }
 
+// OnMessagesGetSearchCounters sets handler of messages.getSearchCounters#1bbcf300 method.
+//
+// See https://core.telegram.org/method/messages.getSearchCounters for reference.
 func (s *ServerDispatcher) OnMessagesGetSearchCounters(f func(ctx context.Context, request *MessagesGetSearchCountersRequest) ([]MessagesSearchCounter, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSearchCountersRequest
@@ -4765,6 +5556,9 @@ func (s *ServerDispatcher) OnMessagesGetSearchCounters(f func(ctx context.Contex
 	s.handlers[MessagesGetSearchCountersRequestTypeID] = handler
 }
 
+// OnMessagesRequestURLAuth sets handler of messages.requestUrlAuth#198fb446 method.
+//
+// See https://core.telegram.org/method/messages.requestUrlAuth for reference.
 func (s *ServerDispatcher) OnMessagesRequestURLAuth(f func(ctx context.Context, request *MessagesRequestURLAuthRequest) (URLAuthResultClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesRequestURLAuthRequest
@@ -4782,6 +5576,9 @@ func (s *ServerDispatcher) OnMessagesRequestURLAuth(f func(ctx context.Context,
 	s.handlers[MessagesRequestURLAuthRequestTypeID] = handler
 }
 
+// OnMessagesAcceptURLAuth sets handler of messages.acceptUrlAuth#b12c7125 method.
+//
+// See https://core.telegram.org/method/messages.acceptUrlAuth for reference.
 func (s *ServerDispatcher) OnMessagesAcceptURLAuth(f func(ctx context.Context, request *MessagesAcceptURLAuthRequest) (URLAuthResultClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesAcceptURLAuthRequest
@@ -4799,6 +5596,9 @@ func (s *ServerDispatcher) OnMessagesAcceptURLAuth(f func(ctx context.Context, r
 	s.handlers[MessagesAcceptURLAuthRequestTypeID] = handler
 }
 
+// OnMessagesHidePeerSettingsBar sets handler of messages.hidePeerSettingsBar#4facb138 method.
+//
+// See https://core.telegram.org/method/messages.hidePeerSettingsBar for reference.
 func (s *ServerDispatcher) OnMessagesHidePeerSettingsBar(f func(ctx context.Context, peer InputPeerClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesHidePeerSettingsBarRequest
@@ -4820,6 +5620,9 @@ func (s *ServerDispatcher) OnMessagesHidePeerSettingsBar(f func(ctx context.Cont
 	s.handlers[MessagesHidePeerSettingsBarRequestTypeID] = handler
 }
 
+// OnMessagesGetScheduledHistory sets handler of messages.getScheduledHistory#f516760b method.
+//
+// See https://core.telegram.org/method/messages.getScheduledHistory for reference.
 func (s *ServerDispatcher) OnMessagesGetScheduledHistory(f func(ctx context.Context, request *MessagesGetScheduledHistoryRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetScheduledHistoryRequest
@@ -4837,6 +5640,9 @@ func (s *ServerDispatcher) OnMessagesGetScheduledHistory(f func(ctx context.Cont
 	s.handlers[MessagesGetScheduledHistoryRequestTypeID] = handler
 }
 
+// OnMessagesGetScheduledMessages sets handler of messages.getScheduledMessages#bdbb0464 method.
+//
+// See https://core.telegram.org/method/messages.getScheduledMessages for reference.
 func (s *ServerDispatcher) OnMessagesGetScheduledMessages(f func(ctx context.Context, request *MessagesGetScheduledMessagesRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetScheduledMessagesRequest
@@ -4854,6 +5660,9 @@ func (s *ServerDispatcher) OnMessagesGetScheduledMessages(f func(ctx context.Con
 	s.handlers[MessagesGetScheduledMessagesRequestTypeID] = handler
 }
 
+// OnMessagesSendScheduledMessages sets handler of messages.sendScheduledMessages#bd38850a method.
+//
+// See https://core.telegram.org/method/messages.sendScheduledMessages for reference.
 func (s *ServerDispatcher) OnMessagesSendScheduledMessages(f func(ctx context.Context, request *MessagesSendScheduledMessagesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendScheduledMessagesRequest
@@ -4871,6 +5680,9 @@ func (s *ServerDispatcher) OnMessagesSendScheduledMessages(f func(ctx context.Co
 	s.handlers[MessagesSendScheduledMessagesRequestTypeID] = handler
 }
 
+// OnMessagesDeleteScheduledMessages sets handler of messages.deleteScheduledMessages#59ae2b16 method.
+//
+// See https://core.telegram.org/method/messages.deleteScheduledMessages for reference.
 func (s *ServerDispatcher) OnMessagesDeleteScheduledMessages(f func(ctx context.Context, request *MessagesDeleteScheduledMessagesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteScheduledMessagesRequest
@@ -4888,6 +5700,9 @@ func (s *ServerDispatcher) OnMessagesDeleteScheduledMessages(f func(ctx context.
 	s.handlers[MessagesDeleteScheduledMessagesRequestTypeID] = handler
 }
 
+// OnMessagesGetPollVotes sets handler of messages.getPollVotes#b86e380e method.
+//
+// See https://core.telegram.org/method/messages.getPollVotes for reference.
 func (s *ServerDispatcher) OnMessagesGetPollVotes(f func(ctx context.Context, request *MessagesGetPollVotesRequest) (*MessagesVotesList, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetPollVotesRequest
@@ -4905,6 +5720,9 @@ func (s *ServerDispatcher) OnMessagesGetPollVotes(f func(ctx context.Context, re
 	s.handlers[MessagesGetPollVotesRequestTypeID] = handler
 }
 
+// OnMessagesToggleStickerSets sets handler of messages.toggleStickerSets#b5052fea method.
+//
+// See https://core.telegram.org/method/messages.toggleStickerSets for reference.
 func (s *ServerDispatcher) OnMessagesToggleStickerSets(f func(ctx context.Context, request *MessagesToggleStickerSetsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesToggleStickerSetsRequest
@@ -4926,6 +5744,9 @@ func (s *ServerDispatcher) OnMessagesToggleStickerSets(f func(ctx context.Contex
 	s.handlers[MessagesToggleStickerSetsRequestTypeID] = handler
 }
 
+// OnMessagesGetDialogFilters sets handler of messages.getDialogFilters#efd48c89 method.
+//
+// See https://core.telegram.org/method/messages.getDialogFilters for reference.
 func (s *ServerDispatcher) OnMessagesGetDialogFilters(f func(ctx context.Context) (*MessagesDialogFilters, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDialogFiltersRequest
@@ -4943,6 +5764,9 @@ func (s *ServerDispatcher) OnMessagesGetDialogFilters(f func(ctx context.Context
 	s.handlers[MessagesGetDialogFiltersRequestTypeID] = handler
 }
 
+// OnMessagesGetSuggestedDialogFilters sets handler of messages.getSuggestedDialogFilters#a29cd42c method.
+//
+// See https://core.telegram.org/method/messages.getSuggestedDialogFilters for reference.
 func (s *ServerDispatcher) OnMessagesGetSuggestedDialogFilters(f func(ctx context.Context) ([]DialogFilterSuggested, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSuggestedDialogFiltersRequest
@@ -4960,6 +5784,9 @@ func (s *ServerDispatcher) OnMessagesGetSuggestedDialogFilters(f func(ctx contex
 	s.handlers[MessagesGetSuggestedDialogFiltersRequestTypeID] = handler
 }
 
+// OnMessagesUpdateDialogFilter sets handler of messages.updateDialogFilter#1ad4a04a method.
+//
+// See https://core.telegram.org/method/messages.updateDialogFilter for reference.
 func (s *ServerDispatcher) OnMessagesUpdateDialogFilter(f func(ctx context.Context, request *MessagesUpdateDialogFilterRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUpdateDialogFilterRequest
@@ -4981,6 +5808,9 @@ func (s *ServerDispatcher) OnMessagesUpdateDialogFilter(f func(ctx context.Conte
 	s.handlers[MessagesUpdateDialogFilterRequestTypeID] = handler
 }
 
+// OnMessagesUpdateDialogFiltersOrder sets handler of messages.updateDialogFiltersOrder#c563c1e4 method.
+//
+// See https://core.telegram.org/method/messages.updateDialogFiltersOrder for reference.
 func (s *ServerDispatcher) OnMessagesUpdateDialogFiltersOrder(f func(ctx context.Context, order []int) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUpdateDialogFiltersOrderRequest
@@ -5002,6 +5832,9 @@ func (s *ServerDispatcher) OnMessagesUpdateDialogFiltersOrder(f func(ctx context
 	s.handlers[MessagesUpdateDialogFiltersOrderRequestTypeID] = handler
 }
 
+// OnMessagesGetOldFeaturedStickers sets handler of messages.getOldFeaturedStickers#7ed094a1 method.
+//
+// See https://core.telegram.org/method/messages.getOldFeaturedStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetOldFeaturedStickers(f func(ctx context.Context, request *MessagesGetOldFeaturedStickersRequest) (MessagesFeaturedStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetOldFeaturedStickersRequest
@@ -5019,6 +5852,9 @@ func (s *ServerDispatcher) OnMessagesGetOldFeaturedStickers(f func(ctx context.C
 	s.handlers[MessagesGetOldFeaturedStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetReplies sets handler of messages.getReplies#22ddd30c method.
+//
+// See https://core.telegram.org/method/messages.getReplies for reference.
 func (s *ServerDispatcher) OnMessagesGetReplies(f func(ctx context.Context, request *MessagesGetRepliesRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetRepliesRequest
@@ -5036,6 +5872,9 @@ func (s *ServerDispatcher) OnMessagesGetReplies(f func(ctx context.Context, requ
 	s.handlers[MessagesGetRepliesRequestTypeID] = handler
 }
 
+// OnMessagesGetDiscussionMessage sets handler of messages.getDiscussionMessage#446972fd method.
+//
+// See https://core.telegram.org/method/messages.getDiscussionMessage for reference.
 func (s *ServerDispatcher) OnMessagesGetDiscussionMessage(f func(ctx context.Context, request *MessagesGetDiscussionMessageRequest) (*MessagesDiscussionMessage, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDiscussionMessageRequest
@@ -5053,6 +5892,9 @@ func (s *ServerDispatcher) OnMessagesGetDiscussionMessage(f func(ctx context.Con
 	s.handlers[MessagesGetDiscussionMessageRequestTypeID] = handler
 }
 
+// OnMessagesReadDiscussion sets handler of messages.readDiscussion#f731a9f4 method.
+//
+// See https://core.telegram.org/method/messages.readDiscussion for reference.
 func (s *ServerDispatcher) OnMessagesReadDiscussion(f func(ctx context.Context, request *MessagesReadDiscussionRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReadDiscussionRequest
@@ -5074,6 +5916,9 @@ func (s *ServerDispatcher) OnMessagesReadDiscussion(f func(ctx context.Context,
 	s.handlers[MessagesReadDiscussionRequestTypeID] = handler
 }
 
+// OnMessagesUnpinAllMessages sets handler of messages.unpinAllMessages#ee22b9a8 method.
+//
+// See https://core.telegram.org/method/messages.unpinAllMessages for reference.
 func (s *ServerDispatcher) OnMessagesUnpinAllMessages(f func(ctx context.Context, request *MessagesUnpinAllMessagesRequest) (*MessagesAffectedHistory, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUnpinAllMessagesRequest
@@ -5091,6 +5936,9 @@ func (s *ServerDispatcher) OnMessagesUnpinAllMessages(f func(ctx context.Context
 	s.handlers[MessagesUnpinAllMessagesRequestTypeID] = handler
 }
 
+// OnMessagesDeleteChat sets handler of messages.deleteChat#5bd0ee50 method.
+//
+// See https://core.telegram.org/method/messages.deleteChat for reference.
 func (s *ServerDispatcher) OnMessagesDeleteChat(f func(ctx context.Context, chatid int64) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteChatRequest
@@ -5112,6 +5960,9 @@ func (s *ServerDispatcher) OnMessagesDeleteChat(f func(ctx context.Context, chat
 	s.handlers[MessagesDeleteChatRequestTypeID] = handler
 }
 
+// OnMessagesDeletePhoneCallHistory sets handler of messages.deletePhoneCallHistory#f9cbe409 method.
+//
+// See https://core.telegram.org/method/messages.deletePhoneCallHistory for reference.
 func (s *ServerDispatcher) OnMessagesDeletePhoneCallHistory(f func(ctx context.Context, request *MessagesDeletePhoneCallHistoryRequest) (*MessagesAffectedFoundMessages, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeletePhoneCallHistoryRequest
@@ -5129,6 +5980,9 @@ func (s *ServerDispatcher) OnMessagesDeletePhoneCallHistory(f func(ctx context.C
 	s.handlers[MessagesDeletePhoneCallHistoryRequestTypeID] = handler
 }
 
+// OnMessagesCheckHistoryImport sets handler of messages.checkHistoryImport#43fe19f3 method.
+//
+// See https://core.telegram.org/method/messages.checkHistoryImport for reference.
 func (s *ServerDispatcher) OnMessagesCheckHistoryImport(f func(ctx context.Context, importhead string) (*MessagesHistoryImportParsed, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesCheckHistoryImportRequest
@@ -5146,6 +6000,9 @@ func (s *ServerDispatcher) OnMessagesCheckHistoryImport(f func(ctx context.Conte
 	s.handlers[MessagesCheckHistoryImportRequestTypeID] = handler
 }
 
+// OnMessagesInitHistoryImport sets handler of messages.initHistoryImport#34090c3b method.
+//
+// See https://core.telegram.org/method/messages.initHistoryImport for reference.
 func (s *ServerDispatcher) OnMessagesInitHistoryImport(f func(ctx context.Context, request *MessagesInitHistoryImportRequest) (*MessagesHistoryImport, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesInitHistoryImportRequest
@@ -5163,6 +6020,9 @@ func (s *ServerDispatcher) OnMessagesInitHistoryImport(f func(ctx context.Contex
 	s.handlers[MessagesInitHistoryImportRequestTypeID] = handler
 }
 
+// OnMessagesUploadImportedMedia sets handler of messages.uploadImportedMedia#2a862092 method.
+//
+// See https://core.telegram.org/method/messages.uploadImportedMedia for reference.
 func (s *ServerDispatcher) OnMessagesUploadImportedMedia(f func(ctx context.Context, request *MessagesUploadImportedMediaRequest) (MessageMediaClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUploadImportedMediaRequest
@@ -5180,6 +6040,9 @@ func (s *ServerDispatcher) OnMessagesUploadImportedMedia(f func(ctx context.Cont
 	s.handlers[MessagesUploadImportedMediaRequestTypeID] = handler
 }
 
+// OnMessagesStartHistoryImport sets handler of messages.startHistoryImport#b43df344 method.
+//
+// See https://core.telegram.org/method/messages.startHistoryImport for reference.
 func (s *ServerDispatcher) OnMessagesStartHistoryImport(f func(ctx context.Context, request *MessagesStartHistoryImportRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesStartHistoryImportRequest
@@ -5201,6 +6064,9 @@ func (s *ServerDispatcher) OnMessagesStartHistoryImport(f func(ctx context.Conte
 	s.handlers[MessagesStartHistoryImportRequestTypeID] = handler
 }
 
+// OnMessagesGetExportedChatInvites sets handler of messages.getExportedChatInvites#a2b5a3f6 method.
+//
+// See https://core.telegram.org/method/messages.getExportedChatInvites for reference.
 func (s *ServerDispatcher) OnMessagesGetExportedChatInvites(f func(ctx context.Context, request *MessagesGetExportedChatInvitesRequest) (*MessagesExportedChatInvites, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetExportedChatInvitesRequest
@@ -5218,6 +6084,9 @@ func (s *ServerDispatcher) OnMessagesGetExportedChatInvites(f func(ctx context.C
 	s.handlers[MessagesGetExportedChatInvitesRequestTypeID] = handler
 }
 
+// OnMessagesGetExportedChatInvite sets handler of messages.getExportedChatInvite#73746f5c method.
+//
+// See https://core.telegram.org/method/messages.getExportedChatInvite for reference.
 func (s *ServerDispatcher) OnMessagesGetExportedChatInvite(f func(ctx context.Context, request *MessagesGetExportedChatInviteRequest) (MessagesExportedChatInviteClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetExportedChatInviteRequest
@@ -5235,6 +6104,9 @@ func (s *ServerDispatcher) OnMessagesGetExportedChatInvite(f func(ctx context.Co
 	s.handlers[MessagesGetExportedChatInviteRequestTypeID] = handler
 }
 
+// OnMessagesEditExportedChatInvite sets handler of messages.editExportedChatInvite#bdca2f75 method.
+//
+// See https://core.telegram.org/method/messages.editExportedChatInvite for reference.
 func (s *ServerDispatcher) OnMessagesEditExportedChatInvite(f func(ctx context.Context, request *MessagesEditExportedChatInviteRequest) (MessagesExportedChatInviteClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditExportedChatInviteRequest
@@ -5252,6 +6124,9 @@ func (s *ServerDispatcher) OnMessagesEditExportedChatInvite(f func(ctx context.C
 	s.handlers[MessagesEditExportedChatInviteRequestTypeID] = handler
 }
 
+// OnMessagesDeleteRevokedExportedChatInvites sets handler of messages.deleteRevokedExportedChatInvites#56987bd5 method.
+//
+// See https://core.telegram.org/method/messages.deleteRevokedExportedChatInvites for reference.
 func (s *ServerDispatcher) OnMessagesDeleteRevokedExportedChatInvites(f func(ctx context.Context, request *MessagesDeleteRevokedExportedChatInvitesRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteRevokedExportedChatInvitesRequest
@@ -5273,6 +6148,9 @@ func (s *ServerDispatcher) OnMessagesDeleteRevokedExportedChatInvites(f func(ctx
 	s.handlers[MessagesDeleteRevokedExportedChatInvitesRequestTypeID] = handler
 }
 
+// OnMessagesDeleteExportedChatInvite sets handler of messages.deleteExportedChatInvite#d464a42b method.
+//
+// See https://core.telegram.org/method/messages.deleteExportedChatInvite for reference.
 func (s *ServerDispatcher) OnMessagesDeleteExportedChatInvite(f func(ctx context.Context, request *MessagesDeleteExportedChatInviteRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteExportedChatInviteRequest
@@ -5294,6 +6172,9 @@ func (s *ServerDispatcher) OnMessagesDeleteExportedChatInvite(f func(ctx context
 	s.handlers[MessagesDeleteExportedChatInviteRequestTypeID] = handler
 }
 
+// OnMessagesGetAdminsWithInvites sets handler of messages.getAdminsWithInvites#3920e6ef method.
+//
+// See https://core.telegram.org/method/messages.getAdminsWithInvites for reference.
 func (s *ServerDispatcher) OnMessagesGetAdminsWithInvites(f func(ctx context.Context, peer InputPeerClass) (*MessagesChatAdminsWithInvites, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetAdminsWithInvitesRequest
@@ -5311,6 +6192,9 @@ func (s *ServerDispatcher) OnMessagesGetAdminsWithInvites(f func(ctx context.Con
 	s.handlers[MessagesGetAdminsWithInvitesRequestTypeID] = handler
 }
 
+// OnMessagesGetChatInviteImporters sets handler of messages.getChatInviteImporters#df04dd4e method.
+//
+// See https://core.telegram.org/method/messages.getChatInviteImporters for reference.
 func (s *ServerDispatcher) OnMessagesGetChatInviteImporters(f func(ctx context.Context, request *MessagesGetChatInviteImportersRequest) (*MessagesChatInviteImporters, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetChatInviteImportersRequest
@@ -5328,6 +6212,9 @@ func (s *ServerDispatcher) OnMessagesGetChatInviteImporters(f func(ctx context.C
 	s.handlers[MessagesGetChatInviteImportersRequestTypeID] = handler
 }
 
+// OnMessagesSetHistoryTTL sets handler of messages.setHistoryTTL#b80e5fe4 method.
+//
+// See https://core.telegram.org/method/messages.setHistoryTTL for reference.
 func (s *ServerDispatcher) OnMessagesSetHistoryTTL(f func(ctx context.Context, request *MessagesSetHistoryTTLRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetHistoryTTLRequest
@@ -5345,6 +6232,9 @@ func (s *ServerDispatcher) OnMessagesSetHistoryTTL(f func(ctx context.Context, r
 	s.handlers[MessagesSetHistoryTTLRequestTypeID] = handler
 }
 
+// OnMessagesCheckHistoryImportPeer sets handler of messages.checkHistoryImportPeer#5dc60f03 method.
+//
+// See https://core.telegram.org/method/messages.checkHistoryImportPeer for reference.
 func (s *ServerDispatcher) OnMessagesCheckHistoryImportPeer(f func(ctx context.Context, peer InputPeerClass) (*MessagesCheckedHistoryImportPeer, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesCheckHistoryImportPeerRequest
@@ -5362,6 +6252,9 @@ func (s *ServerDispatcher) OnMessagesCheckHistoryImportPeer(f func(ctx context.C
 	s.handlers[MessagesCheckHistoryImportPeerRequestTypeID] = handler
 }
 
+// OnMessagesSetChatTheme sets handler of messages.setChatTheme#e63be13f method.
+//
+// See https://core.telegram.org/method/messages.setChatTheme for reference.
 func (s *ServerDispatcher) OnMessagesSetChatTheme(f func(ctx context.Context, request *MessagesSetChatThemeRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetChatThemeRequest
@@ -5379,6 +6272,9 @@ func (s *ServerDispatcher) OnMessagesSetChatTheme(f func(ctx context.Context, re
 	s.handlers[MessagesSetChatThemeRequestTypeID] = handler
 }
 
+// OnMessagesGetMessageReadParticipants sets handler of messages.getMessageReadParticipants#31c1c44f method.
+//
+// See https://core.telegram.org/method/messages.getMessageReadParticipants for reference.
 func (s *ServerDispatcher) OnMessagesGetMessageReadParticipants(f func(ctx context.Context, request *MessagesGetMessageReadParticipantsRequest) ([]ReadParticipantDate, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetMessageReadParticipantsRequest
@@ -5396,6 +6292,9 @@ func (s *ServerDispatcher) OnMessagesGetMessageReadParticipants(f func(ctx conte
 	s.handlers[MessagesGetMessageReadParticipantsRequestTypeID] = handler
 }
 
+// OnMessagesGetSearchResultsCalendar sets handler of messages.getSearchResultsCalendar#6aa3f6bd method.
+//
+// See https://core.telegram.org/method/messages.getSearchResultsCalendar for reference.
 func (s *ServerDispatcher) OnMessagesGetSearchResultsCalendar(f func(ctx context.Context, request *MessagesGetSearchResultsCalendarRequest) (*MessagesSearchResultsCalendar, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSearchResultsCalendarRequest
@@ -5413,6 +6312,9 @@ func (s *ServerDispatcher) OnMessagesGetSearchResultsCalendar(f func(ctx context
 	s.handlers[MessagesGetSearchResultsCalendarRequestTypeID] = handler
 }
 
+// OnMessagesGetSearchResultsPositions sets handler of messages.getSearchResultsPositions#9c7f2f10 method.
+//
+// See https://core.telegram.org/method/messages.getSearchResultsPositions for reference.
 func (s *ServerDispatcher) OnMessagesGetSearchResultsPositions(f func(ctx context.Context, request *MessagesGetSearchResultsPositionsRequest) (*MessagesSearchResultsPositions, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSearchResultsPositionsRequest
@@ -5430,6 +6332,9 @@ func (s *ServerDispatcher) OnMessagesGetSearchResultsPositions(f func(ctx contex
 	s.handlers[MessagesGetSearchResultsPositionsRequestTypeID] = handler
 }
 
+// OnMessagesHideChatJoinRequest sets handler of messages.hideChatJoinRequest#7fe7e815 method.
+//
+// See https://core.telegram.org/method/messages.hideChatJoinRequest for reference.
 func (s *ServerDispatcher) OnMessagesHideChatJoinRequest(f func(ctx context.Context, request *MessagesHideChatJoinRequestRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesHideChatJoinRequestRequest
@@ -5447,6 +6352,9 @@ func (s *ServerDispatcher) OnMessagesHideChatJoinRequest(f func(ctx context.Cont
 	s.handlers[MessagesHideChatJoinRequestRequestTypeID] = handler
 }
 
+// OnMessagesHideAllChatJoinRequests sets handler of messages.hideAllChatJoinRequests#e085f4ea method.
+//
+// See https://core.telegram.org/method/messages.hideAllChatJoinRequests for reference.
 func (s *ServerDispatcher) OnMessagesHideAllChatJoinRequests(f func(ctx context.Context, request *MessagesHideAllChatJoinRequestsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesHideAllChatJoinRequestsRequest
@@ -5464,6 +6372,9 @@ func (s *ServerDispatcher) OnMessagesHideAllChatJoinRequests(f func(ctx context.
 	s.handlers[MessagesHideAllChatJoinRequestsRequestTypeID] = handler
 }
 
+// OnMessagesToggleNoForwards sets handler of messages.toggleNoForwards#b11eafa2 method.
+//
+// See https://core.telegram.org/method/messages.toggleNoForwards for reference.
 func (s *ServerDispatcher) OnMessagesToggleNoForwards(f func(ctx context.Context, request *MessagesToggleNoForwardsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesToggleNoForwardsRequest
@@ -5481,6 +6392,9 @@ func (s *ServerDispatcher) OnMessagesToggleNoForwards(f func(ctx context.Context
 	s.handlers[MessagesToggleNoForwardsRequestTypeID] = handler
 }
 
+// OnMessagesSaveDefaultSendAs sets handler of messages.saveDefaultSendAs#ccfddf96 method.
+//
+// See https://core.telegram.org/method/messages.saveDefaultSendAs for reference.
 func (s *ServerDispatcher) OnMessagesSaveDefaultSendAs(f func(ctx context.Context, request *MessagesSaveDefaultSendAsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSaveDefaultSendAsRequest
@@ -5502,6 +6416,9 @@ func (s *ServerDispatcher) OnMessagesSaveDefaultSendAs(f func(ctx context.Contex
 	s.handlers[MessagesSaveDefaultSendAsRequestTypeID] = handler
 }
 
+// OnMessagesSendReaction sets handler of messages.sendReaction#d30d78d4 method.
+//
+// See https://core.telegram.org/method/messages.sendReaction for reference.
 func (s *ServerDispatcher) OnMessagesSendReaction(f func(ctx context.Context, request *MessagesSendReactionRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendReactionRequest
@@ -5519,6 +6436,9 @@ func (s *ServerDispatcher) OnMessagesSendReaction(f func(ctx context.Context, re
 	s.handlers[MessagesSendReactionRequestTypeID] = handler
 }
 
+// OnMessagesGetMessagesReactions sets handler of messages.getMessagesReactions#8bba90e6 method.
+//
+// See https://core.telegram.org/method/messages.getMessagesReactions for reference.
 func (s *ServerDispatcher) OnMessagesGetMessagesReactions(f func(ctx context.Context, request *MessagesGetMessagesReactionsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetMessagesReactionsRequest
@@ -5536,6 +6456,9 @@ func (s *ServerDispatcher) OnMessagesGetMessagesReactions(f func(ctx context.Con
 	s.handlers[MessagesGetMessagesReactionsRequestTypeID] = handler
 }
 
+// OnMessagesGetMessageReactionsList sets handler of messages.getMessageReactionsList#461b3f48 method.
+//
+// See https://core.telegram.org/method/messages.getMessageReactionsList for reference.
 func (s *ServerDispatcher) OnMessagesGetMessageReactionsList(f func(ctx context.Context, request *MessagesGetMessageReactionsListRequest) (*MessagesMessageReactionsList, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetMessageReactionsListRequest
@@ -5553,6 +6476,9 @@ func (s *ServerDispatcher) OnMessagesGetMessageReactionsList(f func(ctx context.
 	s.handlers[MessagesGetMessageReactionsListRequestTypeID] = handler
 }
 
+// OnMessagesSetChatAvailableReactions sets handler of messages.setChatAvailableReactions#feb16771 method.
+//
+// See https://core.telegram.org/method/messages.setChatAvailableReactions for reference.
 func (s *ServerDispatcher) OnMessagesSetChatAvailableReactions(f func(ctx context.Context, request *MessagesSetChatAvailableReactionsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetChatAvailableReactionsRequest
@@ -5570,6 +6496,9 @@ func (s *ServerDispatcher) OnMessagesSetChatAvailableReactions(f func(ctx contex
 	s.handlers[MessagesSetChatAvailableReactionsRequestTypeID] = handler
 }
 
+// OnMessagesGetAvailableReactions sets handler of messages.getAvailableReactions#18dea0ac method.
+//
+// See https://core.telegram.org/method/messages.getAvailableReactions for reference.
 func (s *ServerDispatcher) OnMessagesGetAvailableReactions(f func(ctx context.Context, hash int) (MessagesAvailableReactionsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetAvailableReactionsRequest
@@ -5587,6 +6516,9 @@ func (s *ServerDispatcher) OnMessagesGetAvailableReactions(f func(ctx context.Co
 	s.handlers[MessagesGetAvailableReactionsRequestTypeID] = handler
 }
 
+// OnMessagesSetDefaultReaction sets handler of messages.setDefaultReaction#4f47a016 method.
+//
+// See https://core.telegram.org/method/messages.setDefaultReaction for reference.
 func (s *ServerDispatcher) OnMessagesSetDefaultReaction(f func(ctx context.Context, reaction ReactionClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetDefaultReactionRequest
@@ -5608,6 +6540,9 @@ func (s *ServerDispatcher) OnMessagesSetDefaultReaction(f func(ctx context.Conte
 	s.handlers[MessagesSetDefaultReactionRequestTypeID] = handler
 }
 
+// OnMessagesTranslateText sets handler of messages.translateText#63183030 method.
+//
+// See https://core.telegram.org/method/messages.translateText for reference.
 func (s *ServerDispatcher) OnMessagesTranslateText(f func(ctx context.Context, request *MessagesTranslateTextRequest) (*MessagesTranslateResult, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesTranslateTextRequest
@@ -5625,6 +6560,9 @@ func (s *ServerDispatcher) OnMessagesTranslateText(f func(ctx context.Context, r
 	s.handlers[MessagesTranslateTextRequestTypeID] = handler
 }
 
+// OnMessagesGetUnreadReactions sets handler of messages.getUnreadReactions#3223495b method.
+//
+// See https://core.telegram.org/method/messages.getUnreadReactions for reference.
 func (s *ServerDispatcher) OnMessagesGetUnreadReactions(f func(ctx context.Context, request *MessagesGetUnreadReactionsRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetUnreadReactionsRequest
@@ -5642,6 +6580,9 @@ func (s *ServerDispatcher) OnMessagesGetUnreadReactions(f func(ctx context.Conte
 	s.handlers[MessagesGetUnreadReactionsRequestTypeID] = handler
 }
 
+// OnMessagesReadReactions sets handler of messages.readReactions#54aa7f8e method.
+//
+// See https://core.telegram.org/method/messages.readReactions for reference.
 func (s *ServerDispatcher) OnMessagesReadReactions(f func(ctx context.Context, request *MessagesReadReactionsRequest) (*MessagesAffectedHistory, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReadReactionsRequest
@@ -5659,6 +6600,9 @@ func (s *ServerDispatcher) OnMessagesReadReactions(f func(ctx context.Context, r
 	s.handlers[MessagesReadReactionsRequestTypeID] = handler
 }
 
+// OnMessagesSearchSentMedia sets handler of messages.searchSentMedia#107e31a0 method.
+//
+// See https://core.telegram.org/method/messages.searchSentMedia for reference.
 func (s *ServerDispatcher) OnMessagesSearchSentMedia(f func(ctx context.Context, request *MessagesSearchSentMediaRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSearchSentMediaRequest
@@ -5676,6 +6620,9 @@ func (s *ServerDispatcher) OnMessagesSearchSentMedia(f func(ctx context.Context,
 	s.handlers[MessagesSearchSentMediaRequestTypeID] = handler
 }
 
+// OnMessagesGetAttachMenuBots sets handler of messages.getAttachMenuBots#16fcc2cb method.
+//
+// See https://core.telegram.org/method/messages.getAttachMenuBots for reference.
 func (s *ServerDispatcher) OnMessagesGetAttachMenuBots(f func(ctx context.Context, hash int64) (AttachMenuBotsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetAttachMenuBotsRequest
@@ -5693,6 +6640,9 @@ func (s *ServerDispatcher) OnMessagesGetAttachMenuBots(f func(ctx context.Contex
 	s.handlers[MessagesGetAttachMenuBotsRequestTypeID] = handler
 }
 
+// OnMessagesGetAttachMenuBot sets handler of messages.getAttachMenuBot#77216192 method.
+//
+// See https://core.telegram.org/method/messages.getAttachMenuBot for reference.
 func (s *ServerDispatcher) OnMessagesGetAttachMenuBot(f func(ctx context.Context, bot InputUserClass) (*AttachMenuBotsBot, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetAttachMenuBotRequest
@@ -5710,6 +6660,9 @@ func (s *ServerDispatcher) OnMessagesGetAttachMenuBot(f func(ctx context.Context
 	s.handlers[MessagesGetAttachMenuBotRequestTypeID] = handler
 }
 
+// OnMessagesToggleBotInAttachMenu sets handler of messages.toggleBotInAttachMenu#69f59d69 method.
+//
+// See https://core.telegram.org/method/messages.toggleBotInAttachMenu for reference.
 func (s *ServerDispatcher) OnMessagesToggleBotInAttachMenu(f func(ctx context.Context, request *MessagesToggleBotInAttachMenuRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesToggleBotInAttachMenuRequest
@@ -5731,6 +6684,9 @@ func (s *ServerDispatcher) OnMessagesToggleBotInAttachMenu(f func(ctx context.Co
 	s.handlers[MessagesToggleBotInAttachMenuRequestTypeID] = handler
 }
 
+// OnMessagesRequestWebView sets handler of messages.requestWebView#269dc2c1 method.
+//
+// See https://core.telegram.org/method/messages.requestWebView for reference.
 func (s *ServerDispatcher) OnMessagesRequestWebView(f func(ctx context.Context, request *MessagesRequestWebViewRequest) (*WebViewResultURL, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesRequestWebViewRequest
@@ -5748,6 +6704,9 @@ func (s *ServerDispatcher) OnMessagesRequestWebView(f func(ctx context.Context,
 	s.handlers[MessagesRequestWebViewRequestTypeID] = handler
 }
 
+// OnMessagesProlongWebView sets handler of messages.prolongWebView#b0d81a83 method.
+//
+// See https://core.telegram.org/method/messages.prolongWebView for reference.
 func (s *ServerDispatcher) OnMessagesProlongWebView(f func(ctx context.Context, request *MessagesProlongWebViewRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesProlongWebViewRequest
@@ -5769,6 +6728,9 @@ func (s *ServerDispatcher) OnMessagesProlongWebView(f func(ctx context.Context,
 	s.handlers[MessagesProlongWebViewRequestTypeID] = handler
 }
 
+// OnMessagesRequestSimpleWebView sets handler of messages.requestSimpleWebView#1a46500a method.
+//
+// See https://core.telegram.org/method/messages.requestSimpleWebView for reference.
 func (s *ServerDispatcher) OnMessagesRequestSimpleWebView(f func(ctx context.Context, request *MessagesRequestSimpleWebViewRequest) (*SimpleWebViewResultURL, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesRequestSimpleWebViewRequest
@@ -5786,6 +6748,9 @@ func (s *ServerDispatcher) OnMessagesRequestSimpleWebView(f func(ctx context.Con
 	s.handlers[MessagesRequestSimpleWebViewRequestTypeID] = handler
 }
 
+// OnMessagesSendWebViewResultMessage sets handler of messages.sendWebViewResultMessage#a4314f5 method.
+//
+// See https://core.telegram.org/method/messages.sendWebViewResultMessage for reference.
 func (s *ServerDispatcher) OnMessagesSendWebViewResultMessage(f func(ctx context.Context, request *MessagesSendWebViewResultMessageRequest) (*WebViewMessageSent, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendWebViewResultMessageRequest
@@ -5803,6 +6768,9 @@ func (s *ServerDispatcher) OnMessagesSendWebViewResultMessage(f func(ctx context
 	s.handlers[MessagesSendWebViewResultMessageRequestTypeID] = handler
 }
 
+// OnMessagesSendWebViewData sets handler of messages.sendWebViewData#dc0242c8 method.
+//
+// See https://core.telegram.org/method/messages.sendWebViewData for reference.
 func (s *ServerDispatcher) OnMessagesSendWebViewData(f func(ctx context.Context, request *MessagesSendWebViewDataRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendWebViewDataRequest
@@ -5820,6 +6788,9 @@ func (s *ServerDispatcher) OnMessagesSendWebViewData(f func(ctx context.Context,
 	s.handlers[MessagesSendWebViewDataRequestTypeID] = handler
 }
 
+// OnMessagesTranscribeAudio sets handler of messages.transcribeAudio#269e9a49 method.
+//
+// See https://core.telegram.org/method/messages.transcribeAudio for reference.
 func (s *ServerDispatcher) OnMessagesTranscribeAudio(f func(ctx context.Context, request *MessagesTranscribeAudioRequest) (*MessagesTranscribedAudio, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesTranscribeAudioRequest
@@ -5837,6 +6808,9 @@ func (s *ServerDispatcher) OnMessagesTranscribeAudio(f func(ctx context.Context,
 	s.handlers[MessagesTranscribeAudioRequestTypeID] = handler
 }
 
+// OnMessagesRateTranscribedAudio sets handler of messages.rateTranscribedAudio#7f1d072f method.
+//
+// See https://core.telegram.org/method/messages.rateTranscribedAudio for reference.
 func (s *ServerDispatcher) OnMessagesRateTranscribedAudio(f func(ctx context.Context, request *MessagesRateTranscribedAudioRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesRateTranscribedAudioRequest
@@ -5858,6 +6832,9 @@ func (s *ServerDispatcher) OnMessagesRateTranscribedAudio(f func(ctx context.Con
 	s.handlers[MessagesRateTranscribedAudioRequestTypeID] = handler
 }
 
+// OnMessagesGetCustomEmojiDocuments sets handler of messages.getCustomEmojiDocuments#d9ab0f54 method.
+//
+// See https://core.telegram.org/method/messages.getCustomEmojiDocuments for reference.
 func (s *ServerDispatcher) OnMessagesGetCustomEmojiDocuments(f func(ctx context.Context, documentid []int64) ([]DocumentClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetCustomEmojiDocumentsRequest
@@ -5875,6 +6852,9 @@ func (s *ServerDispatcher) OnMessagesGetCustomEmojiDocuments(f func(ctx context.
 	s.handlers[MessagesGetCustomEmojiDocumentsRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiStickers sets handler of messages.getEmojiStickers#fbfca18f method.
+//
+// See https://core.telegram.org/method/messages.getEmojiStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiStickers(f func(ctx context.Context, hash int64) (MessagesAllStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiStickersRequest
@@ -5892,6 +6872,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiStickers(f func(ctx context.Context
 	s.handlers[MessagesGetEmojiStickersRequestTypeID] = handler
 }
 
+// OnMessagesGetFeaturedEmojiStickers sets handler of messages.getFeaturedEmojiStickers#ecf6736 method.
+//
+// See https://core.telegram.org/method/messages.getFeaturedEmojiStickers for reference.
 func (s *ServerDispatcher) OnMessagesGetFeaturedEmojiStickers(f func(ctx context.Context, hash int64) (MessagesFeaturedStickersClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetFeaturedEmojiStickersRequest
@@ -5909,6 +6892,9 @@ func (s *ServerDispatcher) OnMessagesGetFeaturedEmojiStickers(f func(ctx context
 	s.handlers[MessagesGetFeaturedEmojiStickersRequestTypeID] = handler
 }
 
+// OnMessagesReportReaction sets handler of messages.reportReaction#3f64c076 method.
+//
+// See https://core.telegram.org/method/messages.reportReaction for reference.
 func (s *ServerDispatcher) OnMessagesReportReaction(f func(ctx context.Context, request *MessagesReportReactionRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReportReactionRequest
@@ -5930,6 +6916,9 @@ func (s *ServerDispatcher) OnMessagesReportReaction(f func(ctx context.Context,
 	s.handlers[MessagesReportReactionRequestTypeID] = handler
 }
 
+// OnMessagesGetTopReactions sets handler of messages.getTopReactions#bb8125ba method.
+//
+// See https://core.telegram.org/method/messages.getTopReactions for reference.
 func (s *ServerDispatcher) OnMessagesGetTopReactions(f func(ctx context.Context, request *MessagesGetTopReactionsRequest) (MessagesReactionsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetTopReactionsRequest
@@ -5947,6 +6936,9 @@ func (s *ServerDispatcher) OnMessagesGetTopReactions(f func(ctx context.Context,
 	s.handlers[MessagesGetTopReactionsRequestTypeID] = handler
 }
 
+// OnMessagesGetRecentReactions sets handler of messages.getRecentReactions#39461db2 method.
+//
+// See https://core.telegram.org/method/messages.getRecentReactions for reference.
 func (s *ServerDispatcher) OnMessagesGetRecentReactions(f func(ctx context.Context, request *MessagesGetRecentReactionsRequest) (MessagesReactionsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetRecentReactionsRequest
@@ -5964,6 +6956,9 @@ func (s *ServerDispatcher) OnMessagesGetRecentReactions(f func(ctx context.Conte
 	s.handlers[MessagesGetRecentReactionsRequestTypeID] = handler
 }
 
+// OnMessagesClearRecentReactions sets handler of messages.clearRecentReactions#9dfeefb4 method.
+//
+// See https://core.telegram.org/method/messages.clearRecentReactions for reference.
 func (s *ServerDispatcher) OnMessagesClearRecentReactions(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesClearRecentReactionsRequest
@@ -5985,6 +6980,9 @@ func (s *ServerDispatcher) OnMessagesClearRecentReactions(f func(ctx context.Con
 	s.handlers[MessagesClearRecentReactionsRequestTypeID] = handler
 }
 
+// OnMessagesGetExtendedMedia sets handler of messages.getExtendedMedia#84f80814 method.
+//
+// See https://core.telegram.org/method/messages.getExtendedMedia for reference.
 func (s *ServerDispatcher) OnMessagesGetExtendedMedia(f func(ctx context.Context, request *MessagesGetExtendedMediaRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetExtendedMediaRequest
@@ -6002,6 +7000,9 @@ func (s *ServerDispatcher) OnMessagesGetExtendedMedia(f func(ctx context.Context
 	s.handlers[MessagesGetExtendedMediaRequestTypeID] = handler
 }
 
+// OnMessagesSetDefaultHistoryTTL sets handler of messages.setDefaultHistoryTTL#9eb51445 method.
+//
+// See https://core.telegram.org/method/messages.setDefaultHistoryTTL for reference.
 func (s *ServerDispatcher) OnMessagesSetDefaultHistoryTTL(f func(ctx context.Context, period int) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetDefaultHistoryTTLRequest
@@ -6023,6 +7024,9 @@ func (s *ServerDispatcher) OnMessagesSetDefaultHistoryTTL(f func(ctx context.Con
 	s.handlers[MessagesSetDefaultHistoryTTLRequestTypeID] = handler
 }
 
+// OnMessagesGetDefaultHistoryTTL sets handler of messages.getDefaultHistoryTTL#658b7188 method.
+//
+// See https://core.telegram.org/method/messages.getDefaultHistoryTTL for reference.
 func (s *ServerDispatcher) OnMessagesGetDefaultHistoryTTL(f func(ctx context.Context) (*DefaultHistoryTTL, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDefaultHistoryTTLRequest
@@ -6040,6 +7044,9 @@ func (s *ServerDispatcher) OnMessagesGetDefaultHistoryTTL(f func(ctx context.Con
 	s.handlers[MessagesGetDefaultHistoryTTLRequestTypeID] = handler
 }
 
+// OnMessagesSendBotRequestedPeer sets handler of messages.sendBotRequestedPeer#91b2d060 method.
+//
+// See https://core.telegram.org/method/messages.sendBotRequestedPeer for reference.
 func (s *ServerDispatcher) OnMessagesSendBotRequestedPeer(f func(ctx context.Context, request *MessagesSendBotRequestedPeerRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendBotRequestedPeerRequest
@@ -6057,6 +7064,9 @@ func (s *ServerDispatcher) OnMessagesSendBotRequestedPeer(f func(ctx context.Con
 	s.handlers[MessagesSendBotRequestedPeerRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiGroups sets handler of messages.getEmojiGroups#7488ce5b method.
+//
+// See https://core.telegram.org/method/messages.getEmojiGroups for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiGroups(f func(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiGroupsRequest
@@ -6074,6 +7084,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiGroups(f func(ctx context.Context,
 	s.handlers[MessagesGetEmojiGroupsRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiStatusGroups sets handler of messages.getEmojiStatusGroups#2ecd56cd method.
+//
+// See https://core.telegram.org/method/messages.getEmojiStatusGroups for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiStatusGroups(f func(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiStatusGroupsRequest
@@ -6091,6 +7104,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiStatusGroups(f func(ctx context.Con
 	s.handlers[MessagesGetEmojiStatusGroupsRequestTypeID] = handler
 }
 
+// OnMessagesGetEmojiProfilePhotoGroups sets handler of messages.getEmojiProfilePhotoGroups#21a548f3 method.
+//
+// See https://core.telegram.org/method/messages.getEmojiProfilePhotoGroups for reference.
 func (s *ServerDispatcher) OnMessagesGetEmojiProfilePhotoGroups(f func(ctx context.Context, hash int) (MessagesEmojiGroupsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetEmojiProfilePhotoGroupsRequest
@@ -6108,6 +7124,9 @@ func (s *ServerDispatcher) OnMessagesGetEmojiProfilePhotoGroups(f func(ctx conte
 	s.handlers[MessagesGetEmojiProfilePhotoGroupsRequestTypeID] = handler
 }
 
+// OnMessagesSearchCustomEmoji sets handler of messages.searchCustomEmoji#2c11c0d7 method.
+//
+// See https://core.telegram.org/method/messages.searchCustomEmoji for reference.
 func (s *ServerDispatcher) OnMessagesSearchCustomEmoji(f func(ctx context.Context, request *MessagesSearchCustomEmojiRequest) (EmojiListClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSearchCustomEmojiRequest
@@ -6125,6 +7144,9 @@ func (s *ServerDispatcher) OnMessagesSearchCustomEmoji(f func(ctx context.Contex
 	s.handlers[MessagesSearchCustomEmojiRequestTypeID] = handler
 }
 
+// OnMessagesTogglePeerTranslations sets handler of messages.togglePeerTranslations#e47cb579 method.
+//
+// See https://core.telegram.org/method/messages.togglePeerTranslations for reference.
 func (s *ServerDispatcher) OnMessagesTogglePeerTranslations(f func(ctx context.Context, request *MessagesTogglePeerTranslationsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesTogglePeerTranslationsRequest
@@ -6146,6 +7168,9 @@ func (s *ServerDispatcher) OnMessagesTogglePeerTranslations(f func(ctx context.C
 	s.handlers[MessagesTogglePeerTranslationsRequestTypeID] = handler
 }
 
+// OnMessagesGetBotApp sets handler of messages.getBotApp#34fdc5c3 method.
+//
+// See https://core.telegram.org/method/messages.getBotApp for reference.
 func (s *ServerDispatcher) OnMessagesGetBotApp(f func(ctx context.Context, request *MessagesGetBotAppRequest) (*MessagesBotApp, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetBotAppRequest
@@ -6163,6 +7188,9 @@ func (s *ServerDispatcher) OnMessagesGetBotApp(f func(ctx context.Context, reque
 	s.handlers[MessagesGetBotAppRequestTypeID] = handler
 }
 
+// OnMessagesRequestAppWebView sets handler of messages.requestAppWebView#8c5a3b3c method.
+//
+// See https://core.telegram.org/method/messages.requestAppWebView for reference.
 func (s *ServerDispatcher) OnMessagesRequestAppWebView(f func(ctx context.Context, request *MessagesRequestAppWebViewRequest) (*AppWebViewResultURL, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesRequestAppWebViewRequest
@@ -6180,6 +7208,9 @@ func (s *ServerDispatcher) OnMessagesRequestAppWebView(f func(ctx context.Contex
 	s.handlers[MessagesRequestAppWebViewRequestTypeID] = handler
 }
 
+// OnMessagesSetChatWallPaper sets handler of messages.setChatWallPaper#8ffacae1 method.
+//
+// See https://core.telegram.org/method/messages.setChatWallPaper for reference.
 func (s *ServerDispatcher) OnMessagesSetChatWallPaper(f func(ctx context.Context, request *MessagesSetChatWallPaperRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSetChatWallPaperRequest
@@ -6197,6 +7228,9 @@ func (s *ServerDispatcher) OnMessagesSetChatWallPaper(f func(ctx context.Context
 	s.handlers[MessagesSetChatWallPaperRequestTypeID] = handler
 }
 
+// OnMessagesSearchEmojiStickerSets sets handler of messages.searchEmojiStickerSets#92b4494c method.
+//
+// See https://core.telegram.org/method/messages.searchEmojiStickerSets for reference.
 func (s *ServerDispatcher) OnMessagesSearchEmojiStickerSets(f func(ctx context.Context, request *MessagesSearchEmojiStickerSetsRequest) (MessagesFoundStickerSetsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSearchEmojiStickerSetsRequest
@@ -6214,6 +7248,9 @@ func (s *ServerDispatcher) OnMessagesSearchEmojiStickerSets(f func(ctx context.C
 	s.handlers[MessagesSearchEmojiStickerSetsRequestTypeID] = handler
 }
 
+// OnMessagesGetSavedDialogs sets handler of messages.getSavedDialogs#5381d21a method.
+//
+// See https://core.telegram.org/method/messages.getSavedDialogs for reference.
 func (s *ServerDispatcher) OnMessagesGetSavedDialogs(f func(ctx context.Context, request *MessagesGetSavedDialogsRequest) (MessagesSavedDialogsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSavedDialogsRequest
@@ -6231,6 +7268,9 @@ func (s *ServerDispatcher) OnMessagesGetSavedDialogs(f func(ctx context.Context,
 	s.handlers[MessagesGetSavedDialogsRequestTypeID] = handler
 }
 
+// OnMessagesGetSavedHistory sets handler of messages.getSavedHistory#3d9a414d method.
+//
+// See https://core.telegram.org/method/messages.getSavedHistory for reference.
 func (s *ServerDispatcher) OnMessagesGetSavedHistory(f func(ctx context.Context, request *MessagesGetSavedHistoryRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSavedHistoryRequest
@@ -6248,6 +7288,9 @@ func (s *ServerDispatcher) OnMessagesGetSavedHistory(f func(ctx context.Context,
 	s.handlers[MessagesGetSavedHistoryRequestTypeID] = handler
 }
 
+// OnMessagesDeleteSavedHistory sets handler of messages.deleteSavedHistory#6e98102b method.
+//
+// See https://core.telegram.org/method/messages.deleteSavedHistory for reference.
 func (s *ServerDispatcher) OnMessagesDeleteSavedHistory(f func(ctx context.Context, request *MessagesDeleteSavedHistoryRequest) (*MessagesAffectedHistory, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteSavedHistoryRequest
@@ -6265,6 +7308,9 @@ func (s *ServerDispatcher) OnMessagesDeleteSavedHistory(f func(ctx context.Conte
 	s.handlers[MessagesDeleteSavedHistoryRequestTypeID] = handler
 }
 
+// OnMessagesGetPinnedSavedDialogs sets handler of messages.getPinnedSavedDialogs#d63d94e0 method.
+//
+// See https://core.telegram.org/method/messages.getPinnedSavedDialogs for reference.
 func (s *ServerDispatcher) OnMessagesGetPinnedSavedDialogs(f func(ctx context.Context) (MessagesSavedDialogsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetPinnedSavedDialogsRequest
@@ -6282,6 +7328,9 @@ func (s *ServerDispatcher) OnMessagesGetPinnedSavedDialogs(f func(ctx context.Co
 	s.handlers[MessagesGetPinnedSavedDialogsRequestTypeID] = handler
 }
 
+// OnMessagesToggleSavedDialogPin sets handler of messages.toggleSavedDialogPin#ac81bbde method.
+//
+// See https://core.telegram.org/method/messages.toggleSavedDialogPin for reference.
 func (s *ServerDispatcher) OnMessagesToggleSavedDialogPin(f func(ctx context.Context, request *MessagesToggleSavedDialogPinRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesToggleSavedDialogPinRequest
@@ -6303,6 +7352,9 @@ func (s *ServerDispatcher) OnMessagesToggleSavedDialogPin(f func(ctx context.Con
 	s.handlers[MessagesToggleSavedDialogPinRequestTypeID] = handler
 }
 
+// OnMessagesReorderPinnedSavedDialogs sets handler of messages.reorderPinnedSavedDialogs#8b716587 method.
+//
+// See https://core.telegram.org/method/messages.reorderPinnedSavedDialogs for reference.
 func (s *ServerDispatcher) OnMessagesReorderPinnedSavedDialogs(f func(ctx context.Context, request *MessagesReorderPinnedSavedDialogsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReorderPinnedSavedDialogsRequest
@@ -6324,6 +7376,9 @@ func (s *ServerDispatcher) OnMessagesReorderPinnedSavedDialogs(f func(ctx contex
 	s.handlers[MessagesReorderPinnedSavedDialogsRequestTypeID] = handler
 }
 
+// OnMessagesGetSavedReactionTags sets handler of messages.getSavedReactionTags#3637e05b method.
+//
+// See https://core.telegram.org/method/messages.getSavedReactionTags for reference.
 func (s *ServerDispatcher) OnMessagesGetSavedReactionTags(f func(ctx context.Context, request *MessagesGetSavedReactionTagsRequest) (MessagesSavedReactionTagsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetSavedReactionTagsRequest
@@ -6341,6 +7396,9 @@ func (s *ServerDispatcher) OnMessagesGetSavedReactionTags(f func(ctx context.Con
 	s.handlers[MessagesGetSavedReactionTagsRequestTypeID] = handler
 }
 
+// OnMessagesUpdateSavedReactionTag sets handler of messages.updateSavedReactionTag#60297dec method.
+//
+// See https://core.telegram.org/method/messages.updateSavedReactionTag for reference.
 func (s *ServerDispatcher) OnMessagesUpdateSavedReactionTag(f func(ctx context.Context, request *MessagesUpdateSavedReactionTagRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesUpdateSavedReactionTagRequest
@@ -6362,6 +7420,9 @@ func (s *ServerDispatcher) OnMessagesUpdateSavedReactionTag(f func(ctx context.C
 	s.handlers[MessagesUpdateSavedReactionTagRequestTypeID] = handler
 }
 
+// OnMessagesGetDefaultTagReactions sets handler of messages.getDefaultTagReactions#bdf93428 method.
+//
+// See https://core.telegram.org/method/messages.getDefaultTagReactions for reference.
 func (s *ServerDispatcher) OnMessagesGetDefaultTagReactions(f func(ctx context.Context, hash int64) (MessagesReactionsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetDefaultTagReactionsRequest
@@ -6379,6 +7440,9 @@ func (s *ServerDispatcher) OnMessagesGetDefaultTagReactions(f func(ctx context.C
 	s.handlers[MessagesGetDefaultTagReactionsRequestTypeID] = handler
 }
 
+// OnMessagesGetOutboxReadDate sets handler of messages.getOutboxReadDate#8c4bfe5d method.
+//
+// See https://core.telegram.org/method/messages.getOutboxReadDate for reference.
 func (s *ServerDispatcher) OnMessagesGetOutboxReadDate(f func(ctx context.Context, request *MessagesGetOutboxReadDateRequest) (*OutboxReadDate, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetOutboxReadDateRequest
@@ -6396,6 +7460,9 @@ func (s *ServerDispatcher) OnMessagesGetOutboxReadDate(f func(ctx context.Contex
 	s.handlers[MessagesGetOutboxReadDateRequestTypeID] = handler
 }
 
+// OnMessagesGetQuickReplies sets handler of messages.getQuickReplies#d483f2a8 method.
+//
+// See https://core.telegram.org/method/messages.getQuickReplies for reference.
 func (s *ServerDispatcher) OnMessagesGetQuickReplies(f func(ctx context.Context, hash int64) (MessagesQuickRepliesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetQuickRepliesRequest
@@ -6413,6 +7480,9 @@ func (s *ServerDispatcher) OnMessagesGetQuickReplies(f func(ctx context.Context,
 	s.handlers[MessagesGetQuickRepliesRequestTypeID] = handler
 }
 
+// OnMessagesReorderQuickReplies sets handler of messages.reorderQuickReplies#60331907 method.
+//
+// See https://core.telegram.org/method/messages.reorderQuickReplies for reference.
 func (s *ServerDispatcher) OnMessagesReorderQuickReplies(f func(ctx context.Context, order []int) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesReorderQuickRepliesRequest
@@ -6434,6 +7504,9 @@ func (s *ServerDispatcher) OnMessagesReorderQuickReplies(f func(ctx context.Cont
 	s.handlers[MessagesReorderQuickRepliesRequestTypeID] = handler
 }
 
+// OnMessagesCheckQuickReplyShortcut sets handler of messages.checkQuickReplyShortcut#f1d0fbd3 method.
+//
+// See https://core.telegram.org/method/messages.checkQuickReplyShortcut for reference.
 func (s *ServerDispatcher) OnMessagesCheckQuickReplyShortcut(f func(ctx context.Context, shortcut string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesCheckQuickReplyShortcutRequest
@@ -6455,6 +7528,9 @@ func (s *ServerDispatcher) OnMessagesCheckQuickReplyShortcut(f func(ctx context.
 	s.handlers[MessagesCheckQuickReplyShortcutRequestTypeID] = handler
 }
 
+// OnMessagesEditQuickReplyShortcut sets handler of messages.editQuickReplyShortcut#5c003cef method.
+//
+// See https://core.telegram.org/method/messages.editQuickReplyShortcut for reference.
 func (s *ServerDispatcher) OnMessagesEditQuickReplyShortcut(f func(ctx context.Context, request *MessagesEditQuickReplyShortcutRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesEditQuickReplyShortcutRequest
@@ -6476,6 +7552,9 @@ func (s *ServerDispatcher) OnMessagesEditQuickReplyShortcut(f func(ctx context.C
 	s.handlers[MessagesEditQuickReplyShortcutRequestTypeID] = handler
 }
 
+// OnMessagesDeleteQuickReplyShortcut sets handler of messages.deleteQuickReplyShortcut#3cc04740 method.
+//
+// See https://core.telegram.org/method/messages.deleteQuickReplyShortcut for reference.
 func (s *ServerDispatcher) OnMessagesDeleteQuickReplyShortcut(f func(ctx context.Context, shortcutid int) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteQuickReplyShortcutRequest
@@ -6497,6 +7576,9 @@ func (s *ServerDispatcher) OnMessagesDeleteQuickReplyShortcut(f func(ctx context
 	s.handlers[MessagesDeleteQuickReplyShortcutRequestTypeID] = handler
 }
 
+// OnMessagesGetQuickReplyMessages sets handler of messages.getQuickReplyMessages#94a495c3 method.
+//
+// See https://core.telegram.org/method/messages.getQuickReplyMessages for reference.
 func (s *ServerDispatcher) OnMessagesGetQuickReplyMessages(f func(ctx context.Context, request *MessagesGetQuickReplyMessagesRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesGetQuickReplyMessagesRequest
@@ -6514,6 +7596,9 @@ func (s *ServerDispatcher) OnMessagesGetQuickReplyMessages(f func(ctx context.Co
 	s.handlers[MessagesGetQuickReplyMessagesRequestTypeID] = handler
 }
 
+// OnMessagesSendQuickReplyMessages sets handler of messages.sendQuickReplyMessages#33153ad4 method.
+//
+// See https://core.telegram.org/method/messages.sendQuickReplyMessages for reference.
 func (s *ServerDispatcher) OnMessagesSendQuickReplyMessages(f func(ctx context.Context, request *MessagesSendQuickReplyMessagesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesSendQuickReplyMessagesRequest
@@ -6531,6 +7616,9 @@ func (s *ServerDispatcher) OnMessagesSendQuickReplyMessages(f func(ctx context.C
 	s.handlers[MessagesSendQuickReplyMessagesRequestTypeID] = handler
 }
 
+// OnMessagesDeleteQuickReplyMessages sets handler of messages.deleteQuickReplyMessages#e105e910 method.
+//
+// See https://core.telegram.org/method/messages.deleteQuickReplyMessages for reference.
 func (s *ServerDispatcher) OnMessagesDeleteQuickReplyMessages(f func(ctx context.Context, request *MessagesDeleteQuickReplyMessagesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesDeleteQuickReplyMessagesRequest
@@ -6548,6 +7636,9 @@ func (s *ServerDispatcher) OnMessagesDeleteQuickReplyMessages(f func(ctx context
 	s.handlers[MessagesDeleteQuickReplyMessagesRequestTypeID] = handler
 }
 
+// OnMessagesToggleDialogFilterTags sets handler of messages.toggleDialogFilterTags#fd2dda49 method.
+//
+// See https://core.telegram.org/method/messages.toggleDialogFilterTags for reference.
 func (s *ServerDispatcher) OnMessagesToggleDialogFilterTags(f func(ctx context.Context, enabled bool) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request MessagesToggleDialogFilterTagsRequest
@@ -6569,6 +7660,9 @@ func (s *ServerDispatcher) OnMessagesToggleDialogFilterTags(f func(ctx context.C
 	s.handlers[MessagesToggleDialogFilterTagsRequestTypeID] = handler
 }
 
+// OnUpdatesGetState sets handler of updates.getState#edd4882a method.
+//
+// See https://core.telegram.org/method/updates.getState for reference.
 func (s *ServerDispatcher) OnUpdatesGetState(f func(ctx context.Context) (*UpdatesState, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UpdatesGetStateRequest
@@ -6586,6 +7680,9 @@ func (s *ServerDispatcher) OnUpdatesGetState(f func(ctx context.Context) (*Updat
 	s.handlers[UpdatesGetStateRequestTypeID] = handler
 }
 
+// OnUpdatesGetDifference sets handler of updates.getDifference#19c2f763 method.
+//
+// See https://core.telegram.org/method/updates.getDifference for reference.
 func (s *ServerDispatcher) OnUpdatesGetDifference(f func(ctx context.Context, request *UpdatesGetDifferenceRequest) (UpdatesDifferenceClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UpdatesGetDifferenceRequest
@@ -6603,6 +7700,9 @@ func (s *ServerDispatcher) OnUpdatesGetDifference(f func(ctx context.Context, re
 	s.handlers[UpdatesGetDifferenceRequestTypeID] = handler
 }
 
+// OnUpdatesGetChannelDifference sets handler of updates.getChannelDifference#3173d78 method.
+//
+// See https://core.telegram.org/method/updates.getChannelDifference for reference.
 func (s *ServerDispatcher) OnUpdatesGetChannelDifference(f func(ctx context.Context, request *UpdatesGetChannelDifferenceRequest) (UpdatesChannelDifferenceClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UpdatesGetChannelDifferenceRequest
@@ -6620,6 +7720,9 @@ func (s *ServerDispatcher) OnUpdatesGetChannelDifference(f func(ctx context.Cont
 	s.handlers[UpdatesGetChannelDifferenceRequestTypeID] = handler
 }
 
+// OnPhotosUpdateProfilePhoto sets handler of photos.updateProfilePhoto#9e82039 method.
+//
+// See https://core.telegram.org/method/photos.updateProfilePhoto for reference.
 func (s *ServerDispatcher) OnPhotosUpdateProfilePhoto(f func(ctx context.Context, request *PhotosUpdateProfilePhotoRequest) (*PhotosPhoto, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhotosUpdateProfilePhotoRequest
@@ -6637,6 +7740,9 @@ func (s *ServerDispatcher) OnPhotosUpdateProfilePhoto(f func(ctx context.Context
 	s.handlers[PhotosUpdateProfilePhotoRequestTypeID] = handler
 }
 
+// OnPhotosUploadProfilePhoto sets handler of photos.uploadProfilePhoto#388a3b5 method.
+//
+// See https://core.telegram.org/method/photos.uploadProfilePhoto for reference.
 func (s *ServerDispatcher) OnPhotosUploadProfilePhoto(f func(ctx context.Context, request *PhotosUploadProfilePhotoRequest) (*PhotosPhoto, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhotosUploadProfilePhotoRequest
@@ -6654,6 +7760,9 @@ func (s *ServerDispatcher) OnPhotosUploadProfilePhoto(f func(ctx context.Context
 	s.handlers[PhotosUploadProfilePhotoRequestTypeID] = handler
 }
 
+// OnPhotosDeletePhotos sets handler of photos.deletePhotos#87cf7f2f method.
+//
+// See https://core.telegram.org/method/photos.deletePhotos for reference.
 func (s *ServerDispatcher) OnPhotosDeletePhotos(f func(ctx context.Context, id []InputPhotoClass) ([]int64, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhotosDeletePhotosRequest
@@ -6671,6 +7780,9 @@ func (s *ServerDispatcher) OnPhotosDeletePhotos(f func(ctx context.Context, id [
 	s.handlers[PhotosDeletePhotosRequestTypeID] = handler
 }
 
+// OnPhotosGetUserPhotos sets handler of photos.getUserPhotos#91cd32a8 method.
+//
+// See https://core.telegram.org/method/photos.getUserPhotos for reference.
 func (s *ServerDispatcher) OnPhotosGetUserPhotos(f func(ctx context.Context, request *PhotosGetUserPhotosRequest) (PhotosPhotosClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhotosGetUserPhotosRequest
@@ -6688,6 +7800,9 @@ func (s *ServerDispatcher) OnPhotosGetUserPhotos(f func(ctx context.Context, req
 	s.handlers[PhotosGetUserPhotosRequestTypeID] = handler
 }
 
+// OnPhotosUploadContactProfilePhoto sets handler of photos.uploadContactProfilePhoto#e14c4a71 method.
+//
+// See https://core.telegram.org/method/photos.uploadContactProfilePhoto for reference.
 func (s *ServerDispatcher) OnPhotosUploadContactProfilePhoto(f func(ctx context.Context, request *PhotosUploadContactProfilePhotoRequest) (*PhotosPhoto, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhotosUploadContactProfilePhotoRequest
@@ -6705,6 +7820,9 @@ func (s *ServerDispatcher) OnPhotosUploadContactProfilePhoto(f func(ctx context.
 	s.handlers[PhotosUploadContactProfilePhotoRequestTypeID] = handler
 }
 
+// OnUploadSaveFilePart sets handler of upload.saveFilePart#b304a621 method.
+//
+// See https://core.telegram.org/method/upload.saveFilePart for reference.
 func (s *ServerDispatcher) OnUploadSaveFilePart(f func(ctx context.Context, request *UploadSaveFilePartRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadSaveFilePartRequest
@@ -6726,6 +7844,9 @@ func (s *ServerDispatcher) OnUploadSaveFilePart(f func(ctx context.Context, requ
 	s.handlers[UploadSaveFilePartRequestTypeID] = handler
 }
 
+// OnUploadGetFile sets handler of upload.getFile#be5335be method.
+//
+// See https://core.telegram.org/method/upload.getFile for reference.
 func (s *ServerDispatcher) OnUploadGetFile(f func(ctx context.Context, request *UploadGetFileRequest) (UploadFileClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadGetFileRequest
@@ -6743,6 +7864,9 @@ func (s *ServerDispatcher) OnUploadGetFile(f func(ctx context.Context, request *
 	s.handlers[UploadGetFileRequestTypeID] = handler
 }
 
+// OnUploadSaveBigFilePart sets handler of upload.saveBigFilePart#de7b673d method.
+//
+// See https://core.telegram.org/method/upload.saveBigFilePart for reference.
 func (s *ServerDispatcher) OnUploadSaveBigFilePart(f func(ctx context.Context, request *UploadSaveBigFilePartRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadSaveBigFilePartRequest
@@ -6764,6 +7888,9 @@ func (s *ServerDispatcher) OnUploadSaveBigFilePart(f func(ctx context.Context, r
 	s.handlers[UploadSaveBigFilePartRequestTypeID] = handler
 }
 
+// OnUploadGetWebFile sets handler of upload.getWebFile#24e6818d method.
+//
+// See https://core.telegram.org/method/upload.getWebFile for reference.
 func (s *ServerDispatcher) OnUploadGetWebFile(f func(ctx context.Context, request *UploadGetWebFileRequest) (*UploadWebFile, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadGetWebFileRequest
@@ -6781,6 +7908,9 @@ func (s *ServerDispatcher) OnUploadGetWebFile(f func(ctx context.Context, reques
 	s.handlers[UploadGetWebFileRequestTypeID] = handler
 }
 
+// OnUploadGetCDNFile sets handler of upload.getCdnFile#395f69da method.
+//
+// See https://core.telegram.org/method/upload.getCdnFile for reference.
 func (s *ServerDispatcher) OnUploadGetCDNFile(f func(ctx context.Context, request *UploadGetCDNFileRequest) (UploadCDNFileClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadGetCDNFileRequest
@@ -6798,6 +7928,9 @@ func (s *ServerDispatcher) OnUploadGetCDNFile(f func(ctx context.Context, reques
 	s.handlers[UploadGetCDNFileRequestTypeID] = handler
 }
 
+// OnUploadReuploadCDNFile sets handler of upload.reuploadCdnFile#9b2754a8 method.
+//
+// See https://core.telegram.org/method/upload.reuploadCdnFile for reference.
 func (s *ServerDispatcher) OnUploadReuploadCDNFile(f func(ctx context.Context, request *UploadReuploadCDNFileRequest) ([]FileHash, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadReuploadCDNFileRequest
@@ -6815,6 +7948,9 @@ func (s *ServerDispatcher) OnUploadReuploadCDNFile(f func(ctx context.Context, r
 	s.handlers[UploadReuploadCDNFileRequestTypeID] = handler
 }
 
+// OnUploadGetCDNFileHashes sets handler of upload.getCdnFileHashes#91dc3f31 method.
+//
+// See https://core.telegram.org/method/upload.getCdnFileHashes for reference.
 func (s *ServerDispatcher) OnUploadGetCDNFileHashes(f func(ctx context.Context, request *UploadGetCDNFileHashesRequest) ([]FileHash, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadGetCDNFileHashesRequest
@@ -6832,6 +7968,9 @@ func (s *ServerDispatcher) OnUploadGetCDNFileHashes(f func(ctx context.Context,
 	s.handlers[UploadGetCDNFileHashesRequestTypeID] = handler
 }
 
+// OnUploadGetFileHashes sets handler of upload.getFileHashes#9156982a method.
+//
+// See https://core.telegram.org/method/upload.getFileHashes for reference.
 func (s *ServerDispatcher) OnUploadGetFileHashes(f func(ctx context.Context, request *UploadGetFileHashesRequest) ([]FileHash, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request UploadGetFileHashesRequest
@@ -6849,6 +7988,9 @@ func (s *ServerDispatcher) OnUploadGetFileHashes(f func(ctx context.Context, req
 	s.handlers[UploadGetFileHashesRequestTypeID] = handler
 }
 
+// OnHelpGetConfig sets handler of help.getConfig#c4f9186b method.
+//
+// See https://core.telegram.org/method/help.getConfig for reference.
 func (s *ServerDispatcher) OnHelpGetConfig(f func(ctx context.Context) (*Config, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetConfigRequest
@@ -6866,6 +8008,9 @@ func (s *ServerDispatcher) OnHelpGetConfig(f func(ctx context.Context) (*Config,
 	s.handlers[HelpGetConfigRequestTypeID] = handler
 }
 
+// OnHelpGetNearestDC sets handler of help.getNearestDc#1fb33026 method.
+//
+// See https://core.telegram.org/method/help.getNearestDc for reference.
 func (s *ServerDispatcher) OnHelpGetNearestDC(f func(ctx context.Context) (*NearestDC, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetNearestDCRequest
@@ -6883,6 +8028,9 @@ func (s *ServerDispatcher) OnHelpGetNearestDC(f func(ctx context.Context) (*Near
 	s.handlers[HelpGetNearestDCRequestTypeID] = handler
 }
 
+// OnHelpGetAppUpdate sets handler of help.getAppUpdate#522d5a7d method.
+//
+// See https://core.telegram.org/method/help.getAppUpdate for reference.
 func (s *ServerDispatcher) OnHelpGetAppUpdate(f func(ctx context.Context, source string) (HelpAppUpdateClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetAppUpdateRequest
@@ -6900,6 +8048,9 @@ func (s *ServerDispatcher) OnHelpGetAppUpdate(f func(ctx context.Context, source
 	s.handlers[HelpGetAppUpdateRequestTypeID] = handler
 }
 
+// OnHelpGetInviteText sets handler of help.getInviteText#4d392343 method.
+//
+// See https://core.telegram.org/method/help.getInviteText for reference.
 func (s *ServerDispatcher) OnHelpGetInviteText(f func(ctx context.Context) (*HelpInviteText, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetInviteTextRequest
@@ -6917,6 +8068,9 @@ func (s *ServerDispatcher) OnHelpGetInviteText(f func(ctx context.Context) (*Hel
 	s.handlers[HelpGetInviteTextRequestTypeID] = handler
 }
 
+// OnHelpGetSupport sets handler of help.getSupport#9cdf08cd method.
+//
+// See https://core.telegram.org/method/help.getSupport for reference.
 func (s *ServerDispatcher) OnHelpGetSupport(f func(ctx context.Context) (*HelpSupport, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetSupportRequest
@@ -6934,6 +8088,9 @@ func (s *ServerDispatcher) OnHelpGetSupport(f func(ctx context.Context) (*HelpSu
 	s.handlers[HelpGetSupportRequestTypeID] = handler
 }
 
+// OnHelpSetBotUpdatesStatus sets handler of help.setBotUpdatesStatus#ec22cfcd method.
+//
+// See https://core.telegram.org/method/help.setBotUpdatesStatus for reference.
 func (s *ServerDispatcher) OnHelpSetBotUpdatesStatus(f func(ctx context.Context, request *HelpSetBotUpdatesStatusRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpSetBotUpdatesStatusRequest
@@ -6955,6 +8112,9 @@ func (s *ServerDispatcher) OnHelpSetBotUpdatesStatus(f func(ctx context.Context,
 	s.handlers[HelpSetBotUpdatesStatusRequestTypeID] = handler
 }
 
+// OnHelpGetCDNConfig sets handler of help.getCdnConfig#52029342 method.
+//
+// See https://core.telegram.org/method/help.getCdnConfig for reference.
 func (s *ServerDispatcher) OnHelpGetCDNConfig(f func(ctx context.Context) (*CDNConfig, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetCDNConfigRequest
@@ -6972,6 +8132,9 @@ func (s *ServerDispatcher) OnHelpGetCDNConfig(f func(ctx context.Context) (*CDNC
 	s.handlers[HelpGetCDNConfigRequestTypeID] = handler
 }
 
+// OnHelpGetRecentMeURLs sets handler of help.getRecentMeUrls#3dc0f114 method.
+//
+// See https://core.telegram.org/method/help.getRecentMeUrls for reference.
 func (s *ServerDispatcher) OnHelpGetRecentMeURLs(f func(ctx context.Context, referer string) (*HelpRecentMeURLs, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetRecentMeURLsRequest
@@ -6989,6 +8152,9 @@ func (s *ServerDispatcher) OnHelpGetRecentMeURLs(f func(ctx context.Context, ref
 	s.handlers[HelpGetRecentMeURLsRequestTypeID] = handler
 }
 
+// OnHelpGetTermsOfServiceUpdate sets handler of help.getTermsOfServiceUpdate#2ca51fd1 method.
+//
+// See https://core.telegram.org/method/help.getTermsOfServiceUpdate for reference.
 func (s *ServerDispatcher) OnHelpGetTermsOfServiceUpdate(f func(ctx context.Context) (HelpTermsOfServiceUpdateClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetTermsOfServiceUpdateRequest
@@ -7006,6 +8172,9 @@ func (s *ServerDispatcher) OnHelpGetTermsOfServiceUpdate(f func(ctx context.Cont
 	s.handlers[HelpGetTermsOfServiceUpdateRequestTypeID] = handler
 }
 
+// OnHelpAcceptTermsOfService sets handler of help.acceptTermsOfService#ee72f79a method.
+//
+// See https://core.telegram.org/method/help.acceptTermsOfService for reference.
 func (s *ServerDispatcher) OnHelpAcceptTermsOfService(f func(ctx context.Context, id DataJSON) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpAcceptTermsOfServiceRequest
@@ -7027,6 +8196,9 @@ func (s *ServerDispatcher) OnHelpAcceptTermsOfService(f func(ctx context.Context
 	s.handlers[HelpAcceptTermsOfServiceRequestTypeID] = handler
 }
 
+// OnHelpGetDeepLinkInfo sets handler of help.getDeepLinkInfo#3fedc75f method.
+//
+// See https://core.telegram.org/method/help.getDeepLinkInfo for reference.
 func (s *ServerDispatcher) OnHelpGetDeepLinkInfo(f func(ctx context.Context, path string) (HelpDeepLinkInfoClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetDeepLinkInfoRequest
@@ -7044,6 +8216,9 @@ func (s *ServerDispatcher) OnHelpGetDeepLinkInfo(f func(ctx context.Context, pat
 	s.handlers[HelpGetDeepLinkInfoRequestTypeID] = handler
 }
 
+// OnHelpGetAppConfig sets handler of help.getAppConfig#61e3f854 method.
+//
+// See https://core.telegram.org/method/help.getAppConfig for reference.
 func (s *ServerDispatcher) OnHelpGetAppConfig(f func(ctx context.Context, hash int) (HelpAppConfigClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetAppConfigRequest
@@ -7061,6 +8236,9 @@ func (s *ServerDispatcher) OnHelpGetAppConfig(f func(ctx context.Context, hash i
 	s.handlers[HelpGetAppConfigRequestTypeID] = handler
 }
 
+// OnHelpSaveAppLog sets handler of help.saveAppLog#6f02f748 method.
+//
+// See https://core.telegram.org/method/help.saveAppLog for reference.
 func (s *ServerDispatcher) OnHelpSaveAppLog(f func(ctx context.Context, events []InputAppEvent) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpSaveAppLogRequest
@@ -7082,6 +8260,9 @@ func (s *ServerDispatcher) OnHelpSaveAppLog(f func(ctx context.Context, events [
 	s.handlers[HelpSaveAppLogRequestTypeID] = handler
 }
 
+// OnHelpGetPassportConfig sets handler of help.getPassportConfig#c661ad08 method.
+//
+// See https://core.telegram.org/method/help.getPassportConfig for reference.
 func (s *ServerDispatcher) OnHelpGetPassportConfig(f func(ctx context.Context, hash int) (HelpPassportConfigClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetPassportConfigRequest
@@ -7099,6 +8280,9 @@ func (s *ServerDispatcher) OnHelpGetPassportConfig(f func(ctx context.Context, h
 	s.handlers[HelpGetPassportConfigRequestTypeID] = handler
 }
 
+// OnHelpGetSupportName sets handler of help.getSupportName#d360e72c method.
+//
+// See https://core.telegram.org/method/help.getSupportName for reference.
 func (s *ServerDispatcher) OnHelpGetSupportName(f func(ctx context.Context) (*HelpSupportName, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetSupportNameRequest
@@ -7116,6 +8300,9 @@ func (s *ServerDispatcher) OnHelpGetSupportName(f func(ctx context.Context) (*He
 	s.handlers[HelpGetSupportNameRequestTypeID] = handler
 }
 
+// OnHelpGetUserInfo sets handler of help.getUserInfo#38a08d3 method.
+//
+// See https://core.telegram.org/method/help.getUserInfo for reference.
 func (s *ServerDispatcher) OnHelpGetUserInfo(f func(ctx context.Context, userid InputUserClass) (HelpUserInfoClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetUserInfoRequest
@@ -7133,6 +8320,9 @@ func (s *ServerDispatcher) OnHelpGetUserInfo(f func(ctx context.Context, userid
 	s.handlers[HelpGetUserInfoRequestTypeID] = handler
 }
 
+// OnHelpEditUserInfo sets handler of help.editUserInfo#66b91b70 method.
+//
+// See https://core.telegram.org/method/help.editUserInfo for reference.
 func (s *ServerDispatcher) OnHelpEditUserInfo(f func(ctx context.Context, request *HelpEditUserInfoRequest) (HelpUserInfoClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpEditUserInfoRequest
@@ -7150,6 +8340,9 @@ func (s *ServerDispatcher) OnHelpEditUserInfo(f func(ctx context.Context, reques
 	s.handlers[HelpEditUserInfoRequestTypeID] = handler
 }
 
+// OnHelpGetPromoData sets handler of help.getPromoData#c0977421 method.
+//
+// See https://core.telegram.org/method/help.getPromoData for reference.
 func (s *ServerDispatcher) OnHelpGetPromoData(f func(ctx context.Context) (HelpPromoDataClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetPromoDataRequest
@@ -7167,6 +8360,9 @@ func (s *ServerDispatcher) OnHelpGetPromoData(f func(ctx context.Context) (HelpP
 	s.handlers[HelpGetPromoDataRequestTypeID] = handler
 }
 
+// OnHelpHidePromoData sets handler of help.hidePromoData#1e251c95 method.
+//
+// See https://core.telegram.org/method/help.hidePromoData for reference.
 func (s *ServerDispatcher) OnHelpHidePromoData(f func(ctx context.Context, peer InputPeerClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpHidePromoDataRequest
@@ -7188,6 +8384,9 @@ func (s *ServerDispatcher) OnHelpHidePromoData(f func(ctx context.Context, peer
 	s.handlers[HelpHidePromoDataRequestTypeID] = handler
 }
 
+// OnHelpDismissSuggestion sets handler of help.dismissSuggestion#f50dbaa1 method.
+//
+// See https://core.telegram.org/method/help.dismissSuggestion for reference.
 func (s *ServerDispatcher) OnHelpDismissSuggestion(f func(ctx context.Context, request *HelpDismissSuggestionRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpDismissSuggestionRequest
@@ -7209,6 +8408,9 @@ func (s *ServerDispatcher) OnHelpDismissSuggestion(f func(ctx context.Context, r
 	s.handlers[HelpDismissSuggestionRequestTypeID] = handler
 }
 
+// OnHelpGetCountriesList sets handler of help.getCountriesList#735787a8 method.
+//
+// See https://core.telegram.org/method/help.getCountriesList for reference.
 func (s *ServerDispatcher) OnHelpGetCountriesList(f func(ctx context.Context, request *HelpGetCountriesListRequest) (HelpCountriesListClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetCountriesListRequest
@@ -7226,6 +8428,9 @@ func (s *ServerDispatcher) OnHelpGetCountriesList(f func(ctx context.Context, re
 	s.handlers[HelpGetCountriesListRequestTypeID] = handler
 }
 
+// OnHelpGetPremiumPromo sets handler of help.getPremiumPromo#b81b93d4 method.
+//
+// See https://core.telegram.org/method/help.getPremiumPromo for reference.
 func (s *ServerDispatcher) OnHelpGetPremiumPromo(f func(ctx context.Context) (*HelpPremiumPromo, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetPremiumPromoRequest
@@ -7243,6 +8448,9 @@ func (s *ServerDispatcher) OnHelpGetPremiumPromo(f func(ctx context.Context) (*H
 	s.handlers[HelpGetPremiumPromoRequestTypeID] = handler
 }
 
+// OnHelpGetPeerColors sets handler of help.getPeerColors#da80f42f method.
+//
+// See https://core.telegram.org/method/help.getPeerColors for reference.
 func (s *ServerDispatcher) OnHelpGetPeerColors(f func(ctx context.Context, hash int) (HelpPeerColorsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetPeerColorsRequest
@@ -7260,6 +8468,9 @@ func (s *ServerDispatcher) OnHelpGetPeerColors(f func(ctx context.Context, hash
 	s.handlers[HelpGetPeerColorsRequestTypeID] = handler
 }
 
+// OnHelpGetPeerProfileColors sets handler of help.getPeerProfileColors#abcfa9fd method.
+//
+// See https://core.telegram.org/method/help.getPeerProfileColors for reference.
 func (s *ServerDispatcher) OnHelpGetPeerProfileColors(f func(ctx context.Context, hash int) (HelpPeerColorsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetPeerProfileColorsRequest
@@ -7277,6 +8488,9 @@ func (s *ServerDispatcher) OnHelpGetPeerProfileColors(f func(ctx context.Context
 	s.handlers[HelpGetPeerProfileColorsRequestTypeID] = handler
 }
 
+// OnHelpGetTimezonesList sets handler of help.getTimezonesList#49b30240 method.
+//
+// See https://core.telegram.org/method/help.getTimezonesList for reference.
 func (s *ServerDispatcher) OnHelpGetTimezonesList(f func(ctx context.Context, hash int) (HelpTimezonesListClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request HelpGetTimezonesListRequest
@@ -7294,6 +8508,9 @@ func (s *ServerDispatcher) OnHelpGetTimezonesList(f func(ctx context.Context, ha
 	s.handlers[HelpGetTimezonesListRequestTypeID] = handler
 }
 
+// OnChannelsReadHistory sets handler of channels.readHistory#cc104937 method.
+//
+// See https://core.telegram.org/method/channels.readHistory for reference.
 func (s *ServerDispatcher) OnChannelsReadHistory(f func(ctx context.Context, request *ChannelsReadHistoryRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsReadHistoryRequest
@@ -7315,6 +8532,9 @@ func (s *ServerDispatcher) OnChannelsReadHistory(f func(ctx context.Context, req
 	s.handlers[ChannelsReadHistoryRequestTypeID] = handler
 }
 
+// OnChannelsDeleteMessages sets handler of channels.deleteMessages#84c1fd4e method.
+//
+// See https://core.telegram.org/method/channels.deleteMessages for reference.
 func (s *ServerDispatcher) OnChannelsDeleteMessages(f func(ctx context.Context, request *ChannelsDeleteMessagesRequest) (*MessagesAffectedMessages, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsDeleteMessagesRequest
@@ -7332,6 +8552,9 @@ func (s *ServerDispatcher) OnChannelsDeleteMessages(f func(ctx context.Context,
 	s.handlers[ChannelsDeleteMessagesRequestTypeID] = handler
 }
 
+// OnChannelsReportSpam sets handler of channels.reportSpam#f44a8315 method.
+//
+// See https://core.telegram.org/method/channels.reportSpam for reference.
 func (s *ServerDispatcher) OnChannelsReportSpam(f func(ctx context.Context, request *ChannelsReportSpamRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsReportSpamRequest
@@ -7353,6 +8576,9 @@ func (s *ServerDispatcher) OnChannelsReportSpam(f func(ctx context.Context, requ
 	s.handlers[ChannelsReportSpamRequestTypeID] = handler
 }
 
+// OnChannelsGetMessages sets handler of channels.getMessages#ad8c9a23 method.
+//
+// See https://core.telegram.org/method/channels.getMessages for reference.
 func (s *ServerDispatcher) OnChannelsGetMessages(f func(ctx context.Context, request *ChannelsGetMessagesRequest) (MessagesMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetMessagesRequest
@@ -7370,6 +8596,9 @@ func (s *ServerDispatcher) OnChannelsGetMessages(f func(ctx context.Context, req
 	s.handlers[ChannelsGetMessagesRequestTypeID] = handler
 }
 
+// OnChannelsGetParticipants sets handler of channels.getParticipants#77ced9d0 method.
+//
+// See https://core.telegram.org/method/channels.getParticipants for reference.
 func (s *ServerDispatcher) OnChannelsGetParticipants(f func(ctx context.Context, request *ChannelsGetParticipantsRequest) (ChannelsChannelParticipantsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetParticipantsRequest
@@ -7387,6 +8616,9 @@ func (s *ServerDispatcher) OnChannelsGetParticipants(f func(ctx context.Context,
 	s.handlers[ChannelsGetParticipantsRequestTypeID] = handler
 }
 
+// OnChannelsGetParticipant sets handler of channels.getParticipant#a0ab6cc6 method.
+//
+// See https://core.telegram.org/method/channels.getParticipant for reference.
 func (s *ServerDispatcher) OnChannelsGetParticipant(f func(ctx context.Context, request *ChannelsGetParticipantRequest) (*ChannelsChannelParticipant, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetParticipantRequest
@@ -7404,6 +8636,9 @@ func (s *ServerDispatcher) OnChannelsGetParticipant(f func(ctx context.Context,
 	s.handlers[ChannelsGetParticipantRequestTypeID] = handler
 }
 
+// OnChannelsGetChannels sets handler of channels.getChannels#a7f6bbb method.
+//
+// See https://core.telegram.org/method/channels.getChannels for reference.
 func (s *ServerDispatcher) OnChannelsGetChannels(f func(ctx context.Context, id []InputChannelClass) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetChannelsRequest
@@ -7421,6 +8656,9 @@ func (s *ServerDispatcher) OnChannelsGetChannels(f func(ctx context.Context, id
 	s.handlers[ChannelsGetChannelsRequestTypeID] = handler
 }
 
+// OnChannelsGetFullChannel sets handler of channels.getFullChannel#8736a09 method.
+//
+// See https://core.telegram.org/method/channels.getFullChannel for reference.
 func (s *ServerDispatcher) OnChannelsGetFullChannel(f func(ctx context.Context, channel InputChannelClass) (*MessagesChatFull, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetFullChannelRequest
@@ -7438,6 +8676,9 @@ func (s *ServerDispatcher) OnChannelsGetFullChannel(f func(ctx context.Context,
 	s.handlers[ChannelsGetFullChannelRequestTypeID] = handler
 }
 
+// OnChannelsCreateChannel sets handler of channels.createChannel#91006707 method.
+//
+// See https://core.telegram.org/method/channels.createChannel for reference.
 func (s *ServerDispatcher) OnChannelsCreateChannel(f func(ctx context.Context, request *ChannelsCreateChannelRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsCreateChannelRequest
@@ -7455,6 +8696,9 @@ func (s *ServerDispatcher) OnChannelsCreateChannel(f func(ctx context.Context, r
 	s.handlers[ChannelsCreateChannelRequestTypeID] = handler
 }
 
+// OnChannelsEditAdmin sets handler of channels.editAdmin#d33c8902 method.
+//
+// See https://core.telegram.org/method/channels.editAdmin for reference.
 func (s *ServerDispatcher) OnChannelsEditAdmin(f func(ctx context.Context, request *ChannelsEditAdminRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsEditAdminRequest
@@ -7472,6 +8716,9 @@ func (s *ServerDispatcher) OnChannelsEditAdmin(f func(ctx context.Context, reque
 	s.handlers[ChannelsEditAdminRequestTypeID] = handler
 }
 
+// OnChannelsEditTitle sets handler of channels.editTitle#566decd0 method.
+//
+// See https://core.telegram.org/method/channels.editTitle for reference.
 func (s *ServerDispatcher) OnChannelsEditTitle(f func(ctx context.Context, request *ChannelsEditTitleRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsEditTitleRequest
@@ -7489,6 +8736,9 @@ func (s *ServerDispatcher) OnChannelsEditTitle(f func(ctx context.Context, reque
 	s.handlers[ChannelsEditTitleRequestTypeID] = handler
 }
 
+// OnChannelsEditPhoto sets handler of channels.editPhoto#f12e57c9 method.
+//
+// See https://core.telegram.org/method/channels.editPhoto for reference.
 func (s *ServerDispatcher) OnChannelsEditPhoto(f func(ctx context.Context, request *ChannelsEditPhotoRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsEditPhotoRequest
@@ -7506,6 +8756,9 @@ func (s *ServerDispatcher) OnChannelsEditPhoto(f func(ctx context.Context, reque
 	s.handlers[ChannelsEditPhotoRequestTypeID] = handler
 }
 
+// OnChannelsCheckUsername sets handler of channels.checkUsername#10e6bd2c method.
+//
+// See https://core.telegram.org/method/channels.checkUsername for reference.
 func (s *ServerDispatcher) OnChannelsCheckUsername(f func(ctx context.Context, request *ChannelsCheckUsernameRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsCheckUsernameRequest
@@ -7527,6 +8780,9 @@ func (s *ServerDispatcher) OnChannelsCheckUsername(f func(ctx context.Context, r
 	s.handlers[ChannelsCheckUsernameRequestTypeID] = handler
 }
 
+// OnChannelsUpdateUsername sets handler of channels.updateUsername#3514b3de method.
+//
+// See https://core.telegram.org/method/channels.updateUsername for reference.
 func (s *ServerDispatcher) OnChannelsUpdateUsername(f func(ctx context.Context, request *ChannelsUpdateUsernameRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsUpdateUsernameRequest
@@ -7548,6 +8804,9 @@ func (s *ServerDispatcher) OnChannelsUpdateUsername(f func(ctx context.Context,
 	s.handlers[ChannelsUpdateUsernameRequestTypeID] = handler
 }
 
+// OnChannelsJoinChannel sets handler of channels.joinChannel#24b524c5 method.
+//
+// See https://core.telegram.org/method/channels.joinChannel for reference.
 func (s *ServerDispatcher) OnChannelsJoinChannel(f func(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsJoinChannelRequest
@@ -7565,6 +8824,9 @@ func (s *ServerDispatcher) OnChannelsJoinChannel(f func(ctx context.Context, cha
 	s.handlers[ChannelsJoinChannelRequestTypeID] = handler
 }
 
+// OnChannelsLeaveChannel sets handler of channels.leaveChannel#f836aa95 method.
+//
+// See https://core.telegram.org/method/channels.leaveChannel for reference.
 func (s *ServerDispatcher) OnChannelsLeaveChannel(f func(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsLeaveChannelRequest
@@ -7582,6 +8844,9 @@ func (s *ServerDispatcher) OnChannelsLeaveChannel(f func(ctx context.Context, ch
 	s.handlers[ChannelsLeaveChannelRequestTypeID] = handler
 }
 
+// OnChannelsInviteToChannel sets handler of channels.inviteToChannel#199f3a6c method.
+//
+// See https://core.telegram.org/method/channels.inviteToChannel for reference.
 func (s *ServerDispatcher) OnChannelsInviteToChannel(f func(ctx context.Context, request *ChannelsInviteToChannelRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsInviteToChannelRequest
@@ -7599,6 +8864,9 @@ func (s *ServerDispatcher) OnChannelsInviteToChannel(f func(ctx context.Context,
 	s.handlers[ChannelsInviteToChannelRequestTypeID] = handler
 }
 
+// OnChannelsDeleteChannel sets handler of channels.deleteChannel#c0111fe3 method.
+//
+// See https://core.telegram.org/method/channels.deleteChannel for reference.
 func (s *ServerDispatcher) OnChannelsDeleteChannel(f func(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsDeleteChannelRequest
@@ -7616,6 +8884,9 @@ func (s *ServerDispatcher) OnChannelsDeleteChannel(f func(ctx context.Context, c
 	s.handlers[ChannelsDeleteChannelRequestTypeID] = handler
 }
 
+// OnChannelsExportMessageLink sets handler of channels.exportMessageLink#e63fadeb method.
+//
+// See https://core.telegram.org/method/channels.exportMessageLink for reference.
 func (s *ServerDispatcher) OnChannelsExportMessageLink(f func(ctx context.Context, request *ChannelsExportMessageLinkRequest) (*ExportedMessageLink, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsExportMessageLinkRequest
@@ -7633,6 +8904,9 @@ func (s *ServerDispatcher) OnChannelsExportMessageLink(f func(ctx context.Contex
 	s.handlers[ChannelsExportMessageLinkRequestTypeID] = handler
 }
 
+// OnChannelsToggleSignatures sets handler of channels.toggleSignatures#1f69b606 method.
+//
+// See https://core.telegram.org/method/channels.toggleSignatures for reference.
 func (s *ServerDispatcher) OnChannelsToggleSignatures(f func(ctx context.Context, request *ChannelsToggleSignaturesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleSignaturesRequest
@@ -7650,6 +8924,9 @@ func (s *ServerDispatcher) OnChannelsToggleSignatures(f func(ctx context.Context
 	s.handlers[ChannelsToggleSignaturesRequestTypeID] = handler
 }
 
+// OnChannelsGetAdminedPublicChannels sets handler of channels.getAdminedPublicChannels#f8b036af method.
+//
+// See https://core.telegram.org/method/channels.getAdminedPublicChannels for reference.
 func (s *ServerDispatcher) OnChannelsGetAdminedPublicChannels(f func(ctx context.Context, request *ChannelsGetAdminedPublicChannelsRequest) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetAdminedPublicChannelsRequest
@@ -7667,6 +8944,9 @@ func (s *ServerDispatcher) OnChannelsGetAdminedPublicChannels(f func(ctx context
 	s.handlers[ChannelsGetAdminedPublicChannelsRequestTypeID] = handler
 }
 
+// OnChannelsEditBanned sets handler of channels.editBanned#96e6cd81 method.
+//
+// See https://core.telegram.org/method/channels.editBanned for reference.
 func (s *ServerDispatcher) OnChannelsEditBanned(f func(ctx context.Context, request *ChannelsEditBannedRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsEditBannedRequest
@@ -7684,6 +8964,9 @@ func (s *ServerDispatcher) OnChannelsEditBanned(f func(ctx context.Context, requ
 	s.handlers[ChannelsEditBannedRequestTypeID] = handler
 }
 
+// OnChannelsGetAdminLog sets handler of channels.getAdminLog#33ddf480 method.
+//
+// See https://core.telegram.org/method/channels.getAdminLog for reference.
 func (s *ServerDispatcher) OnChannelsGetAdminLog(f func(ctx context.Context, request *ChannelsGetAdminLogRequest) (*ChannelsAdminLogResults, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetAdminLogRequest
@@ -7701,6 +8984,9 @@ func (s *ServerDispatcher) OnChannelsGetAdminLog(f func(ctx context.Context, req
 	s.handlers[ChannelsGetAdminLogRequestTypeID] = handler
 }
 
+// OnChannelsSetStickers sets handler of channels.setStickers#ea8ca4f9 method.
+//
+// See https://core.telegram.org/method/channels.setStickers for reference.
 func (s *ServerDispatcher) OnChannelsSetStickers(f func(ctx context.Context, request *ChannelsSetStickersRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsSetStickersRequest
@@ -7722,6 +9008,9 @@ func (s *ServerDispatcher) OnChannelsSetStickers(f func(ctx context.Context, req
 	s.handlers[ChannelsSetStickersRequestTypeID] = handler
 }
 
+// OnChannelsReadMessageContents sets handler of channels.readMessageContents#eab5dc38 method.
+//
+// See https://core.telegram.org/method/channels.readMessageContents for reference.
 func (s *ServerDispatcher) OnChannelsReadMessageContents(f func(ctx context.Context, request *ChannelsReadMessageContentsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsReadMessageContentsRequest
@@ -7743,6 +9032,9 @@ func (s *ServerDispatcher) OnChannelsReadMessageContents(f func(ctx context.Cont
 	s.handlers[ChannelsReadMessageContentsRequestTypeID] = handler
 }
 
+// OnChannelsDeleteHistory sets handler of channels.deleteHistory#9baa9647 method.
+//
+// See https://core.telegram.org/method/channels.deleteHistory for reference.
 func (s *ServerDispatcher) OnChannelsDeleteHistory(f func(ctx context.Context, request *ChannelsDeleteHistoryRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsDeleteHistoryRequest
@@ -7760,6 +9052,9 @@ func (s *ServerDispatcher) OnChannelsDeleteHistory(f func(ctx context.Context, r
 	s.handlers[ChannelsDeleteHistoryRequestTypeID] = handler
 }
 
+// OnChannelsTogglePreHistoryHidden sets handler of channels.togglePreHistoryHidden#eabbb94c method.
+//
+// See https://core.telegram.org/method/channels.togglePreHistoryHidden for reference.
 func (s *ServerDispatcher) OnChannelsTogglePreHistoryHidden(f func(ctx context.Context, request *ChannelsTogglePreHistoryHiddenRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsTogglePreHistoryHiddenRequest
@@ -7777,6 +9072,9 @@ func (s *ServerDispatcher) OnChannelsTogglePreHistoryHidden(f func(ctx context.C
 	s.handlers[ChannelsTogglePreHistoryHiddenRequestTypeID] = handler
 }
 
+// OnChannelsGetLeftChannels sets handler of channels.getLeftChannels#8341ecc0 method.
+//
+// See https://core.telegram.org/method/channels.getLeftChannels for reference.
 func (s *ServerDispatcher) OnChannelsGetLeftChannels(f func(ctx context.Context, offset int) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetLeftChannelsRequest
@@ -7794,6 +9092,9 @@ func (s *ServerDispatcher) OnChannelsGetLeftChannels(f func(ctx context.Context,
 	s.handlers[ChannelsGetLeftChannelsRequestTypeID] = handler
 }
 
+// OnChannelsGetGroupsForDiscussion sets handler of channels.getGroupsForDiscussion#f5dad378 method.
+//
+// See https://core.telegram.org/method/channels.getGroupsForDiscussion for reference.
 func (s *ServerDispatcher) OnChannelsGetGroupsForDiscussion(f func(ctx context.Context) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetGroupsForDiscussionRequest
@@ -7811,6 +9112,9 @@ func (s *ServerDispatcher) OnChannelsGetGroupsForDiscussion(f func(ctx context.C
 	s.handlers[ChannelsGetGroupsForDiscussionRequestTypeID] = handler
 }
 
+// OnChannelsSetDiscussionGroup sets handler of channels.setDiscussionGroup#40582bb2 method.
+//
+// See https://core.telegram.org/method/channels.setDiscussionGroup for reference.
 func (s *ServerDispatcher) OnChannelsSetDiscussionGroup(f func(ctx context.Context, request *ChannelsSetDiscussionGroupRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsSetDiscussionGroupRequest
@@ -7832,6 +9136,9 @@ func (s *ServerDispatcher) OnChannelsSetDiscussionGroup(f func(ctx context.Conte
 	s.handlers[ChannelsSetDiscussionGroupRequestTypeID] = handler
 }
 
+// OnChannelsEditCreator sets handler of channels.editCreator#8f38cd1f method.
+//
+// See https://core.telegram.org/method/channels.editCreator for reference.
 func (s *ServerDispatcher) OnChannelsEditCreator(f func(ctx context.Context, request *ChannelsEditCreatorRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsEditCreatorRequest
@@ -7849,6 +9156,9 @@ func (s *ServerDispatcher) OnChannelsEditCreator(f func(ctx context.Context, req
 	s.handlers[ChannelsEditCreatorRequestTypeID] = handler
 }
 
+// OnChannelsEditLocation sets handler of channels.editLocation#58e63f6d method.
+//
+// See https://core.telegram.org/method/channels.editLocation for reference.
 func (s *ServerDispatcher) OnChannelsEditLocation(f func(ctx context.Context, request *ChannelsEditLocationRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsEditLocationRequest
@@ -7870,6 +9180,9 @@ func (s *ServerDispatcher) OnChannelsEditLocation(f func(ctx context.Context, re
 	s.handlers[ChannelsEditLocationRequestTypeID] = handler
 }
 
+// OnChannelsToggleSlowMode sets handler of channels.toggleSlowMode#edd49ef0 method.
+//
+// See https://core.telegram.org/method/channels.toggleSlowMode for reference.
 func (s *ServerDispatcher) OnChannelsToggleSlowMode(f func(ctx context.Context, request *ChannelsToggleSlowModeRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleSlowModeRequest
@@ -7887,6 +9200,9 @@ func (s *ServerDispatcher) OnChannelsToggleSlowMode(f func(ctx context.Context,
 	s.handlers[ChannelsToggleSlowModeRequestTypeID] = handler
 }
 
+// OnChannelsGetInactiveChannels sets handler of channels.getInactiveChannels#11e831ee method.
+//
+// See https://core.telegram.org/method/channels.getInactiveChannels for reference.
 func (s *ServerDispatcher) OnChannelsGetInactiveChannels(f func(ctx context.Context) (*MessagesInactiveChats, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetInactiveChannelsRequest
@@ -7904,6 +9220,9 @@ func (s *ServerDispatcher) OnChannelsGetInactiveChannels(f func(ctx context.Cont
 	s.handlers[ChannelsGetInactiveChannelsRequestTypeID] = handler
 }
 
+// OnChannelsConvertToGigagroup sets handler of channels.convertToGigagroup#b290c69 method.
+//
+// See https://core.telegram.org/method/channels.convertToGigagroup for reference.
 func (s *ServerDispatcher) OnChannelsConvertToGigagroup(f func(ctx context.Context, channel InputChannelClass) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsConvertToGigagroupRequest
@@ -7921,6 +9240,9 @@ func (s *ServerDispatcher) OnChannelsConvertToGigagroup(f func(ctx context.Conte
 	s.handlers[ChannelsConvertToGigagroupRequestTypeID] = handler
 }
 
+// OnChannelsViewSponsoredMessage sets handler of channels.viewSponsoredMessage#beaedb94 method.
+//
+// See https://core.telegram.org/method/channels.viewSponsoredMessage for reference.
 func (s *ServerDispatcher) OnChannelsViewSponsoredMessage(f func(ctx context.Context, request *ChannelsViewSponsoredMessageRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsViewSponsoredMessageRequest
@@ -7942,6 +9264,9 @@ func (s *ServerDispatcher) OnChannelsViewSponsoredMessage(f func(ctx context.Con
 	s.handlers[ChannelsViewSponsoredMessageRequestTypeID] = handler
 }
 
+// OnChannelsGetSponsoredMessages sets handler of channels.getSponsoredMessages#ec210fbf method.
+//
+// See https://core.telegram.org/method/channels.getSponsoredMessages for reference.
 func (s *ServerDispatcher) OnChannelsGetSponsoredMessages(f func(ctx context.Context, channel InputChannelClass) (MessagesSponsoredMessagesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetSponsoredMessagesRequest
@@ -7959,6 +9284,9 @@ func (s *ServerDispatcher) OnChannelsGetSponsoredMessages(f func(ctx context.Con
 	s.handlers[ChannelsGetSponsoredMessagesRequestTypeID] = handler
 }
 
+// OnChannelsGetSendAs sets handler of channels.getSendAs#dc770ee method.
+//
+// See https://core.telegram.org/method/channels.getSendAs for reference.
 func (s *ServerDispatcher) OnChannelsGetSendAs(f func(ctx context.Context, peer InputPeerClass) (*ChannelsSendAsPeers, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetSendAsRequest
@@ -7976,6 +9304,9 @@ func (s *ServerDispatcher) OnChannelsGetSendAs(f func(ctx context.Context, peer
 	s.handlers[ChannelsGetSendAsRequestTypeID] = handler
 }
 
+// OnChannelsDeleteParticipantHistory sets handler of channels.deleteParticipantHistory#367544db method.
+//
+// See https://core.telegram.org/method/channels.deleteParticipantHistory for reference.
 func (s *ServerDispatcher) OnChannelsDeleteParticipantHistory(f func(ctx context.Context, request *ChannelsDeleteParticipantHistoryRequest) (*MessagesAffectedHistory, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsDeleteParticipantHistoryRequest
@@ -7993,6 +9324,9 @@ func (s *ServerDispatcher) OnChannelsDeleteParticipantHistory(f func(ctx context
 	s.handlers[ChannelsDeleteParticipantHistoryRequestTypeID] = handler
 }
 
+// OnChannelsToggleJoinToSend sets handler of channels.toggleJoinToSend#e4cb9580 method.
+//
+// See https://core.telegram.org/method/channels.toggleJoinToSend for reference.
 func (s *ServerDispatcher) OnChannelsToggleJoinToSend(f func(ctx context.Context, request *ChannelsToggleJoinToSendRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleJoinToSendRequest
@@ -8010,6 +9344,9 @@ func (s *ServerDispatcher) OnChannelsToggleJoinToSend(f func(ctx context.Context
 	s.handlers[ChannelsToggleJoinToSendRequestTypeID] = handler
 }
 
+// OnChannelsToggleJoinRequest sets handler of channels.toggleJoinRequest#4c2985b6 method.
+//
+// See https://core.telegram.org/method/channels.toggleJoinRequest for reference.
 func (s *ServerDispatcher) OnChannelsToggleJoinRequest(f func(ctx context.Context, request *ChannelsToggleJoinRequestRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleJoinRequestRequest
@@ -8027,6 +9364,9 @@ func (s *ServerDispatcher) OnChannelsToggleJoinRequest(f func(ctx context.Contex
 	s.handlers[ChannelsToggleJoinRequestRequestTypeID] = handler
 }
 
+// OnChannelsReorderUsernames sets handler of channels.reorderUsernames#b45ced1d method.
+//
+// See https://core.telegram.org/method/channels.reorderUsernames for reference.
 func (s *ServerDispatcher) OnChannelsReorderUsernames(f func(ctx context.Context, request *ChannelsReorderUsernamesRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsReorderUsernamesRequest
@@ -8048,6 +9388,9 @@ func (s *ServerDispatcher) OnChannelsReorderUsernames(f func(ctx context.Context
 	s.handlers[ChannelsReorderUsernamesRequestTypeID] = handler
 }
 
+// OnChannelsToggleUsername sets handler of channels.toggleUsername#50f24105 method.
+//
+// See https://core.telegram.org/method/channels.toggleUsername for reference.
 func (s *ServerDispatcher) OnChannelsToggleUsername(f func(ctx context.Context, request *ChannelsToggleUsernameRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleUsernameRequest
@@ -8069,6 +9412,9 @@ func (s *ServerDispatcher) OnChannelsToggleUsername(f func(ctx context.Context,
 	s.handlers[ChannelsToggleUsernameRequestTypeID] = handler
 }
 
+// OnChannelsDeactivateAllUsernames sets handler of channels.deactivateAllUsernames#a245dd3 method.
+//
+// See https://core.telegram.org/method/channels.deactivateAllUsernames for reference.
 func (s *ServerDispatcher) OnChannelsDeactivateAllUsernames(f func(ctx context.Context, channel InputChannelClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsDeactivateAllUsernamesRequest
@@ -8090,6 +9436,9 @@ func (s *ServerDispatcher) OnChannelsDeactivateAllUsernames(f func(ctx context.C
 	s.handlers[ChannelsDeactivateAllUsernamesRequestTypeID] = handler
 }
 
+// OnChannelsToggleForum sets handler of channels.toggleForum#a4298b29 method.
+//
+// See https://core.telegram.org/method/channels.toggleForum for reference.
 func (s *ServerDispatcher) OnChannelsToggleForum(f func(ctx context.Context, request *ChannelsToggleForumRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleForumRequest
@@ -8107,6 +9456,9 @@ func (s *ServerDispatcher) OnChannelsToggleForum(f func(ctx context.Context, req
 	s.handlers[ChannelsToggleForumRequestTypeID] = handler
 }
 
+// OnChannelsCreateForumTopic sets handler of channels.createForumTopic#f40c0224 method.
+//
+// See https://core.telegram.org/method/channels.createForumTopic for reference.
 func (s *ServerDispatcher) OnChannelsCreateForumTopic(f func(ctx context.Context, request *ChannelsCreateForumTopicRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsCreateForumTopicRequest
@@ -8124,6 +9476,9 @@ func (s *ServerDispatcher) OnChannelsCreateForumTopic(f func(ctx context.Context
 	s.handlers[ChannelsCreateForumTopicRequestTypeID] = handler
 }
 
+// OnChannelsGetForumTopics sets handler of channels.getForumTopics#de560d1 method.
+//
+// See https://core.telegram.org/method/channels.getForumTopics for reference.
 func (s *ServerDispatcher) OnChannelsGetForumTopics(f func(ctx context.Context, request *ChannelsGetForumTopicsRequest) (*MessagesForumTopics, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetForumTopicsRequest
@@ -8141,6 +9496,9 @@ func (s *ServerDispatcher) OnChannelsGetForumTopics(f func(ctx context.Context,
 	s.handlers[ChannelsGetForumTopicsRequestTypeID] = handler
 }
 
+// OnChannelsGetForumTopicsByID sets handler of channels.getForumTopicsByID#b0831eb9 method.
+//
+// See https://core.telegram.org/method/channels.getForumTopicsByID for reference.
 func (s *ServerDispatcher) OnChannelsGetForumTopicsByID(f func(ctx context.Context, request *ChannelsGetForumTopicsByIDRequest) (*MessagesForumTopics, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetForumTopicsByIDRequest
@@ -8158,6 +9516,9 @@ func (s *ServerDispatcher) OnChannelsGetForumTopicsByID(f func(ctx context.Conte
 	s.handlers[ChannelsGetForumTopicsByIDRequestTypeID] = handler
 }
 
+// OnChannelsEditForumTopic sets handler of channels.editForumTopic#f4dfa185 method.
+//
+// See https://core.telegram.org/method/channels.editForumTopic for reference.
 func (s *ServerDispatcher) OnChannelsEditForumTopic(f func(ctx context.Context, request *ChannelsEditForumTopicRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsEditForumTopicRequest
@@ -8175,6 +9536,9 @@ func (s *ServerDispatcher) OnChannelsEditForumTopic(f func(ctx context.Context,
 	s.handlers[ChannelsEditForumTopicRequestTypeID] = handler
 }
 
+// OnChannelsUpdatePinnedForumTopic sets handler of channels.updatePinnedForumTopic#6c2d9026 method.
+//
+// See https://core.telegram.org/method/channels.updatePinnedForumTopic for reference.
 func (s *ServerDispatcher) OnChannelsUpdatePinnedForumTopic(f func(ctx context.Context, request *ChannelsUpdatePinnedForumTopicRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsUpdatePinnedForumTopicRequest
@@ -8192,6 +9556,9 @@ func (s *ServerDispatcher) OnChannelsUpdatePinnedForumTopic(f func(ctx context.C
 	s.handlers[ChannelsUpdatePinnedForumTopicRequestTypeID] = handler
 }
 
+// OnChannelsDeleteTopicHistory sets handler of channels.deleteTopicHistory#34435f2d method.
+//
+// See https://core.telegram.org/method/channels.deleteTopicHistory for reference.
 func (s *ServerDispatcher) OnChannelsDeleteTopicHistory(f func(ctx context.Context, request *ChannelsDeleteTopicHistoryRequest) (*MessagesAffectedHistory, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsDeleteTopicHistoryRequest
@@ -8209,6 +9576,9 @@ func (s *ServerDispatcher) OnChannelsDeleteTopicHistory(f func(ctx context.Conte
 	s.handlers[ChannelsDeleteTopicHistoryRequestTypeID] = handler
 }
 
+// OnChannelsReorderPinnedForumTopics sets handler of channels.reorderPinnedForumTopics#2950a18f method.
+//
+// See https://core.telegram.org/method/channels.reorderPinnedForumTopics for reference.
 func (s *ServerDispatcher) OnChannelsReorderPinnedForumTopics(f func(ctx context.Context, request *ChannelsReorderPinnedForumTopicsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsReorderPinnedForumTopicsRequest
@@ -8226,6 +9596,9 @@ func (s *ServerDispatcher) OnChannelsReorderPinnedForumTopics(f func(ctx context
 	s.handlers[ChannelsReorderPinnedForumTopicsRequestTypeID] = handler
 }
 
+// OnChannelsToggleAntiSpam sets handler of channels.toggleAntiSpam#68f3e4eb method.
+//
+// See https://core.telegram.org/method/channels.toggleAntiSpam for reference.
 func (s *ServerDispatcher) OnChannelsToggleAntiSpam(f func(ctx context.Context, request *ChannelsToggleAntiSpamRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleAntiSpamRequest
@@ -8243,6 +9616,9 @@ func (s *ServerDispatcher) OnChannelsToggleAntiSpam(f func(ctx context.Context,
 	s.handlers[ChannelsToggleAntiSpamRequestTypeID] = handler
 }
 
+// OnChannelsReportAntiSpamFalsePositive sets handler of channels.reportAntiSpamFalsePositive#a850a693 method.
+//
+// See https://core.telegram.org/method/channels.reportAntiSpamFalsePositive for reference.
 func (s *ServerDispatcher) OnChannelsReportAntiSpamFalsePositive(f func(ctx context.Context, request *ChannelsReportAntiSpamFalsePositiveRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsReportAntiSpamFalsePositiveRequest
@@ -8264,6 +9640,9 @@ func (s *ServerDispatcher) OnChannelsReportAntiSpamFalsePositive(f func(ctx cont
 	s.handlers[ChannelsReportAntiSpamFalsePositiveRequestTypeID] = handler
 }
 
+// OnChannelsToggleParticipantsHidden sets handler of channels.toggleParticipantsHidden#6a6e7854 method.
+//
+// See https://core.telegram.org/method/channels.toggleParticipantsHidden for reference.
 func (s *ServerDispatcher) OnChannelsToggleParticipantsHidden(f func(ctx context.Context, request *ChannelsToggleParticipantsHiddenRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleParticipantsHiddenRequest
@@ -8281,6 +9660,9 @@ func (s *ServerDispatcher) OnChannelsToggleParticipantsHidden(f func(ctx context
 	s.handlers[ChannelsToggleParticipantsHiddenRequestTypeID] = handler
 }
 
+// OnChannelsClickSponsoredMessage sets handler of channels.clickSponsoredMessage#18afbc93 method.
+//
+// See https://core.telegram.org/method/channels.clickSponsoredMessage for reference.
 func (s *ServerDispatcher) OnChannelsClickSponsoredMessage(f func(ctx context.Context, request *ChannelsClickSponsoredMessageRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsClickSponsoredMessageRequest
@@ -8302,6 +9684,9 @@ func (s *ServerDispatcher) OnChannelsClickSponsoredMessage(f func(ctx context.Co
 	s.handlers[ChannelsClickSponsoredMessageRequestTypeID] = handler
 }
 
+// OnChannelsUpdateColor sets handler of channels.updateColor#d8aa3671 method.
+//
+// See https://core.telegram.org/method/channels.updateColor for reference.
 func (s *ServerDispatcher) OnChannelsUpdateColor(f func(ctx context.Context, request *ChannelsUpdateColorRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsUpdateColorRequest
@@ -8319,6 +9704,9 @@ func (s *ServerDispatcher) OnChannelsUpdateColor(f func(ctx context.Context, req
 	s.handlers[ChannelsUpdateColorRequestTypeID] = handler
 }
 
+// OnChannelsToggleViewForumAsMessages sets handler of channels.toggleViewForumAsMessages#9738bb15 method.
+//
+// See https://core.telegram.org/method/channels.toggleViewForumAsMessages for reference.
 func (s *ServerDispatcher) OnChannelsToggleViewForumAsMessages(f func(ctx context.Context, request *ChannelsToggleViewForumAsMessagesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsToggleViewForumAsMessagesRequest
@@ -8336,6 +9724,9 @@ func (s *ServerDispatcher) OnChannelsToggleViewForumAsMessages(f func(ctx contex
 	s.handlers[ChannelsToggleViewForumAsMessagesRequestTypeID] = handler
 }
 
+// OnChannelsGetChannelRecommendations sets handler of channels.getChannelRecommendations#83b70d97 method.
+//
+// See https://core.telegram.org/method/channels.getChannelRecommendations for reference.
 func (s *ServerDispatcher) OnChannelsGetChannelRecommendations(f func(ctx context.Context, channel InputChannelClass) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsGetChannelRecommendationsRequest
@@ -8353,6 +9744,9 @@ func (s *ServerDispatcher) OnChannelsGetChannelRecommendations(f func(ctx contex
 	s.handlers[ChannelsGetChannelRecommendationsRequestTypeID] = handler
 }
 
+// OnChannelsUpdateEmojiStatus sets handler of channels.updateEmojiStatus#f0d3e6a8 method.
+//
+// See https://core.telegram.org/method/channels.updateEmojiStatus for reference.
 func (s *ServerDispatcher) OnChannelsUpdateEmojiStatus(f func(ctx context.Context, request *ChannelsUpdateEmojiStatusRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsUpdateEmojiStatusRequest
@@ -8370,6 +9764,9 @@ func (s *ServerDispatcher) OnChannelsUpdateEmojiStatus(f func(ctx context.Contex
 	s.handlers[ChannelsUpdateEmojiStatusRequestTypeID] = handler
 }
 
+// OnChannelsSetBoostsToUnblockRestrictions sets handler of channels.setBoostsToUnblockRestrictions#ad399cee method.
+//
+// See https://core.telegram.org/method/channels.setBoostsToUnblockRestrictions for reference.
 func (s *ServerDispatcher) OnChannelsSetBoostsToUnblockRestrictions(f func(ctx context.Context, request *ChannelsSetBoostsToUnblockRestrictionsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsSetBoostsToUnblockRestrictionsRequest
@@ -8387,6 +9784,9 @@ func (s *ServerDispatcher) OnChannelsSetBoostsToUnblockRestrictions(f func(ctx c
 	s.handlers[ChannelsSetBoostsToUnblockRestrictionsRequestTypeID] = handler
 }
 
+// OnChannelsSetEmojiStickers sets handler of channels.setEmojiStickers#3cd930b7 method.
+//
+// See https://core.telegram.org/method/channels.setEmojiStickers for reference.
 func (s *ServerDispatcher) OnChannelsSetEmojiStickers(f func(ctx context.Context, request *ChannelsSetEmojiStickersRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChannelsSetEmojiStickersRequest
@@ -8408,6 +9808,9 @@ func (s *ServerDispatcher) OnChannelsSetEmojiStickers(f func(ctx context.Context
 	s.handlers[ChannelsSetEmojiStickersRequestTypeID] = handler
 }
 
+// OnBotsSendCustomRequest sets handler of bots.sendCustomRequest#aa2769ed method.
+//
+// See https://core.telegram.org/method/bots.sendCustomRequest for reference.
 func (s *ServerDispatcher) OnBotsSendCustomRequest(f func(ctx context.Context, request *BotsSendCustomRequestRequest) (*DataJSON, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsSendCustomRequestRequest
@@ -8425,6 +9828,9 @@ func (s *ServerDispatcher) OnBotsSendCustomRequest(f func(ctx context.Context, r
 	s.handlers[BotsSendCustomRequestRequestTypeID] = handler
 }
 
+// OnBotsAnswerWebhookJSONQuery sets handler of bots.answerWebhookJSONQuery#e6213f4d method.
+//
+// See https://core.telegram.org/method/bots.answerWebhookJSONQuery for reference.
 func (s *ServerDispatcher) OnBotsAnswerWebhookJSONQuery(f func(ctx context.Context, request *BotsAnswerWebhookJSONQueryRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsAnswerWebhookJSONQueryRequest
@@ -8446,6 +9852,9 @@ func (s *ServerDispatcher) OnBotsAnswerWebhookJSONQuery(f func(ctx context.Conte
 	s.handlers[BotsAnswerWebhookJSONQueryRequestTypeID] = handler
 }
 
+// OnBotsSetBotCommands sets handler of bots.setBotCommands#517165a method.
+//
+// See https://core.telegram.org/method/bots.setBotCommands for reference.
 func (s *ServerDispatcher) OnBotsSetBotCommands(f func(ctx context.Context, request *BotsSetBotCommandsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsSetBotCommandsRequest
@@ -8467,6 +9876,9 @@ func (s *ServerDispatcher) OnBotsSetBotCommands(f func(ctx context.Context, requ
 	s.handlers[BotsSetBotCommandsRequestTypeID] = handler
 }
 
+// OnBotsResetBotCommands sets handler of bots.resetBotCommands#3d8de0f9 method.
+//
+// See https://core.telegram.org/method/bots.resetBotCommands for reference.
 func (s *ServerDispatcher) OnBotsResetBotCommands(f func(ctx context.Context, request *BotsResetBotCommandsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsResetBotCommandsRequest
@@ -8488,6 +9900,9 @@ func (s *ServerDispatcher) OnBotsResetBotCommands(f func(ctx context.Context, re
 	s.handlers[BotsResetBotCommandsRequestTypeID] = handler
 }
 
+// OnBotsGetBotCommands sets handler of bots.getBotCommands#e34c0dd6 method.
+//
+// See https://core.telegram.org/method/bots.getBotCommands for reference.
 func (s *ServerDispatcher) OnBotsGetBotCommands(f func(ctx context.Context, request *BotsGetBotCommandsRequest) ([]BotCommand, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsGetBotCommandsRequest
@@ -8505,6 +9920,9 @@ func (s *ServerDispatcher) OnBotsGetBotCommands(f func(ctx context.Context, requ
 	s.handlers[BotsGetBotCommandsRequestTypeID] = handler
 }
 
+// OnBotsSetBotMenuButton sets handler of bots.setBotMenuButton#4504d54f method.
+//
+// See https://core.telegram.org/method/bots.setBotMenuButton for reference.
 func (s *ServerDispatcher) OnBotsSetBotMenuButton(f func(ctx context.Context, request *BotsSetBotMenuButtonRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsSetBotMenuButtonRequest
@@ -8526,6 +9944,9 @@ func (s *ServerDispatcher) OnBotsSetBotMenuButton(f func(ctx context.Context, re
 	s.handlers[BotsSetBotMenuButtonRequestTypeID] = handler
 }
 
+// OnBotsGetBotMenuButton sets handler of bots.getBotMenuButton#9c60eb28 method.
+//
+// See https://core.telegram.org/method/bots.getBotMenuButton for reference.
 func (s *ServerDispatcher) OnBotsGetBotMenuButton(f func(ctx context.Context, userid InputUserClass) (BotMenuButtonClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsGetBotMenuButtonRequest
@@ -8543,6 +9964,9 @@ func (s *ServerDispatcher) OnBotsGetBotMenuButton(f func(ctx context.Context, us
 	s.handlers[BotsGetBotMenuButtonRequestTypeID] = handler
 }
 
+// OnBotsSetBotBroadcastDefaultAdminRights sets handler of bots.setBotBroadcastDefaultAdminRights#788464e1 method.
+//
+// See https://core.telegram.org/method/bots.setBotBroadcastDefaultAdminRights for reference.
 func (s *ServerDispatcher) OnBotsSetBotBroadcastDefaultAdminRights(f func(ctx context.Context, adminrights ChatAdminRights) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsSetBotBroadcastDefaultAdminRightsRequest
@@ -8564,6 +9988,9 @@ func (s *ServerDispatcher) OnBotsSetBotBroadcastDefaultAdminRights(f func(ctx co
 	s.handlers[BotsSetBotBroadcastDefaultAdminRightsRequestTypeID] = handler
 }
 
+// OnBotsSetBotGroupDefaultAdminRights sets handler of bots.setBotGroupDefaultAdminRights#925ec9ea method.
+//
+// See https://core.telegram.org/method/bots.setBotGroupDefaultAdminRights for reference.
 func (s *ServerDispatcher) OnBotsSetBotGroupDefaultAdminRights(f func(ctx context.Context, adminrights ChatAdminRights) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsSetBotGroupDefaultAdminRightsRequest
@@ -8585,6 +10012,9 @@ func (s *ServerDispatcher) OnBotsSetBotGroupDefaultAdminRights(f func(ctx contex
 	s.handlers[BotsSetBotGroupDefaultAdminRightsRequestTypeID] = handler
 }
 
+// OnBotsSetBotInfo sets handler of bots.setBotInfo#10cf3123 method.
+//
+// See https://core.telegram.org/method/bots.setBotInfo for reference.
 func (s *ServerDispatcher) OnBotsSetBotInfo(f func(ctx context.Context, request *BotsSetBotInfoRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsSetBotInfoRequest
@@ -8606,6 +10036,9 @@ func (s *ServerDispatcher) OnBotsSetBotInfo(f func(ctx context.Context, request
 	s.handlers[BotsSetBotInfoRequestTypeID] = handler
 }
 
+// OnBotsGetBotInfo sets handler of bots.getBotInfo#dcd914fd method.
+//
+// See https://core.telegram.org/method/bots.getBotInfo for reference.
 func (s *ServerDispatcher) OnBotsGetBotInfo(f func(ctx context.Context, request *BotsGetBotInfoRequest) (*BotsBotInfo, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsGetBotInfoRequest
@@ -8623,6 +10056,9 @@ func (s *ServerDispatcher) OnBotsGetBotInfo(f func(ctx context.Context, request
 	s.handlers[BotsGetBotInfoRequestTypeID] = handler
 }
 
+// OnBotsReorderUsernames sets handler of bots.reorderUsernames#9709b1c2 method.
+//
+// See https://core.telegram.org/method/bots.reorderUsernames for reference.
 func (s *ServerDispatcher) OnBotsReorderUsernames(f func(ctx context.Context, request *BotsReorderUsernamesRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsReorderUsernamesRequest
@@ -8644,6 +10080,9 @@ func (s *ServerDispatcher) OnBotsReorderUsernames(f func(ctx context.Context, re
 	s.handlers[BotsReorderUsernamesRequestTypeID] = handler
 }
 
+// OnBotsToggleUsername sets handler of bots.toggleUsername#53ca973 method.
+//
+// See https://core.telegram.org/method/bots.toggleUsername for reference.
 func (s *ServerDispatcher) OnBotsToggleUsername(f func(ctx context.Context, request *BotsToggleUsernameRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsToggleUsernameRequest
@@ -8665,6 +10104,9 @@ func (s *ServerDispatcher) OnBotsToggleUsername(f func(ctx context.Context, requ
 	s.handlers[BotsToggleUsernameRequestTypeID] = handler
 }
 
+// OnBotsCanSendMessage sets handler of bots.canSendMessage#1359f4e6 method.
+//
+// See https://core.telegram.org/method/bots.canSendMessage for reference.
 func (s *ServerDispatcher) OnBotsCanSendMessage(f func(ctx context.Context, bot InputUserClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsCanSendMessageRequest
@@ -8686,6 +10128,9 @@ func (s *ServerDispatcher) OnBotsCanSendMessage(f func(ctx context.Context, bot
 	s.handlers[BotsCanSendMessageRequestTypeID] = handler
 }
 
+// OnBotsAllowSendMessage sets handler of bots.allowSendMessage#f132e3ef method.
+//
+// See https://core.telegram.org/method/bots.allowSendMessage for reference.
 func (s *ServerDispatcher) OnBotsAllowSendMessage(f func(ctx context.Context, bot InputUserClass) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsAllowSendMessageRequest
@@ -8703,6 +10148,9 @@ func (s *ServerDispatcher) OnBotsAllowSendMessage(f func(ctx context.Context, bo
 	s.handlers[BotsAllowSendMessageRequestTypeID] = handler
 }
 
+// OnBotsInvokeWebViewCustomMethod sets handler of bots.invokeWebViewCustomMethod#87fc5e7 method.
+//
+// See https://core.telegram.org/method/bots.invokeWebViewCustomMethod for reference.
 func (s *ServerDispatcher) OnBotsInvokeWebViewCustomMethod(f func(ctx context.Context, request *BotsInvokeWebViewCustomMethodRequest) (*DataJSON, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request BotsInvokeWebViewCustomMethodRequest
@@ -8720,6 +10168,9 @@ func (s *ServerDispatcher) OnBotsInvokeWebViewCustomMethod(f func(ctx context.Co
 	s.handlers[BotsInvokeWebViewCustomMethodRequestTypeID] = handler
 }
 
+// OnPaymentsGetPaymentForm sets handler of payments.getPaymentForm#37148dbb method.
+//
+// See https://core.telegram.org/method/payments.getPaymentForm for reference.
 func (s *ServerDispatcher) OnPaymentsGetPaymentForm(f func(ctx context.Context, request *PaymentsGetPaymentFormRequest) (*PaymentsPaymentForm, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsGetPaymentFormRequest
@@ -8737,6 +10188,9 @@ func (s *ServerDispatcher) OnPaymentsGetPaymentForm(f func(ctx context.Context,
 	s.handlers[PaymentsGetPaymentFormRequestTypeID] = handler
 }
 
+// OnPaymentsGetPaymentReceipt sets handler of payments.getPaymentReceipt#2478d1cc method.
+//
+// See https://core.telegram.org/method/payments.getPaymentReceipt for reference.
 func (s *ServerDispatcher) OnPaymentsGetPaymentReceipt(f func(ctx context.Context, request *PaymentsGetPaymentReceiptRequest) (*PaymentsPaymentReceipt, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsGetPaymentReceiptRequest
@@ -8754,6 +10208,9 @@ func (s *ServerDispatcher) OnPaymentsGetPaymentReceipt(f func(ctx context.Contex
 	s.handlers[PaymentsGetPaymentReceiptRequestTypeID] = handler
 }
 
+// OnPaymentsValidateRequestedInfo sets handler of payments.validateRequestedInfo#b6c8f12b method.
+//
+// See https://core.telegram.org/method/payments.validateRequestedInfo for reference.
 func (s *ServerDispatcher) OnPaymentsValidateRequestedInfo(f func(ctx context.Context, request *PaymentsValidateRequestedInfoRequest) (*PaymentsValidatedRequestedInfo, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsValidateRequestedInfoRequest
@@ -8771,6 +10228,9 @@ func (s *ServerDispatcher) OnPaymentsValidateRequestedInfo(f func(ctx context.Co
 	s.handlers[PaymentsValidateRequestedInfoRequestTypeID] = handler
 }
 
+// OnPaymentsSendPaymentForm sets handler of payments.sendPaymentForm#2d03522f method.
+//
+// See https://core.telegram.org/method/payments.sendPaymentForm for reference.
 func (s *ServerDispatcher) OnPaymentsSendPaymentForm(f func(ctx context.Context, request *PaymentsSendPaymentFormRequest) (PaymentsPaymentResultClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsSendPaymentFormRequest
@@ -8788,6 +10248,9 @@ func (s *ServerDispatcher) OnPaymentsSendPaymentForm(f func(ctx context.Context,
 	s.handlers[PaymentsSendPaymentFormRequestTypeID] = handler
 }
 
+// OnPaymentsGetSavedInfo sets handler of payments.getSavedInfo#227d824b method.
+//
+// See https://core.telegram.org/method/payments.getSavedInfo for reference.
 func (s *ServerDispatcher) OnPaymentsGetSavedInfo(f func(ctx context.Context) (*PaymentsSavedInfo, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsGetSavedInfoRequest
@@ -8805,6 +10268,9 @@ func (s *ServerDispatcher) OnPaymentsGetSavedInfo(f func(ctx context.Context) (*
 	s.handlers[PaymentsGetSavedInfoRequestTypeID] = handler
 }
 
+// OnPaymentsClearSavedInfo sets handler of payments.clearSavedInfo#d83d70c1 method.
+//
+// See https://core.telegram.org/method/payments.clearSavedInfo for reference.
 func (s *ServerDispatcher) OnPaymentsClearSavedInfo(f func(ctx context.Context, request *PaymentsClearSavedInfoRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsClearSavedInfoRequest
@@ -8826,6 +10292,9 @@ func (s *ServerDispatcher) OnPaymentsClearSavedInfo(f func(ctx context.Context,
 	s.handlers[PaymentsClearSavedInfoRequestTypeID] = handler
 }
 
+// OnPaymentsGetBankCardData sets handler of payments.getBankCardData#2e79d779 method.
+//
+// See https://core.telegram.org/method/payments.getBankCardData for reference.
 func (s *ServerDispatcher) OnPaymentsGetBankCardData(f func(ctx context.Context, number string) (*PaymentsBankCardData, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsGetBankCardDataRequest
@@ -8843,6 +10312,9 @@ func (s *ServerDispatcher) OnPaymentsGetBankCardData(f func(ctx context.Context,
 	s.handlers[PaymentsGetBankCardDataRequestTypeID] = handler
 }
 
+// OnPaymentsExportInvoice sets handler of payments.exportInvoice#f91b065 method.
+//
+// See https://core.telegram.org/method/payments.exportInvoice for reference.
 func (s *ServerDispatcher) OnPaymentsExportInvoice(f func(ctx context.Context, invoicemedia InputMediaClass) (*PaymentsExportedInvoice, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsExportInvoiceRequest
@@ -8860,6 +10332,9 @@ func (s *ServerDispatcher) OnPaymentsExportInvoice(f func(ctx context.Context, i
 	s.handlers[PaymentsExportInvoiceRequestTypeID] = handler
 }
 
+// OnPaymentsAssignAppStoreTransaction sets handler of payments.assignAppStoreTransaction#80ed747d method.
+//
+// See https://core.telegram.org/method/payments.assignAppStoreTransaction for reference.
 func (s *ServerDispatcher) OnPaymentsAssignAppStoreTransaction(f func(ctx context.Context, request *PaymentsAssignAppStoreTransactionRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsAssignAppStoreTransactionRequest
@@ -8877,6 +10352,9 @@ func (s *ServerDispatcher) OnPaymentsAssignAppStoreTransaction(f func(ctx contex
 	s.handlers[PaymentsAssignAppStoreTransactionRequestTypeID] = handler
 }
 
+// OnPaymentsAssignPlayMarketTransaction sets handler of payments.assignPlayMarketTransaction#dffd50d3 method.
+//
+// See https://core.telegram.org/method/payments.assignPlayMarketTransaction for reference.
 func (s *ServerDispatcher) OnPaymentsAssignPlayMarketTransaction(f func(ctx context.Context, request *PaymentsAssignPlayMarketTransactionRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsAssignPlayMarketTransactionRequest
@@ -8894,6 +10372,9 @@ func (s *ServerDispatcher) OnPaymentsAssignPlayMarketTransaction(f func(ctx cont
 	s.handlers[PaymentsAssignPlayMarketTransactionRequestTypeID] = handler
 }
 
+// OnPaymentsCanPurchasePremium sets handler of payments.canPurchasePremium#9fc19eb6 method.
+//
+// See https://core.telegram.org/method/payments.canPurchasePremium for reference.
 func (s *ServerDispatcher) OnPaymentsCanPurchasePremium(f func(ctx context.Context, purpose InputStorePaymentPurposeClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsCanPurchasePremiumRequest
@@ -8915,6 +10396,9 @@ func (s *ServerDispatcher) OnPaymentsCanPurchasePremium(f func(ctx context.Conte
 	s.handlers[PaymentsCanPurchasePremiumRequestTypeID] = handler
 }
 
+// OnPaymentsGetPremiumGiftCodeOptions sets handler of payments.getPremiumGiftCodeOptions#2757ba54 method.
+//
+// See https://core.telegram.org/method/payments.getPremiumGiftCodeOptions for reference.
 func (s *ServerDispatcher) OnPaymentsGetPremiumGiftCodeOptions(f func(ctx context.Context, request *PaymentsGetPremiumGiftCodeOptionsRequest) ([]PremiumGiftCodeOption, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsGetPremiumGiftCodeOptionsRequest
@@ -8932,6 +10416,9 @@ func (s *ServerDispatcher) OnPaymentsGetPremiumGiftCodeOptions(f func(ctx contex
 	s.handlers[PaymentsGetPremiumGiftCodeOptionsRequestTypeID] = handler
 }
 
+// OnPaymentsCheckGiftCode sets handler of payments.checkGiftCode#8e51b4c1 method.
+//
+// See https://core.telegram.org/method/payments.checkGiftCode for reference.
 func (s *ServerDispatcher) OnPaymentsCheckGiftCode(f func(ctx context.Context, slug string) (*PaymentsCheckedGiftCode, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsCheckGiftCodeRequest
@@ -8949,6 +10436,9 @@ func (s *ServerDispatcher) OnPaymentsCheckGiftCode(f func(ctx context.Context, s
 	s.handlers[PaymentsCheckGiftCodeRequestTypeID] = handler
 }
 
+// OnPaymentsApplyGiftCode sets handler of payments.applyGiftCode#f6e26854 method.
+//
+// See https://core.telegram.org/method/payments.applyGiftCode for reference.
 func (s *ServerDispatcher) OnPaymentsApplyGiftCode(f func(ctx context.Context, slug string) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsApplyGiftCodeRequest
@@ -8966,6 +10456,9 @@ func (s *ServerDispatcher) OnPaymentsApplyGiftCode(f func(ctx context.Context, s
 	s.handlers[PaymentsApplyGiftCodeRequestTypeID] = handler
 }
 
+// OnPaymentsGetGiveawayInfo sets handler of payments.getGiveawayInfo#f4239425 method.
+//
+// See https://core.telegram.org/method/payments.getGiveawayInfo for reference.
 func (s *ServerDispatcher) OnPaymentsGetGiveawayInfo(f func(ctx context.Context, request *PaymentsGetGiveawayInfoRequest) (PaymentsGiveawayInfoClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsGetGiveawayInfoRequest
@@ -8983,6 +10476,9 @@ func (s *ServerDispatcher) OnPaymentsGetGiveawayInfo(f func(ctx context.Context,
 	s.handlers[PaymentsGetGiveawayInfoRequestTypeID] = handler
 }
 
+// OnPaymentsLaunchPrepaidGiveaway sets handler of payments.launchPrepaidGiveaway#5ff58f20 method.
+//
+// See https://core.telegram.org/method/payments.launchPrepaidGiveaway for reference.
 func (s *ServerDispatcher) OnPaymentsLaunchPrepaidGiveaway(f func(ctx context.Context, request *PaymentsLaunchPrepaidGiveawayRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PaymentsLaunchPrepaidGiveawayRequest
@@ -9000,6 +10496,9 @@ func (s *ServerDispatcher) OnPaymentsLaunchPrepaidGiveaway(f func(ctx context.Co
 	s.handlers[PaymentsLaunchPrepaidGiveawayRequestTypeID] = handler
 }
 
+// OnStickersCreateStickerSet sets handler of stickers.createStickerSet#9021ab67 method.
+//
+// See https://core.telegram.org/method/stickers.createStickerSet for reference.
 func (s *ServerDispatcher) OnStickersCreateStickerSet(f func(ctx context.Context, request *StickersCreateStickerSetRequest) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersCreateStickerSetRequest
@@ -9017,6 +10516,9 @@ func (s *ServerDispatcher) OnStickersCreateStickerSet(f func(ctx context.Context
 	s.handlers[StickersCreateStickerSetRequestTypeID] = handler
 }
 
+// OnStickersRemoveStickerFromSet sets handler of stickers.removeStickerFromSet#f7760f51 method.
+//
+// See https://core.telegram.org/method/stickers.removeStickerFromSet for reference.
 func (s *ServerDispatcher) OnStickersRemoveStickerFromSet(f func(ctx context.Context, sticker InputDocumentClass) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersRemoveStickerFromSetRequest
@@ -9034,6 +10536,9 @@ func (s *ServerDispatcher) OnStickersRemoveStickerFromSet(f func(ctx context.Con
 	s.handlers[StickersRemoveStickerFromSetRequestTypeID] = handler
 }
 
+// OnStickersChangeStickerPosition sets handler of stickers.changeStickerPosition#ffb6d4ca method.
+//
+// See https://core.telegram.org/method/stickers.changeStickerPosition for reference.
 func (s *ServerDispatcher) OnStickersChangeStickerPosition(f func(ctx context.Context, request *StickersChangeStickerPositionRequest) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersChangeStickerPositionRequest
@@ -9051,6 +10556,9 @@ func (s *ServerDispatcher) OnStickersChangeStickerPosition(f func(ctx context.Co
 	s.handlers[StickersChangeStickerPositionRequestTypeID] = handler
 }
 
+// OnStickersAddStickerToSet sets handler of stickers.addStickerToSet#8653febe method.
+//
+// See https://core.telegram.org/method/stickers.addStickerToSet for reference.
 func (s *ServerDispatcher) OnStickersAddStickerToSet(f func(ctx context.Context, request *StickersAddStickerToSetRequest) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersAddStickerToSetRequest
@@ -9068,6 +10576,9 @@ func (s *ServerDispatcher) OnStickersAddStickerToSet(f func(ctx context.Context,
 	s.handlers[StickersAddStickerToSetRequestTypeID] = handler
 }
 
+// OnStickersSetStickerSetThumb sets handler of stickers.setStickerSetThumb#a76a5392 method.
+//
+// See https://core.telegram.org/method/stickers.setStickerSetThumb for reference.
 func (s *ServerDispatcher) OnStickersSetStickerSetThumb(f func(ctx context.Context, request *StickersSetStickerSetThumbRequest) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersSetStickerSetThumbRequest
@@ -9085,6 +10596,9 @@ func (s *ServerDispatcher) OnStickersSetStickerSetThumb(f func(ctx context.Conte
 	s.handlers[StickersSetStickerSetThumbRequestTypeID] = handler
 }
 
+// OnStickersCheckShortName sets handler of stickers.checkShortName#284b3639 method.
+//
+// See https://core.telegram.org/method/stickers.checkShortName for reference.
 func (s *ServerDispatcher) OnStickersCheckShortName(f func(ctx context.Context, shortname string) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersCheckShortNameRequest
@@ -9106,6 +10620,9 @@ func (s *ServerDispatcher) OnStickersCheckShortName(f func(ctx context.Context,
 	s.handlers[StickersCheckShortNameRequestTypeID] = handler
 }
 
+// OnStickersSuggestShortName sets handler of stickers.suggestShortName#4dafc503 method.
+//
+// See https://core.telegram.org/method/stickers.suggestShortName for reference.
 func (s *ServerDispatcher) OnStickersSuggestShortName(f func(ctx context.Context, title string) (*StickersSuggestedShortName, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersSuggestShortNameRequest
@@ -9123,6 +10640,9 @@ func (s *ServerDispatcher) OnStickersSuggestShortName(f func(ctx context.Context
 	s.handlers[StickersSuggestShortNameRequestTypeID] = handler
 }
 
+// OnStickersChangeSticker sets handler of stickers.changeSticker#f5537ebc method.
+//
+// See https://core.telegram.org/method/stickers.changeSticker for reference.
 func (s *ServerDispatcher) OnStickersChangeSticker(f func(ctx context.Context, request *StickersChangeStickerRequest) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersChangeStickerRequest
@@ -9140,6 +10660,9 @@ func (s *ServerDispatcher) OnStickersChangeSticker(f func(ctx context.Context, r
 	s.handlers[StickersChangeStickerRequestTypeID] = handler
 }
 
+// OnStickersRenameStickerSet sets handler of stickers.renameStickerSet#124b1c00 method.
+//
+// See https://core.telegram.org/method/stickers.renameStickerSet for reference.
 func (s *ServerDispatcher) OnStickersRenameStickerSet(f func(ctx context.Context, request *StickersRenameStickerSetRequest) (MessagesStickerSetClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersRenameStickerSetRequest
@@ -9157,6 +10680,9 @@ func (s *ServerDispatcher) OnStickersRenameStickerSet(f func(ctx context.Context
 	s.handlers[StickersRenameStickerSetRequestTypeID] = handler
 }
 
+// OnStickersDeleteStickerSet sets handler of stickers.deleteStickerSet#87704394 method.
+//
+// See https://core.telegram.org/method/stickers.deleteStickerSet for reference.
 func (s *ServerDispatcher) OnStickersDeleteStickerSet(f func(ctx context.Context, stickerset InputStickerSetClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StickersDeleteStickerSetRequest
@@ -9178,6 +10704,9 @@ func (s *ServerDispatcher) OnStickersDeleteStickerSet(f func(ctx context.Context
 	s.handlers[StickersDeleteStickerSetRequestTypeID] = handler
 }
 
+// OnPhoneGetCallConfig sets handler of phone.getCallConfig#55451fa9 method.
+//
+// See https://core.telegram.org/method/phone.getCallConfig for reference.
 func (s *ServerDispatcher) OnPhoneGetCallConfig(f func(ctx context.Context) (*DataJSON, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneGetCallConfigRequest
@@ -9195,6 +10724,9 @@ func (s *ServerDispatcher) OnPhoneGetCallConfig(f func(ctx context.Context) (*Da
 	s.handlers[PhoneGetCallConfigRequestTypeID] = handler
 }
 
+// OnPhoneRequestCall sets handler of phone.requestCall#42ff96ed method.
+//
+// See https://core.telegram.org/method/phone.requestCall for reference.
 func (s *ServerDispatcher) OnPhoneRequestCall(f func(ctx context.Context, request *PhoneRequestCallRequest) (*PhonePhoneCall, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneRequestCallRequest
@@ -9212,6 +10744,9 @@ func (s *ServerDispatcher) OnPhoneRequestCall(f func(ctx context.Context, reques
 	s.handlers[PhoneRequestCallRequestTypeID] = handler
 }
 
+// OnPhoneAcceptCall sets handler of phone.acceptCall#3bd2b4a0 method.
+//
+// See https://core.telegram.org/method/phone.acceptCall for reference.
 func (s *ServerDispatcher) OnPhoneAcceptCall(f func(ctx context.Context, request *PhoneAcceptCallRequest) (*PhonePhoneCall, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneAcceptCallRequest
@@ -9229,6 +10764,9 @@ func (s *ServerDispatcher) OnPhoneAcceptCall(f func(ctx context.Context, request
 	s.handlers[PhoneAcceptCallRequestTypeID] = handler
 }
 
+// OnPhoneConfirmCall sets handler of phone.confirmCall#2efe1722 method.
+//
+// See https://core.telegram.org/method/phone.confirmCall for reference.
 func (s *ServerDispatcher) OnPhoneConfirmCall(f func(ctx context.Context, request *PhoneConfirmCallRequest) (*PhonePhoneCall, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneConfirmCallRequest
@@ -9246,6 +10784,9 @@ func (s *ServerDispatcher) OnPhoneConfirmCall(f func(ctx context.Context, reques
 	s.handlers[PhoneConfirmCallRequestTypeID] = handler
 }
 
+// OnPhoneReceivedCall sets handler of phone.receivedCall#17d54f61 method.
+//
+// See https://core.telegram.org/method/phone.receivedCall for reference.
 func (s *ServerDispatcher) OnPhoneReceivedCall(f func(ctx context.Context, peer InputPhoneCall) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneReceivedCallRequest
@@ -9267,6 +10808,9 @@ func (s *ServerDispatcher) OnPhoneReceivedCall(f func(ctx context.Context, peer
 	s.handlers[PhoneReceivedCallRequestTypeID] = handler
 }
 
+// OnPhoneDiscardCall sets handler of phone.discardCall#b2cbc1c0 method.
+//
+// See https://core.telegram.org/method/phone.discardCall for reference.
 func (s *ServerDispatcher) OnPhoneDiscardCall(f func(ctx context.Context, request *PhoneDiscardCallRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneDiscardCallRequest
@@ -9284,6 +10828,9 @@ func (s *ServerDispatcher) OnPhoneDiscardCall(f func(ctx context.Context, reques
 	s.handlers[PhoneDiscardCallRequestTypeID] = handler
 }
 
+// OnPhoneSetCallRating sets handler of phone.setCallRating#59ead627 method.
+//
+// See https://core.telegram.org/method/phone.setCallRating for reference.
 func (s *ServerDispatcher) OnPhoneSetCallRating(f func(ctx context.Context, request *PhoneSetCallRatingRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneSetCallRatingRequest
@@ -9301,6 +10848,9 @@ func (s *ServerDispatcher) OnPhoneSetCallRating(f func(ctx context.Context, requ
 	s.handlers[PhoneSetCallRatingRequestTypeID] = handler
 }
 
+// OnPhoneSaveCallDebug sets handler of phone.saveCallDebug#277add7e method.
+//
+// See https://core.telegram.org/method/phone.saveCallDebug for reference.
 func (s *ServerDispatcher) OnPhoneSaveCallDebug(f func(ctx context.Context, request *PhoneSaveCallDebugRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneSaveCallDebugRequest
@@ -9322,6 +10872,9 @@ func (s *ServerDispatcher) OnPhoneSaveCallDebug(f func(ctx context.Context, requ
 	s.handlers[PhoneSaveCallDebugRequestTypeID] = handler
 }
 
+// OnPhoneSendSignalingData sets handler of phone.sendSignalingData#ff7a9383 method.
+//
+// See https://core.telegram.org/method/phone.sendSignalingData for reference.
 func (s *ServerDispatcher) OnPhoneSendSignalingData(f func(ctx context.Context, request *PhoneSendSignalingDataRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneSendSignalingDataRequest
@@ -9343,6 +10896,9 @@ func (s *ServerDispatcher) OnPhoneSendSignalingData(f func(ctx context.Context,
 	s.handlers[PhoneSendSignalingDataRequestTypeID] = handler
 }
 
+// OnPhoneCreateGroupCall sets handler of phone.createGroupCall#48cdc6d8 method.
+//
+// See https://core.telegram.org/method/phone.createGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneCreateGroupCall(f func(ctx context.Context, request *PhoneCreateGroupCallRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneCreateGroupCallRequest
@@ -9360,6 +10916,9 @@ func (s *ServerDispatcher) OnPhoneCreateGroupCall(f func(ctx context.Context, re
 	s.handlers[PhoneCreateGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneJoinGroupCall sets handler of phone.joinGroupCall#b132ff7b method.
+//
+// See https://core.telegram.org/method/phone.joinGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneJoinGroupCall(f func(ctx context.Context, request *PhoneJoinGroupCallRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneJoinGroupCallRequest
@@ -9377,6 +10936,9 @@ func (s *ServerDispatcher) OnPhoneJoinGroupCall(f func(ctx context.Context, requ
 	s.handlers[PhoneJoinGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneLeaveGroupCall sets handler of phone.leaveGroupCall#500377f9 method.
+//
+// See https://core.telegram.org/method/phone.leaveGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneLeaveGroupCall(f func(ctx context.Context, request *PhoneLeaveGroupCallRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneLeaveGroupCallRequest
@@ -9394,6 +10956,9 @@ func (s *ServerDispatcher) OnPhoneLeaveGroupCall(f func(ctx context.Context, req
 	s.handlers[PhoneLeaveGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneInviteToGroupCall sets handler of phone.inviteToGroupCall#7b393160 method.
+//
+// See https://core.telegram.org/method/phone.inviteToGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneInviteToGroupCall(f func(ctx context.Context, request *PhoneInviteToGroupCallRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneInviteToGroupCallRequest
@@ -9411,6 +10976,9 @@ func (s *ServerDispatcher) OnPhoneInviteToGroupCall(f func(ctx context.Context,
 	s.handlers[PhoneInviteToGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneDiscardGroupCall sets handler of phone.discardGroupCall#7a777135 method.
+//
+// See https://core.telegram.org/method/phone.discardGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneDiscardGroupCall(f func(ctx context.Context, call InputGroupCall) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneDiscardGroupCallRequest
@@ -9428,6 +10996,9 @@ func (s *ServerDispatcher) OnPhoneDiscardGroupCall(f func(ctx context.Context, c
 	s.handlers[PhoneDiscardGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneToggleGroupCallSettings sets handler of phone.toggleGroupCallSettings#74bbb43d method.
+//
+// See https://core.telegram.org/method/phone.toggleGroupCallSettings for reference.
 func (s *ServerDispatcher) OnPhoneToggleGroupCallSettings(f func(ctx context.Context, request *PhoneToggleGroupCallSettingsRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneToggleGroupCallSettingsRequest
@@ -9445,6 +11016,9 @@ func (s *ServerDispatcher) OnPhoneToggleGroupCallSettings(f func(ctx context.Con
 	s.handlers[PhoneToggleGroupCallSettingsRequestTypeID] = handler
 }
 
+// OnPhoneGetGroupCall sets handler of phone.getGroupCall#41845db method.
+//
+// See https://core.telegram.org/method/phone.getGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneGetGroupCall(f func(ctx context.Context, request *PhoneGetGroupCallRequest) (*PhoneGroupCall, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneGetGroupCallRequest
@@ -9462,6 +11036,9 @@ func (s *ServerDispatcher) OnPhoneGetGroupCall(f func(ctx context.Context, reque
 	s.handlers[PhoneGetGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneGetGroupParticipants sets handler of phone.getGroupParticipants#c558d8ab method.
+//
+// See https://core.telegram.org/method/phone.getGroupParticipants for reference.
 func (s *ServerDispatcher) OnPhoneGetGroupParticipants(f func(ctx context.Context, request *PhoneGetGroupParticipantsRequest) (*PhoneGroupParticipants, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneGetGroupParticipantsRequest
@@ -9479,6 +11056,9 @@ func (s *ServerDispatcher) OnPhoneGetGroupParticipants(f func(ctx context.Contex
 	s.handlers[PhoneGetGroupParticipantsRequestTypeID] = handler
 }
 
+// OnPhoneCheckGroupCall sets handler of phone.checkGroupCall#b59cf977 method.
+//
+// See https://core.telegram.org/method/phone.checkGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneCheckGroupCall(f func(ctx context.Context, request *PhoneCheckGroupCallRequest) ([]int, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneCheckGroupCallRequest
@@ -9496,6 +11076,9 @@ func (s *ServerDispatcher) OnPhoneCheckGroupCall(f func(ctx context.Context, req
 	s.handlers[PhoneCheckGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneToggleGroupCallRecord sets handler of phone.toggleGroupCallRecord#f128c708 method.
+//
+// See https://core.telegram.org/method/phone.toggleGroupCallRecord for reference.
 func (s *ServerDispatcher) OnPhoneToggleGroupCallRecord(f func(ctx context.Context, request *PhoneToggleGroupCallRecordRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneToggleGroupCallRecordRequest
@@ -9513,6 +11096,9 @@ func (s *ServerDispatcher) OnPhoneToggleGroupCallRecord(f func(ctx context.Conte
 	s.handlers[PhoneToggleGroupCallRecordRequestTypeID] = handler
 }
 
+// OnPhoneEditGroupCallParticipant sets handler of phone.editGroupCallParticipant#a5273abf method.
+//
+// See https://core.telegram.org/method/phone.editGroupCallParticipant for reference.
 func (s *ServerDispatcher) OnPhoneEditGroupCallParticipant(f func(ctx context.Context, request *PhoneEditGroupCallParticipantRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneEditGroupCallParticipantRequest
@@ -9530,6 +11116,9 @@ func (s *ServerDispatcher) OnPhoneEditGroupCallParticipant(f func(ctx context.Co
 	s.handlers[PhoneEditGroupCallParticipantRequestTypeID] = handler
 }
 
+// OnPhoneEditGroupCallTitle sets handler of phone.editGroupCallTitle#1ca6ac0a method.
+//
+// See https://core.telegram.org/method/phone.editGroupCallTitle for reference.
 func (s *ServerDispatcher) OnPhoneEditGroupCallTitle(f func(ctx context.Context, request *PhoneEditGroupCallTitleRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneEditGroupCallTitleRequest
@@ -9547,6 +11136,9 @@ func (s *ServerDispatcher) OnPhoneEditGroupCallTitle(f func(ctx context.Context,
 	s.handlers[PhoneEditGroupCallTitleRequestTypeID] = handler
 }
 
+// OnPhoneGetGroupCallJoinAs sets handler of phone.getGroupCallJoinAs#ef7c213a method.
+//
+// See https://core.telegram.org/method/phone.getGroupCallJoinAs for reference.
 func (s *ServerDispatcher) OnPhoneGetGroupCallJoinAs(f func(ctx context.Context, peer InputPeerClass) (*PhoneJoinAsPeers, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneGetGroupCallJoinAsRequest
@@ -9564,6 +11156,9 @@ func (s *ServerDispatcher) OnPhoneGetGroupCallJoinAs(f func(ctx context.Context,
 	s.handlers[PhoneGetGroupCallJoinAsRequestTypeID] = handler
 }
 
+// OnPhoneExportGroupCallInvite sets handler of phone.exportGroupCallInvite#e6aa647f method.
+//
+// See https://core.telegram.org/method/phone.exportGroupCallInvite for reference.
 func (s *ServerDispatcher) OnPhoneExportGroupCallInvite(f func(ctx context.Context, request *PhoneExportGroupCallInviteRequest) (*PhoneExportedGroupCallInvite, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneExportGroupCallInviteRequest
@@ -9581,6 +11176,9 @@ func (s *ServerDispatcher) OnPhoneExportGroupCallInvite(f func(ctx context.Conte
 	s.handlers[PhoneExportGroupCallInviteRequestTypeID] = handler
 }
 
+// OnPhoneToggleGroupCallStartSubscription sets handler of phone.toggleGroupCallStartSubscription#219c34e6 method.
+//
+// See https://core.telegram.org/method/phone.toggleGroupCallStartSubscription for reference.
 func (s *ServerDispatcher) OnPhoneToggleGroupCallStartSubscription(f func(ctx context.Context, request *PhoneToggleGroupCallStartSubscriptionRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneToggleGroupCallStartSubscriptionRequest
@@ -9598,6 +11196,9 @@ func (s *ServerDispatcher) OnPhoneToggleGroupCallStartSubscription(f func(ctx co
 	s.handlers[PhoneToggleGroupCallStartSubscriptionRequestTypeID] = handler
 }
 
+// OnPhoneStartScheduledGroupCall sets handler of phone.startScheduledGroupCall#5680e342 method.
+//
+// See https://core.telegram.org/method/phone.startScheduledGroupCall for reference.
 func (s *ServerDispatcher) OnPhoneStartScheduledGroupCall(f func(ctx context.Context, call InputGroupCall) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneStartScheduledGroupCallRequest
@@ -9615,6 +11216,9 @@ func (s *ServerDispatcher) OnPhoneStartScheduledGroupCall(f func(ctx context.Con
 	s.handlers[PhoneStartScheduledGroupCallRequestTypeID] = handler
 }
 
+// OnPhoneSaveDefaultGroupCallJoinAs sets handler of phone.saveDefaultGroupCallJoinAs#575e1f8c method.
+//
+// See https://core.telegram.org/method/phone.saveDefaultGroupCallJoinAs for reference.
 func (s *ServerDispatcher) OnPhoneSaveDefaultGroupCallJoinAs(f func(ctx context.Context, request *PhoneSaveDefaultGroupCallJoinAsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneSaveDefaultGroupCallJoinAsRequest
@@ -9636,6 +11240,9 @@ func (s *ServerDispatcher) OnPhoneSaveDefaultGroupCallJoinAs(f func(ctx context.
 	s.handlers[PhoneSaveDefaultGroupCallJoinAsRequestTypeID] = handler
 }
 
+// OnPhoneJoinGroupCallPresentation sets handler of phone.joinGroupCallPresentation#cbea6bc4 method.
+//
+// See https://core.telegram.org/method/phone.joinGroupCallPresentation for reference.
 func (s *ServerDispatcher) OnPhoneJoinGroupCallPresentation(f func(ctx context.Context, request *PhoneJoinGroupCallPresentationRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneJoinGroupCallPresentationRequest
@@ -9653,6 +11260,9 @@ func (s *ServerDispatcher) OnPhoneJoinGroupCallPresentation(f func(ctx context.C
 	s.handlers[PhoneJoinGroupCallPresentationRequestTypeID] = handler
 }
 
+// OnPhoneLeaveGroupCallPresentation sets handler of phone.leaveGroupCallPresentation#1c50d144 method.
+//
+// See https://core.telegram.org/method/phone.leaveGroupCallPresentation for reference.
 func (s *ServerDispatcher) OnPhoneLeaveGroupCallPresentation(f func(ctx context.Context, call InputGroupCall) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneLeaveGroupCallPresentationRequest
@@ -9670,6 +11280,9 @@ func (s *ServerDispatcher) OnPhoneLeaveGroupCallPresentation(f func(ctx context.
 	s.handlers[PhoneLeaveGroupCallPresentationRequestTypeID] = handler
 }
 
+// OnPhoneGetGroupCallStreamChannels sets handler of phone.getGroupCallStreamChannels#1ab21940 method.
+//
+// See https://core.telegram.org/method/phone.getGroupCallStreamChannels for reference.
 func (s *ServerDispatcher) OnPhoneGetGroupCallStreamChannels(f func(ctx context.Context, call InputGroupCall) (*PhoneGroupCallStreamChannels, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneGetGroupCallStreamChannelsRequest
@@ -9687,6 +11300,9 @@ func (s *ServerDispatcher) OnPhoneGetGroupCallStreamChannels(f func(ctx context.
 	s.handlers[PhoneGetGroupCallStreamChannelsRequestTypeID] = handler
 }
 
+// OnPhoneGetGroupCallStreamRtmpURL sets handler of phone.getGroupCallStreamRtmpUrl#deb3abbf method.
+//
+// See https://core.telegram.org/method/phone.getGroupCallStreamRtmpUrl for reference.
 func (s *ServerDispatcher) OnPhoneGetGroupCallStreamRtmpURL(f func(ctx context.Context, request *PhoneGetGroupCallStreamRtmpURLRequest) (*PhoneGroupCallStreamRtmpURL, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneGetGroupCallStreamRtmpURLRequest
@@ -9704,6 +11320,9 @@ func (s *ServerDispatcher) OnPhoneGetGroupCallStreamRtmpURL(f func(ctx context.C
 	s.handlers[PhoneGetGroupCallStreamRtmpURLRequestTypeID] = handler
 }
 
+// OnPhoneSaveCallLog sets handler of phone.saveCallLog#41248786 method.
+//
+// See https://core.telegram.org/method/phone.saveCallLog for reference.
 func (s *ServerDispatcher) OnPhoneSaveCallLog(f func(ctx context.Context, request *PhoneSaveCallLogRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PhoneSaveCallLogRequest
@@ -9725,6 +11344,9 @@ func (s *ServerDispatcher) OnPhoneSaveCallLog(f func(ctx context.Context, reques
 	s.handlers[PhoneSaveCallLogRequestTypeID] = handler
 }
 
+// OnLangpackGetLangPack sets handler of langpack.getLangPack#f2f2330a method.
+//
+// See https://core.telegram.org/method/langpack.getLangPack for reference.
 func (s *ServerDispatcher) OnLangpackGetLangPack(f func(ctx context.Context, request *LangpackGetLangPackRequest) (*LangPackDifference, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request LangpackGetLangPackRequest
@@ -9742,6 +11364,9 @@ func (s *ServerDispatcher) OnLangpackGetLangPack(f func(ctx context.Context, req
 	s.handlers[LangpackGetLangPackRequestTypeID] = handler
 }
 
+// OnLangpackGetStrings sets handler of langpack.getStrings#efea3803 method.
+//
+// See https://core.telegram.org/method/langpack.getStrings for reference.
 func (s *ServerDispatcher) OnLangpackGetStrings(f func(ctx context.Context, request *LangpackGetStringsRequest) ([]LangPackStringClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request LangpackGetStringsRequest
@@ -9759,6 +11384,9 @@ func (s *ServerDispatcher) OnLangpackGetStrings(f func(ctx context.Context, requ
 	s.handlers[LangpackGetStringsRequestTypeID] = handler
 }
 
+// OnLangpackGetDifference sets handler of langpack.getDifference#cd984aa5 method.
+//
+// See https://core.telegram.org/method/langpack.getDifference for reference.
 func (s *ServerDispatcher) OnLangpackGetDifference(f func(ctx context.Context, request *LangpackGetDifferenceRequest) (*LangPackDifference, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request LangpackGetDifferenceRequest
@@ -9776,6 +11404,9 @@ func (s *ServerDispatcher) OnLangpackGetDifference(f func(ctx context.Context, r
 	s.handlers[LangpackGetDifferenceRequestTypeID] = handler
 }
 
+// OnLangpackGetLanguages sets handler of langpack.getLanguages#42c6978f method.
+//
+// See https://core.telegram.org/method/langpack.getLanguages for reference.
 func (s *ServerDispatcher) OnLangpackGetLanguages(f func(ctx context.Context, langpack string) ([]LangPackLanguage, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request LangpackGetLanguagesRequest
@@ -9793,6 +11424,9 @@ func (s *ServerDispatcher) OnLangpackGetLanguages(f func(ctx context.Context, la
 	s.handlers[LangpackGetLanguagesRequestTypeID] = handler
 }
 
+// OnLangpackGetLanguage sets handler of langpack.getLanguage#6a596502 method.
+//
+// See https://core.telegram.org/method/langpack.getLanguage for reference.
 func (s *ServerDispatcher) OnLangpackGetLanguage(f func(ctx context.Context, request *LangpackGetLanguageRequest) (*LangPackLanguage, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request LangpackGetLanguageRequest
@@ -9810,6 +11444,9 @@ func (s *ServerDispatcher) OnLangpackGetLanguage(f func(ctx context.Context, req
 	s.handlers[LangpackGetLanguageRequestTypeID] = handler
 }
 
+// OnFoldersEditPeerFolders sets handler of folders.editPeerFolders#6847d0ab method.
+//
+// See https://core.telegram.org/method/folders.editPeerFolders for reference.
 func (s *ServerDispatcher) OnFoldersEditPeerFolders(f func(ctx context.Context, folderpeers []InputFolderPeer) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request FoldersEditPeerFoldersRequest
@@ -9827,6 +11464,9 @@ func (s *ServerDispatcher) OnFoldersEditPeerFolders(f func(ctx context.Context,
 	s.handlers[FoldersEditPeerFoldersRequestTypeID] = handler
 }
 
+// OnStatsGetBroadcastStats sets handler of stats.getBroadcastStats#ab42441a method.
+//
+// See https://core.telegram.org/method/stats.getBroadcastStats for reference.
 func (s *ServerDispatcher) OnStatsGetBroadcastStats(f func(ctx context.Context, request *StatsGetBroadcastStatsRequest) (*StatsBroadcastStats, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StatsGetBroadcastStatsRequest
@@ -9844,6 +11484,9 @@ func (s *ServerDispatcher) OnStatsGetBroadcastStats(f func(ctx context.Context,
 	s.handlers[StatsGetBroadcastStatsRequestTypeID] = handler
 }
 
+// OnStatsLoadAsyncGraph sets handler of stats.loadAsyncGraph#621d5fa0 method.
+//
+// See https://core.telegram.org/method/stats.loadAsyncGraph for reference.
 func (s *ServerDispatcher) OnStatsLoadAsyncGraph(f func(ctx context.Context, request *StatsLoadAsyncGraphRequest) (StatsGraphClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StatsLoadAsyncGraphRequest
@@ -9861,6 +11504,9 @@ func (s *ServerDispatcher) OnStatsLoadAsyncGraph(f func(ctx context.Context, req
 	s.handlers[StatsLoadAsyncGraphRequestTypeID] = handler
 }
 
+// OnStatsGetMegagroupStats sets handler of stats.getMegagroupStats#dcdf8607 method.
+//
+// See https://core.telegram.org/method/stats.getMegagroupStats for reference.
 func (s *ServerDispatcher) OnStatsGetMegagroupStats(f func(ctx context.Context, request *StatsGetMegagroupStatsRequest) (*StatsMegagroupStats, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StatsGetMegagroupStatsRequest
@@ -9878,6 +11524,9 @@ func (s *ServerDispatcher) OnStatsGetMegagroupStats(f func(ctx context.Context,
 	s.handlers[StatsGetMegagroupStatsRequestTypeID] = handler
 }
 
+// OnStatsGetMessagePublicForwards sets handler of stats.getMessagePublicForwards#5f150144 method.
+//
+// See https://core.telegram.org/method/stats.getMessagePublicForwards for reference.
 func (s *ServerDispatcher) OnStatsGetMessagePublicForwards(f func(ctx context.Context, request *StatsGetMessagePublicForwardsRequest) (*StatsPublicForwards, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StatsGetMessagePublicForwardsRequest
@@ -9895,6 +11544,9 @@ func (s *ServerDispatcher) OnStatsGetMessagePublicForwards(f func(ctx context.Co
 	s.handlers[StatsGetMessagePublicForwardsRequestTypeID] = handler
 }
 
+// OnStatsGetMessageStats sets handler of stats.getMessageStats#b6e0a3f5 method.
+//
+// See https://core.telegram.org/method/stats.getMessageStats for reference.
 func (s *ServerDispatcher) OnStatsGetMessageStats(f func(ctx context.Context, request *StatsGetMessageStatsRequest) (*StatsMessageStats, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StatsGetMessageStatsRequest
@@ -9912,6 +11564,9 @@ func (s *ServerDispatcher) OnStatsGetMessageStats(f func(ctx context.Context, re
 	s.handlers[StatsGetMessageStatsRequestTypeID] = handler
 }
 
+// OnStatsGetStoryStats sets handler of stats.getStoryStats#374fef40 method.
+//
+// See https://core.telegram.org/method/stats.getStoryStats for reference.
 func (s *ServerDispatcher) OnStatsGetStoryStats(f func(ctx context.Context, request *StatsGetStoryStatsRequest) (*StatsStoryStats, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StatsGetStoryStatsRequest
@@ -9929,6 +11584,9 @@ func (s *ServerDispatcher) OnStatsGetStoryStats(f func(ctx context.Context, requ
 	s.handlers[StatsGetStoryStatsRequestTypeID] = handler
 }
 
+// OnStatsGetStoryPublicForwards sets handler of stats.getStoryPublicForwards#a6437ef6 method.
+//
+// See https://core.telegram.org/method/stats.getStoryPublicForwards for reference.
 func (s *ServerDispatcher) OnStatsGetStoryPublicForwards(f func(ctx context.Context, request *StatsGetStoryPublicForwardsRequest) (*StatsPublicForwards, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StatsGetStoryPublicForwardsRequest
@@ -9946,6 +11604,9 @@ func (s *ServerDispatcher) OnStatsGetStoryPublicForwards(f func(ctx context.Cont
 	s.handlers[StatsGetStoryPublicForwardsRequestTypeID] = handler
 }
 
+// OnChatlistsExportChatlistInvite sets handler of chatlists.exportChatlistInvite#8472478e method.
+//
+// See https://core.telegram.org/method/chatlists.exportChatlistInvite for reference.
 func (s *ServerDispatcher) OnChatlistsExportChatlistInvite(f func(ctx context.Context, request *ChatlistsExportChatlistInviteRequest) (*ChatlistsExportedChatlistInvite, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsExportChatlistInviteRequest
@@ -9963,6 +11624,9 @@ func (s *ServerDispatcher) OnChatlistsExportChatlistInvite(f func(ctx context.Co
 	s.handlers[ChatlistsExportChatlistInviteRequestTypeID] = handler
 }
 
+// OnChatlistsDeleteExportedInvite sets handler of chatlists.deleteExportedInvite#719c5c5e method.
+//
+// See https://core.telegram.org/method/chatlists.deleteExportedInvite for reference.
 func (s *ServerDispatcher) OnChatlistsDeleteExportedInvite(f func(ctx context.Context, request *ChatlistsDeleteExportedInviteRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsDeleteExportedInviteRequest
@@ -9984,6 +11648,9 @@ func (s *ServerDispatcher) OnChatlistsDeleteExportedInvite(f func(ctx context.Co
 	s.handlers[ChatlistsDeleteExportedInviteRequestTypeID] = handler
 }
 
+// OnChatlistsEditExportedInvite sets handler of chatlists.editExportedInvite#653db63d method.
+//
+// See https://core.telegram.org/method/chatlists.editExportedInvite for reference.
 func (s *ServerDispatcher) OnChatlistsEditExportedInvite(f func(ctx context.Context, request *ChatlistsEditExportedInviteRequest) (*ExportedChatlistInvite, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsEditExportedInviteRequest
@@ -10001,6 +11668,9 @@ func (s *ServerDispatcher) OnChatlistsEditExportedInvite(f func(ctx context.Cont
 	s.handlers[ChatlistsEditExportedInviteRequestTypeID] = handler
 }
 
+// OnChatlistsGetExportedInvites sets handler of chatlists.getExportedInvites#ce03da83 method.
+//
+// See https://core.telegram.org/method/chatlists.getExportedInvites for reference.
 func (s *ServerDispatcher) OnChatlistsGetExportedInvites(f func(ctx context.Context, chatlist InputChatlistDialogFilter) (*ChatlistsExportedInvites, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsGetExportedInvitesRequest
@@ -10018,6 +11688,9 @@ func (s *ServerDispatcher) OnChatlistsGetExportedInvites(f func(ctx context.Cont
 	s.handlers[ChatlistsGetExportedInvitesRequestTypeID] = handler
 }
 
+// OnChatlistsCheckChatlistInvite sets handler of chatlists.checkChatlistInvite#41c10fff method.
+//
+// See https://core.telegram.org/method/chatlists.checkChatlistInvite for reference.
 func (s *ServerDispatcher) OnChatlistsCheckChatlistInvite(f func(ctx context.Context, slug string) (ChatlistsChatlistInviteClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsCheckChatlistInviteRequest
@@ -10035,6 +11708,9 @@ func (s *ServerDispatcher) OnChatlistsCheckChatlistInvite(f func(ctx context.Con
 	s.handlers[ChatlistsCheckChatlistInviteRequestTypeID] = handler
 }
 
+// OnChatlistsJoinChatlistInvite sets handler of chatlists.joinChatlistInvite#a6b1e39a method.
+//
+// See https://core.telegram.org/method/chatlists.joinChatlistInvite for reference.
 func (s *ServerDispatcher) OnChatlistsJoinChatlistInvite(f func(ctx context.Context, request *ChatlistsJoinChatlistInviteRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsJoinChatlistInviteRequest
@@ -10052,6 +11728,9 @@ func (s *ServerDispatcher) OnChatlistsJoinChatlistInvite(f func(ctx context.Cont
 	s.handlers[ChatlistsJoinChatlistInviteRequestTypeID] = handler
 }
 
+// OnChatlistsGetChatlistUpdates sets handler of chatlists.getChatlistUpdates#89419521 method.
+//
+// See https://core.telegram.org/method/chatlists.getChatlistUpdates for reference.
 func (s *ServerDispatcher) OnChatlistsGetChatlistUpdates(f func(ctx context.Context, chatlist InputChatlistDialogFilter) (*ChatlistsChatlistUpdates, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsGetChatlistUpdatesRequest
@@ -10069,6 +11748,9 @@ func (s *ServerDispatcher) OnChatlistsGetChatlistUpdates(f func(ctx context.Cont
 	s.handlers[ChatlistsGetChatlistUpdatesRequestTypeID] = handler
 }
 
+// OnChatlistsJoinChatlistUpdates sets handler of chatlists.joinChatlistUpdates#e089f8f5 method.
+//
+// See https://core.telegram.org/method/chatlists.joinChatlistUpdates for reference.
 func (s *ServerDispatcher) OnChatlistsJoinChatlistUpdates(f func(ctx context.Context, request *ChatlistsJoinChatlistUpdatesRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsJoinChatlistUpdatesRequest
@@ -10086,6 +11768,9 @@ func (s *ServerDispatcher) OnChatlistsJoinChatlistUpdates(f func(ctx context.Con
 	s.handlers[ChatlistsJoinChatlistUpdatesRequestTypeID] = handler
 }
 
+// OnChatlistsHideChatlistUpdates sets handler of chatlists.hideChatlistUpdates#66e486fb method.
+//
+// See https://core.telegram.org/method/chatlists.hideChatlistUpdates for reference.
 func (s *ServerDispatcher) OnChatlistsHideChatlistUpdates(f func(ctx context.Context, chatlist InputChatlistDialogFilter) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsHideChatlistUpdatesRequest
@@ -10107,6 +11792,9 @@ func (s *ServerDispatcher) OnChatlistsHideChatlistUpdates(f func(ctx context.Con
 	s.handlers[ChatlistsHideChatlistUpdatesRequestTypeID] = handler
 }
 
+// OnChatlistsGetLeaveChatlistSuggestions sets handler of chatlists.getLeaveChatlistSuggestions#fdbcd714 method.
+//
+// See https://core.telegram.org/method/chatlists.getLeaveChatlistSuggestions for reference.
 func (s *ServerDispatcher) OnChatlistsGetLeaveChatlistSuggestions(f func(ctx context.Context, chatlist InputChatlistDialogFilter) ([]PeerClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsGetLeaveChatlistSuggestionsRequest
@@ -10124,6 +11812,9 @@ func (s *ServerDispatcher) OnChatlistsGetLeaveChatlistSuggestions(f func(ctx con
 	s.handlers[ChatlistsGetLeaveChatlistSuggestionsRequestTypeID] = handler
 }
 
+// OnChatlistsLeaveChatlist sets handler of chatlists.leaveChatlist#74fae13a method.
+//
+// See https://core.telegram.org/method/chatlists.leaveChatlist for reference.
 func (s *ServerDispatcher) OnChatlistsLeaveChatlist(f func(ctx context.Context, request *ChatlistsLeaveChatlistRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request ChatlistsLeaveChatlistRequest
@@ -10141,6 +11832,9 @@ func (s *ServerDispatcher) OnChatlistsLeaveChatlist(f func(ctx context.Context,
 	s.handlers[ChatlistsLeaveChatlistRequestTypeID] = handler
 }
 
+// OnStoriesCanSendStory sets handler of stories.canSendStory#c7dfdfdd method.
+//
+// See https://core.telegram.org/method/stories.canSendStory for reference.
 func (s *ServerDispatcher) OnStoriesCanSendStory(f func(ctx context.Context, peer InputPeerClass) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesCanSendStoryRequest
@@ -10162,6 +11856,9 @@ func (s *ServerDispatcher) OnStoriesCanSendStory(f func(ctx context.Context, pee
 	s.handlers[StoriesCanSendStoryRequestTypeID] = handler
 }
 
+// OnStoriesSendStory sets handler of stories.sendStory#e4e6694b method.
+//
+// See https://core.telegram.org/method/stories.sendStory for reference.
 func (s *ServerDispatcher) OnStoriesSendStory(f func(ctx context.Context, request *StoriesSendStoryRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesSendStoryRequest
@@ -10179,6 +11876,9 @@ func (s *ServerDispatcher) OnStoriesSendStory(f func(ctx context.Context, reques
 	s.handlers[StoriesSendStoryRequestTypeID] = handler
 }
 
+// OnStoriesEditStory sets handler of stories.editStory#b583ba46 method.
+//
+// See https://core.telegram.org/method/stories.editStory for reference.
 func (s *ServerDispatcher) OnStoriesEditStory(f func(ctx context.Context, request *StoriesEditStoryRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesEditStoryRequest
@@ -10196,6 +11896,9 @@ func (s *ServerDispatcher) OnStoriesEditStory(f func(ctx context.Context, reques
 	s.handlers[StoriesEditStoryRequestTypeID] = handler
 }
 
+// OnStoriesDeleteStories sets handler of stories.deleteStories#ae59db5f method.
+//
+// See https://core.telegram.org/method/stories.deleteStories for reference.
 func (s *ServerDispatcher) OnStoriesDeleteStories(f func(ctx context.Context, request *StoriesDeleteStoriesRequest) ([]int, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesDeleteStoriesRequest
@@ -10213,6 +11916,9 @@ func (s *ServerDispatcher) OnStoriesDeleteStories(f func(ctx context.Context, re
 	s.handlers[StoriesDeleteStoriesRequestTypeID] = handler
 }
 
+// OnStoriesTogglePinned sets handler of stories.togglePinned#9a75a1ef method.
+//
+// See https://core.telegram.org/method/stories.togglePinned for reference.
 func (s *ServerDispatcher) OnStoriesTogglePinned(f func(ctx context.Context, request *StoriesTogglePinnedRequest) ([]int, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesTogglePinnedRequest
@@ -10230,6 +11936,9 @@ func (s *ServerDispatcher) OnStoriesTogglePinned(f func(ctx context.Context, req
 	s.handlers[StoriesTogglePinnedRequestTypeID] = handler
 }
 
+// OnStoriesGetAllStories sets handler of stories.getAllStories#eeb0d625 method.
+//
+// See https://core.telegram.org/method/stories.getAllStories for reference.
 func (s *ServerDispatcher) OnStoriesGetAllStories(f func(ctx context.Context, request *StoriesGetAllStoriesRequest) (StoriesAllStoriesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetAllStoriesRequest
@@ -10247,6 +11956,9 @@ func (s *ServerDispatcher) OnStoriesGetAllStories(f func(ctx context.Context, re
 	s.handlers[StoriesGetAllStoriesRequestTypeID] = handler
 }
 
+// OnStoriesGetPinnedStories sets handler of stories.getPinnedStories#5821a5dc method.
+//
+// See https://core.telegram.org/method/stories.getPinnedStories for reference.
 func (s *ServerDispatcher) OnStoriesGetPinnedStories(f func(ctx context.Context, request *StoriesGetPinnedStoriesRequest) (*StoriesStories, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetPinnedStoriesRequest
@@ -10264,6 +11976,9 @@ func (s *ServerDispatcher) OnStoriesGetPinnedStories(f func(ctx context.Context,
 	s.handlers[StoriesGetPinnedStoriesRequestTypeID] = handler
 }
 
+// OnStoriesGetStoriesArchive sets handler of stories.getStoriesArchive#b4352016 method.
+//
+// See https://core.telegram.org/method/stories.getStoriesArchive for reference.
 func (s *ServerDispatcher) OnStoriesGetStoriesArchive(f func(ctx context.Context, request *StoriesGetStoriesArchiveRequest) (*StoriesStories, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetStoriesArchiveRequest
@@ -10281,6 +11996,9 @@ func (s *ServerDispatcher) OnStoriesGetStoriesArchive(f func(ctx context.Context
 	s.handlers[StoriesGetStoriesArchiveRequestTypeID] = handler
 }
 
+// OnStoriesGetStoriesByID sets handler of stories.getStoriesByID#5774ca74 method.
+//
+// See https://core.telegram.org/method/stories.getStoriesByID for reference.
 func (s *ServerDispatcher) OnStoriesGetStoriesByID(f func(ctx context.Context, request *StoriesGetStoriesByIDRequest) (*StoriesStories, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetStoriesByIDRequest
@@ -10298,6 +12016,9 @@ func (s *ServerDispatcher) OnStoriesGetStoriesByID(f func(ctx context.Context, r
 	s.handlers[StoriesGetStoriesByIDRequestTypeID] = handler
 }
 
+// OnStoriesToggleAllStoriesHidden sets handler of stories.toggleAllStoriesHidden#7c2557c4 method.
+//
+// See https://core.telegram.org/method/stories.toggleAllStoriesHidden for reference.
 func (s *ServerDispatcher) OnStoriesToggleAllStoriesHidden(f func(ctx context.Context, hidden bool) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesToggleAllStoriesHiddenRequest
@@ -10319,6 +12040,9 @@ func (s *ServerDispatcher) OnStoriesToggleAllStoriesHidden(f func(ctx context.Co
 	s.handlers[StoriesToggleAllStoriesHiddenRequestTypeID] = handler
 }
 
+// OnStoriesReadStories sets handler of stories.readStories#a556dac8 method.
+//
+// See https://core.telegram.org/method/stories.readStories for reference.
 func (s *ServerDispatcher) OnStoriesReadStories(f func(ctx context.Context, request *StoriesReadStoriesRequest) ([]int, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesReadStoriesRequest
@@ -10336,6 +12060,9 @@ func (s *ServerDispatcher) OnStoriesReadStories(f func(ctx context.Context, requ
 	s.handlers[StoriesReadStoriesRequestTypeID] = handler
 }
 
+// OnStoriesIncrementStoryViews sets handler of stories.incrementStoryViews#b2028afb method.
+//
+// See https://core.telegram.org/method/stories.incrementStoryViews for reference.
 func (s *ServerDispatcher) OnStoriesIncrementStoryViews(f func(ctx context.Context, request *StoriesIncrementStoryViewsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesIncrementStoryViewsRequest
@@ -10357,6 +12084,9 @@ func (s *ServerDispatcher) OnStoriesIncrementStoryViews(f func(ctx context.Conte
 	s.handlers[StoriesIncrementStoryViewsRequestTypeID] = handler
 }
 
+// OnStoriesGetStoryViewsList sets handler of stories.getStoryViewsList#7ed23c57 method.
+//
+// See https://core.telegram.org/method/stories.getStoryViewsList for reference.
 func (s *ServerDispatcher) OnStoriesGetStoryViewsList(f func(ctx context.Context, request *StoriesGetStoryViewsListRequest) (*StoriesStoryViewsList, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetStoryViewsListRequest
@@ -10374,6 +12104,9 @@ func (s *ServerDispatcher) OnStoriesGetStoryViewsList(f func(ctx context.Context
 	s.handlers[StoriesGetStoryViewsListRequestTypeID] = handler
 }
 
+// OnStoriesGetStoriesViews sets handler of stories.getStoriesViews#28e16cc8 method.
+//
+// See https://core.telegram.org/method/stories.getStoriesViews for reference.
 func (s *ServerDispatcher) OnStoriesGetStoriesViews(f func(ctx context.Context, request *StoriesGetStoriesViewsRequest) (*StoriesStoryViews, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetStoriesViewsRequest
@@ -10391,6 +12124,9 @@ func (s *ServerDispatcher) OnStoriesGetStoriesViews(f func(ctx context.Context,
 	s.handlers[StoriesGetStoriesViewsRequestTypeID] = handler
 }
 
+// OnStoriesExportStoryLink sets handler of stories.exportStoryLink#7b8def20 method.
+//
+// See https://core.telegram.org/method/stories.exportStoryLink for reference.
 func (s *ServerDispatcher) OnStoriesExportStoryLink(f func(ctx context.Context, request *StoriesExportStoryLinkRequest) (*ExportedStoryLink, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesExportStoryLinkRequest
@@ -10408,6 +12144,9 @@ func (s *ServerDispatcher) OnStoriesExportStoryLink(f func(ctx context.Context,
 	s.handlers[StoriesExportStoryLinkRequestTypeID] = handler
 }
 
+// OnStoriesReport sets handler of stories.report#1923fa8c method.
+//
+// See https://core.telegram.org/method/stories.report for reference.
 func (s *ServerDispatcher) OnStoriesReport(f func(ctx context.Context, request *StoriesReportRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesReportRequest
@@ -10429,6 +12168,9 @@ func (s *ServerDispatcher) OnStoriesReport(f func(ctx context.Context, request *
 	s.handlers[StoriesReportRequestTypeID] = handler
 }
 
+// OnStoriesActivateStealthMode sets handler of stories.activateStealthMode#57bbd166 method.
+//
+// See https://core.telegram.org/method/stories.activateStealthMode for reference.
 func (s *ServerDispatcher) OnStoriesActivateStealthMode(f func(ctx context.Context, request *StoriesActivateStealthModeRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesActivateStealthModeRequest
@@ -10446,6 +12188,9 @@ func (s *ServerDispatcher) OnStoriesActivateStealthMode(f func(ctx context.Conte
 	s.handlers[StoriesActivateStealthModeRequestTypeID] = handler
 }
 
+// OnStoriesSendReaction sets handler of stories.sendReaction#7fd736b2 method.
+//
+// See https://core.telegram.org/method/stories.sendReaction for reference.
 func (s *ServerDispatcher) OnStoriesSendReaction(f func(ctx context.Context, request *StoriesSendReactionRequest) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesSendReactionRequest
@@ -10463,6 +12208,9 @@ func (s *ServerDispatcher) OnStoriesSendReaction(f func(ctx context.Context, req
 	s.handlers[StoriesSendReactionRequestTypeID] = handler
 }
 
+// OnStoriesGetPeerStories sets handler of stories.getPeerStories#2c4ada50 method.
+//
+// See https://core.telegram.org/method/stories.getPeerStories for reference.
 func (s *ServerDispatcher) OnStoriesGetPeerStories(f func(ctx context.Context, peer InputPeerClass) (*StoriesPeerStories, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetPeerStoriesRequest
@@ -10480,6 +12228,9 @@ func (s *ServerDispatcher) OnStoriesGetPeerStories(f func(ctx context.Context, p
 	s.handlers[StoriesGetPeerStoriesRequestTypeID] = handler
 }
 
+// OnStoriesGetAllReadPeerStories sets handler of stories.getAllReadPeerStories#9b5ae7f9 method.
+//
+// See https://core.telegram.org/method/stories.getAllReadPeerStories for reference.
 func (s *ServerDispatcher) OnStoriesGetAllReadPeerStories(f func(ctx context.Context) (UpdatesClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetAllReadPeerStoriesRequest
@@ -10497,6 +12248,9 @@ func (s *ServerDispatcher) OnStoriesGetAllReadPeerStories(f func(ctx context.Con
 	s.handlers[StoriesGetAllReadPeerStoriesRequestTypeID] = handler
 }
 
+// OnStoriesGetPeerMaxIDs sets handler of stories.getPeerMaxIDs#535983c3 method.
+//
+// See https://core.telegram.org/method/stories.getPeerMaxIDs for reference.
 func (s *ServerDispatcher) OnStoriesGetPeerMaxIDs(f func(ctx context.Context, id []InputPeerClass) ([]int, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetPeerMaxIDsRequest
@@ -10514,6 +12268,9 @@ func (s *ServerDispatcher) OnStoriesGetPeerMaxIDs(f func(ctx context.Context, id
 	s.handlers[StoriesGetPeerMaxIDsRequestTypeID] = handler
 }
 
+// OnStoriesGetChatsToSend sets handler of stories.getChatsToSend#a56a8b60 method.
+//
+// See https://core.telegram.org/method/stories.getChatsToSend for reference.
 func (s *ServerDispatcher) OnStoriesGetChatsToSend(f func(ctx context.Context) (MessagesChatsClass, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetChatsToSendRequest
@@ -10531,6 +12288,9 @@ func (s *ServerDispatcher) OnStoriesGetChatsToSend(f func(ctx context.Context) (
 	s.handlers[StoriesGetChatsToSendRequestTypeID] = handler
 }
 
+// OnStoriesTogglePeerStoriesHidden sets handler of stories.togglePeerStoriesHidden#bd0415c4 method.
+//
+// See https://core.telegram.org/method/stories.togglePeerStoriesHidden for reference.
 func (s *ServerDispatcher) OnStoriesTogglePeerStoriesHidden(f func(ctx context.Context, request *StoriesTogglePeerStoriesHiddenRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesTogglePeerStoriesHiddenRequest
@@ -10552,6 +12312,9 @@ func (s *ServerDispatcher) OnStoriesTogglePeerStoriesHidden(f func(ctx context.C
 	s.handlers[StoriesTogglePeerStoriesHiddenRequestTypeID] = handler
 }
 
+// OnStoriesGetStoryReactionsList sets handler of stories.getStoryReactionsList#b9b2881f method.
+//
+// See https://core.telegram.org/method/stories.getStoryReactionsList for reference.
 func (s *ServerDispatcher) OnStoriesGetStoryReactionsList(f func(ctx context.Context, request *StoriesGetStoryReactionsListRequest) (*StoriesStoryReactionsList, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request StoriesGetStoryReactionsListRequest
@@ -10569,6 +12332,9 @@ func (s *ServerDispatcher) OnStoriesGetStoryReactionsList(f func(ctx context.Con
 	s.handlers[StoriesGetStoryReactionsListRequestTypeID] = handler
 }
 
+// OnPremiumGetBoostsList sets handler of premium.getBoostsList#60f67660 method.
+//
+// See https://core.telegram.org/method/premium.getBoostsList for reference.
 func (s *ServerDispatcher) OnPremiumGetBoostsList(f func(ctx context.Context, request *PremiumGetBoostsListRequest) (*PremiumBoostsList, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PremiumGetBoostsListRequest
@@ -10586,6 +12352,9 @@ func (s *ServerDispatcher) OnPremiumGetBoostsList(f func(ctx context.Context, re
 	s.handlers[PremiumGetBoostsListRequestTypeID] = handler
 }
 
+// OnPremiumGetMyBoosts sets handler of premium.getMyBoosts#be77b4a method.
+//
+// See https://core.telegram.org/method/premium.getMyBoosts for reference.
 func (s *ServerDispatcher) OnPremiumGetMyBoosts(f func(ctx context.Context) (*PremiumMyBoosts, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PremiumGetMyBoostsRequest
@@ -10603,6 +12372,9 @@ func (s *ServerDispatcher) OnPremiumGetMyBoosts(f func(ctx context.Context) (*Pr
 	s.handlers[PremiumGetMyBoostsRequestTypeID] = handler
 }
 
+// OnPremiumApplyBoost sets handler of premium.applyBoost#6b7da746 method.
+//
+// See https://core.telegram.org/method/premium.applyBoost for reference.
 func (s *ServerDispatcher) OnPremiumApplyBoost(f func(ctx context.Context, request *PremiumApplyBoostRequest) (*PremiumMyBoosts, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PremiumApplyBoostRequest
@@ -10620,6 +12392,9 @@ func (s *ServerDispatcher) OnPremiumApplyBoost(f func(ctx context.Context, reque
 	s.handlers[PremiumApplyBoostRequestTypeID] = handler
 }
 
+// OnPremiumGetBoostsStatus sets handler of premium.getBoostsStatus#42f1f61 method.
+//
+// See https://core.telegram.org/method/premium.getBoostsStatus for reference.
 func (s *ServerDispatcher) OnPremiumGetBoostsStatus(f func(ctx context.Context, peer InputPeerClass) (*PremiumBoostsStatus, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PremiumGetBoostsStatusRequest
@@ -10637,6 +12412,9 @@ func (s *ServerDispatcher) OnPremiumGetBoostsStatus(f func(ctx context.Context,
 	s.handlers[PremiumGetBoostsStatusRequestTypeID] = handler
 }
 
+// OnPremiumGetUserBoosts sets handler of premium.getUserBoosts#39854d1f method.
+//
+// See https://core.telegram.org/method/premium.getUserBoosts for reference.
 func (s *ServerDispatcher) OnPremiumGetUserBoosts(f func(ctx context.Context, request *PremiumGetUserBoostsRequest) (*PremiumBoostsList, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request PremiumGetUserBoostsRequest
@@ -10654,6 +12432,9 @@ func (s *ServerDispatcher) OnPremiumGetUserBoosts(f func(ctx context.Context, re
 	s.handlers[PremiumGetUserBoostsRequestTypeID] = handler
 }
 
+// OnSMSJobsIsEligibleToJoin sets handler of smsjobs.isEligibleToJoin#edc39d0 method.
+//
+// See https://core.telegram.org/method/smsjobs.isEligibleToJoin for reference.
 func (s *ServerDispatcher) OnSMSJobsIsEligibleToJoin(f func(ctx context.Context) (*SMSJobsEligibleToJoin, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SMSJobsIsEligibleToJoinRequest
@@ -10671,6 +12452,9 @@ func (s *ServerDispatcher) OnSMSJobsIsEligibleToJoin(f func(ctx context.Context)
 	s.handlers[SMSJobsIsEligibleToJoinRequestTypeID] = handler
 }
 
+// OnSMSJobsJoin sets handler of smsjobs.join#a74ece2d method.
+//
+// See https://core.telegram.org/method/smsjobs.join for reference.
 func (s *ServerDispatcher) OnSMSJobsJoin(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SMSJobsJoinRequest
@@ -10692,6 +12476,9 @@ func (s *ServerDispatcher) OnSMSJobsJoin(f func(ctx context.Context) (bool, erro
 	s.handlers[SMSJobsJoinRequestTypeID] = handler
 }
 
+// OnSMSJobsLeave sets handler of smsjobs.leave#9898ad73 method.
+//
+// See https://core.telegram.org/method/smsjobs.leave for reference.
 func (s *ServerDispatcher) OnSMSJobsLeave(f func(ctx context.Context) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SMSJobsLeaveRequest
@@ -10713,6 +12500,9 @@ func (s *ServerDispatcher) OnSMSJobsLeave(f func(ctx context.Context) (bool, err
 	s.handlers[SMSJobsLeaveRequestTypeID] = handler
 }
 
+// OnSMSJobsUpdateSettings sets handler of smsjobs.updateSettings#93fa0bf method.
+//
+// See https://core.telegram.org/method/smsjobs.updateSettings for reference.
 func (s *ServerDispatcher) OnSMSJobsUpdateSettings(f func(ctx context.Context, request *SMSJobsUpdateSettingsRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SMSJobsUpdateSettingsRequest
@@ -10734,6 +12524,9 @@ func (s *ServerDispatcher) OnSMSJobsUpdateSettings(f func(ctx context.Context, r
 	s.handlers[SMSJobsUpdateSettingsRequestTypeID] = handler
 }
 
+// OnSMSJobsGetStatus sets handler of smsjobs.getStatus#10a698e8 method.
+//
+// See https://core.telegram.org/method/smsjobs.getStatus for reference.
 func (s *ServerDispatcher) OnSMSJobsGetStatus(f func(ctx context.Context) (*SMSJobsStatus, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SMSJobsGetStatusRequest
@@ -10751,6 +12544,9 @@ func (s *ServerDispatcher) OnSMSJobsGetStatus(f func(ctx context.Context) (*SMSJ
 	s.handlers[SMSJobsGetStatusRequestTypeID] = handler
 }
 
+// OnSMSJobsGetSMSJob sets handler of smsjobs.getSmsJob#778d902f method.
+//
+// See https://core.telegram.org/method/smsjobs.getSmsJob for reference.
 func (s *ServerDispatcher) OnSMSJobsGetSMSJob(f func(ctx context.Context, jobid string) (*SMSJob, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SMSJobsGetSMSJobRequest
@@ -10768,6 +12564,9 @@ func (s *ServerDispatcher) OnSMSJobsGetSMSJob(f func(ctx context.Context, jobid
 	s.handlers[SMSJobsGetSMSJobRequestTypeID] = handler
 }
 
+// OnSMSJobsFinishJob sets handler of smsjobs.finishJob#4f1ebf24 method.
+//
+// See https://core.telegram.org/method/smsjobs.finishJob for reference.
 func (s *ServerDispatcher) OnSMSJobsFinishJob(f func(ctx context.Context, request *SMSJobsFinishJobRequest) (bool, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request SMSJobsFinishJobRequest
@@ -10789,6 +12588,9 @@ func (s *ServerDispatcher) OnSMSJobsFinishJob(f func(ctx context.Context, reques
 	s.handlers[SMSJobsFinishJobRequestTypeID] = handler
 }
 
+// OnTestUseError sets handler of test.useError#ee75af01 method.
+//
+// See https://core.telegram.org/method/test.useError for reference.
 func (s *ServerDispatcher) OnTestUseError(f func(ctx context.Context) (*Error, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request TestUseErrorRequest
@@ -10806,6 +12608,9 @@ func (s *ServerDispatcher) OnTestUseError(f func(ctx context.Context) (*Error, e
 	s.handlers[TestUseErrorRequestTypeID] = handler
 }
 
+// OnTestUseConfigSimple sets handler of test.useConfigSimple#f9b7b23d method.
+//
+// See https://core.telegram.org/method/test.useConfigSimple for reference.
 func (s *ServerDispatcher) OnTestUseConfigSimple(f func(ctx context.Context) (*HelpConfigSimple, error)) {
 	handler := func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
 		var request TestUseConfigSimpleRequest
@@ -0,0 +1,169 @@
+// Package cassette implements recording of RPC calls and replaying them
+// as tg.Invoker.
+//
+// Recorder is a telegram.Middleware which writes every request and
+// response to cassette file, along with human-readable sidecar file,
+// formatted by tdp:
+//
+//	rec, err := cassette.Create("testdata/login.cassette")
+//	if err != nil {
+//		return err
+//	}
+//	defer rec.Close()
+//
+//	client := telegram.NewClient(appID, appHash, telegram.Options{
+//		Middlewares: []telegram.Middleware{rec},
+//	})
+//
+// Replayer serves recorded calls back without network:
+//
+//	c, err := cassette.Load("testdata/login.cassette")
+//	if err != nil {
+//		return err
+//	}
+//	api := tg.NewClient(cassette.NewReplayer(c, cassette.InOrder))
+package cassette
+
+import (
+	"io"
+	"os"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tgerr"
+)
+
+// magic is a header of cassette file.
+const magic = "gotd-cassette-v1"
+
+// Result kinds.
+const (
+	kindResponse = iota
+	kindRPCError
+	kindError
+)
+
+// Entry is a recorded RPC call.
+type Entry struct {
+	// Request is encoded request.
+	Request []byte
+	// Response is encoded response. Nil if call failed.
+	Response []byte
+	// Err is error of call. Errors which are not *tgerr.Error are
+	// recorded and replayed as text.
+	Err error
+}
+
+// TypeID returns type ID of request.
+func (e Entry) TypeID() uint32 {
+	b := bin.Buffer{Buf: e.Request}
+	id, _ := b.PeekID()
+	return id
+}
+
+func (e Entry) encode(b *bin.Buffer) {
+	b.PutBytes(e.Request)
+	if e.Err == nil {
+		b.PutInt(kindResponse)
+		b.PutBytes(e.Response)
+		return
+	}
+	if rpcErr, ok := tgerr.As(e.Err); ok {
+		b.PutInt(kindRPCError)
+		b.PutInt(rpcErr.Code)
+		b.PutString(rpcErr.Message)
+		return
+	}
+	b.PutInt(kindError)
+	b.PutString(e.Err.Error())
+}
+
+func (e *Entry) decode(b *bin.Buffer) error {
+	req, err := b.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "request")
+	}
+	e.Request = req
+
+	kind, err := b.Int()
+	if err != nil {
+		return errors.Wrap(err, "kind")
+	}
+	switch kind {
+	case kindResponse:
+		resp, err := b.Bytes()
+		if err != nil {
+			return errors.Wrap(err, "response")
+		}
+		e.Response = resp
+	case kindRPCError:
+		code, err := b.Int()
+		if err != nil {
+			return errors.Wrap(err, "error code")
+		}
+		msg, err := b.String()
+		if err != nil {
+			return errors.Wrap(err, "error message")
+		}
+		e.Err = tgerr.New(code, msg)
+	case kindError:
+		msg, err := b.String()
+		if err != nil {
+			return errors.Wrap(err, "error")
+		}
+		e.Err = errors.New(msg)
+	default:
+		return errors.Errorf("unknown result kind %d", kind)
+	}
+	return nil
+}
+
+// Cassette is a sequence of recorded calls.
+type Cassette struct {
+	Entries []Entry
+}
+
+// Encode writes cassette to w.
+func (c *Cassette) Encode(w io.Writer) error {
+	var b bin.Buffer
+	b.PutString(magic)
+	for _, e := range c.Entries {
+		e.encode(&b)
+	}
+	_, err := w.Write(b.Buf)
+	return err
+}
+
+// Decode decodes cassette from data.
+func Decode(data []byte) (*Cassette, error) {
+	if len(data) == 0 {
+		// Nothing was recorded.
+		return &Cassette{}, nil
+	}
+
+	b := &bin.Buffer{Buf: data}
+	m, err := b.String()
+	if err != nil || m != magic {
+		return nil, errors.New("invalid cassette header")
+	}
+
+	c := &Cassette{}
+	for b.Len() > 0 {
+		var e Entry
+		if err := e.decode(b); err != nil {
+			return nil, errors.Wrapf(err, "decode entry %d", len(c.Entries))
+		}
+		c.Entries = append(c.Entries, e)
+	}
+	return c, nil
+}
+
+// Load reads cassette from file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read")
+	}
+	return Decode(data)
+}
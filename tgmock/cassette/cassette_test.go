@@ -0,0 +1,175 @@
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgmock"
+)
+
+var (
+	testUser  = &tg.User{ID: 10, AccessHash: 10, Username: "gotd"}
+	testState = &tg.UpdatesState{Pts: 1, Date: 10, Seq: 2}
+)
+
+func server() tg.Invoker {
+	return tgmock.Invoker(func(input bin.Encoder) (bin.Encoder, error) {
+		switch input.(type) {
+		case *tg.UsersGetUsersRequest:
+			return &tg.UserClassVector{Elems: []tg.UserClass{testUser}}, nil
+		case *tg.UpdatesGetStateRequest:
+			return testState, nil
+		case *tg.ContactsResolveUsernameRequest:
+			return nil, tgerr.New(400, tg.ErrUsernameNotOccupied)
+		default:
+			return nil, errors.Errorf("unexpected %T", input)
+		}
+	})
+}
+
+func calls(ctx context.Context, a *require.Assertions, api *tg.Client) {
+	users, err := api.UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+	a.NoError(err)
+	a.Equal([]tg.UserClass{testUser}, users)
+
+	state, err := api.UpdatesGetState(ctx)
+	a.NoError(err)
+	a.Equal(testState, state)
+
+	_, err = api.ContactsResolveUsername(ctx, "gotd")
+	a.True(tgerr.Is(err, tg.ErrUsernameNotOccupied), err)
+}
+
+func record(t *testing.T) (*Cassette, string) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	var data, text bytes.Buffer
+	r := NewRecorder(&data, &text)
+	calls(ctx, a, tg.NewClient(r.Handle(server())))
+
+	c, err := Decode(data.Bytes())
+	a.NoError(err)
+	a.Len(c.Entries, 3)
+	return c, text.String()
+}
+
+func TestRecorder(t *testing.T) {
+	a := require.New(t)
+	_, text := record(t)
+
+	for _, s := range []string{
+		"# 1\n--> users.getUsers",
+		"<-- updates.state",
+		"<-- error: rpc error code 400: USERNAME_NOT_OCCUPIED",
+	} {
+		a.True(strings.Contains(text, s), "%q not found in:\n%s", s, text)
+	}
+}
+
+// decoderOnly hides Encode method of result.
+type decoderOnly struct {
+	bin.Decoder
+}
+
+func TestRecorder_Error(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	var data bytes.Buffer
+	r := NewRecorder(&data, nil)
+	invoke := r.Handle(server())
+
+	// Call succeeds even if result can't be recorded.
+	var users tg.UserClassVector
+	a.NoError(invoke(ctx, &tg.UsersGetUsersRequest{}, decoderOnly{&users}))
+	a.Equal([]tg.UserClass{testUser}, users.Elems)
+	a.Error(r.Err())
+	a.Zero(data.Len())
+
+	calls(ctx, a, tg.NewClient(invoke))
+	a.Error(r.Close())
+}
+
+func TestCreate(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "test.cassette")
+
+	r, err := Create(path)
+	a.NoError(err)
+	calls(ctx, a, tg.NewClient(r.Handle(server())))
+	a.NoError(r.Close())
+
+	c, err := Load(path)
+	a.NoError(err)
+	a.Len(c.Entries, 3)
+	a.FileExists(path + ".tdp")
+}
+
+func TestReplayer(t *testing.T) {
+	ctx := context.Background()
+	c, _ := record(t)
+
+	t.Run("InOrder", func(t *testing.T) {
+		a := require.New(t)
+		r := NewReplayer(c, InOrder)
+		api := tg.NewClient(r)
+
+		calls(ctx, a, api)
+		a.Zero(r.Remaining())
+
+		_, err := api.UpdatesGetState(ctx)
+		a.ErrorIs(err, ErrUnexpectedCall)
+	})
+	t.Run("InOrderMismatch", func(t *testing.T) {
+		a := require.New(t)
+		r := NewReplayer(c, InOrder)
+
+		_, err := tg.NewClient(r).UpdatesGetState(ctx)
+		a.ErrorIs(err, ErrUnexpectedCall)
+		a.Equal(3, r.Remaining())
+	})
+	t.Run("ByRequest", func(t *testing.T) {
+		a := require.New(t)
+		r := NewReplayer(c, ByRequest)
+		api := tg.NewClient(r)
+
+		state, err := api.UpdatesGetState(ctx)
+		a.NoError(err)
+		a.Equal(testState, state)
+		a.Equal(2, r.Remaining())
+
+		_, err = api.ContactsResolveUsername(ctx, "other")
+		a.ErrorIs(err, ErrUnexpectedCall)
+	})
+}
+
+func TestDecode(t *testing.T) {
+	a := require.New(t)
+
+	c, err := Decode(nil)
+	a.NoError(err)
+	a.Empty(c.Entries)
+
+	_, err = Decode([]byte("invalid"))
+	a.Error(err)
+
+	var b bytes.Buffer
+	a.NoError((&Cassette{Entries: []Entry{
+		{Request: []byte{1, 2, 3, 4}, Err: errors.New("network")},
+	}}).Encode(&b))
+	c, err = Decode(b.Bytes())
+	a.NoError(err)
+	a.EqualError(c.Entries[0].Err, "network")
+	a.Equal(uint32(0x04030201), c.Entries[0].TypeID())
+}
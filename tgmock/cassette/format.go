@@ -0,0 +1,68 @@
+package cassette
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/mt"
+	"github.com/gotd/td/tdp"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tmap"
+)
+
+var (
+	constructor     *tmap.Constructor
+	constructorOnce sync.Once
+)
+
+func getConstructor() *tmap.Constructor {
+	constructorOnce.Do(func() {
+		constructor = tmap.NewConstructor(
+			tg.TypesConstructorMap(),
+			mt.TypesConstructorMap(),
+		)
+	})
+	return constructor
+}
+
+// formatObject pretty-prints object using tdp if possible. Objects
+// without type information, like boxes, are decoded from data.
+func formatObject(v interface{}, data []byte) string {
+	if obj, ok := v.(tdp.Object); ok {
+		return tdp.Format(obj)
+	}
+
+	b := &bin.Buffer{Buf: data}
+	if id, err := b.PeekID(); err == nil {
+		if obj := getConstructor().New(id); obj != nil {
+			if err := obj.Decode(b); err == nil {
+				if o, ok := obj.(tdp.Object); ok {
+					return tdp.Format(o)
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("%T (%d bytes)", v, len(data))
+}
+
+// writeText writes human-readable representation of entry.
+func writeText(w io.Writer, n int, e Entry, input, output interface{}) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %d\n", n)
+	b.WriteString("--> ")
+	b.WriteString(formatObject(input, e.Request))
+	b.WriteString("\n<-- ")
+	if e.Err != nil {
+		b.WriteString("error: ")
+		b.WriteString(e.Err.Error())
+	} else {
+		b.WriteString(formatObject(output, e.Response))
+	}
+	b.WriteString("\n\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,155 @@
+package cassette
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/multierr"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+)
+
+var _ telegram.Middleware = (*Recorder)(nil)
+
+// Recorder is a telegram.Middleware which records calls.
+//
+// Calls are written in order of completion, so concurrent calls may be
+// reordered. Use ByRequest mode to replay such cassettes.
+//
+// Recording errors do not affect calls, they are returned by Err and Close.
+type Recorder struct {
+	mux    sync.Mutex
+	w      io.Writer
+	text   io.Writer // nil if no sidecar
+	closer []io.Closer
+	n      int
+	header bool
+	err    error // first recording error
+}
+
+// NewRecorder creates new Recorder which writes cassette to w and
+// human-readable representation of calls to text. Text is optional.
+func NewRecorder(w, text io.Writer) *Recorder {
+	return &Recorder{
+		w:    w,
+		text: text,
+	}
+}
+
+// Create creates cassette file and its sidecar file with ".tdp" suffix.
+//
+// Recorder must be closed.
+func Create(path string) (*Recorder, error) {
+	w, err := os.Create(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "create cassette")
+	}
+	text, err := os.Create(path + ".tdp")
+	if err != nil {
+		_ = w.Close()
+		return nil, errors.Wrap(err, "create sidecar")
+	}
+
+	r := NewRecorder(w, text)
+	r.closer = []io.Closer{w, text}
+	return r, nil
+}
+
+// Err returns first recording error, if any.
+func (r *Recorder) Err() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.err
+}
+
+// Close closes files created by Create.
+//
+// Returns first recording error, if any.
+func (r *Recorder) Close() (err error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	err = r.err
+	for _, c := range r.closer {
+		multierr.AppendInto(&err, c.Close())
+	}
+	r.closer = nil
+	return err
+}
+
+// Handle implements telegram.Middleware.
+func (r *Recorder) Handle(next tg.Invoker) telegram.InvokeFunc {
+	return func(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+		invokeErr := next.Invoke(ctx, input, output)
+		if invokeErr != nil && ctx.Err() != nil {
+			// Do not record canceled calls.
+			return invokeErr
+		}
+
+		if err := r.record(input, output, invokeErr); err != nil {
+			r.fail(errors.Wrapf(err, "record %T", input))
+		}
+		return invokeErr
+	}
+}
+
+func (r *Recorder) record(input bin.Encoder, output bin.Decoder, invokeErr error) error {
+	var req bin.Buffer
+	if err := input.Encode(&req); err != nil {
+		return errors.Wrap(err, "encode request")
+	}
+
+	e := Entry{
+		Request: req.Buf,
+		Err:     invokeErr,
+	}
+	if invokeErr == nil {
+		enc, ok := output.(bin.Encoder)
+		if !ok {
+			return errors.Errorf("result %T is not bin.Encoder", output)
+		}
+		var resp bin.Buffer
+		if err := enc.Encode(&resp); err != nil {
+			return errors.Wrap(err, "encode result")
+		}
+		e.Response = resp.Buf
+	}
+
+	return r.write(e, input, output)
+}
+
+func (r *Recorder) fail(err error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *Recorder) write(e Entry, input, output interface{}) error {
+	var b bin.Buffer
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if !r.header {
+		b.PutString(magic)
+		r.header = true
+	}
+	e.encode(&b)
+	if _, err := r.w.Write(b.Buf); err != nil {
+		return err
+	}
+
+	r.n++
+	if r.text == nil {
+		return nil
+	}
+	return writeText(r.text, r.n, e, input, output)
+}
@@ -0,0 +1,107 @@
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+)
+
+var _ tg.Invoker = (*Replayer)(nil)
+
+// ErrUnexpectedCall is returned by Replayer if there is no recorded call
+// for request.
+var ErrUnexpectedCall = errors.New("unexpected call")
+
+// Mode of Replayer.
+type Mode int
+
+const (
+	// InOrder serves calls in recorded order. Only request type is checked,
+	// so requests with random IDs or dates can be replayed.
+	InOrder Mode = iota
+	// ByRequest serves first unused recorded call with equal request.
+	ByRequest
+)
+
+// Replayer is a tg.Invoker which serves recorded calls.
+type Replayer struct {
+	mode Mode // immutable
+
+	mux     sync.Mutex
+	entries []Entry
+	used    []bool
+	next    int // InOrder only
+}
+
+// NewReplayer creates new Replayer.
+func NewReplayer(c *Cassette, mode Mode) *Replayer {
+	return &Replayer{
+		mode:    mode,
+		entries: c.Entries,
+		used:    make([]bool, len(c.Entries)),
+	}
+}
+
+// Remaining returns count of calls not served yet.
+func (r *Replayer) Remaining() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	n := 0
+	for _, used := range r.used {
+		if !used {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Replayer) find(req []byte) (Entry, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.mode == ByRequest {
+		for i, e := range r.entries {
+			if !r.used[i] && bytes.Equal(e.Request, req) {
+				r.used[i] = true
+				return e, nil
+			}
+		}
+		return Entry{}, ErrUnexpectedCall
+	}
+
+	if r.next >= len(r.entries) {
+		return Entry{}, errors.Wrap(ErrUnexpectedCall, "cassette is over")
+	}
+	e := r.entries[r.next]
+	if got := (Entry{Request: req}).TypeID(); got != e.TypeID() {
+		return Entry{}, errors.Wrapf(ErrUnexpectedCall,
+			"call %d: expected %#x, got %#x", r.next, e.TypeID(), got,
+		)
+	}
+	r.used[r.next] = true
+	r.next++
+	return e, nil
+}
+
+// Invoke implements tg.Invoker.
+func (r *Replayer) Invoke(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+	var req bin.Buffer
+	if err := input.Encode(&req); err != nil {
+		return errors.Wrapf(err, "encode %T", input)
+	}
+
+	e, err := r.find(req.Buf)
+	if err != nil {
+		return errors.Wrapf(err, "replay %T", input)
+	}
+	if e.Err != nil {
+		return e.Err
+	}
+	return output.Decode(&bin.Buffer{Buf: e.Response})
+}
@@ -0,0 +1,55 @@
+package tgtest
+
+import (
+	"encoding/binary"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/mt"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// handleBindTempAuthKey checks and accepts binding of temporary key.
+//
+// See https://core.telegram.org/method/auth.bindTempAuthKey.
+func (s *Server) handleBindTempAuthKey(req *Request) error {
+	var bind tg.AuthBindTempAuthKeyRequest
+	if err := bind.Decode(req.Buf); err != nil {
+		return errors.Wrap(err, "decode")
+	}
+	invalid := tgerr.New(400, "ENCRYPTED_MESSAGE_INVALID")
+
+	var permID [8]byte
+	binary.LittleEndian.PutUint64(permID[:], uint64(bind.PermAuthKeyID))
+	perm, ok := s.users.getSession(permID)
+	if !ok {
+		return s.SendErr(req, invalid)
+	}
+
+	var encrypted crypto.EncryptedMessage
+	if err := encrypted.Decode(&bin.Buffer{Buf: bind.EncryptedMessage}); err != nil {
+		return s.SendErr(req, invalid)
+	}
+	msg, err := s.cipher.DecryptOld(perm, &encrypted)
+	if err != nil || msg.MessageID != req.MsgID {
+		return s.SendErr(req, invalid)
+	}
+
+	var inner mt.BindAuthKeyInner
+	if err := inner.Decode(&bin.Buffer{Buf: msg.Data()}); err != nil {
+		return s.SendErr(req, invalid)
+	}
+	temp := req.Session.AuthKey.ID
+	if inner.Nonce != bind.Nonce ||
+		inner.PermAuthKeyID != bind.PermAuthKeyID ||
+		inner.TempAuthKeyID != int64(binary.LittleEndian.Uint64(temp[:])) ||
+		inner.TempSessionID != req.Session.ID ||
+		inner.ExpiresAt != bind.ExpiresAt {
+		return s.SendErr(req, invalid)
+	}
+
+	return s.SendBool(req, true)
+}
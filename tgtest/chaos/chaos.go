@@ -0,0 +1,94 @@
+// Package chaos implements fault injection for tgtest.Server and transport
+// connections.
+//
+// Faults are chosen by Policy: scripted (Script) or probabilistic with seed
+// for reproducibility (Random). Injector applies them to server handler,
+// transport.Conn and net.Conn:
+//
+//	inj := chaos.New(chaos.Random(42,
+//		chaos.Rule{
+//			Match:       chaos.Match{Point: chaos.PointRequest},
+//			Probability: 0.1,
+//			Action:      chaos.BadSalt(10),
+//		},
+//		chaos.Rule{
+//			Match:       chaos.Match{Point: chaos.PointSend},
+//			Probability: 0.01,
+//			Action:      chaos.Reset(),
+//		},
+//	), chaos.Options{})
+//
+//	srv := tgtest.NewServer(key, tgtest.UnpackInvoke(inj.Handler(dispatcher)), tgtest.ServerOptions{
+//		WrapConn: inj.Conn,
+//	})
+package chaos
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/clock"
+)
+
+// ErrReset is returned by connection reset by Injector.
+var ErrReset = errors.New("connection reset by chaos")
+
+// Options of Injector.
+type Options struct {
+	// Clock to use for latency. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger is instance of zap.Logger. No logs by default.
+	Logger *zap.Logger
+}
+
+func (o *Options) setDefaults() {
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+}
+
+// Injector injects faults chosen by Policy.
+type Injector struct {
+	policy Policy      // immutable
+	clock  clock.Clock // immutable
+	log    *zap.Logger // immutable
+}
+
+// New creates new Injector.
+func New(p Policy, opts Options) *Injector {
+	opts.setDefaults()
+	return &Injector{
+		policy: p,
+		clock:  opts.Clock,
+		log:    opts.Logger,
+	}
+}
+
+func (i *Injector) next(e Event) Action {
+	a := i.policy.Next(e)
+	if a.Fault != FaultNone {
+		i.log.Debug("Injecting fault",
+			zap.Stringer("point", e.Point),
+			zap.Uint32("type_id", e.TypeID),
+			zap.Stringer("fault", a.Fault),
+		)
+	}
+	return a
+}
+
+func (i *Injector) sleep(ctx context.Context, a Action) error {
+	timer := i.clock.Timer(a.Delay)
+	defer clock.StopTimer(timer)
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
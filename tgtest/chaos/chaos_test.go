@@ -0,0 +1,100 @@
+package chaos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgtest/chaos"
+	"github.com/gotd/td/tgtest/cluster"
+)
+
+func TestInjector(t *testing.T) {
+	a := require.New(t)
+	log := zaptest.NewLogger(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	g := tdsync.NewCancellableGroup(ctx)
+
+	match := chaos.Match{Point: chaos.PointRequest, TypeID: tg.UsersGetUsersRequestTypeID}
+	// Step without fault, passes request retried by client.
+	retry := chaos.Step{Match: match}
+	requests := chaos.New(chaos.Script(
+		chaos.Step{Match: match, Action: chaos.BadSalt(10)},
+		retry,
+		chaos.Step{Match: match, Action: chaos.Duplicate()},
+		chaos.Step{Match: match, Action: chaos.NewSession(10)},
+		chaos.Step{Match: match, Action: chaos.Latency(10 * time.Millisecond)},
+		chaos.Step{Match: match, Action: chaos.Drop()},
+		retry,
+		chaos.Step{Match: match, Action: chaos.AuthKeyUnregistered()},
+	), chaos.Options{Logger: log.Named("requests")})
+	// Key exchange is not retried, so skip its frames (ResPQ,
+	// Server_DH_Params and DH_gen_ok) and duplicate encrypted ones.
+	send := chaos.Match{Point: chaos.PointSend}
+	conns := chaos.New(chaos.Script(
+		chaos.Step{Match: send, Skip: 3, Action: chaos.Duplicate()},
+		chaos.Step{Match: send, Skip: 1, Action: chaos.Duplicate()},
+	), chaos.Options{Logger: log.Named("conns")})
+
+	c := cluster.NewCluster(cluster.Options{
+		Logger:     log.Named("cluster"),
+		Middleware: requests.Handler,
+		WrapConn:   conns.Conn,
+	})
+	c.Dispatch(2, "server").Vector(tg.UsersGetUsersRequestTypeID, &tg.User{ID: 10})
+
+	g.Go(c.Up)
+	g.Go(func(ctx context.Context) error {
+		defer g.Cancel()
+
+		select {
+		case <-c.Ready():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		client := telegram.NewClient(telegram.TestAppID, telegram.TestAppHash, telegram.Options{
+			PublicKeys:     c.Keys(),
+			DC:             2,
+			DCList:         c.List(),
+			Resolver:       c.Resolver(),
+			NoUpdates:      true,
+			Logger:         log.Named("client"),
+			SessionStorage: &session.StorageMemory{},
+			RetryInterval:  100 * time.Millisecond,
+		})
+		return client.Run(ctx, func(ctx context.Context) error {
+			get := func() error {
+				_, err := client.API().UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+				return err
+			}
+
+			// Bad salt, duplicate, new session, latency and drop are
+			// handled by client.
+			for i := 0; i < 5; i++ {
+				if err := get(); err != nil {
+					return errors.Wrapf(err, "call %d", i)
+				}
+			}
+			if err := get(); !tgerr.Is(err, "AUTH_KEY_UNREGISTERED") {
+				return errors.Errorf("expected AUTH_KEY_UNREGISTERED, got %v", err)
+			}
+			return get()
+		})
+	})
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		a.NoError(err)
+	}
+}
@@ -0,0 +1,127 @@
+package chaos
+
+import (
+	"context"
+	"net"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/transport"
+)
+
+var _ transport.Conn = (*conn)(nil)
+
+type conn struct {
+	transport.Conn
+	inj *Injector
+
+	// pending is a frame to receive again, used by FaultDuplicate.
+	pending *bin.Buffer
+}
+
+// Conn wraps transport connection to inject faults on PointSend and
+// PointRecv. FaultLatency, FaultDrop, FaultReset and FaultDuplicate are
+// supported.
+func (i *Injector) Conn(c transport.Conn) transport.Conn {
+	return &conn{
+		Conn: c,
+		inj:  i,
+	}
+}
+
+func (c *conn) Send(ctx context.Context, b *bin.Buffer) error {
+	switch a := c.inj.next(Event{Point: PointSend}); a.Fault {
+	case FaultLatency:
+		if err := c.inj.sleep(ctx, a); err != nil {
+			return err
+		}
+	case FaultDrop:
+		return nil
+	case FaultReset:
+		_ = c.Conn.Close()
+		return ErrReset
+	case FaultDuplicate:
+		dup := &bin.Buffer{Buf: b.Copy()}
+		if err := c.Conn.Send(ctx, b); err != nil {
+			return err
+		}
+		return c.Conn.Send(ctx, dup)
+	}
+	return c.Conn.Send(ctx, b)
+}
+
+func (c *conn) Recv(ctx context.Context, b *bin.Buffer) error {
+	if p := c.pending; p != nil {
+		c.pending = nil
+		b.ResetTo(p.Buf)
+		return nil
+	}
+
+	for {
+		a := c.inj.next(Event{Point: PointRecv})
+		if a.Fault == FaultReset {
+			_ = c.Conn.Close()
+			return ErrReset
+		}
+		if err := c.Conn.Recv(ctx, b); err != nil {
+			return err
+		}
+
+		switch a.Fault {
+		case FaultLatency:
+			if err := c.inj.sleep(ctx, a); err != nil {
+				return err
+			}
+		case FaultDrop:
+			continue
+		case FaultDuplicate:
+			c.pending = &bin.Buffer{Buf: b.Copy()}
+		}
+		return nil
+	}
+}
+
+var _ net.Conn = (*netConn)(nil)
+
+type netConn struct {
+	net.Conn
+	inj *Injector
+}
+
+// NetConn wraps network connection to inject faults on PointSend and
+// PointRecv. FaultLatency and FaultReset are supported, write is reset
+// in the middle of data.
+//
+// Can be used in dialer of client resolver to emulate broken network.
+func (i *Injector) NetConn(c net.Conn) net.Conn {
+	return &netConn{
+		Conn: c,
+		inj:  i,
+	}
+}
+
+func (c *netConn) Write(p []byte) (int, error) {
+	switch a := c.inj.next(Event{Point: PointSend}); a.Fault {
+	case FaultLatency:
+		if err := c.inj.sleep(context.Background(), a); err != nil {
+			return 0, err
+		}
+	case FaultReset:
+		n, _ := c.Conn.Write(p[:len(p)/2])
+		_ = c.Conn.Close()
+		return n, ErrReset
+	}
+	return c.Conn.Write(p)
+}
+
+func (c *netConn) Read(p []byte) (int, error) {
+	switch a := c.inj.next(Event{Point: PointRecv}); a.Fault {
+	case FaultLatency:
+		if err := c.inj.sleep(context.Background(), a); err != nil {
+			return 0, err
+		}
+	case FaultReset:
+		_ = c.Conn.Close()
+		return 0, ErrReset
+	}
+	return c.Conn.Read(p)
+}
@@ -0,0 +1,65 @@
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/transport"
+)
+
+func TestConn(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	inj := New(Script(
+		Step{Match: Match{Point: PointSend}, Action: Drop()},
+		Step{Match: Match{Point: PointSend}, Action: Duplicate()},
+		Step{Match: Match{Point: PointRecv}, Action: Duplicate()},
+		Step{Match: Match{Point: PointSend}, Action: Reset()},
+	), Options{})
+
+	client, server := transport.Intermediate.Pipe()
+	client = inj.Conn(client)
+
+	// Payload must not look like protocol error code.
+	send := func(conn transport.Conn, v int64) error {
+		var b bin.Buffer
+		b.PutLong(v)
+		return conn.Send(ctx, &b)
+	}
+	recv := func(conn transport.Conn) int64 {
+		var b bin.Buffer
+		a.NoError(conn.Recv(ctx, &b))
+		v, err := b.Long()
+		a.NoError(err)
+		return v
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		// Dropped.
+		if err := send(client, 1); err != nil {
+			errs <- err
+			return
+		}
+		// Duplicated.
+		errs <- send(client, 2)
+	}()
+	a.Equal(int64(2), recv(server))
+	a.Equal(int64(2), recv(server))
+	a.NoError(<-errs)
+
+	go func() {
+		errs <- send(server, 3)
+	}()
+	a.Equal(int64(3), recv(client))
+	a.Equal(int64(3), recv(client), "duplicated on receive")
+	a.NoError(<-errs)
+
+	a.ErrorIs(send(client, 4), ErrReset)
+}
@@ -0,0 +1,62 @@
+package chaos
+
+import (
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/mt"
+	"github.com/gotd/td/tgtest"
+)
+
+// Handler returns tgtest.Handler middleware which injects faults on
+// PointRequest. All faults are supported, FaultReset deletes session
+// connection via tgtest.Server.ForceDisconnect.
+//
+// Use it inside tgtest.UnpackInvoke to match unwrapped request types.
+func (i *Injector) Handler(next tgtest.Handler) tgtest.Handler {
+	return tgtest.HandlerFunc(func(s *tgtest.Server, req *tgtest.Request) error {
+		id, err := req.Buf.PeekID()
+		if err != nil {
+			return err
+		}
+
+		switch a := i.next(Event{Point: PointRequest, TypeID: id}); a.Fault {
+		case FaultLatency:
+			if err := i.sleep(req.RequestCtx, a); err != nil {
+				return err
+			}
+		case FaultDrop:
+			return nil
+		case FaultReset:
+			s.ForceDisconnect(req.Session)
+			return nil
+		case FaultDuplicate:
+			// Handler consumes buffer.
+			dup := *req
+			dup.Buf = &bin.Buffer{Buf: req.Buf.Copy()}
+			if err := next.OnMessage(s, req); err != nil {
+				return err
+			}
+			return next.OnMessage(s, &dup)
+		case FaultBadMsg:
+			return s.Send(req.RequestCtx, req.Session, tgtest.MessageFromServer, &mt.BadMsgNotification{
+				BadMsgID:  req.MsgID,
+				ErrorCode: a.Code,
+			})
+		case FaultBadSalt:
+			return s.Send(req.RequestCtx, req.Session, tgtest.MessageFromServer, &mt.BadServerSalt{
+				BadMsgID:      req.MsgID,
+				ErrorCode:     48,
+				NewServerSalt: a.Salt,
+			})
+		case FaultError:
+			return s.SendErr(req, a.Err)
+		case FaultNewSession:
+			if err := s.Send(req.RequestCtx, req.Session, tgtest.MessageFromServer, &mt.NewSessionCreated{
+				FirstMsgID: req.MsgID,
+				ServerSalt: a.Salt,
+			}); err != nil {
+				return err
+			}
+		}
+		return next.OnMessage(s, req)
+	})
+}
@@ -0,0 +1,268 @@
+package chaos
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gotd/td/tgerr"
+)
+
+// Point is a place where fault is injected.
+type Point int
+
+const (
+	// PointSend is a sending of frame (transport.Conn) or bytes (net.Conn).
+	PointSend Point = iota
+	// PointRecv is a receiving of frame (transport.Conn) or bytes (net.Conn).
+	PointRecv
+	// PointRequest is a handling of request by tgtest.Server.
+	PointRequest
+)
+
+// String implements fmt.Stringer.
+func (p Point) String() string {
+	switch p {
+	case PointSend:
+		return "send"
+	case PointRecv:
+		return "recv"
+	case PointRequest:
+		return "request"
+	default:
+		return fmt.Sprintf("Point(%d)", int(p))
+	}
+}
+
+// Event is an intercepted operation.
+type Event struct {
+	Point Point
+	// TypeID is a type ID of request. Set only for PointRequest.
+	TypeID uint32
+}
+
+// Fault is a kind of injected fault.
+type Fault int
+
+const (
+	// FaultNone means no fault.
+	FaultNone Fault = iota
+	// FaultLatency delays operation.
+	FaultLatency
+	// FaultDrop silently drops frame or request.
+	FaultDrop
+	// FaultReset closes connection. Connection created by NetConn is
+	// closed in the middle of frame.
+	FaultReset
+	// FaultDuplicate sends or receives frame twice. On PointRequest,
+	// request is handled twice, so client gets duplicated response.
+	FaultDuplicate
+	// FaultBadMsg sends bad_msg_notification instead of response.
+	FaultBadMsg
+	// FaultBadSalt sends bad_server_salt instead of response.
+	FaultBadSalt
+	// FaultError sends RPC error instead of response.
+	FaultError
+	// FaultNewSession sends new_session_created before response.
+	FaultNewSession
+)
+
+// String implements fmt.Stringer.
+func (f Fault) String() string {
+	switch f {
+	case FaultNone:
+		return "none"
+	case FaultLatency:
+		return "latency"
+	case FaultDrop:
+		return "drop"
+	case FaultReset:
+		return "reset"
+	case FaultDuplicate:
+		return "duplicate"
+	case FaultBadMsg:
+		return "bad_msg_notification"
+	case FaultBadSalt:
+		return "bad_server_salt"
+	case FaultError:
+		return "rpc_error"
+	case FaultNewSession:
+		return "new_session_created"
+	default:
+		return fmt.Sprintf("Fault(%d)", int(f))
+	}
+}
+
+// Action is a fault to inject.
+type Action struct {
+	Fault Fault
+	// Delay of FaultLatency.
+	Delay time.Duration
+	// Code of FaultBadMsg.
+	Code int
+	// Salt is a new server salt of FaultBadSalt and FaultNewSession.
+	Salt int64
+	// Err of FaultError.
+	Err *tgerr.Error
+}
+
+// Latency returns Action which delays operation.
+func Latency(d time.Duration) Action {
+	return Action{Fault: FaultLatency, Delay: d}
+}
+
+// Drop returns Action which drops frame or request.
+func Drop() Action {
+	return Action{Fault: FaultDrop}
+}
+
+// Reset returns Action which closes connection.
+func Reset() Action {
+	return Action{Fault: FaultReset}
+}
+
+// Duplicate returns Action which duplicates frame or response.
+func Duplicate() Action {
+	return Action{Fault: FaultDuplicate}
+}
+
+// BadMsg returns Action which sends bad_msg_notification with given code,
+// e.g. 32 (msg_seqno too low).
+func BadMsg(code int) Action {
+	return Action{Fault: FaultBadMsg, Code: code}
+}
+
+// BadSalt returns Action which sends bad_server_salt with given new salt.
+func BadSalt(salt int64) Action {
+	return Action{Fault: FaultBadSalt, Salt: salt}
+}
+
+// NewSession returns Action which sends new_session_created with given salt.
+func NewSession(salt int64) Action {
+	return Action{Fault: FaultNewSession, Salt: salt}
+}
+
+// Error returns Action which sends RPC error.
+func Error(err *tgerr.Error) Action {
+	return Action{Fault: FaultError, Err: err}
+}
+
+// AuthKeyUnregistered returns Action which sends AUTH_KEY_UNREGISTERED error.
+func AuthKeyUnregistered() Action {
+	return Error(tgerr.New(401, "AUTH_KEY_UNREGISTERED"))
+}
+
+// Migrate returns Action which sends NETWORK_MIGRATE_X error.
+func Migrate(dc int) Action {
+	return Error(tgerr.New(303, fmt.Sprintf("NETWORK_MIGRATE_%d", dc)))
+}
+
+// Policy decides which fault to inject.
+//
+// Policy must be safe for concurrent use.
+type Policy interface {
+	Next(e Event) Action
+}
+
+// PolicyFunc is functional adapter for Policy.
+type PolicyFunc func(e Event) Action
+
+// Next implements Policy.
+func (f PolicyFunc) Next(e Event) Action {
+	return f(e)
+}
+
+// Match selects events.
+type Match struct {
+	Point Point
+	// TypeID matches type ID of request, zero matches any.
+	TypeID uint32
+}
+
+func (m Match) match(e Event) bool {
+	return m.Point == e.Point && (m.TypeID == 0 || m.TypeID == e.TypeID)
+}
+
+// Step is a scripted fault.
+type Step struct {
+	Match
+	// Skip is a count of matching events to pass before injection.
+	Skip   int
+	Action Action
+}
+
+type script struct {
+	mux   sync.Mutex
+	steps []Step
+}
+
+// Script returns Policy which injects faults of steps one by one.
+// Every step is applied once, to the first matching event after
+// previous step.
+func Script(steps ...Step) Policy {
+	return &script{
+		steps: append([]Step(nil), steps...),
+	}
+}
+
+func (s *script) Next(e Event) Action {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if len(s.steps) == 0 || !s.steps[0].match(e) {
+		return Action{}
+	}
+	if s.steps[0].Skip > 0 {
+		s.steps[0].Skip--
+		return Action{}
+	}
+
+	a := s.steps[0].Action
+	s.steps = s.steps[1:]
+	return a
+}
+
+// Rule is a probabilistic fault.
+type Rule struct {
+	Match
+	// Probability of injection, from 0 to 1.
+	Probability float64
+	Action      Action
+}
+
+type random struct {
+	mux   sync.Mutex
+	rand  *rand.Rand
+	rules []Rule
+}
+
+// Random returns Policy which injects faults with probability of rule.
+// First matched and fired rule wins.
+//
+// Injected faults are reproducible for given seed and sequence of events.
+// Note that events of concurrent connections sharing Policy are interleaved
+// nondeterministically, use Script or separate Injector per connection
+// if exact faults matter.
+func Random(seed int64, rules ...Rule) Policy {
+	return &random{
+		// #nosec G404 -- reproducibility is required.
+		rand:  rand.New(rand.NewSource(seed)),
+		rules: append([]Rule(nil), rules...),
+	}
+}
+
+func (r *random) Next(e Event) Action {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for _, rule := range r.rules {
+		if !rule.match(e) {
+			continue
+		}
+		if r.rand.Float64() < rule.Probability {
+			return rule.Action
+		}
+	}
+	return Action{}
+}
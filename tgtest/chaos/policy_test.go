@@ -0,0 +1,68 @@
+package chaos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/tg"
+)
+
+func TestScript(t *testing.T) {
+	a := require.New(t)
+	p := Script(
+		Step{
+			Match:  Match{Point: PointRequest, TypeID: tg.UsersGetUsersRequestTypeID},
+			Skip:   1,
+			Action: Drop(),
+		},
+		Step{
+			Match:  Match{Point: PointSend},
+			Action: Reset(),
+		},
+	)
+
+	get := Event{Point: PointRequest, TypeID: tg.UsersGetUsersRequestTypeID}
+	a.Equal(Action{}, p.Next(Event{Point: PointSend}), "first step is not done")
+	a.Equal(Action{}, p.Next(Event{Point: PointRequest, TypeID: tg.HelpGetConfigRequestTypeID}))
+	a.Equal(Action{}, p.Next(get), "skipped")
+	a.Equal(Drop(), p.Next(get))
+	a.Equal(Action{}, p.Next(get))
+	a.Equal(Reset(), p.Next(Event{Point: PointSend}))
+	a.Equal(Action{}, p.Next(Event{Point: PointSend}), "script is over")
+}
+
+func TestRandom(t *testing.T) {
+	a := require.New(t)
+	rules := []Rule{
+		{
+			Match:       Match{Point: PointRecv},
+			Probability: 0.5,
+			Action:      Duplicate(),
+		},
+		{
+			Match:       Match{Point: PointRecv},
+			Probability: 1,
+			Action:      Drop(),
+		},
+		{
+			Match:       Match{Point: PointSend},
+			Probability: 0,
+			Action:      Reset(),
+		},
+	}
+	run := func(seed int64) (r []Fault) {
+		p := Random(seed, rules...)
+		for i := 0; i < 100; i++ {
+			a.Equal(FaultNone, p.Next(Event{Point: PointSend}).Fault)
+			r = append(r, p.Next(Event{Point: PointRecv}).Fault)
+		}
+		return r
+	}
+
+	faults := run(42)
+	a.Equal(faults, run(42), "must be reproducible")
+	a.Contains(faults, FaultDuplicate)
+	a.Contains(faults, FaultDrop)
+	a.NotContains(faults, FaultNone)
+}
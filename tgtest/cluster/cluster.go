@@ -6,12 +6,14 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/gotd/td/clock"
 	"github.com/gotd/td/exchange"
 	"github.com/gotd/td/tdsync"
 	"github.com/gotd/td/telegram/dcs"
 	"github.com/gotd/td/tg"
 	"github.com/gotd/td/tgtest"
 	"github.com/gotd/td/tgtest/services/config"
+	"github.com/gotd/td/transport"
 )
 
 type setup struct {
@@ -23,6 +25,8 @@ type setup struct {
 type Cluster struct {
 	// denotes to use websocket listener
 	web bool
+	// denotes to use HTTP transport listener
+	http bool
 
 	setups map[int]setup
 	keys   []exchange.PublicKey
@@ -38,9 +42,12 @@ type Cluster struct {
 	// RPC dispatcher.
 	common *tgtest.Dispatcher
 
-	log      *zap.Logger
-	random   io.Reader
-	protocol dcs.Protocol
+	log        *zap.Logger
+	random     io.Reader
+	clock      clock.Clock
+	protocol   dcs.Protocol
+	middleware func(next tgtest.Handler) tgtest.Handler
+	wrapConn   func(conn transport.Conn) transport.Conn
 }
 
 // NewCluster creates new server Cluster.
@@ -48,17 +55,21 @@ func NewCluster(opts Options) *Cluster {
 	opts.setDefaults()
 
 	q := &Cluster{
-		web:      opts.Web,
-		setups:   map[int]setup{},
-		keys:     nil,
-		cfg:      opts.Config,
-		cdnCfg:   opts.CDNConfig,
-		domains:  map[int]string{},
-		ready:    tdsync.NewReady(),
-		common:   tgtest.NewDispatcher(),
-		log:      opts.Logger,
-		random:   opts.Random,
-		protocol: opts.Protocol,
+		web:        opts.Web,
+		http:       opts.HTTP,
+		setups:     map[int]setup{},
+		keys:       nil,
+		cfg:        opts.Config,
+		cdnCfg:     opts.CDNConfig,
+		domains:    map[int]string{},
+		ready:      tdsync.NewReady(),
+		common:     tgtest.NewDispatcher(),
+		log:        opts.Logger,
+		random:     opts.Random,
+		clock:      opts.Clock,
+		protocol:   opts.Protocol,
+		middleware: opts.Middleware,
+		wrapConn:   opts.WrapConn,
 	}
 	config.NewService(&q.cfg, &q.cdnCfg).Register(q.common)
 	q.common.Fallback(q.fallback())
@@ -79,6 +90,9 @@ func (c *Cluster) Resolver() dcs.Resolver {
 	if c.web {
 		return dcs.Websocket(dcs.WebsocketOptions{})
 	}
+	if c.http {
+		return dcs.HTTP(dcs.HTTPOptions{})
+	}
 
 	return dcs.Plain(dcs.PlainOptions{
 		Protocol: c.protocol,
@@ -40,10 +40,12 @@ func (c *Cluster) DC(id int, name string) (*tgtest.Server, *tgtest.Dispatcher) {
 	}
 
 	d := tgtest.NewDispatcher()
-	server := tgtest.NewServer(privateKey, tgtest.UnpackInvoke(d), tgtest.ServerOptions{
-		DC:     id,
-		Logger: c.log.Named(name).With(zap.Int("dc_id", id)),
-		Codec:  c.getCodec(),
+	server := tgtest.NewServer(privateKey, tgtest.UnpackInvoke(c.middleware(d)), tgtest.ServerOptions{
+		DC:       id,
+		Logger:   c.log.Named(name).With(zap.Int("dc_id", id)),
+		Codec:    c.getCodec(),
+		Clock:    c.clock,
+		WrapConn: c.wrapConn,
 	})
 	c.setups[id] = setup{
 		srv:      server,
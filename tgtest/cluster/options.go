@@ -5,9 +5,11 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/gotd/td/clock"
 	"github.com/gotd/td/crypto"
 	"github.com/gotd/td/telegram/dcs"
 	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgtest"
 	"github.com/gotd/td/transport"
 )
 
@@ -15,6 +17,8 @@ import (
 type Options struct {
 	// Web denotes to use websocket listener.
 	Web bool
+	// HTTP denotes to use HTTP transport listener.
+	HTTP bool
 	// Random is random source. Used to generate RSA keys.
 	// Defaults to rand.Reader.
 	Random io.Reader
@@ -23,14 +27,22 @@ type Options struct {
 	// Codec constructor.
 	// Defaults to nil (underlying transport server detects protocol automatically).
 	Protocol dcs.Protocol
+	// Clock to use in servers. Defaults to clock.System.
+	Clock clock.Clock
 	// Config is an initial cluster config.
 	Config tg.Config
 	// CDNConfig is an initial cluster CDN config.
 	CDNConfig tg.CDNConfig
+	// Middleware wraps handler of every server (optional).
+	// Requests are unpacked from Invoke* wrappers before middleware.
+	Middleware func(next tgtest.Handler) tgtest.Handler
+	// WrapConn wraps every accepted connection (optional).
+	WrapConn func(conn transport.Conn) transport.Conn
 }
 
 func (opt *Options) setDefaults() {
 	// It's okay to use zero value Web.
+	// It's okay to use zero value HTTP.
 	if opt.Random == nil {
 		opt.Random = crypto.DefaultRand()
 	}
@@ -40,6 +52,13 @@ func (opt *Options) setDefaults() {
 	if opt.Protocol == nil {
 		opt.Protocol = transport.Intermediate
 	}
+	if opt.Clock == nil {
+		opt.Clock = clock.System
+	}
 	// It's okay to use zero value Config.
 	// It's okay to use zero value CDNConfig.
+	if opt.Middleware == nil {
+		opt.Middleware = func(next tgtest.Handler) tgtest.Handler { return next }
+	}
+	// It's okay to use nil WrapConn.
 }
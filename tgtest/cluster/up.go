@@ -17,6 +17,9 @@ import (
 
 // Up runs all servers in a cluster.
 func (c *Cluster) Up(ctx context.Context) error {
+	if c.http {
+		return c.upHTTP(ctx)
+	}
 	g := tdsync.NewCancellableGroup(ctx)
 
 	listen := func(ctx context.Context, _ int) (net.Listener, error) {
@@ -95,3 +98,48 @@ func (c *Cluster) Up(ctx context.Context) error {
 
 	return g.Wait()
 }
+
+// upHTTP runs all servers in a cluster using HTTP transport.
+func (c *Cluster) upHTTP(ctx context.Context) error {
+	g := tdsync.NewCancellableGroup(ctx)
+
+	for dcID, s := range c.setups {
+		l, err := newLocalListener(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "DC %d: listen port", dcID)
+		}
+
+		if addr, ok := l.Addr().(*net.TCPAddr); ok {
+			c.cfg.DCOptions = append(c.cfg.DCOptions, tg.DCOption{
+				Static:    true,
+				ID:        dcID,
+				IPAddress: addr.IP.String(),
+				Port:      addr.Port,
+			})
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/api", s.srv)
+		srv := &http.Server{
+			ReadHeaderTimeout: time.Second * 10,
+			Handler:           mux,
+			BaseContext: func(net.Listener) context.Context {
+				return ctx
+			},
+		}
+		g.Go(func(ctx context.Context) error {
+			if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return errors.Wrap(err, "serve")
+			}
+			return nil
+		})
+		g.Go(func(ctx context.Context) error {
+			<-ctx.Done()
+
+			return srv.Close()
+		})
+	}
+	c.ready.Signal()
+
+	return g.Wait()
+}
@@ -74,9 +74,9 @@ func (c *users) addSession(key crypto.AuthKey) {
 }
 
 func (c *users) getSession(k [8]byte) (s crypto.AuthKey, ok bool) {
-	c.connsMux.Lock()
+	c.sessionsMux.Lock()
 	s, ok = c.sessions[k]
-	c.connsMux.Unlock()
+	c.sessionsMux.Unlock()
 
 	return
 }
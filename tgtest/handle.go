@@ -12,52 +12,73 @@ import (
 	"github.com/gotd/td/crypto"
 	"github.com/gotd/td/mt"
 	"github.com/gotd/td/proto"
+	"github.com/gotd/td/tg"
 	"github.com/gotd/td/tgerr"
 	"github.com/gotd/td/transport"
 )
 
 func (s *Server) rpcHandle(ctx context.Context, c transport.Conn, b *bin.Buffer) error {
+	session, msg, err := s.decrypt(b)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.serveMessage(ctx, c, session, msg)
+	return err
+}
+
+// decrypt decrypts message from buffer.
+func (s *Server) decrypt(b *bin.Buffer) (Session, *crypto.EncryptedMessageData, error) {
 	m := &crypto.EncryptedMessage{}
 	if err := m.DecodeWithoutCopy(b); err != nil {
-		return errors.Wrap(err, "decode encrypted message")
+		return Session{}, nil, errors.Wrap(err, "decode encrypted message")
 	}
 
 	key, ok := s.users.getSession(m.AuthKeyID)
 	if !ok {
-		return errors.New("invalid session")
+		return Session{}, nil, errors.New("invalid session")
 	}
 
 	msg, err := s.cipher.Decrypt(key, m)
 	if err != nil {
-		return errors.Wrap(err, "decrypt message")
+		return Session{}, nil, errors.Wrap(err, "decrypt message")
 	}
 
-	session := Session{
+	return Session{
 		ID:      msg.SessionID,
 		AuthKey: key,
-	}
-	if conn := s.users.createConnection(msg.SessionID, c); !conn.sentCreated() {
+	}, msg, nil
+}
+
+// serveMessage handles decrypted message.
+//
+// Given connection is attached to the session if session is new.
+func (s *Server) serveMessage(
+	ctx context.Context,
+	c transport.Conn,
+	session Session,
+	msg *crypto.EncryptedMessageData,
+) (*connection, error) {
+	conn := s.users.createConnection(msg.SessionID, c)
+	if !conn.sentCreated() {
 		s.log.Debug("Send handleSessionCreated event", zap.Inline(session))
-		salt := int64(binary.LittleEndian.Uint64(key.ID[:]))
+		salt := int64(binary.LittleEndian.Uint64(session.AuthKey.ID[:]))
 		if err := s.sendSessionCreated(ctx, session, salt); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	// Buffer now contains plaintext message payload.
-	b.ResetTo(msg.Data())
-
 	if err := s.handle(&Request{
 		DC:         s.dcID,
 		Session:    session,
 		MsgID:      msg.MessageID,
-		Buf:        b,
+		Buf:        &bin.Buffer{Buf: msg.Data()},
 		RequestCtx: ctx,
 	}); err != nil {
-		return errors.Wrap(err, "handle")
+		return nil, errors.Wrap(err, "handle")
 	}
 
-	return nil
+	return conn, nil
 }
 
 func (s *Server) handle(req *Request) error {
@@ -106,6 +127,13 @@ func (s *Server) handle(req *Request) error {
 
 		return s.SendResult(req, &mt.RPCAnswerDroppedRunning{})
 
+	case mt.HTTPWaitRequestTypeID:
+		// Handled by HTTP transport, see serveHTTPMessage.
+		return nil
+
+	case tg.AuthBindTempAuthKeyRequestTypeID:
+		return s.handleBindTempAuthKey(req)
+
 	case proto.GZIPTypeID:
 		var content proto.GZIP
 		if err := content.Decode(in); err != nil {
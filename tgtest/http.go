@@ -0,0 +1,318 @@
+package tgtest
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/exchange"
+	"github.com/gotd/td/mt"
+	"github.com/gotd/td/proto"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/transport"
+)
+
+// maxHTTPBodySize is maximum size of HTTP request body.
+const maxHTTPBodySize = 1 << 24 // 16 MB
+
+var _ http.Handler = (*Server)(nil)
+
+// httpConn is server-side connection of HTTP transport session.
+//
+// Sent messages are queued until client polls them using POST request.
+type httpConn struct {
+	mux    sync.Mutex
+	queue  [][]byte
+	notify chan struct{}
+	closed *tdsync.Ready
+}
+
+func newHTTPConn() *httpConn {
+	return &httpConn{
+		notify: make(chan struct{}, 1),
+		closed: tdsync.NewReady(),
+	}
+}
+
+func (c *httpConn) Send(ctx context.Context, b *bin.Buffer) error {
+	select {
+	case <-c.closed.Ready():
+		return net.ErrClosed
+	default:
+	}
+
+	c.mux.Lock()
+	c.queue = append(c.queue, b.Copy())
+	c.mux.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (c *httpConn) Recv(ctx context.Context, b *bin.Buffer) error {
+	return errors.New("HTTP connection does not support Recv")
+}
+
+func (c *httpConn) Close() error {
+	c.closed.Signal()
+	return nil
+}
+
+func (c *httpConn) pop() ([]byte, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if len(c.queue) == 0 {
+		return nil, false
+	}
+	data := c.queue[0]
+	c.queue[0] = nil
+	c.queue = c.queue[1:]
+	if len(c.queue) > 0 {
+		// Wake up next waiter.
+		select {
+		case c.notify <- struct{}{}:
+		default:
+		}
+	}
+	return data, true
+}
+
+// wait waits for next queued message no longer than given duration.
+//
+// Returns nil if there is nothing to send.
+func (c *httpConn) wait(ctx context.Context, d time.Duration) []byte {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	for {
+		if data, ok := c.pop(); ok {
+			return data
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.closed.Ready():
+			return nil
+		case <-timer.C:
+			return nil
+		case <-c.notify:
+		}
+	}
+}
+
+// httpExchange is key exchange over HTTP transport.
+type httpExchange struct {
+	// mux serializes exchange requests.
+	mux  sync.Mutex
+	conn transport.Conn
+	// done is closed when exchange is finished.
+	done chan struct{}
+}
+
+// ServeHTTP serves MTProto over HTTP transport.
+//
+// See https://core.telegram.org/mtproto/transports#http.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxHTTPBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	b := &bin.Buffer{Buf: body}
+
+	var authKeyID [8]byte
+	if err := b.PeekN(authKeyID[:], len(authKeyID)); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var resp []byte
+	switch {
+	case authKeyID == [8]byte{}:
+		resp, err = s.serveHTTPExchange(r.Context(), b)
+	default:
+		if _, ok := s.users.getSession(authKeyID); !ok {
+			err = &codec.ProtocolErr{Code: codec.CodeAuthKeyNotFound}
+			break
+		}
+		resp, err = s.serveHTTPMessage(r.Context(), b)
+	}
+
+	var protoErr *codec.ProtocolErr
+	switch {
+	case errors.As(err, &protoErr):
+		var buf bin.Buffer
+		buf.PutInt32(-protoErr.Code)
+
+		w.WriteHeader(int(protoErr.Code))
+		_, _ = w.Write(buf.Buf)
+	case err != nil:
+		s.log.Info("HTTP handler error", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+	default:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(resp)
+	}
+}
+
+// serveHTTPMessage handles encrypted message and waits for response.
+func (s *Server) serveHTTPMessage(ctx context.Context, b *bin.Buffer) ([]byte, error) {
+	session, msg, err := s.decrypt(b)
+	if err != nil {
+		return nil, err
+	}
+	maxWait := httpWait(&bin.Buffer{Buf: msg.Data()})
+
+	conn, err := s.serveMessage(ctx, newHTTPConn(), session, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	c, ok := conn.Conn.(*httpConn)
+	if !ok {
+		return nil, errors.Errorf("session %d is not HTTP", session.ID)
+	}
+	return c.wait(ctx, maxWait), nil
+}
+
+// httpWait returns max_wait of http_wait request from given message,
+// zero if not found.
+func httpWait(b *bin.Buffer) time.Duration {
+	id, err := b.PeekID()
+	if err != nil {
+		return 0
+	}
+
+	switch id {
+	case mt.HTTPWaitRequestTypeID:
+		var wait mt.HTTPWaitRequest
+		if err := wait.Decode(b); err != nil {
+			return 0
+		}
+		return time.Duration(wait.MaxWait) * time.Millisecond
+	case proto.MessageContainerTypeID:
+		var container proto.MessageContainer
+		if err := container.Decode(b); err != nil {
+			return 0
+		}
+		for _, msg := range container.Messages {
+			if d := httpWait(&bin.Buffer{Buf: msg.Body}); d > 0 {
+				return d
+			}
+		}
+	}
+	return 0
+}
+
+// serveHTTPExchange passes key exchange message to exchange flow
+// and waits for response.
+//
+// Messages of the same exchange are matched using nonce.
+func (s *Server) serveHTTPExchange(ctx context.Context, b *bin.Buffer) ([]byte, error) {
+	var msg proto.UnencryptedMessage
+	if err := msg.Decode(&bin.Buffer{Buf: b.Copy()}); err != nil {
+		return nil, errors.Wrap(err, "decode")
+	}
+
+	payload := &bin.Buffer{Buf: msg.MessageData}
+	id, err := payload.ID()
+	if err != nil {
+		return nil, errors.Wrap(err, "peek id")
+	}
+	// Every exchange request starts with nonce.
+	nonce, err := payload.Int128()
+	if err != nil {
+		return nil, errors.Wrap(err, "read nonce")
+	}
+
+	s.httpMux.Lock()
+	e, ok := s.httpExchanges[nonce]
+	if !ok && (id == mt.ReqPqMultiRequestTypeID || id == mt.ReqPqRequestTypeID) {
+		e = s.startHTTPExchange(nonce)
+		ok = true
+	}
+	s.httpMux.Unlock()
+	if !ok {
+		return nil, &codec.ProtocolErr{Code: codec.CodeAuthKeyNotFound}
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if err := e.conn.Send(ctx, b); err != nil {
+		return nil, errors.Wrap(err, "send")
+	}
+	var resp bin.Buffer
+	if err := e.conn.Recv(ctx, &resp); err != nil {
+		return nil, errors.Wrap(err, "recv")
+	}
+
+	if id == mt.SetClientDHParamsRequestTypeID {
+		// Wait for key registration, otherwise next client request
+		// can be rejected.
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return resp.Buf, nil
+}
+
+// startHTTPExchange starts new key exchange flow.
+//
+// Must be called with locked httpMux.
+func (s *Server) startHTTPExchange(nonce bin.Int128) *httpExchange {
+	client, server := transport.Intermediate.Pipe()
+	e := &httpExchange{
+		conn: client,
+		done: make(chan struct{}),
+	}
+	s.httpExchanges[nonce] = e
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), exchange.DefaultTimeout*3)
+		defer cancel()
+		defer close(e.done)
+		defer func() {
+			s.httpMux.Lock()
+			delete(s.httpExchanges, nonce)
+			s.httpMux.Unlock()
+
+			_ = client.Close()
+			_ = server.Close()
+		}()
+
+		key, err := s.exchange(ctx, server)
+		if err != nil {
+			var exchangeErr *exchange.ServerExchangeError
+			if errors.As(err, &exchangeErr) {
+				_ = s.sendProtoError(ctx, server, exchangeErr.Code)
+				return
+			}
+			s.log.Info("HTTP key exchange failed", zap.Error(err))
+			return
+		}
+
+		s.users.addSession(key)
+	}()
+
+	return e
+}
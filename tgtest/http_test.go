@@ -0,0 +1,107 @@
+package tgtest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgtest"
+	"github.com/gotd/td/tgtest/cluster"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	a := require.New(t)
+	log := zaptest.NewLogger(t)
+	defer func() { _ = log.Sync() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	const text = "pushed"
+	g := tdsync.NewCancellableGroup(ctx)
+	c := cluster.NewCluster(cluster.Options{
+		HTTP:   true,
+		Logger: log.Named("cluster"),
+	})
+	c.Dispatch(2, "server").HandleFunc(tg.UsersGetUsersRequestTypeID,
+		func(server *tgtest.Server, req *tgtest.Request) error {
+			if err := server.SendVector(req, &tg.User{ID: 10}); err != nil {
+				return err
+			}
+
+			// Server push should be delivered by http_wait.
+			return server.SendUpdates(req.RequestCtx, req.Session, &tg.UpdateNewMessage{
+				Message: &tg.Message{
+					PeerID:  &tg.PeerUser{UserID: 10},
+					Message: text,
+				},
+			})
+		},
+	)
+	g.Go(c.Up)
+
+	g.Go(func(ctx context.Context) error {
+		select {
+		case <-c.Ready():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		defer g.Cancel()
+
+		dispatcher := tg.NewUpdateDispatcher()
+		got := make(chan string, 1)
+		dispatcher.OnNewMessage(func(ctx context.Context, e tg.Entities, u *tg.UpdateNewMessage) error {
+			msg, ok := u.Message.(*tg.Message)
+			if ok {
+				select {
+				case got <- msg.Message:
+				default:
+				}
+			}
+			return nil
+		})
+
+		client := telegram.NewClient(telegram.TestAppID, telegram.TestAppHash, telegram.Options{
+			PublicKeys:     c.Keys(),
+			DC:             2,
+			DCList:         c.List(),
+			Resolver:       c.Resolver(),
+			UpdateHandler:  dispatcher,
+			Logger:         log.Named("client"),
+			SessionStorage: &session.StorageMemory{},
+			RetryInterval:  100 * time.Millisecond,
+		})
+
+		return client.Run(ctx, func(ctx context.Context) error {
+			users, err := client.API().UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+			if err != nil {
+				return errors.Wrap(err, "get users")
+			}
+			if len(users) != 1 {
+				return errors.Errorf("unexpected users count %d", len(users))
+			}
+
+			select {
+			case msg := <-got:
+				if msg != text {
+					return errors.Errorf("unexpected message %q", msg)
+				}
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	})
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		a.NoError(err)
+	}
+}
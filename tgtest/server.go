@@ -5,12 +5,14 @@ import (
 	"crypto/rsa"
 	"io"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/go-faster/errors"
 	"go.uber.org/zap"
 	"nhooyr.io/websocket"
 
+	"github.com/gotd/td/bin"
 	"github.com/gotd/td/clock"
 	"github.com/gotd/td/crypto"
 	"github.com/gotd/td/exchange"
@@ -38,6 +40,8 @@ type Server struct {
 
 	readTimeout  time.Duration
 	writeTimeout time.Duration
+	// Connection wrapper. May be nil.
+	wrapConn func(conn transport.Conn) transport.Conn // immutable,nilable
 
 	// RPC handler.
 	handler Handler // immutable
@@ -45,6 +49,10 @@ type Server struct {
 	// users stores session info.
 	users *users
 
+	// httpExchanges stores key exchanges over HTTP transport by nonce.
+	httpExchanges map[bin.Int128]*httpExchange
+	httpMux       sync.Mutex
+
 	// type map for logging.
 	types *tmap.Map   // immutable
 	log   *zap.Logger // immutable
@@ -70,10 +78,13 @@ func NewServer(key exchange.PrivateKey, handler Handler, opts ServerOptions) *Se
 		msgID:        opts.MessageID,
 		readTimeout:  opts.ReadTimeout,
 		writeTimeout: opts.WriteTimeout,
+		wrapConn:     opts.WrapConn,
 		handler:      handler,
 		users:        newUsers(),
-		types:        opts.Types,
-		log:          opts.Logger,
+
+		httpExchanges: map[bin.Int128]*httpExchange{},
+		types:         opts.Types,
+		log:           opts.Logger,
 	}
 	return s
 }
@@ -104,6 +115,9 @@ func (s *Server) serve(ctx context.Context, l transport.Listener) error {
 				}
 				return errors.Wrap(err, "accept")
 			}
+			if s.wrapConn != nil {
+				conn = s.wrapConn(conn)
+			}
 
 			grp.Go(func(ctx context.Context) error {
 				if err := s.serveConn(ctx, conn); err != nil {
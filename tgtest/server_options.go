@@ -38,6 +38,9 @@ type ServerOptions struct {
 	ReadTimeout time.Duration
 	// ReadTimeout is a connection write timeout.
 	WriteTimeout time.Duration
+	// WrapConn wraps every accepted connection, e.g. to inject faults.
+	// Optional.
+	WrapConn func(conn transport.Conn) transport.Conn
 }
 
 func (opt *ServerOptions) setDefaults() {
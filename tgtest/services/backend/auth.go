@@ -0,0 +1,209 @@
+package backend
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// CreateUser creates new user and returns its ID.
+//
+// User can sign in using phone and Config.Code.
+func (s *Service) CreateUser(p Profile) (int64, error) {
+	if p.Phone == "" {
+		return 0, errors.New("phone is required")
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	u, err := s.createUser(p, false)
+	if err != nil {
+		return 0, err
+	}
+	return u.id, nil
+}
+
+// CreateBot creates new bot and returns its ID and token.
+//
+// Bot can sign in using token.
+func (s *Service) CreateBot(p Profile) (id int64, token string, _ error) {
+	p.Phone = ""
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	u, err := s.createUser(p, true)
+	if err != nil {
+		return 0, "", err
+	}
+	return u.id, u.token, nil
+}
+
+// Must be called with s.mux held.
+func (s *Service) randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(s.random, buf); err != nil {
+		return "", errors.Wrap(err, "read random")
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Must be called with s.mux held.
+func (s *Service) createUser(p Profile, bot bool) (*user, error) {
+	for _, u := range s.users {
+		if p.Phone != "" && u.profile.Phone == p.Phone {
+			return nil, errors.Errorf("phone %q is occupied", p.Phone)
+		}
+		if p.Username != "" && strings.EqualFold(u.profile.Username, p.Username) {
+			return nil, errors.Errorf("username %q is occupied", p.Username)
+		}
+	}
+	accessHash, err := crypto.RandInt64(s.random)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate access hash")
+	}
+
+	u := &user{
+		id:         s.nextID(),
+		accessHash: accessHash,
+		profile:    p,
+		bot:        bot,
+		messages:   map[int]*boxMessage{},
+		sessions:   map[int64]session{},
+	}
+	if bot {
+		secret, err := s.randomHex(16)
+		if err != nil {
+			return nil, err
+		}
+		u.token = fmt.Sprintf("%d:%s", u.id, secret)
+	}
+	s.users[u.id] = u
+	return u, nil
+}
+
+// login binds auth key of call to user.
+//
+// Must be called with s.mux held.
+func (s *Service) login(ctx context.Context, u *user) (*tg.AuthAuthorization, error) {
+	c := getCall(ctx)
+	if c == nil {
+		return nil, errUnauthorized
+	}
+	s.keys[c.req.Session.AuthKey.ID] = u.id
+	if _, err := s.authorized(c); err != nil {
+		return nil, err
+	}
+	return &tg.AuthAuthorization{
+		User: u.object(u.id),
+	}, nil
+}
+
+// Must be called with s.mux held.
+func (s *Service) checkCode(phone, hash string) error {
+	if p, ok := s.codes[hash]; !ok || p != phone {
+		return tgerr.New(400, tg.ErrPhoneCodeExpired)
+	}
+	return nil
+}
+
+func (s *Service) authSendCode(ctx context.Context, req *tg.AuthSendCodeRequest) (tg.AuthSentCodeClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if req.PhoneNumber == "" {
+		return nil, tgerr.New(400, tg.ErrPhoneNumberInvalid)
+	}
+	hash, err := s.randomHex(8)
+	if err != nil {
+		return nil, err
+	}
+	s.codes[hash] = req.PhoneNumber
+
+	return &tg.AuthSentCode{
+		Type:          &tg.AuthSentCodeTypeApp{Length: len(s.code)},
+		PhoneCodeHash: hash,
+	}, nil
+}
+
+func (s *Service) authSignIn(ctx context.Context, req *tg.AuthSignInRequest) (tg.AuthAuthorizationClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if err := s.checkCode(req.PhoneNumber, req.PhoneCodeHash); err != nil {
+		return nil, err
+	}
+	if req.PhoneCode != s.code {
+		return nil, tgerr.New(400, tg.ErrPhoneCodeInvalid)
+	}
+
+	for _, u := range s.users {
+		if u.profile.Phone == req.PhoneNumber {
+			delete(s.codes, req.PhoneCodeHash)
+			return s.login(ctx, u)
+		}
+	}
+	return &tg.AuthAuthorizationSignUpRequired{}, nil
+}
+
+func (s *Service) authSignUp(ctx context.Context, req *tg.AuthSignUpRequest) (tg.AuthAuthorizationClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if err := s.checkCode(req.PhoneNumber, req.PhoneCodeHash); err != nil {
+		return nil, err
+	}
+	for _, u := range s.users {
+		if u.profile.Phone == req.PhoneNumber {
+			return nil, tgerr.New(400, tg.ErrPhoneNumberOccupied)
+		}
+	}
+
+	u, err := s.createUser(Profile{
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Phone:     req.PhoneNumber,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.codes, req.PhoneCodeHash)
+	return s.login(ctx, u)
+}
+
+func (s *Service) authImportBotAuthorization(
+	ctx context.Context,
+	req *tg.AuthImportBotAuthorizationRequest,
+) (tg.AuthAuthorizationClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, u := range s.users {
+		if u.bot && u.token == req.BotAuthToken {
+			return s.login(ctx, u)
+		}
+	}
+	return nil, tgerr.New(400, tg.ErrAccessTokenInvalid)
+}
+
+func (s *Service) authLogOut(ctx context.Context) (*tg.AuthLoggedOut, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.keys, c.req.Session.AuthKey.ID)
+	delete(u.sessions, c.req.Session.ID)
+	return &tg.AuthLoggedOut{}, nil
+}
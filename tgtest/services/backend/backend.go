@@ -0,0 +1,366 @@
+// Package backend contains stateful in-memory Telegram backend for tgtest server.
+//
+// Backend emulates users and bots, private chats, basic groups and channels,
+// update boxes and file uploads, so multiple clients can talk to each other
+// without the real network.
+//
+// Common and channel updates are tracked with pts. Every updates container
+// pushed to user gets next seq of user. Bots also receive participant
+// updates with qts when users join their chats. Secret chats are not
+// supported.
+//
+// Backend does not serve help.getConfig, use cluster.Cluster or register
+// config.Service explicitly.
+package backend
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/clock"
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgtest"
+	"github.com/gotd/td/tgtest/services"
+	"github.com/gotd/td/tgtest/services/file"
+)
+
+// Config of Service.
+type Config struct {
+	// Code is a login code accepted for every phone. Defaults to "12345".
+	Code string
+	// Files is a config of file service.
+	Files file.Config
+	// Random is random source used to generate access hashes and tokens.
+	// Defaults to crypto.DefaultRand().
+	Random io.Reader
+	// Clock to use. Defaults to clock.System.
+	Clock clock.Clock
+	// Logger is instance of zap.Logger. No logs by default.
+	Logger *zap.Logger
+}
+
+func (c *Config) setDefaults() {
+	if c.Code == "" {
+		c.Code = "12345"
+	}
+	if c.Random == nil {
+		c.Random = crypto.DefaultRand()
+	}
+	if c.Clock == nil {
+		c.Clock = clock.System
+	}
+	if c.Logger == nil {
+		c.Logger = zap.NewNop()
+	}
+}
+
+// Service is a stateful Telegram backend.
+type Service struct {
+	code   string      // immutable
+	random io.Reader   // immutable
+	clock  clock.Clock // immutable
+	log    *zap.Logger // immutable
+
+	files    *file.Service
+	dispatch *tg.ServerDispatcher
+
+	mux      sync.Mutex
+	lastID   int64
+	users    map[int64]*user
+	chats    map[int64]*chat
+	channels map[int64]*channel
+	keys     map[[8]byte]int64 // auth key ID -> user ID
+	codes    map[string]string // phone code hash -> phone
+}
+
+// NewService creates new Service.
+func NewService(cfg Config) *Service {
+	cfg.setDefaults()
+
+	s := &Service{
+		code:     cfg.Code,
+		random:   cfg.Random,
+		clock:    cfg.Clock,
+		log:      cfg.Logger,
+		files:    file.NewService(cfg.Files),
+		lastID:   100,
+		users:    map[int64]*user{},
+		chats:    map[int64]*chat{},
+		channels: map[int64]*channel{},
+		keys:     map[[8]byte]int64{},
+		codes:    map[string]string{},
+	}
+	s.dispatch = tg.NewServerDispatcher(func(ctx context.Context, b *bin.Buffer) (bin.Encoder, error) {
+		return nil, services.ErrMethodNotImplemented
+	})
+	s.register(s.dispatch)
+	return s
+}
+
+var errUnauthorized = tgerr.New(401, "AUTH_KEY_UNREGISTERED")
+
+// fileMethods is a list of methods implemented by file.Service.
+var fileMethods = []uint32{
+	tg.UploadGetFileRequestTypeID,
+	tg.UploadGetFileHashesRequestTypeID,
+	tg.UploadSaveFilePartRequestTypeID,
+	tg.UploadSaveBigFilePartRequestTypeID,
+}
+
+func (s *Service) register(d *tg.ServerDispatcher) {
+	d.OnAuthSendCode(s.authSendCode)
+	d.OnAuthSignIn(s.authSignIn)
+	d.OnAuthSignUp(s.authSignUp)
+	d.OnAuthImportBotAuthorization(s.authImportBotAuthorization)
+	d.OnAuthLogOut(s.authLogOut)
+	d.OnUsersGetUsers(s.usersGetUsers)
+	d.OnUsersGetFullUser(s.usersGetFullUser)
+	d.OnContactsResolveUsername(s.contactsResolveUsername)
+	d.OnUpdatesGetState(s.updatesGetState)
+	d.OnUpdatesGetDifference(s.updatesGetDifference)
+	d.OnUpdatesGetChannelDifference(s.updatesGetChannelDifference)
+	d.OnMessagesSendMessage(s.messagesSendMessage)
+	d.OnMessagesEditMessage(s.messagesEditMessage)
+	d.OnMessagesDeleteMessages(s.messagesDeleteMessages)
+	d.OnMessagesGetHistory(s.messagesGetHistory)
+	d.OnMessagesGetDialogs(s.messagesGetDialogs)
+	d.OnMessagesCreateChat(s.messagesCreateChat)
+	d.OnMessagesAddChatUser(s.messagesAddChatUser)
+	d.OnMessagesGetChats(s.messagesGetChats)
+	d.OnChannelsCreateChannel(s.channelsCreateChannel)
+	d.OnChannelsInviteToChannel(s.channelsInviteToChannel)
+	d.OnChannelsJoinChannel(s.channelsJoinChannel)
+	d.OnChannelsGetChannels(s.channelsGetChannels)
+	d.OnChannelsDeleteMessages(s.channelsDeleteMessages)
+}
+
+// Register registers service handlers.
+func (s *Service) Register(dispatcher *tgtest.Dispatcher) {
+	for _, id := range s.dispatch.TypeIDs() {
+		dispatcher.Handle(id, s)
+	}
+	for _, id := range fileMethods {
+		dispatcher.HandleFunc(id, s.onFile)
+	}
+}
+
+// call is a state of RPC call.
+type call struct {
+	server *tgtest.Server
+	req    *tgtest.Request
+	// user is an ID of caller, set by Service.authorized.
+	user int64
+	// pushes are updates to send after result.
+	pushes []push
+	// inResult reports whether result contains updates pushed to caller,
+	// so current session does not need them.
+	inResult bool
+}
+
+type push struct {
+	to      int64
+	updates *tg.Updates
+}
+
+type callKey struct{}
+
+func getCall(ctx context.Context) *call {
+	c, _ := ctx.Value(callKey{}).(*call)
+	return c
+}
+
+// OnMessage implements tgtest.Handler.
+func (s *Service) OnMessage(server *tgtest.Server, req *tgtest.Request) error {
+	c := &call{server: server, req: req}
+	ctx := context.WithValue(req.RequestCtx, callKey{}, c)
+
+	result, err := s.dispatch.Handle(ctx, req.Buf)
+	if err != nil {
+		return err
+	}
+
+	s.mux.Lock()
+	s.attachSeq(c, result)
+	data, err := encode(result)
+	s.mux.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := server.SendResult(req, data); err != nil {
+		return err
+	}
+	s.flush(ctx, c)
+	return nil
+}
+
+// attachSeq sets seq of updates pushed to caller to result, because
+// current session receives them in result instead of push.
+//
+// Handlers already put pts updates of caller to result, so only qts
+// updates are added.
+//
+// Must be called with s.mux held.
+func (s *Service) attachSeq(c *call, result bin.Encoder) {
+	box, ok := result.(*tg.UpdatesBox)
+	if !ok {
+		return
+	}
+	r, ok := box.Updates.(*tg.Updates)
+	if !ok {
+		return
+	}
+	for _, p := range c.pushes {
+		if p.to != c.user {
+			continue
+		}
+		updates := r.Updates
+		for _, u := range p.updates.Updates {
+			if _, ok := tg.IsQtsUpdate(u); ok {
+				updates = append(updates, u)
+			}
+		}
+		*r = *s.updates(c.user, updates...)
+		r.Seq = p.updates.Seq
+		c.inResult = true
+		return
+	}
+}
+
+func (s *Service) onFile(server *tgtest.Server, req *tgtest.Request) error {
+	s.mux.Lock()
+	u, err := s.authorized(&call{server: server, req: req})
+	s.mux.Unlock()
+	if err != nil {
+		return err
+	}
+
+	r := *req
+	r.RequestCtx = file.WithUserID(req.RequestCtx, u.id)
+	return s.files.OnMessage(server, &r)
+}
+
+// authorized returns user of current call and remembers its session
+// to push updates.
+//
+// Must be called with s.mux held.
+func (s *Service) authorized(c *call) (*user, error) {
+	if c == nil {
+		return nil, errUnauthorized
+	}
+	id, ok := s.keys[c.req.Session.AuthKey.ID]
+	if !ok {
+		return nil, errUnauthorized
+	}
+	u, ok := s.users[id]
+	if !ok {
+		return nil, errUnauthorized
+	}
+	c.user = u.id
+	u.sessions[c.req.Session.ID] = session{
+		server:  c.server,
+		session: c.req.Session,
+	}
+	return u, nil
+}
+
+// caller is like authorized, but gets call from context.
+//
+// Must be called with s.mux held.
+func (s *Service) caller(ctx context.Context) (*call, *user, error) {
+	c := getCall(ctx)
+	u, err := s.authorized(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, u, nil
+}
+
+// flush sends pending updates of call to recipients.
+func (s *Service) flush(ctx context.Context, c *call) {
+	type dest struct {
+		to      int64
+		session session
+		updates encoded
+	}
+
+	s.mux.Lock()
+	var dests []dest
+	for _, p := range c.pushes {
+		u, ok := s.users[p.to]
+		if !ok {
+			continue
+		}
+		data, err := encode(p.updates)
+		if err != nil {
+			s.log.Error("Encode push", zap.Error(err))
+			continue
+		}
+		for id, sess := range u.sessions {
+			if c.inResult && p.to == c.user && id == c.req.Session.ID {
+				// Current session gets updates in result.
+				continue
+			}
+			dests = append(dests, dest{
+				to:      p.to,
+				session: sess,
+				updates: data,
+			})
+		}
+	}
+	s.mux.Unlock()
+
+	for _, t := range dests {
+		if err := t.session.server.Send(ctx, t.session.session, tgtest.MessageFromServer, t.updates); err != nil {
+			s.log.Debug("Push failed, forget session",
+				zap.Int64("user_id", t.to),
+				zap.Inline(t.session.session),
+				zap.Error(err),
+			)
+
+			s.mux.Lock()
+			if u, ok := s.users[t.to]; ok {
+				delete(u.sessions, t.session.session.ID)
+			}
+			s.mux.Unlock()
+		}
+	}
+}
+
+// encoded is a pre-encoded object.
+type encoded []byte
+
+// Encode implements bin.Encoder.
+func (e encoded) Encode(b *bin.Buffer) error {
+	b.Put(e)
+	return nil
+}
+
+// encode encodes object. Must be called with Service.mux held: results share
+// messages with update boxes and encoding of message sets its flags.
+func encode(e bin.Encoder) (encoded, error) {
+	var b bin.Buffer
+	if err := e.Encode(&b); err != nil {
+		return nil, errors.Wrapf(err, "encode %T", e)
+	}
+	return encoded(b.Copy()), nil
+}
+
+// now returns current date.
+func (s *Service) now() int {
+	return int(s.clock.Now().Unix())
+}
+
+// nextID returns new entity ID.
+//
+// Must be called with s.mux held.
+func (s *Service) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
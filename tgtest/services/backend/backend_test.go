@@ -0,0 +1,306 @@
+package backend_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgtest/cluster"
+	"github.com/gotd/td/tgtest/services/backend"
+	"github.com/gotd/td/transport"
+)
+
+// waitUpdate waits for update matching given type.
+func waitUpdate[U tg.UpdateClass](ctx context.Context, ch <-chan tg.UpdatesClass) (U, error) {
+	var zero U
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, errors.Wrapf(ctx.Err(), "wait for %T", zero)
+		case u := <-ch:
+			upd, ok := u.(*tg.Updates)
+			if !ok {
+				continue
+			}
+			for _, e := range upd.Updates {
+				if r, ok := e.(U); ok {
+					return r, nil
+				}
+			}
+		}
+	}
+}
+
+func TestService(t *testing.T) {
+	a := require.New(t)
+	log := zaptest.NewLogger(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	g := tdsync.NewCancellableGroup(ctx)
+
+	c := cluster.NewCluster(cluster.Options{
+		Logger:   log.Named("cluster"),
+		Protocol: transport.Intermediate,
+	})
+	s := backend.NewService(backend.Config{
+		// auth.TestUser uses DC ID repeated as code.
+		Code:   "22222",
+		Logger: log.Named("backend"),
+	})
+	s.Register(c.Dispatch(2, "server"))
+
+	botID, token, err := s.CreateBot(backend.Profile{
+		FirstName: "Echo",
+		Username:  "echo_bot",
+	})
+	a.NoError(err)
+	_, _, err = s.CreateBot(backend.Profile{Username: "Echo_Bot"})
+	a.Error(err, "username must be unique")
+
+	newClient := func(name string, updates chan tg.UpdatesClass) *telegram.Client {
+		return telegram.NewClient(1, "hash", telegram.Options{
+			UpdateHandler: telegram.UpdateHandlerFunc(func(ctx context.Context, u tg.UpdatesClass) error {
+				select {
+				case updates <- u:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}),
+			PublicKeys:     c.Keys(),
+			Resolver:       c.Resolver(),
+			DCList:         c.List(),
+			SessionStorage: &session.StorageMemory{},
+			Logger:         log.Named(name),
+		})
+	}
+	aliceUpdates := make(chan tg.UpdatesClass, 16)
+	botUpdates := make(chan tg.UpdatesClass, 16)
+	var alice, bot *telegram.Client
+
+	scenario := func(ctx context.Context) error {
+		aliceAPI, botAPI := alice.API(), bot.API()
+
+		// Unauthorized call.
+		_, err := aliceAPI.UpdatesGetState(ctx)
+		a.True(tgerr.Is(err, "AUTH_KEY_UNREGISTERED"), err)
+
+		// Sign up and sign in.
+		a.NoError(auth.NewFlow(
+			auth.TestUser("9996621234", 2),
+			auth.SendCodeOptions{},
+		).Run(ctx, alice.Auth()))
+		self, err := alice.Self(ctx)
+		a.NoError(err)
+		a.Equal("9996621234", self.Phone)
+
+		_, err = bot.Auth().Bot(ctx, token)
+		a.NoError(err)
+
+		// Private messages.
+		resolved, err := aliceAPI.ContactsResolveUsername(ctx, "echo_bot")
+		a.NoError(err)
+		a.Len(resolved.Users, 1)
+		botUser := resolved.Users[0].(*tg.User)
+		a.Equal(botID, botUser.ID)
+		a.True(botUser.Bot)
+		botPeer := &tg.InputPeerUser{UserID: botUser.ID, AccessHash: botUser.AccessHash}
+
+		sent, err := aliceAPI.MessagesSendMessage(ctx, &tg.MessagesSendMessageRequest{
+			Peer:     botPeer,
+			Message:  "ping",
+			RandomID: 1,
+		})
+		a.NoError(err)
+		// Result is the first updates container of alice.
+		a.Equal(1, sent.(*tg.Updates).Seq)
+		sentUpdates := sent.(*tg.Updates).Updates
+		a.Equal(&tg.UpdateMessageID{ID: 1, RandomID: 1}, sentUpdates[0])
+		ping := sentUpdates[1].(*tg.UpdateNewMessage).Message.(*tg.Message)
+		a.True(ping.Out)
+
+		received, err := waitUpdate[*tg.UpdateNewMessage](ctx, botUpdates)
+		a.NoError(err)
+		botPing := received.Message.(*tg.Message)
+		a.Equal("ping", botPing.Message)
+		a.False(botPing.Out)
+		a.Equal(&tg.PeerUser{UserID: self.ID}, botPing.PeerID)
+
+		// Reply is mapped to message ID of recipient.
+		_, err = botAPI.MessagesSendMessage(ctx, &tg.MessagesSendMessageRequest{
+			Peer:     &tg.InputPeerUser{UserID: self.ID, AccessHash: self.AccessHash},
+			ReplyTo:  &tg.InputReplyToMessage{ReplyToMsgID: botPing.ID},
+			Message:  "pong",
+			RandomID: 2,
+		})
+		a.NoError(err)
+		received, err = waitUpdate[*tg.UpdateNewMessage](ctx, aliceUpdates)
+		a.NoError(err)
+		pong := received.Message.(*tg.Message)
+		a.Equal("pong", pong.Message)
+		a.Equal(ping.ID, pong.ReplyTo.(*tg.MessageReplyHeader).ReplyToMsgID)
+
+		history, err := aliceAPI.MessagesGetHistory(ctx, &tg.MessagesGetHistoryRequest{
+			Peer:  botPeer,
+			Limit: 10,
+		})
+		a.NoError(err)
+		msgs := history.(*tg.MessagesMessages).Messages
+		a.Len(msgs, 2)
+		a.Equal(pong.ID, msgs[0].GetID())
+
+		_, err = aliceAPI.MessagesEditMessage(ctx, &tg.MessagesEditMessageRequest{
+			Peer:    botPeer,
+			ID:      pong.ID,
+			Message: "not mine",
+		})
+		a.True(tgerr.Is(err, tg.ErrMessageAuthorRequired), err)
+		_, err = aliceAPI.MessagesEditMessage(ctx, &tg.MessagesEditMessageRequest{
+			Peer:    botPeer,
+			ID:      ping.ID,
+			Message: "ping!",
+		})
+		a.NoError(err)
+		edited, err := waitUpdate[*tg.UpdateEditMessage](ctx, botUpdates)
+		a.NoError(err)
+		a.Equal(botPing.ID, edited.Message.GetID())
+		a.Equal("ping!", edited.Message.(*tg.Message).Message)
+
+		// Channels.
+		created, err := aliceAPI.ChannelsCreateChannel(ctx, &tg.ChannelsCreateChannelRequest{
+			Megagroup: true,
+			Title:     "Group",
+		})
+		a.NoError(err)
+		ch := created.(*tg.Updates).Chats[0].(*tg.Channel)
+		a.True(ch.Creator)
+		a.True(ch.Megagroup)
+		channel := &tg.InputChannel{ChannelID: ch.ID, AccessHash: ch.AccessHash}
+
+		_, err = botAPI.UpdatesGetChannelDifference(ctx, &tg.UpdatesGetChannelDifferenceRequest{
+			Channel: channel,
+			Filter:  &tg.ChannelMessagesFilterEmpty{},
+			Limit:   10,
+		})
+		a.True(tgerr.Is(err, tg.ErrChannelPrivate), err)
+
+		_, err = aliceAPI.ChannelsInviteToChannel(ctx, &tg.ChannelsInviteToChannelRequest{
+			Channel: channel,
+			Users:   []tg.InputUserClass{&tg.InputUser{UserID: botUser.ID, AccessHash: botUser.AccessHash}},
+		})
+		a.NoError(err)
+		_, err = botAPI.MessagesSendMessage(ctx, &tg.MessagesSendMessageRequest{
+			Peer:     &tg.InputPeerChannel{ChannelID: ch.ID, AccessHash: ch.AccessHash},
+			Message:  "hi all",
+			RandomID: 3,
+		})
+		a.NoError(err)
+		post, err := waitUpdate[*tg.UpdateNewChannelMessage](ctx, aliceUpdates)
+		a.NoError(err)
+		a.Equal("hi all", post.Message.(*tg.Message).Message)
+		a.False(post.Message.(*tg.Message).Out)
+
+		diff, err := botAPI.UpdatesGetChannelDifference(ctx, &tg.UpdatesGetChannelDifferenceRequest{
+			Channel: channel,
+			Filter:  &tg.ChannelMessagesFilterEmpty{},
+			Limit:   10,
+		})
+		a.NoError(err)
+		channelDiff := diff.(*tg.UpdatesChannelDifference)
+		a.True(channelDiff.Final)
+		a.Equal(post.Pts, channelDiff.Pts)
+		// Channel creation, invite and message.
+		a.Len(channelDiff.NewMessages, 3)
+		a.True(channelDiff.NewMessages[2].(*tg.Message).Out)
+
+		dialogs, err := aliceAPI.MessagesGetDialogs(ctx, &tg.MessagesGetDialogsRequest{
+			OffsetPeer: &tg.InputPeerEmpty{},
+			Limit:      10,
+		})
+		a.NoError(err)
+		a.Len(dialogs.(*tg.MessagesDialogs).Dialogs, 2)
+
+		// Deletion.
+		affected, err := aliceAPI.MessagesDeleteMessages(ctx, &tg.MessagesDeleteMessagesRequest{
+			Revoke: true,
+			ID:     []int{ping.ID},
+		})
+		a.NoError(err)
+		a.Equal(1, affected.PtsCount)
+		deleted, err := waitUpdate[*tg.UpdateDeleteMessages](ctx, botUpdates)
+		a.NoError(err)
+		a.Equal([]int{botPing.ID}, deleted.Messages)
+
+		// Difference of bot box: ping, pong, edit and delete, and qts update
+		// of invite to channel.
+		difference, err := botAPI.UpdatesGetDifference(ctx, &tg.UpdatesGetDifferenceRequest{})
+		a.NoError(err)
+		d := difference.(*tg.UpdatesDifference)
+		a.Len(d.NewMessages, 2)
+		a.Len(d.OtherUpdates, 3)
+		a.Equal(4, d.State.Pts)
+		a.Equal(1, d.State.Qts)
+		// Ping, pong, edit, invite, post and delete.
+		a.Equal(6, d.State.Seq)
+		participant := d.OtherUpdates[2].(*tg.UpdateChannelParticipant)
+		a.Equal(botID, participant.UserID)
+		a.Equal(self.ID, participant.ActorID)
+		a.Equal(1, participant.Qts)
+
+		difference, err = botAPI.UpdatesGetDifference(ctx, &tg.UpdatesGetDifferenceRequest{
+			Pts: d.State.Pts,
+			Qts: d.State.Qts,
+		})
+		a.NoError(err)
+		a.Equal(d.State.Seq, difference.(*tg.UpdatesDifferenceEmpty).Seq)
+
+		// Files.
+		payload := bytes.Repeat([]byte{42}, 16384)
+		f, err := uploader.NewUploader(aliceAPI).FromBytes(ctx, "42.jpg", payload)
+		a.NoError(err)
+		var b bytes.Buffer
+		_, err = downloader.NewDownloader().Download(aliceAPI, &tg.InputFileLocation{
+			VolumeID: f.GetID(),
+			LocalID:  int(self.ID),
+		}).Stream(ctx, &b)
+		a.NoError(err)
+		a.Equal(payload, b.Bytes())
+
+		return nil
+	}
+
+	g.Go(c.Up)
+	g.Go(func(ctx context.Context) error {
+		defer g.Cancel()
+
+		select {
+		case <-c.Ready():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		alice = newClient("alice", aliceUpdates)
+		bot = newClient("bot", botUpdates)
+		return bot.Run(ctx, func(ctx context.Context) error {
+			return alice.Run(ctx, scenario)
+		})
+	})
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		a.NoError(err)
+	}
+}
@@ -0,0 +1,318 @@
+package backend
+
+import (
+	"context"
+
+	"github.com/gotd/td/crypto"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// service delivers service message with action to all members of chat.
+//
+// Must be called with s.mux held.
+func (s *Service) service(c *call, from *user, ch *chat, action tg.MessageActionClass) []tg.UpdateClass {
+	date := s.now()
+	return s.deliver(c, from, s.deliveries(from, target{chat: ch}), func(d delivery, id int) tg.MessageClass {
+		return &tg.MessageService{
+			Out:    d.to == from,
+			ID:     id,
+			FromID: &tg.PeerUser{UserID: from.id},
+			PeerID: d.peer,
+			Date:   date,
+			Action: action,
+		}
+	})
+}
+
+// channelService posts service message with action to channel.
+//
+// Must be called with s.mux held.
+func (s *Service) channelService(c *call, from *user, ch *channel, action tg.MessageActionClass) tg.UpdateClass {
+	msg := &tg.MessageService{
+		ID:     ch.nextMsgID(),
+		PeerID: &tg.PeerChannel{ChannelID: ch.id},
+		Date:   s.now(),
+		Action: action,
+	}
+	if ch.broadcast {
+		msg.Post = true
+	} else {
+		msg.FromID = &tg.PeerUser{UserID: from.id}
+	}
+	return s.post(c, from, ch, msg)
+}
+
+// participants adds update to qts boxes of bot members and pushes it.
+//
+// Must be called with s.mux held.
+func (s *Service) participants(c *call, members map[int64]struct{}, f func(qts int) tg.UpdateClass) {
+	for _, id := range sortedIDs(members) {
+		b, ok := s.users[id]
+		if !ok || !b.bot {
+			continue
+		}
+		s.push(c, id, b.qts.add(1, f))
+	}
+}
+
+// chatParticipant pushes participant update of added chat member to bots.
+//
+// Must be called with s.mux held.
+func (s *Service) chatParticipant(c *call, actor *user, ch *chat, userID int64) {
+	date := s.now()
+	s.participants(c, ch.members, func(qts int) tg.UpdateClass {
+		return &tg.UpdateChatParticipant{
+			ChatID:  ch.id,
+			Date:    date,
+			ActorID: actor.id,
+			UserID:  userID,
+			NewParticipant: &tg.ChatParticipant{
+				UserID:    userID,
+				InviterID: actor.id,
+				Date:      date,
+			},
+			Qts: qts,
+		}
+	})
+}
+
+// channelParticipant pushes participant update of new channel member to bots.
+//
+// Must be called with s.mux held.
+func (s *Service) channelParticipant(c *call, actor *user, ch *channel, userID int64) {
+	date := s.now()
+	s.participants(c, ch.members, func(qts int) tg.UpdateClass {
+		return &tg.UpdateChannelParticipant{
+			ChannelID: ch.id,
+			Date:      date,
+			ActorID:   actor.id,
+			UserID:    userID,
+			NewParticipant: &tg.ChannelParticipant{
+				UserID: userID,
+				Date:   date,
+			},
+			Qts: qts,
+		}
+	})
+}
+
+func (s *Service) messagesCreateChat(ctx context.Context, req *tg.MessagesCreateChatRequest) (tg.UpdatesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Title == "" {
+		return nil, tgerr.New(400, tg.ErrChatTitleEmpty)
+	}
+
+	members := map[int64]struct{}{u.id: {}}
+	for _, in := range req.Users {
+		m, err := s.inputUser(u, in)
+		if err != nil {
+			return nil, err
+		}
+		members[m.id] = struct{}{}
+	}
+	if len(members) < 2 {
+		return nil, tgerr.New(400, tg.ErrUsersTooFew)
+	}
+
+	ch := &chat{
+		id:      s.nextID(),
+		title:   req.Title,
+		date:    s.now(),
+		creator: u.id,
+		version: 1,
+		members: members,
+	}
+	s.chats[ch.id] = ch
+
+	result := s.service(c, u, ch, &tg.MessageActionChatCreate{
+		Title: ch.title,
+		Users: sortedIDs(members),
+	})
+	for _, id := range sortedIDs(members) {
+		if id != u.id {
+			s.chatParticipant(c, u, ch, id)
+		}
+	}
+	return s.updates(u.id, result...), nil
+}
+
+func (s *Service) messagesAddChatUser(ctx context.Context, req *tg.MessagesAddChatUserRequest) (tg.UpdatesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ch, ok := s.chats[req.ChatID]
+	if !ok {
+		return nil, tgerr.New(400, tg.ErrChatIDInvalid)
+	}
+	if err := (target{chat: ch}).member(u); err != nil {
+		return nil, err
+	}
+	m, err := s.inputUser(u, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ch.members[m.id]; ok {
+		return nil, tgerr.New(400, tg.ErrUserAlreadyParticipant)
+	}
+
+	ch.members[m.id] = struct{}{}
+	ch.version++
+	result := s.service(c, u, ch, &tg.MessageActionChatAddUser{
+		Users: []int64{m.id},
+	})
+	s.chatParticipant(c, u, ch, m.id)
+	return s.updates(u.id, result...), nil
+}
+
+func (s *Service) messagesGetChats(ctx context.Context, ids []int64) (tg.MessagesChatsClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &tg.MessagesChats{Chats: []tg.ChatClass{}}
+	for _, id := range ids {
+		if ch, ok := s.chats[id]; ok {
+			r.Chats = append(r.Chats, ch.object(u.id))
+		}
+	}
+	return r, nil
+}
+
+func (s *Service) channelsCreateChannel(ctx context.Context, req *tg.ChannelsCreateChannelRequest) (tg.UpdatesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Title == "" {
+		return nil, tgerr.New(400, tg.ErrChatTitleEmpty)
+	}
+	accessHash, err := crypto.RandInt64(s.random)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := &channel{
+		id:         s.nextID(),
+		accessHash: accessHash,
+		title:      req.Title,
+		broadcast:  !req.Megagroup,
+		date:       s.now(),
+		creator:    u.id,
+		members:    map[int64]struct{}{u.id: {}},
+		messages:   map[int]tg.MessageClass{},
+	}
+	s.channels[ch.id] = ch
+
+	return s.updates(u.id,
+		&tg.UpdateChannel{ChannelID: ch.id},
+		s.channelService(c, u, ch, &tg.MessageActionChannelCreate{Title: ch.title}),
+	), nil
+}
+
+func (s *Service) channelsInviteToChannel(
+	ctx context.Context,
+	req *tg.ChannelsInviteToChannelRequest,
+) (tg.UpdatesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := s.inputChannel(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if ch.creator != u.id {
+		return nil, tgerr.New(403, tg.ErrChatAdminRequired)
+	}
+
+	var invited []int64
+	for _, in := range req.Users {
+		m, err := s.inputUser(u, in)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ch.members[m.id]; ok {
+			continue
+		}
+		ch.members[m.id] = struct{}{}
+		invited = append(invited, m.id)
+	}
+	if len(invited) == 0 {
+		return nil, tgerr.New(400, tg.ErrUserAlreadyParticipant)
+	}
+
+	for _, id := range invited {
+		s.push(c, id, &tg.UpdateChannel{ChannelID: ch.id})
+		s.channelParticipant(c, u, ch, id)
+	}
+	result := []tg.UpdateClass{&tg.UpdateChannel{ChannelID: ch.id}}
+	if !ch.broadcast {
+		result = append(result, s.channelService(c, u, ch, &tg.MessageActionChatAddUser{
+			Users: invited,
+		}))
+	}
+	return s.updates(u.id, result...), nil
+}
+
+func (s *Service) channelsJoinChannel(ctx context.Context, in tg.InputChannelClass) (tg.UpdatesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := s.inputChannel(in)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ch.members[u.id]; ok {
+		return nil, tgerr.New(400, tg.ErrUserAlreadyParticipant)
+	}
+	ch.members[u.id] = struct{}{}
+
+	s.push(c, u.id, &tg.UpdateChannel{ChannelID: ch.id})
+	s.channelParticipant(c, u, ch, u.id)
+	return s.updates(u.id, &tg.UpdateChannel{ChannelID: ch.id}), nil
+}
+
+func (s *Service) channelsGetChannels(ctx context.Context, ids []tg.InputChannelClass) (tg.MessagesChatsClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &tg.MessagesChats{Chats: []tg.ChatClass{}}
+	for _, in := range ids {
+		ch, err := s.inputChannel(in)
+		if err != nil {
+			return nil, err
+		}
+		r.Chats = append(r.Chats, ch.object(u.id))
+	}
+	return r, nil
+}
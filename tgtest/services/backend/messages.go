@@ -0,0 +1,672 @@
+package backend
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// historyLimit is a maximum number of messages or dialogs returned at once.
+const historyLimit = 100
+
+// delivery is a recipient of private chat or basic group message.
+type delivery struct {
+	to *user
+	// peer is a peer of message as seen by recipient.
+	peer tg.PeerClass
+}
+
+// deliveries returns recipients of message from user to target.
+//
+// Must be called with s.mux held.
+func (s *Service) deliveries(from *user, t target) []delivery {
+	if t.chat != nil {
+		r := make([]delivery, 0, len(t.chat.members))
+		for _, id := range sortedIDs(t.chat.members) {
+			if u, ok := s.users[id]; ok {
+				r = append(r, delivery{to: u, peer: &tg.PeerChat{ChatID: t.chat.id}})
+			}
+		}
+		return r
+	}
+
+	r := []delivery{{to: from, peer: &tg.PeerUser{UserID: t.user.id}}}
+	if t.user != from {
+		r = append(r, delivery{to: t.user, peer: &tg.PeerUser{UserID: from.id}})
+	}
+	return r
+}
+
+// deliver puts copies of message to boxes of recipients and returns
+// updates of sender. Other recipients and other sessions of sender
+// receive updates after result.
+//
+// Must be called with s.mux held.
+func (s *Service) deliver(
+	c *call,
+	from *user,
+	to []delivery,
+	build func(d delivery, id int) tg.MessageClass,
+) (result []tg.UpdateClass) {
+	copies := map[int64]int{}
+	for _, d := range to {
+		id := d.to.nextMsgID()
+		msg := build(d, id)
+		copies[d.to.id] = id
+		d.to.messages[id] = &boxMessage{msg: msg, copies: copies}
+
+		u := d.to.box.add(1, func(pts int) tg.UpdateClass {
+			return &tg.UpdateNewMessage{Message: msg, Pts: pts, PtsCount: 1}
+		})
+		if d.to == from {
+			result = append(result, u)
+		}
+		s.push(c, d.to.id, u)
+	}
+	return result
+}
+
+// post puts message to channel and returns update of sender.
+//
+// Must be called with s.mux held.
+func (s *Service) post(c *call, from *user, ch *channel, msg tg.MessageClass) tg.UpdateClass {
+	ch.messages[msg.GetID()] = msg
+	u := ch.box.add(1, func(pts int) tg.UpdateClass {
+		return &tg.UpdateNewChannelMessage{Message: msg, Pts: pts, PtsCount: 1}
+	})
+	for _, id := range sortedIDs(ch.members) {
+		s.push(c, id, ch.personalizeUpdate(u, id))
+	}
+	return ch.personalizeUpdate(u, from.id)
+}
+
+func replyToID(r tg.InputReplyToClass) int {
+	m, ok := r.(*tg.InputReplyToMessage)
+	if !ok {
+		return 0
+	}
+	return m.ReplyToMsgID
+}
+
+func (s *Service) messagesSendMessage(ctx context.Context, req *tg.MessagesSendMessageRequest) (tg.UpdatesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Message == "" {
+		return nil, tgerr.New(400, tg.ErrMessageEmpty)
+	}
+	t, err := s.inputPeer(u, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.member(u); err != nil {
+		return nil, err
+	}
+
+	base := tg.Message{
+		Silent:      req.Silent,
+		Date:        s.now(),
+		Message:     req.Message,
+		ReplyMarkup: req.ReplyMarkup,
+		Entities:    req.Entities,
+	}
+	replyTo := replyToID(req.ReplyTo)
+
+	if ch := t.channel; ch != nil {
+		if !ch.canPost(u.id) {
+			return nil, tgerr.New(403, tg.ErrChatWriteForbidden)
+		}
+		msg := base
+		msg.ID = ch.nextMsgID()
+		msg.PeerID = &tg.PeerChannel{ChannelID: ch.id}
+		if ch.broadcast {
+			msg.Post = true
+		} else {
+			msg.FromID = &tg.PeerUser{UserID: u.id}
+		}
+		if _, ok := ch.messages[replyTo]; ok {
+			msg.ReplyTo = &tg.MessageReplyHeader{ReplyToMsgID: replyTo}
+		}
+
+		return s.updates(u.id,
+			&tg.UpdateMessageID{ID: msg.ID, RandomID: req.RandomID},
+			s.post(c, u, ch, &msg),
+		), nil
+	}
+
+	var replies map[int64]int
+	if m, ok := u.messages[replyTo]; ok {
+		replies = m.copies
+	}
+	result := s.deliver(c, u, s.deliveries(u, t), func(d delivery, id int) tg.MessageClass {
+		msg := base
+		msg.ID = id
+		msg.PeerID = d.peer
+		msg.Out = d.to == u
+		if t.chat != nil {
+			msg.FromID = &tg.PeerUser{UserID: u.id}
+		}
+		if reply, ok := replies[d.to.id]; ok {
+			msg.ReplyTo = &tg.MessageReplyHeader{ReplyToMsgID: reply}
+		}
+		return &msg
+	})
+
+	id := result[0].(*tg.UpdateNewMessage).Message.GetID()
+	return s.updates(u.id, append([]tg.UpdateClass{
+		&tg.UpdateMessageID{ID: id, RandomID: req.RandomID},
+	}, result...)...), nil
+}
+
+// edit returns edited copy of message.
+func edit(m tg.Message, req *tg.MessagesEditMessageRequest, date int) *tg.Message {
+	m.Flags = 0
+	if v, ok := req.GetMessage(); ok {
+		m.Message = v
+	}
+	if v, ok := req.GetEntities(); ok {
+		m.Entities = v
+	}
+	if v, ok := req.GetReplyMarkup(); ok {
+		m.ReplyMarkup = v
+	}
+	m.EditDate = date
+	return &m
+}
+
+func (s *Service) messagesEditMessage(ctx context.Context, req *tg.MessagesEditMessageRequest) (tg.UpdatesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.inputPeer(u, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.member(u); err != nil {
+		return nil, err
+	}
+	if v, ok := req.GetMessage(); ok && v == "" {
+		return nil, tgerr.New(400, tg.ErrMessageEmpty)
+	}
+
+	if ch := t.channel; ch != nil {
+		msg, ok := ch.messages[req.ID].(*tg.Message)
+		if !ok {
+			return nil, tgerr.New(400, tg.ErrMessageIDInvalid)
+		}
+		if !ch.out(msg.FromID, u.id) {
+			return nil, tgerr.New(403, tg.ErrMessageAuthorRequired)
+		}
+		if msg.Message == req.Message {
+			return nil, tgerr.New(400, tg.ErrMessageNotModified)
+		}
+
+		edited := edit(*msg, req, s.now())
+		ch.messages[edited.ID] = edited
+		upd := ch.box.add(1, func(pts int) tg.UpdateClass {
+			return &tg.UpdateEditChannelMessage{Message: edited, Pts: pts, PtsCount: 1}
+		})
+		for _, id := range sortedIDs(ch.members) {
+			s.push(c, id, ch.personalizeUpdate(upd, id))
+		}
+		return s.updates(u.id, ch.personalizeUpdate(upd, u.id)), nil
+	}
+
+	bm, ok := u.messages[req.ID]
+	if !ok || !samePeer(messagePeer(bm.msg), t.peer()) {
+		return nil, tgerr.New(400, tg.ErrMessageIDInvalid)
+	}
+	msg, ok := bm.msg.(*tg.Message)
+	if !ok {
+		return nil, tgerr.New(400, tg.ErrMessageIDInvalid)
+	}
+	if !msg.Out {
+		return nil, tgerr.New(403, tg.ErrMessageAuthorRequired)
+	}
+	if msg.Message == req.Message {
+		return nil, tgerr.New(400, tg.ErrMessageNotModified)
+	}
+
+	var result tg.UpdateClass
+	for _, ownerID := range sortedCopies(bm.copies) {
+		owner, ok := s.users[ownerID]
+		if !ok {
+			continue
+		}
+		m, ok := owner.messages[bm.copies[ownerID]]
+		if !ok {
+			continue
+		}
+		copied, ok := m.msg.(*tg.Message)
+		if !ok {
+			continue
+		}
+
+		edited := edit(*copied, req, s.now())
+		m.msg = edited
+		upd := owner.box.add(1, func(pts int) tg.UpdateClass {
+			return &tg.UpdateEditMessage{Message: edited, Pts: pts, PtsCount: 1}
+		})
+		if owner == u {
+			result = upd
+		}
+		s.push(c, owner.id, upd)
+	}
+	return s.updates(u.id, result), nil
+}
+
+func (s *Service) messagesDeleteMessages(
+	ctx context.Context,
+	req *tg.MessagesDeleteMessagesRequest,
+) (*tg.MessagesAffectedMessages, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleted message IDs by owner.
+	deleted := map[int64][]int{}
+	for _, id := range req.ID {
+		bm, ok := u.messages[id]
+		if !ok {
+			continue
+		}
+		owners := []int64{u.id}
+		if req.Revoke {
+			owners = sortedCopies(bm.copies)
+		}
+		for _, ownerID := range owners {
+			owner, ok := s.users[ownerID]
+			if !ok {
+				continue
+			}
+			copyID, ok := bm.copies[ownerID]
+			if !ok {
+				continue
+			}
+			delete(owner.messages, copyID)
+			delete(bm.copies, ownerID)
+			deleted[ownerID] = append(deleted[ownerID], copyID)
+		}
+	}
+
+	for ownerID, ids := range deleted {
+		owner := s.users[ownerID]
+		ids := ids
+		upd := owner.box.add(len(ids), func(pts int) tg.UpdateClass {
+			return &tg.UpdateDeleteMessages{Messages: ids, Pts: pts, PtsCount: len(ids)}
+		})
+		s.push(c, ownerID, upd)
+	}
+
+	return &tg.MessagesAffectedMessages{
+		Pts:      u.box.pts,
+		PtsCount: len(deleted[u.id]),
+	}, nil
+}
+
+func (s *Service) channelsDeleteMessages(
+	ctx context.Context,
+	req *tg.ChannelsDeleteMessagesRequest,
+) (*tg.MessagesAffectedMessages, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	c, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := s.inputChannel(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := (target{channel: ch}).member(u); err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for _, id := range req.ID {
+		m, ok := ch.messages[id]
+		if !ok {
+			continue
+		}
+		if ch.creator != u.id && !isFrom(messageFrom(m), u.id) {
+			return nil, tgerr.New(403, tg.ErrMessageDeleteForbidden)
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return &tg.MessagesAffectedMessages{Pts: ch.box.pts}, nil
+	}
+	for _, id := range ids {
+		delete(ch.messages, id)
+	}
+
+	upd := ch.box.add(len(ids), func(pts int) tg.UpdateClass {
+		return &tg.UpdateDeleteChannelMessages{
+			ChannelID: ch.id,
+			Messages:  ids,
+			Pts:       pts,
+			PtsCount:  len(ids),
+		}
+	})
+	for _, id := range sortedIDs(ch.members) {
+		s.push(c, id, upd)
+	}
+	return &tg.MessagesAffectedMessages{
+		Pts:      ch.box.pts,
+		PtsCount: len(ids),
+	}, nil
+}
+
+// page selects messages of history page. Messages must be sorted by ID
+// in descending order.
+func page(msgs []tg.MessageClass, req *tg.MessagesGetHistoryRequest) []tg.MessageClass {
+	start := 0
+	if req.OffsetID > 0 {
+		start = sort.Search(len(msgs), func(i int) bool {
+			return msgs[i].GetID() < req.OffsetID
+		})
+	}
+	start += req.AddOffset
+	if start < 0 {
+		start = 0
+	}
+	if start > len(msgs) {
+		start = len(msgs)
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > historyLimit {
+		limit = historyLimit
+	}
+	var r []tg.MessageClass
+	for _, m := range msgs[start:] {
+		if len(r) >= limit {
+			break
+		}
+		id := m.GetID()
+		if req.MaxID > 0 && id >= req.MaxID {
+			continue
+		}
+		if req.MinID > 0 && id <= req.MinID {
+			continue
+		}
+		r = append(r, m)
+	}
+	return r
+}
+
+func sortMessages(msgs []tg.MessageClass) {
+	sort.Slice(msgs, func(i, j int) bool {
+		return msgs[i].GetID() > msgs[j].GetID()
+	})
+}
+
+func (s *Service) messagesGetHistory(
+	ctx context.Context,
+	req *tg.MessagesGetHistoryRequest,
+) (tg.MessagesMessagesClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.inputPeer(u, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.member(u); err != nil {
+		return nil, err
+	}
+
+	var all []tg.MessageClass
+	if ch := t.channel; ch != nil {
+		for _, m := range ch.messages {
+			all = append(all, ch.personalize(m, u.id))
+		}
+	} else {
+		p := t.peer()
+		for _, m := range u.messages {
+			if samePeer(messagePeer(m.msg), p) {
+				all = append(all, m.msg)
+			}
+		}
+	}
+	sortMessages(all)
+
+	msgs := page(all, req)
+	e := newEntities()
+	e.peer(t.peer())
+	for _, m := range msgs {
+		e.message(m)
+	}
+	chats, users := s.chatsOf(e, u.id), s.usersOf(e, u.id)
+
+	if t.channel != nil {
+		return &tg.MessagesChannelMessages{
+			Pts:      t.channel.box.pts,
+			Count:    len(all),
+			Messages: msgs,
+			Chats:    chats,
+			Users:    users,
+		}, nil
+	}
+	if len(msgs) == len(all) {
+		return &tg.MessagesMessages{
+			Messages: msgs,
+			Chats:    chats,
+			Users:    users,
+		}, nil
+	}
+	return &tg.MessagesMessagesSlice{
+		Count:    len(all),
+		Messages: msgs,
+		Chats:    chats,
+		Users:    users,
+	}, nil
+}
+
+func (s *Service) messagesGetDialogs(
+	ctx context.Context,
+	req *tg.MessagesGetDialogsRequest,
+) (tg.MessagesDialogsClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		dialog *tg.Dialog
+		top    tg.MessageClass
+	}
+	var all []entry
+
+	tops := map[peerKey]tg.MessageClass{}
+	for _, m := range u.messages {
+		k := keyOf(messagePeer(m.msg))
+		if top, ok := tops[k]; !ok || top.GetID() < m.msg.GetID() {
+			tops[k] = m.msg
+		}
+	}
+	for _, top := range tops {
+		all = append(all, entry{
+			dialog: &tg.Dialog{Peer: messagePeer(top), TopMessage: top.GetID()},
+			top:    top,
+		})
+	}
+	for _, ch := range s.channels {
+		if _, ok := ch.members[u.id]; !ok {
+			continue
+		}
+		var top tg.MessageClass
+		for _, m := range ch.messages {
+			if top == nil || top.GetID() < m.GetID() {
+				top = m
+			}
+		}
+		if top == nil {
+			continue
+		}
+		all = append(all, entry{
+			dialog: &tg.Dialog{
+				Peer:       &tg.PeerChannel{ChannelID: ch.id},
+				TopMessage: top.GetID(),
+				Pts:        ch.box.pts,
+			},
+			top: ch.personalize(top, u.id),
+		})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		a, b := messageDate(all[i].top), messageDate(all[j].top)
+		if a != b {
+			return a > b
+		}
+		return keyOf(all[i].dialog.Peer).less(keyOf(all[j].dialog.Peer))
+	})
+
+	start := 0
+	if req.OffsetPeer != nil {
+		if _, empty := req.OffsetPeer.(*tg.InputPeerEmpty); !empty {
+			t, err := s.inputPeer(u, req.OffsetPeer)
+			if err != nil {
+				return nil, err
+			}
+			k := keyOf(t.peer())
+			for i, e := range all {
+				if keyOf(e.dialog.Peer) == k {
+					start = i + 1
+					break
+				}
+			}
+		}
+	}
+	limit := req.Limit
+	if limit <= 0 || limit > historyLimit {
+		limit = historyLimit
+	}
+	end := start + limit
+	if end > len(all) {
+		end = len(all)
+	}
+
+	var (
+		dialogs  []tg.DialogClass
+		messages []tg.MessageClass
+		e        = newEntities()
+	)
+	for _, d := range all[start:end] {
+		dialogs = append(dialogs, d.dialog)
+		messages = append(messages, d.top)
+		e.peer(d.dialog.Peer)
+		e.message(d.top)
+	}
+	chats, users := s.chatsOf(e, u.id), s.usersOf(e, u.id)
+
+	if len(dialogs) == len(all) {
+		return &tg.MessagesDialogs{
+			Dialogs:  dialogs,
+			Messages: messages,
+			Chats:    chats,
+			Users:    users,
+		}, nil
+	}
+	return &tg.MessagesDialogsSlice{
+		Count:    len(all),
+		Dialogs:  dialogs,
+		Messages: messages,
+		Chats:    chats,
+		Users:    users,
+	}, nil
+}
+
+func sortedCopies(copies map[int64]int) []int64 {
+	r := make([]int64, 0, len(copies))
+	for id := range copies {
+		r = append(r, id)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+func messagePeer(m tg.MessageClass) tg.PeerClass {
+	switch m := m.(type) {
+	case *tg.Message:
+		return m.PeerID
+	case *tg.MessageService:
+		return m.PeerID
+	default:
+		return nil
+	}
+}
+
+func messageFrom(m tg.MessageClass) tg.PeerClass {
+	switch m := m.(type) {
+	case *tg.Message:
+		return m.FromID
+	case *tg.MessageService:
+		return m.FromID
+	default:
+		return nil
+	}
+}
+
+func messageDate(m tg.MessageClass) int {
+	switch m := m.(type) {
+	case *tg.Message:
+		return m.Date
+	case *tg.MessageService:
+		return m.Date
+	default:
+		return 0
+	}
+}
+
+// peerKey is a comparable peer.
+type peerKey struct {
+	kind int
+	id   int64
+}
+
+func (k peerKey) less(b peerKey) bool {
+	if k.kind != b.kind {
+		return k.kind < b.kind
+	}
+	return k.id < b.id
+}
+
+func keyOf(p tg.PeerClass) peerKey {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		return peerKey{kind: 1, id: p.UserID}
+	case *tg.PeerChat:
+		return peerKey{kind: 2, id: p.ChatID}
+	case *tg.PeerChannel:
+		return peerKey{kind: 3, id: p.ChannelID}
+	default:
+		return peerKey{}
+	}
+}
+
+func samePeer(a, b tg.PeerClass) bool {
+	k := keyOf(a)
+	return k != peerKey{} && k == keyOf(b)
+}
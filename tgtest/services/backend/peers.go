@@ -0,0 +1,274 @@
+package backend
+
+import (
+	"sort"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// entities collects users and chats to return along with result.
+type entities struct {
+	users    map[int64]struct{}
+	chats    map[int64]struct{}
+	channels map[int64]struct{}
+}
+
+func newEntities() *entities {
+	return &entities{
+		users:    map[int64]struct{}{},
+		chats:    map[int64]struct{}{},
+		channels: map[int64]struct{}{},
+	}
+}
+
+func (e *entities) peer(p tg.PeerClass) {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		e.users[p.UserID] = struct{}{}
+	case *tg.PeerChat:
+		e.chats[p.ChatID] = struct{}{}
+	case *tg.PeerChannel:
+		e.channels[p.ChannelID] = struct{}{}
+	}
+}
+
+func (e *entities) message(m tg.MessageClass) {
+	switch m := m.(type) {
+	case *tg.Message:
+		e.peer(m.PeerID)
+		if m.FromID != nil {
+			e.peer(m.FromID)
+		}
+	case *tg.MessageService:
+		e.peer(m.PeerID)
+		if m.FromID != nil {
+			e.peer(m.FromID)
+		}
+		switch a := m.Action.(type) {
+		case *tg.MessageActionChatCreate:
+			for _, id := range a.Users {
+				e.users[id] = struct{}{}
+			}
+		case *tg.MessageActionChatAddUser:
+			for _, id := range a.Users {
+				e.users[id] = struct{}{}
+			}
+		}
+	}
+}
+
+func (e *entities) update(u tg.UpdateClass) {
+	switch u := u.(type) {
+	case *tg.UpdateNewMessage:
+		e.message(u.Message)
+	case *tg.UpdateEditMessage:
+		e.message(u.Message)
+	case *tg.UpdateNewChannelMessage:
+		e.message(u.Message)
+	case *tg.UpdateEditChannelMessage:
+		e.message(u.Message)
+	case *tg.UpdateChannel:
+		e.channels[u.ChannelID] = struct{}{}
+	case *tg.UpdateDeleteChannelMessages:
+		e.channels[u.ChannelID] = struct{}{}
+	case *tg.UpdateChatParticipant:
+		e.chats[u.ChatID] = struct{}{}
+		e.users[u.ActorID] = struct{}{}
+		e.users[u.UserID] = struct{}{}
+	case *tg.UpdateChannelParticipant:
+		e.channels[u.ChannelID] = struct{}{}
+		e.users[u.ActorID] = struct{}{}
+		e.users[u.UserID] = struct{}{}
+	}
+}
+
+func sortedIDs(m map[int64]struct{}) []int64 {
+	r := make([]int64, 0, len(m))
+	for id := range m {
+		r = append(r, id)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// usersOf returns collected users as seen by viewer.
+//
+// Must be called with s.mux held.
+func (s *Service) usersOf(e *entities, viewer int64) []tg.UserClass {
+	r := make([]tg.UserClass, 0, len(e.users))
+	for _, id := range sortedIDs(e.users) {
+		if u, ok := s.users[id]; ok {
+			r = append(r, u.object(viewer))
+		}
+	}
+	return r
+}
+
+// chatsOf returns collected chats and channels as seen by viewer.
+//
+// Must be called with s.mux held.
+func (s *Service) chatsOf(e *entities, viewer int64) []tg.ChatClass {
+	r := make([]tg.ChatClass, 0, len(e.chats)+len(e.channels))
+	for _, id := range sortedIDs(e.chats) {
+		if c, ok := s.chats[id]; ok {
+			r = append(r, c.object(viewer))
+		}
+	}
+	for _, id := range sortedIDs(e.channels) {
+		if c, ok := s.channels[id]; ok {
+			r = append(r, c.object(viewer))
+		}
+	}
+	return r
+}
+
+// updates creates tg.Updates with entities as seen by viewer.
+//
+// Must be called with s.mux held.
+func (s *Service) updates(viewer int64, updates ...tg.UpdateClass) *tg.Updates {
+	e := newEntities()
+	for _, u := range updates {
+		e.update(u)
+	}
+	return &tg.Updates{
+		Updates: updates,
+		Users:   s.usersOf(e, viewer),
+		Chats:   s.chatsOf(e, viewer),
+		Date:    s.now(),
+	}
+}
+
+// push queues updates to send to user after result.
+//
+// Updates of one call to the same user are sent in a single container,
+// which gets next seq of user.
+//
+// Must be called with s.mux held.
+func (s *Service) push(c *call, to int64, updates ...tg.UpdateClass) {
+	for i, p := range c.pushes {
+		if p.to != to {
+			continue
+		}
+		merged := s.updates(to, append(p.updates.Updates, updates...)...)
+		merged.Seq = p.updates.Seq
+		c.pushes[i].updates = merged
+		return
+	}
+
+	r := s.updates(to, updates...)
+	if u, ok := s.users[to]; ok {
+		u.seq++
+		r.Seq = u.seq
+	}
+	c.pushes = append(c.pushes, push{
+		to:      to,
+		updates: r,
+	})
+}
+
+// target is a resolved peer.
+type target struct {
+	user    *user
+	chat    *chat
+	channel *channel
+}
+
+// peer returns peer of target as seen from private chat with it.
+func (t target) peer() tg.PeerClass {
+	switch {
+	case t.chat != nil:
+		return &tg.PeerChat{ChatID: t.chat.id}
+	case t.channel != nil:
+		return &tg.PeerChannel{ChannelID: t.channel.id}
+	default:
+		return &tg.PeerUser{UserID: t.user.id}
+	}
+}
+
+// Must be called with s.mux held.
+func (s *Service) userByID(id int64, accessHash int64, checkHash bool) (*user, error) {
+	u, ok := s.users[id]
+	if !ok || (checkHash && u.accessHash != accessHash) {
+		return nil, tgerr.New(400, tg.ErrUserIDInvalid)
+	}
+	return u, nil
+}
+
+// Must be called with s.mux held.
+func (s *Service) channelByID(id int64, accessHash int64, checkHash bool) (*channel, error) {
+	c, ok := s.channels[id]
+	if !ok || (checkHash && c.accessHash != accessHash) {
+		return nil, tgerr.New(400, tg.ErrChannelInvalid)
+	}
+	return c, nil
+}
+
+// Must be called with s.mux held.
+func (s *Service) inputUser(viewer *user, in tg.InputUserClass) (*user, error) {
+	switch in := in.(type) {
+	case *tg.InputUserSelf:
+		return viewer, nil
+	case *tg.InputUser:
+		return s.userByID(in.UserID, in.AccessHash, true)
+	case *tg.InputUserFromMessage:
+		return s.userByID(in.UserID, 0, false)
+	default:
+		return nil, tgerr.New(400, tg.ErrUserIDInvalid)
+	}
+}
+
+// Must be called with s.mux held.
+func (s *Service) inputChannel(in tg.InputChannelClass) (*channel, error) {
+	switch in := in.(type) {
+	case *tg.InputChannel:
+		return s.channelByID(in.ChannelID, in.AccessHash, true)
+	case *tg.InputChannelFromMessage:
+		return s.channelByID(in.ChannelID, 0, false)
+	default:
+		return nil, tgerr.New(400, tg.ErrChannelInvalid)
+	}
+}
+
+// Must be called with s.mux held.
+func (s *Service) inputPeer(viewer *user, in tg.InputPeerClass) (t target, err error) {
+	switch in := in.(type) {
+	case *tg.InputPeerSelf:
+		t.user = viewer
+	case *tg.InputPeerUser:
+		t.user, err = s.userByID(in.UserID, in.AccessHash, true)
+	case *tg.InputPeerUserFromMessage:
+		t.user, err = s.userByID(in.UserID, 0, false)
+	case *tg.InputPeerChat:
+		c, ok := s.chats[in.ChatID]
+		if !ok {
+			return t, tgerr.New(400, tg.ErrPeerIDInvalid)
+		}
+		t.chat = c
+	case *tg.InputPeerChannel:
+		t.channel, err = s.channelByID(in.ChannelID, in.AccessHash, true)
+	case *tg.InputPeerChannelFromMessage:
+		t.channel, err = s.channelByID(in.ChannelID, 0, false)
+	default:
+		return t, tgerr.New(400, tg.ErrPeerIDInvalid)
+	}
+	if err != nil {
+		return t, tgerr.New(400, tg.ErrPeerIDInvalid)
+	}
+	return t, nil
+}
+
+// member checks that viewer is a member of chat or channel.
+func (t target) member(viewer *user) error {
+	switch {
+	case t.chat != nil:
+		if _, ok := t.chat.members[viewer.id]; !ok {
+			return tgerr.New(400, tg.ErrPeerIDInvalid)
+		}
+	case t.channel != nil:
+		if _, ok := t.channel.members[viewer.id]; !ok {
+			return tgerr.New(406, tg.ErrChannelPrivate)
+		}
+	}
+	return nil
+}
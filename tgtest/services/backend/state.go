@@ -0,0 +1,218 @@
+package backend
+
+import (
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgtest"
+)
+
+// Profile is a public profile of user or bot.
+type Profile struct {
+	FirstName string
+	LastName  string
+	Username  string
+	// Phone is a phone number of user, used to sign in.
+	// Bots have no phone.
+	Phone string
+}
+
+type session struct {
+	server  *tgtest.Server
+	session tgtest.Session
+}
+
+// event is an entry of update box.
+type event struct {
+	pts      int
+	ptsCount int
+	update   tg.UpdateClass
+}
+
+// box is a sequence of updates with pts or qts.
+type box struct {
+	pts    int
+	events []event
+}
+
+// add adds update to box. New pts or qts is passed to f.
+func (b *box) add(count int, f func(pts int) tg.UpdateClass) tg.UpdateClass {
+	b.pts += count
+	u := f(b.pts)
+	b.events = append(b.events, event{
+		pts:      b.pts,
+		ptsCount: count,
+		update:   u,
+	})
+	return u
+}
+
+// since returns events after given pts.
+func (b *box) since(pts int) []event {
+	for i, e := range b.events {
+		if e.pts > pts {
+			return b.events[i:]
+		}
+	}
+	return nil
+}
+
+// boxMessage is a copy of private chat or basic group message in user box.
+type boxMessage struct {
+	msg tg.MessageClass
+	// copies is a set of message IDs of all copies by user ID,
+	// shared between copies.
+	copies map[int64]int
+}
+
+type user struct {
+	id         int64
+	accessHash int64
+	profile    Profile
+	bot        bool
+	token      string
+
+	box       box
+	qts       box // only bots have qts updates
+	seq       int // seq of last pushed updates
+	lastMsgID int
+	messages  map[int]*boxMessage
+
+	sessions map[int64]session // by MTProto session ID
+}
+
+func (u *user) nextMsgID() int {
+	u.lastMsgID++
+	return u.lastMsgID
+}
+
+// object returns tg.User as seen by viewer.
+func (u *user) object(viewer int64) *tg.User {
+	r := &tg.User{
+		Self:       u.id == viewer,
+		Bot:        u.bot,
+		ID:         u.id,
+		AccessHash: u.accessHash,
+		FirstName:  u.profile.FirstName,
+		LastName:   u.profile.LastName,
+		Username:   u.profile.Username,
+	}
+	if r.Self {
+		r.Phone = u.profile.Phone
+	}
+	return r
+}
+
+type chat struct {
+	id      int64
+	title   string
+	date    int
+	creator int64
+	version int
+	members map[int64]struct{}
+}
+
+func (c *chat) object(viewer int64) *tg.Chat {
+	_, member := c.members[viewer]
+	return &tg.Chat{
+		Creator:           c.creator == viewer,
+		Left:              !member,
+		ID:                c.id,
+		Title:             c.title,
+		Photo:             &tg.ChatPhotoEmpty{},
+		ParticipantsCount: len(c.members),
+		Date:              c.date,
+		Version:           c.version,
+	}
+}
+
+type channel struct {
+	id         int64
+	accessHash int64
+	title      string
+	username   string
+	broadcast  bool
+	date       int
+	creator    int64
+	members    map[int64]struct{}
+
+	box       box
+	lastMsgID int
+	messages  map[int]tg.MessageClass
+}
+
+func (c *channel) nextMsgID() int {
+	c.lastMsgID++
+	return c.lastMsgID
+}
+
+func (c *channel) object(viewer int64) *tg.Channel {
+	_, member := c.members[viewer]
+	return &tg.Channel{
+		Creator:           c.creator == viewer,
+		Left:              !member,
+		Broadcast:         c.broadcast,
+		Megagroup:         !c.broadcast,
+		ID:                c.id,
+		AccessHash:        c.accessHash,
+		Title:             c.title,
+		Username:          c.username,
+		Photo:             &tg.ChatPhotoEmpty{},
+		Date:              c.date,
+		ParticipantsCount: len(c.members),
+	}
+}
+
+func (c *channel) canPost(userID int64) bool {
+	if _, ok := c.members[userID]; !ok {
+		return false
+	}
+	return !c.broadcast || c.creator == userID
+}
+
+// out reports whether channel message is outgoing for viewer.
+func (c *channel) out(from tg.PeerClass, viewer int64) bool {
+	if c.broadcast {
+		return c.creator == viewer
+	}
+	return isFrom(from, viewer)
+}
+
+// personalize returns copy of channel message as seen by viewer.
+//
+// Flags are reset, because encoder sets them from fields, but never clears.
+func (c *channel) personalize(m tg.MessageClass, viewer int64) tg.MessageClass {
+	switch m := m.(type) {
+	case *tg.Message:
+		r := *m
+		r.Flags = 0
+		r.Out = c.out(m.FromID, viewer)
+		return &r
+	case *tg.MessageService:
+		r := *m
+		r.Flags = 0
+		r.Out = c.out(m.FromID, viewer)
+		return &r
+	default:
+		return m
+	}
+}
+
+// personalizeUpdate returns copy of channel update as seen by viewer.
+func (c *channel) personalizeUpdate(u tg.UpdateClass, viewer int64) tg.UpdateClass {
+	switch u := u.(type) {
+	case *tg.UpdateNewChannelMessage:
+		r := *u
+		r.Message = c.personalize(u.Message, viewer)
+		return &r
+	case *tg.UpdateEditChannelMessage:
+		r := *u
+		r.Message = c.personalize(u.Message, viewer)
+		return &r
+	default:
+		return u
+	}
+}
+
+func isFrom(from tg.PeerClass, userID int64) bool {
+	p, ok := from.(*tg.PeerUser)
+	return ok && p.UserID == userID
+}
@@ -0,0 +1,134 @@
+package backend
+
+import (
+	"context"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// Must be called with s.mux held.
+func (s *Service) state(u *user) tg.UpdatesState {
+	return tg.UpdatesState{
+		Pts:  u.box.pts,
+		Qts:  u.qts.pts,
+		Date: s.now(),
+		Seq:  u.seq,
+	}
+}
+
+func (s *Service) updatesGetState(ctx context.Context) (*tg.UpdatesState, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state := s.state(u)
+	return &state, nil
+}
+
+// split splits events to new messages and other updates.
+func split(events []event, f func(u tg.UpdateClass) tg.UpdateClass) (
+	msgs []tg.MessageClass,
+	other []tg.UpdateClass,
+	e *entities,
+) {
+	e = newEntities()
+	msgs = []tg.MessageClass{}
+	other = []tg.UpdateClass{}
+	for _, ev := range events {
+		u := f(ev.update)
+		e.update(u)
+		switch u := u.(type) {
+		case *tg.UpdateNewMessage:
+			msgs = append(msgs, u.Message)
+		case *tg.UpdateNewChannelMessage:
+			msgs = append(msgs, u.Message)
+		default:
+			other = append(other, u)
+		}
+	}
+	return msgs, other, e
+}
+
+func (s *Service) updatesGetDifference(
+	ctx context.Context,
+	req *tg.UpdatesGetDifferenceRequest,
+) (tg.UpdatesDifferenceClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []event
+	events = append(events, u.box.since(req.Pts)...)
+	events = append(events, u.qts.since(req.Qts)...)
+	if len(events) == 0 {
+		return &tg.UpdatesDifferenceEmpty{Date: s.now(), Seq: u.seq}, nil
+	}
+
+	msgs, other, e := split(events, func(u tg.UpdateClass) tg.UpdateClass { return u })
+	return &tg.UpdatesDifference{
+		NewMessages:          msgs,
+		NewEncryptedMessages: []tg.EncryptedMessageClass{},
+		OtherUpdates:         other,
+		Chats:                s.chatsOf(e, u.id),
+		Users:                s.usersOf(e, u.id),
+		State:                s.state(u),
+	}, nil
+}
+
+func (s *Service) updatesGetChannelDifference(
+	ctx context.Context,
+	req *tg.UpdatesGetChannelDifferenceRequest,
+) (tg.UpdatesChannelDifferenceClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, u, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := s.inputChannel(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ch.members[u.id]; !ok {
+		return nil, tgerr.New(406, tg.ErrChannelPrivate)
+	}
+
+	events := ch.box.since(req.Pts)
+	if len(events) == 0 {
+		return &tg.UpdatesChannelDifferenceEmpty{
+			Final: true,
+			Pts:   ch.box.pts,
+		}, nil
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > historyLimit {
+		limit = historyLimit
+	}
+	final := len(events) <= limit
+	if !final {
+		events = events[:limit]
+	}
+
+	msgs, other, e := split(events, func(upd tg.UpdateClass) tg.UpdateClass {
+		return ch.personalizeUpdate(upd, u.id)
+	})
+	e.channels[ch.id] = struct{}{}
+	return &tg.UpdatesChannelDifference{
+		Final:        final,
+		Pts:          events[len(events)-1].pts,
+		NewMessages:  msgs,
+		OtherUpdates: other,
+		Chats:        s.chatsOf(e, u.id),
+		Users:        s.usersOf(e, u.id),
+	}, nil
+}
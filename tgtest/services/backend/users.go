@@ -0,0 +1,82 @@
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+func (s *Service) usersGetUsers(ctx context.Context, ids []tg.InputUserClass) ([]tg.UserClass, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, viewer, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]tg.UserClass, 0, len(ids))
+	for _, id := range ids {
+		u, err := s.inputUser(viewer, id)
+		if err != nil {
+			continue
+		}
+		r = append(r, u.object(viewer.id))
+	}
+	return r, nil
+}
+
+func (s *Service) usersGetFullUser(ctx context.Context, id tg.InputUserClass) (*tg.UsersUserFull, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, viewer, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.inputUser(viewer, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tg.UsersUserFull{
+		FullUser: tg.UserFull{
+			ID:             u.id,
+			Settings:       tg.PeerSettings{},
+			NotifySettings: tg.PeerNotifySettings{},
+		},
+		Users: []tg.UserClass{u.object(viewer.id)},
+	}, nil
+}
+
+func (s *Service) contactsResolveUsername(ctx context.Context, username string) (*tg.ContactsResolvedPeer, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, viewer, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if username != "" {
+		for _, u := range s.users {
+			if strings.EqualFold(u.profile.Username, username) {
+				return &tg.ContactsResolvedPeer{
+					Peer:  &tg.PeerUser{UserID: u.id},
+					Users: []tg.UserClass{u.object(viewer.id)},
+				}, nil
+			}
+		}
+		for _, c := range s.channels {
+			if strings.EqualFold(c.username, username) {
+				return &tg.ContactsResolvedPeer{
+					Peer:  &tg.PeerChannel{ChannelID: c.id},
+					Chats: []tg.ChatClass{c.object(viewer.id)},
+				}, nil
+			}
+		}
+	}
+	return nil, tgerr.New(400, tg.ErrUsernameNotOccupied)
+}
@@ -55,10 +55,18 @@ func validatePartSize(got, stored int) *tgerr.Error {
 	}
 }
 
+type userIDKey struct{}
+
+// WithUserID returns new context with ID of user who uploads file.
+//
+// Uploaded file can be downloaded using InputFileLocation with LocalID
+// set to user ID and VolumeID set to file ID. Defaults to 10.
+func WithUserID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, id)
+}
+
 func (m *Service) write(ctx context.Context, request upload) (err error) {
-	// TODO(tdakkota): Better way to handle user id. For now we haven't auth service to pair
-	//  user ID and authkey
-	id, ok := ctx.Value("user_id").(int)
+	id, ok := ctx.Value(userIDKey{}).(int64)
 	if !ok {
 		id = 10
 	}
@@ -0,0 +1,64 @@
+package tgtest
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+type callKey struct{}
+
+type call struct {
+	server *Server
+	req    *Request
+}
+
+// RequestFromContext returns server and request of RPC call handled by
+// typed handler, see ServerHandler.
+func RequestFromContext(ctx context.Context) (*Server, *Request, bool) {
+	c, ok := ctx.Value(callKey{}).(call)
+	if !ok {
+		return nil, nil, false
+	}
+	return c.server, c.req, true
+}
+
+// ServerHandler creates new Handler which uses given typed dispatcher.
+//
+// Result of typed handler is sent as RPC result and returned *tgerr.Error
+// is sent as RPC error. Server and request can be obtained from handler
+// context using RequestFromContext.
+func ServerHandler(d *tg.ServerDispatcher) Handler {
+	return HandlerFunc(func(server *Server, req *Request) error {
+		ctx := context.WithValue(req.RequestCtx, callKey{}, call{
+			server: server,
+			req:    req,
+		})
+
+		result, err := d.Handle(ctx, req.Buf)
+		if err != nil {
+			if rpcErr, ok := tgerr.As(err); ok {
+				return server.SendErr(req, rpcErr)
+			}
+			return errors.Wrap(err, "handle")
+		}
+
+		return server.SendResult(req, result)
+	})
+}
+
+// HandleServer sets handler of every method registered in given typed
+// dispatcher.
+//
+// Handlers must be set before call, methods registered in typed dispatcher
+// after call are handled only by fallback.
+func (d *Dispatcher) HandleServer(s *tg.ServerDispatcher) *Dispatcher {
+	h := ServerHandler(s)
+	for _, id := range s.TypeIDs() {
+		d.Handle(id, h)
+	}
+	return d
+}
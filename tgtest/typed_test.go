@@ -0,0 +1,92 @@
+package tgtest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/gotd/td/session"
+	"github.com/gotd/td/tdsync"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/gotd/td/tgtest"
+	"github.com/gotd/td/tgtest/cluster"
+)
+
+func TestServerHandler(t *testing.T) {
+	a := require.New(t)
+	log := zaptest.NewLogger(t)
+	defer func() { _ = log.Sync() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	d := tg.NewServerDispatcher(nil)
+	d.OnUsersGetUsers(func(ctx context.Context, id []tg.InputUserClass) ([]tg.UserClass, error) {
+		_, req, ok := tgtest.RequestFromContext(ctx)
+		if !ok {
+			return nil, errors.New("no request in context")
+		}
+		return []tg.UserClass{&tg.User{ID: int64(req.DC)}}, nil
+	})
+	d.OnContactsResolveUsername(func(ctx context.Context, username string) (*tg.ContactsResolvedPeer, error) {
+		return nil, tgerr.New(400, tg.ErrUsernameNotOccupied)
+	})
+	d.OnAccountUpdateStatus(func(ctx context.Context, offline bool) (bool, error) {
+		return offline, nil
+	})
+
+	g := tdsync.NewCancellableGroup(ctx)
+	c := cluster.NewCluster(cluster.Options{
+		Logger: log.Named("cluster"),
+	})
+	c.Dispatch(2, "server").HandleServer(d)
+	g.Go(c.Up)
+
+	g.Go(func(ctx context.Context) error {
+		select {
+		case <-c.Ready():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		defer g.Cancel()
+
+		client := telegram.NewClient(telegram.TestAppID, telegram.TestAppHash, telegram.Options{
+			PublicKeys:     c.Keys(),
+			DC:             2,
+			DCList:         c.List(),
+			Resolver:       c.Resolver(),
+			Logger:         log.Named("client"),
+			SessionStorage: &session.StorageMemory{},
+		})
+
+		return client.Run(ctx, func(ctx context.Context) error {
+			api := client.API()
+
+			users, err := api.UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+			a.NoError(err)
+			a.Equal([]tg.UserClass{&tg.User{ID: 2}}, users)
+
+			_, err = api.ContactsResolveUsername(ctx, "gotd")
+			a.True(tgerr.Is(err, tg.ErrUsernameNotOccupied), err)
+
+			ok, err := api.AccountUpdateStatus(ctx, true)
+			a.NoError(err)
+			a.True(ok)
+			ok, err = api.AccountUpdateStatus(ctx, false)
+			a.NoError(err)
+			a.False(ok)
+
+			return nil
+		})
+	})
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		a.NoError(err)
+	}
+}
@@ -0,0 +1,183 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/tdsync"
+)
+
+// HTTPOptions is HTTP transport connection options.
+type HTTPOptions struct {
+	// Client is HTTP client to use.
+	//
+	// Proxy settings of client transport are respected, so HTTP transport
+	// can be used in networks where raw TCP is blocked.
+	// Defaults to http.DefaultClient.
+	Client *http.Client
+	// MaxWait is the maximum time server may hold http_wait request
+	// before responding. Defaults to 25 seconds.
+	MaxWait time.Duration
+}
+
+func (opt *HTTPOptions) setDefaults() {
+	if opt.Client == nil {
+		opt.Client = http.DefaultClient
+	}
+	if opt.MaxWait == 0 {
+		opt.MaxWait = 25 * time.Second
+	}
+}
+
+// maxHTTPMessageSize is maximum size of HTTP response body.
+const maxHTTPMessageSize = 1 << 24 // 16 MB
+
+var _ Conn = (*httpConn)(nil)
+
+// httpConn is MTProto connection over HTTP.
+//
+// Every sent message is POST request, response body (if any) is queued
+// to be returned by Recv.
+type httpConn struct {
+	client  *http.Client
+	url     string
+	maxWait time.Duration
+
+	mux    sync.Mutex
+	queue  [][]byte
+	notify chan struct{}
+
+	closed *tdsync.Ready
+}
+
+// HTTP creates new MTProto connection over HTTP using given endpoint URL,
+// like "http://149.154.167.50:80/api".
+//
+// Server is able to send messages only as responses to client requests,
+// so client should send http_wait to receive updates.
+//
+// See https://core.telegram.org/mtproto/transports#http.
+func HTTP(url string, opts HTTPOptions) Conn {
+	opts.setDefaults()
+	return &httpConn{
+		client:  opts.Client,
+		url:     url,
+		maxWait: opts.MaxWait,
+		notify:  make(chan struct{}, 1),
+		closed:  tdsync.NewReady(),
+	}
+}
+
+// MaxWait returns maximum time server may hold http_wait request.
+func (c *httpConn) MaxWait() time.Duration {
+	return c.maxWait
+}
+
+// Send sends message from buffer as POST request and queues response.
+func (c *httpConn) Send(ctx context.Context, b *bin.Buffer) error {
+	select {
+	case <-c.closed.Ready():
+		return net.ErrClosed
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(b.Copy()))
+	if err != nil {
+		return errors.Wrap(err, "create request")
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send request")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPMessageSize+1))
+	if err != nil {
+		return errors.Wrap(err, "read response")
+	}
+	switch {
+	case len(body) > maxHTTPMessageSize:
+		return errors.Errorf("response is too big (%d > %d)", len(body), maxHTTPMessageSize)
+	case resp.StatusCode != http.StatusOK && len(body) != bin.Word:
+		// Protocol errors are passed as 4-byte body, otherwise
+		// error is not related to MTProto.
+		return errors.Errorf("unexpected status %q", resp.Status)
+	case len(body) == 0:
+		// Server has nothing to send.
+		return nil
+	}
+
+	c.mux.Lock()
+	c.queue = append(c.queue, body)
+	c.mux.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (c *httpConn) pop() ([]byte, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if len(c.queue) == 0 {
+		return nil, false
+	}
+	data := c.queue[0]
+	c.queue[0] = nil
+	c.queue = c.queue[1:]
+	if len(c.queue) > 0 {
+		// Wake up next receiver.
+		select {
+		case c.notify <- struct{}{}:
+		default:
+		}
+	}
+	return data, true
+}
+
+// Recv waits for the next received message.
+func (c *httpConn) Recv(ctx context.Context, b *bin.Buffer) error {
+	for {
+		if data, ok := c.pop(); ok {
+			b.ResetTo(data)
+			if b.Len() == bin.Word {
+				code, err := b.Int32()
+				if err != nil {
+					return err
+				}
+				return &codec.ProtocolErr{Code: -code}
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.closed.Ready():
+			return net.ErrClosed
+		case <-c.notify:
+		}
+	}
+}
+
+// Close closes connection.
+func (c *httpConn) Close() error {
+	c.closed.Signal()
+	return nil
+}
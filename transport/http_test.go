@@ -0,0 +1,74 @@
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/proto/codec"
+	"github.com/gotd/td/transport"
+)
+
+func TestHTTP(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch string(body) {
+		case "404":
+			var b bin.Buffer
+			b.PutInt32(-codec.CodeAuthKeyNotFound)
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write(b.Buf)
+		case "empty":
+		case "bad":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			_, _ = w.Write(body)
+		}
+	}))
+	defer srv.Close()
+
+	conn := transport.HTTP(srv.URL+"/api", transport.HTTPOptions{
+		Client: srv.Client(),
+	})
+	defer func() {
+		a.NoError(conn.Close())
+	}()
+
+	// Echo.
+	a.NoError(conn.Send(ctx, &bin.Buffer{Buf: []byte("hello")}))
+	a.NoError(conn.Send(ctx, &bin.Buffer{Buf: []byte("world")}))
+	var b bin.Buffer
+	a.NoError(conn.Recv(ctx, &b))
+	a.Equal("hello", string(b.Buf))
+	a.NoError(conn.Recv(ctx, &b))
+	a.Equal("world", string(b.Buf))
+
+	// Nothing to receive.
+	a.NoError(conn.Send(ctx, &bin.Buffer{Buf: []byte("empty")}))
+	recvCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	a.ErrorIs(conn.Recv(recvCtx, &b), context.Canceled)
+
+	// Protocol error.
+	a.NoError(conn.Send(ctx, &bin.Buffer{Buf: []byte("404")}))
+	var protoErr *codec.ProtocolErr
+	a.True(errors.As(conn.Recv(ctx, &b), &protoErr))
+	a.Equal(int32(codec.CodeAuthKeyNotFound), protoErr.Code)
+
+	// Non-MTProto error.
+	a.Error(conn.Send(ctx, &bin.Buffer{Buf: []byte("bad")}))
+}